@@ -2,20 +2,28 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
 	"github.com/vblanchet22/back_coloc/internal/auth"
 	"github.com/vblanchet22/back_coloc/internal/config"
+	"github.com/vblanchet22/back_coloc/internal/constants"
 	handler "github.com/vblanchet22/back_coloc/internal/grpc"
 	"github.com/vblanchet22/back_coloc/internal/repository/postgres"
+	"github.com/vblanchet22/back_coloc/internal/scheduler"
 	"github.com/vblanchet22/back_coloc/internal/service"
+	"github.com/vblanchet22/back_coloc/internal/storage"
 	pb "github.com/vblanchet22/back_coloc/proto/pb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -30,12 +38,21 @@ type server struct {
 	userHandler         *handler.UserHandler
 	colocationHandler   *handler.ColocationHandler
 	categoryHandler     *handler.CategoryHandler
+	tagHandler          *handler.TagHandler
+	budgetHandler       *handler.BudgetHandler
 	expenseHandler      *handler.ExpenseHandler
+	attachmentHandler   *handler.AttachmentHandler
 	balanceHandler      *handler.BalanceHandler
 	paymentHandler      *handler.PaymentHandler
+	settlementHandler   *handler.SettlementHandler
+	exchangeRateHandler *handler.ExchangeRateHandler
+	statementHandler    *handler.StatementHandler
+	exportHandler       *handler.ExportHandler
 	decisionHandler     *handler.DecisionHandler
 	fundHandler         *handler.FundHandler
+	eventHandler        *handler.EventHandler
 	notificationHandler *handler.NotificationHandler
+	searchHandler       *handler.SearchHandler
 }
 
 func main() {
@@ -45,6 +62,10 @@ func main() {
 
 	cfg := config.Load()
 
+	// Cancelled on SIGINT/SIGTERM to stop the servers and the background jobs
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	// Connect to database
 	pool, err := postgres.Connect(&cfg.Database)
 	if err != nil {
@@ -63,36 +84,68 @@ func main() {
 	authRepo := postgres.NewAuthRepository(pool)
 	colocationRepo := postgres.NewColocationRepository(pool)
 	categoryRepo := postgres.NewCategoryRepository(pool)
+	tagRepo := postgres.NewTagRepository(pool)
+	budgetRepo := postgres.NewBudgetRepository(pool)
 	expenseRepo := postgres.NewExpenseRepository(pool)
 	balanceRepo := postgres.NewBalanceRepository(pool)
 	paymentRepo := postgres.NewPaymentRepository(pool)
 	decisionRepo := postgres.NewDecisionRepository(pool)
 	fundRepo := postgres.NewFundRepository(pool)
+	eventRepo := postgres.NewEventRepository(pool)
 	notificationRepo := postgres.NewNotificationRepository(pool)
+	settlementRepo := postgres.NewSettlementRepository(pool)
+	attachmentRepo := postgres.NewAttachmentRepository(pool)
+	exchangeRateRepo := postgres.NewExchangeRateRepository(pool)
+	searchRepo := postgres.NewSearchRepository(pool)
+
+	// Initialize file storage
+	blobStore, err := storage.NewLocalStore(cfg.Storage.AttachmentsDir)
+	if err != nil {
+		log.Fatalf("Erreur d'initialisation du stockage: %v", err)
+	}
 
 	// Initialize services
 	authService := service.NewAuthService(authRepo, jwtManager)
 	userService := service.NewUserService(authRepo)
-	colocationService := service.NewColocationService(colocationRepo)
+	notificationService := service.NewNotificationService(notificationRepo)
+	attachmentService := service.NewAttachmentService(attachmentRepo, expenseRepo, colocationRepo, blobStore, cfg.Storage.MaxAttachmentSize)
+	colocationService := service.NewColocationService(colocationRepo, attachmentService, notificationService)
 	categoryService := service.NewCategoryService(categoryRepo, colocationRepo)
-	expenseService := service.NewExpenseService(expenseRepo, colocationRepo, categoryRepo)
+	tagService := service.NewTagService(tagRepo, colocationRepo)
+	settlementService := service.NewSettlementService(settlementRepo, balanceRepo, colocationRepo, notificationService)
+	exchangeRateService := service.NewExchangeRateService(exchangeRateRepo, colocationRepo)
+	budgetService := service.NewBudgetService(budgetRepo, expenseRepo, categoryRepo, colocationRepo, notificationService)
+	softDelete := service.SoftDeletePolicy{UndoWindow: cfg.SoftDelete.UndoWindow, Retention: cfg.SoftDelete.Retention}
+	expenseService := service.NewExpenseService(expenseRepo, colocationRepo, categoryRepo, tagRepo, exchangeRateService, settlementService, budgetService, notificationService, softDelete)
+	statementService := service.NewStatementService(expenseService, expenseRepo, categoryRepo)
+	exportService := service.NewExportService(colocationRepo, categoryRepo, expenseRepo, paymentRepo, balanceRepo, fundRepo, eventRepo, decisionRepo, exchangeRateRepo)
 	balanceService := service.NewBalanceService(balanceRepo, colocationRepo)
-	paymentService := service.NewPaymentService(paymentRepo, colocationRepo)
-	decisionService := service.NewDecisionService(decisionRepo, colocationRepo)
-	fundService := service.NewFundService(fundRepo, colocationRepo)
-	notificationService := service.NewNotificationService(notificationRepo)
+	paymentService := service.NewPaymentService(paymentRepo, colocationRepo, authRepo, exchangeRateService, settlementService, notificationService, blobStore, softDelete)
+	decisionService := service.NewDecisionService(decisionRepo, colocationRepo, notificationService)
+	fundService := service.NewFundService(fundRepo, expenseRepo, colocationRepo, settlementService, notificationService, softDelete)
+	eventService := service.NewEventService(eventRepo, fundRepo, colocationRepo, notificationService)
+	searchService := service.NewSearchService(searchRepo, colocationRepo)
 
 	// Initialize handlers
 	authHandler := handler.NewAuthHandler(authService)
 	userHandler := handler.NewUserHandler(userService)
 	colocationHandler := handler.NewColocationHandler(colocationService)
 	categoryHandler := handler.NewCategoryHandler(categoryService)
+	tagHandler := handler.NewTagHandler(tagService)
+	budgetHandler := handler.NewBudgetHandler(budgetService)
 	expenseHandler := handler.NewExpenseHandler(expenseService)
+	attachmentHandler := handler.NewAttachmentHandler(attachmentService)
 	balanceHandler := handler.NewBalanceHandler(balanceService)
 	paymentHandler := handler.NewPaymentHandler(paymentService)
+	settlementHandler := handler.NewSettlementHandler(settlementService)
+	exchangeRateHandler := handler.NewExchangeRateHandler(exchangeRateService)
+	statementHandler := handler.NewStatementHandler(statementService)
+	exportHandler := handler.NewExportHandler(exportService)
 	decisionHandler := handler.NewDecisionHandler(decisionService)
 	fundHandler := handler.NewFundHandler(fundService)
+	eventHandler := handler.NewEventHandler(eventService)
 	notificationHandler := handler.NewNotificationHandler(notificationService)
+	searchHandler := handler.NewSearchHandler(searchService)
 
 	srv := &server{
 		cfg:                 cfg,
@@ -102,28 +155,80 @@ func main() {
 		userHandler:         userHandler,
 		colocationHandler:   colocationHandler,
 		categoryHandler:     categoryHandler,
+		tagHandler:          tagHandler,
+		budgetHandler:       budgetHandler,
 		expenseHandler:      expenseHandler,
+		attachmentHandler:   attachmentHandler,
 		balanceHandler:      balanceHandler,
 		paymentHandler:      paymentHandler,
+		settlementHandler:   settlementHandler,
+		exchangeRateHandler: exchangeRateHandler,
+		statementHandler:    statementHandler,
+		exportHandler:       exportHandler,
 		decisionHandler:     decisionHandler,
 		fundHandler:         fundHandler,
+		eventHandler:        eventHandler,
 		notificationHandler: notificationHandler,
+		searchHandler:       searchHandler,
+	}
+
+	// Start background jobs
+	var jobs *scheduler.Scheduler
+	if cfg.Scheduler.Enabled {
+		jobs = scheduler.New(pool)
+		jobs.Register("recurring_expenses", cfg.Scheduler.RecurringExpensesInterval, func(ctx context.Context) error {
+			created, err := expenseService.ProcessDueRecurringExpenses(ctx)
+			if created > 0 {
+				log.Printf("%d depense(s) recurrente(s) generee(s)", created)
+			}
+			return err
+		})
+		jobs.Register("purge_deleted", cfg.Scheduler.PurgeDeletedInterval, func(ctx context.Context) error {
+			expenses, attachments, expenseErr := expenseService.PurgeDeleted(ctx)
+			attachmentService.DeleteFiles(ctx, attachments)
+			payments, paymentErr := paymentService.PurgeDeleted(ctx)
+			contributions, contributionErr := fundService.PurgeDeletedContributions(ctx)
+			withdrawals, withdrawalErr := fundService.PurgeDeletedWithdrawals(ctx)
+			if purged := expenses + payments + contributions + withdrawals; purged > 0 {
+				log.Printf("%d element(s) supprime(s) purge(s)", purged)
+			}
+			return errors.Join(expenseErr, paymentErr, contributionErr, withdrawalErr)
+		})
+		jobs.Register("stale_payments", cfg.Scheduler.StalePaymentsInterval, func(ctx context.Context) error {
+			reminded, expired, err := paymentService.ProcessStalePayments(ctx)
+			if reminded+expired > 0 {
+				log.Printf("%d paiement(s) relance(s), %d paiement(s) expire(s)", reminded, expired)
+			}
+			return err
+		})
+		jobs.Start(ctx)
 	}
 
 	// Start gRPC server in goroutine
+	grpcDone := make(chan struct{})
 	go func() {
-		if err := srv.runGRPCServer(); err != nil {
+		defer close(grpcDone)
+		if err := srv.runGRPCServer(ctx); err != nil {
 			log.Fatalf("Erreur serveur gRPC: %v", err)
 		}
 	}()
 
-	// Start HTTP gateway
-	if err := srv.runHTTPGateway(); err != nil {
+	// Start HTTP gateway, returns once ctx is cancelled
+	if err := srv.runHTTPGateway(ctx); err != nil {
 		log.Fatalf("Erreur gateway HTTP: %v", err)
 	}
+
+	// Let the running jobs finish before the pool is closed
+	<-grpcDone
+	if jobs != nil {
+		jobs.Wait()
+	}
+	log.Println("Serveur arrete")
 }
 
-func (s *server) runGRPCServer() error {
+// runGRPCServer serves gRPC until ctx is cancelled, then waits for the
+// running calls up to the shutdown timeout
+func (s *server) runGRPCServer(ctx context.Context) error {
 	lis, err := net.Listen("tcp", ":"+s.cfg.Server.GRPCPort)
 	if err != nil {
 		return err
@@ -142,22 +247,46 @@ func (s *server) runGRPCServer() error {
 	pb.RegisterUserServiceServer(grpcServer, s.userHandler)
 	pb.RegisterColocationServiceServer(grpcServer, s.colocationHandler)
 	pb.RegisterCategoryServiceServer(grpcServer, s.categoryHandler)
+	pb.RegisterTagServiceServer(grpcServer, s.tagHandler)
+	pb.RegisterBudgetServiceServer(grpcServer, s.budgetHandler)
 	pb.RegisterExpenseServiceServer(grpcServer, s.expenseHandler)
+	pb.RegisterAttachmentServiceServer(grpcServer, s.attachmentHandler)
 	pb.RegisterBalanceServiceServer(grpcServer, s.balanceHandler)
 	pb.RegisterPaymentServiceServer(grpcServer, s.paymentHandler)
+	pb.RegisterSettlementServiceServer(grpcServer, s.settlementHandler)
+	pb.RegisterExchangeRateServiceServer(grpcServer, s.exchangeRateHandler)
+	pb.RegisterStatementServiceServer(grpcServer, s.statementHandler)
+	pb.RegisterExportServiceServer(grpcServer, s.exportHandler)
 	pb.RegisterDecisionServiceServer(grpcServer, s.decisionHandler)
 	pb.RegisterFundServiceServer(grpcServer, s.fundHandler)
+	pb.RegisterEventServiceServer(grpcServer, s.eventHandler)
 	pb.RegisterNotificationServiceServer(grpcServer, s.notificationHandler)
+	pb.RegisterSearchServiceServer(grpcServer, s.searchHandler)
 
 	// Enable reflection for grpcurl/grpcui
 	reflection.Register(grpcServer)
 
+	go func() {
+		<-ctx.Done()
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		// Notification streams never end on their own
+		select {
+		case <-stopped:
+		case <-time.After(constants.ShutdownTimeout):
+			grpcServer.Stop()
+		}
+	}()
+
 	log.Printf("Serveur gRPC demarre sur le port %s", s.cfg.Server.GRPCPort)
 	return grpcServer.Serve(lis)
 }
 
-func (s *server) runHTTPGateway() error {
-	ctx := context.Background()
+// runHTTPGateway serves the REST gateway until ctx is cancelled
+func (s *server) runHTTPGateway(ctx context.Context) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -165,7 +294,11 @@ func (s *server) runHTTPGateway() error {
 		runtime.WithIncomingHeaderMatcher(customHeaderMatcher),
 	)
 
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		// Exports can exceed the default 4 MB limit of received messages
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(constants.MaxExportSize)),
+	}
 	grpcEndpoint := "localhost:" + s.cfg.Server.GRPCPort
 
 	// Register HTTP handlers
@@ -181,24 +314,67 @@ func (s *server) runHTTPGateway() error {
 	if err := pb.RegisterCategoryServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
 		return err
 	}
+	if err := pb.RegisterTagServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
+		return err
+	}
+	if err := pb.RegisterBudgetServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
+		return err
+	}
 	if err := pb.RegisterExpenseServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
 		return err
 	}
+	if err := pb.RegisterAttachmentServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
+		return err
+	}
 	if err := pb.RegisterBalanceServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
 		return err
 	}
 	if err := pb.RegisterPaymentServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
 		return err
 	}
+	if err := pb.RegisterSettlementServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
+		return err
+	}
+	if err := pb.RegisterExchangeRateServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
+		return err
+	}
+	if err := pb.RegisterStatementServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
+		return err
+	}
+	if err := pb.RegisterExportServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
+		return err
+	}
 	if err := pb.RegisterDecisionServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
 		return err
 	}
 	if err := pb.RegisterFundServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
 		return err
 	}
+	if err := pb.RegisterEventServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
+		return err
+	}
 	if err := pb.RegisterNotificationServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
 		return err
 	}
+	if err := pb.RegisterSearchServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
+		return err
+	}
+
+	// Multipart, streaming and download routes (attachments, statement upload, exports)
+	conn, err := grpc.NewClient(grpcEndpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := handler.RegisterAttachmentRoutes(mux, pb.NewAttachmentServiceClient(conn)); err != nil {
+		return err
+	}
+	if err := handler.RegisterStatementRoutes(mux, pb.NewStatementServiceClient(conn)); err != nil {
+		return err
+	}
+	if err := handler.RegisterExportRoutes(mux, pb.NewExportServiceClient(conn)); err != nil {
+		return err
+	}
 
 	// Wrap with CORS
 	handler := corsMiddleware(mux)
@@ -220,7 +396,25 @@ func (s *server) runHTTPGateway() error {
 	log.Printf("API disponible sur http://localhost:%s/api/", s.cfg.Server.HTTPPort)
 	log.Printf("Swagger UI disponible sur http://localhost:%s/swagger/", s.cfg.Server.HTTPPort)
 
-	return http.ListenAndServe(":"+s.cfg.Server.HTTPPort, httpMux)
+	httpServer := &http.Server{Addr: ":" + s.cfg.Server.HTTPPort, Handler: httpMux}
+	shutdownDone := make(chan struct{})
+	go func() {
+		defer close(shutdownDone)
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), constants.ShutdownTimeout)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			log.Printf("Arret de la gateway HTTP: %v", err)
+		}
+	}()
+
+	if err := httpServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	// ListenAndServe returns as soon as Shutdown starts, wait for the running requests
+	<-shutdownDone
+	return nil
 }
 
 // customHeaderMatcher allows Authorization header to pass through
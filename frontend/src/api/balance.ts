@@ -1,12 +1,13 @@
 ﻿import api from './client';
-import type { UserBalance, SimplifiedDebt } from '../types';
+import type { Money, UserBalance, SimplifiedDebt } from '../types';
 
 interface BalanceHistoryEntry {
   date: string;
+  cumulative_balance: Money;
+  event_type: 'expense' | 'payment';
+  event_id: string;
   description: string;
-  amount: number;
-  type: 'expense_paid' | 'expense_owed' | 'payment_made' | 'payment_received';
-  running_balance: number;
+  amount: Money;
 }
 
 export const balanceApi = {
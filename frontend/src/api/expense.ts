@@ -1,9 +1,9 @@
 ﻿import api from './client';
-import type { Expense, RecurringExpense, SplitType, Recurrence, MonthlyForecast } from '../types';
+import type { Expense, Money, RecurringExpense, SplitType, Recurrence, MonthlyForecast } from '../types';
 
 interface SplitInput {
   user_id: string;
-  amount?: number;
+  amount?: Money;
   percentage?: number;
 }
 
@@ -12,7 +12,7 @@ interface CreateExpenseRequest {
   category_id: string;
   title: string;
   description?: string;
-  amount: number;
+  amount: Money;
   split_type: SplitType;
   expense_date: string;
   splits?: SplitInput[];
@@ -22,7 +22,7 @@ interface UpdateExpenseRequest {
   category_id?: string;
   title?: string;
   description?: string;
-  amount?: number;
+  amount?: Money;
   split_type?: SplitType;
   expense_date?: string;
   splits?: SplitInput[];
@@ -43,7 +43,7 @@ interface CreateRecurringExpenseRequest {
   category_id: string;
   title: string;
   description?: string;
-  amount: number;
+  amount: Money;
   split_type: SplitType;
   recurrence: Recurrence;
   start_date: string;
@@ -1,10 +1,10 @@
 ﻿import api from './client';
-import type { Payment, PaymentStatus } from '../types';
+import type { Money, Payment, PaymentStatus } from '../types';
 
 interface CreatePaymentRequest {
   colocation_id: string;
   to_user_id: string;
-  amount: number;
+  amount: Money;
   note?: string;
 }
 
@@ -13,6 +13,7 @@ import { useAuth } from '../context/AuthContext';
 import { balanceApi } from '../api';
 import { Card, CardHeader, Button, Avatar, Badge } from '../components/ui';
 import type { UserBalance, SimplifiedDebt } from '../types';
+import { moneyToNumber, formatMoney } from '../utils/money';
 
 export function Balances() {
   const { user } = useAuth();
@@ -41,9 +42,10 @@ export function Balances() {
     fetchData();
   }, [currentColocation]);
 
-  const positiveBalances = balances.filter((b) => b.net_balance > 0);
-  const negativeBalances = balances.filter((b) => b.net_balance < 0);
+  const positiveBalances = balances.filter((b) => moneyToNumber(b.net_balance) > 0);
+  const negativeBalances = balances.filter((b) => moneyToNumber(b.net_balance) < 0);
   const userBalance = balances.find((b) => b.user_id === user?.id);
+  const userNet = moneyToNumber(userBalance?.net_balance);
 
   return (
     <div className="space-y-8">
@@ -59,29 +61,29 @@ export function Balances() {
       {/* Your Balance Card */}
       {userBalance && (
         <motion.div initial={{ opacity: 0, y: 20 }} animate={{ opacity: 1, y: 0 }}>
-          <Card className={`p-8 relative overflow-hidden ${userBalance.net_balance >= 0 ? 'bg-gradient-to-br from-emerald-50 to-teal-50' : 'bg-gradient-to-br from-red-50 to-orange-50'}`}>
+          <Card className={`p-8 relative overflow-hidden ${userNet >= 0 ? 'bg-gradient-to-br from-emerald-50 to-teal-50' : 'bg-gradient-to-br from-red-50 to-orange-50'}`}>
             <div className="flex items-center justify-between">
               <div>
                 <p className="text-sm text-slate-500 mb-2">Votre solde</p>
-                <p className={`text-5xl font-semibold ${userBalance.net_balance >= 0 ? 'text-emerald-600' : 'text-red-500'}`}>
-                  {userBalance.net_balance >= 0 ? '+' : ''}{userBalance.net_balance.toFixed(2)} €
+                <p className={`text-5xl font-semibold ${userNet >= 0 ? 'text-emerald-600' : 'text-red-500'}`}>
+                  {userNet >= 0 ? '+' : ''}{userNet.toFixed(2)} €
                 </p>
                 <p className="text-base text-slate-400 mt-3">
-                  {userBalance.net_balance >= 0 ? "On vous doit de l'argent" : "Vous devez de l'argent"}
+                  {userNet >= 0 ? "On vous doit de l'argent" : "Vous devez de l'argent"}
                 </p>
               </div>
-              <div className={`w-20 h-20 rounded-2xl flex items-center justify-center ${userBalance.net_balance >= 0 ? 'bg-emerald-100' : 'bg-red-100'}`}>
-                {userBalance.net_balance >= 0 ? <TrendingUp className="w-10 h-10 text-emerald-600" /> : <TrendingDown className="w-10 h-10 text-red-500" />}
+              <div className={`w-20 h-20 rounded-2xl flex items-center justify-center ${userNet >= 0 ? 'bg-emerald-100' : 'bg-red-100'}`}>
+                {userNet >= 0 ? <TrendingUp className="w-10 h-10 text-emerald-600" /> : <TrendingDown className="w-10 h-10 text-red-500" />}
               </div>
             </div>
             <div className="grid grid-cols-2 gap-8 mt-8 pt-8 border-t border-black/5">
               <div>
                 <p className="text-sm text-slate-400 mb-1">Total payé</p>
-                <p className="text-2xl font-semibold text-slate-800">{userBalance.total_paid.toFixed(2)} €</p>
+                <p className="text-2xl font-semibold text-slate-800">{formatMoney(userBalance.total_paid)}</p>
               </div>
               <div>
                 <p className="text-sm text-slate-400 mb-1">Total dû</p>
-                <p className="text-2xl font-semibold text-slate-800">{userBalance.total_owed.toFixed(2)} €</p>
+                <p className="text-2xl font-semibold text-slate-800">{formatMoney(userBalance.total_owed)}</p>
               </div>
             </div>
           </Card>
@@ -133,7 +135,7 @@ export function Balances() {
                         {isUserDebtor ? 'Vous devez rembourser' : isUserCreditor ? 'Vous allez recevoir' : 'Transaction entre colocataires'}
                       </p>
                     </div>
-                    <p className="text-xl font-semibold text-slate-800">{debt.amount.toFixed(2)} €</p>
+                    <p className="text-xl font-semibold text-slate-800">{formatMoney(debt.amount)}</p>
                     {isUserDebtor && (
                       <Button>Rembourser <ChevronRight className="w-4 h-4 ml-1" /></Button>
                     )}
@@ -152,7 +154,7 @@ export function Balances() {
             <CardHeader title="Créanciers" subtitle="Membres avec un solde positif" action={
               <div className="flex items-center gap-2 text-emerald-600">
                 <TrendingUp className="w-5 h-5" />
-                <span className="text-base font-semibold">{positiveBalances.reduce((sum, b) => sum + b.net_balance, 0).toFixed(2)} €</span>
+                <span className="text-base font-semibold">{positiveBalances.reduce((sum, b) => sum + moneyToNumber(b.net_balance), 0).toFixed(2)} €</span>
               </div>
             } />
             {positiveBalances.length === 0 ? (
@@ -167,9 +169,9 @@ export function Balances() {
                         {balance.user?.prenom} {balance.user?.nom}
                         {balance.user_id === user?.id && <Badge variant="primary" size="sm" className="ml-2">Vous</Badge>}
                       </p>
-                      <p className="text-sm text-slate-400 mt-0.5">A payé {balance.total_paid.toFixed(2)} €</p>
+                      <p className="text-sm text-slate-400 mt-0.5">A payé {formatMoney(balance.total_paid)}</p>
                     </div>
-                    <p className="text-lg font-semibold text-emerald-600">+{balance.net_balance.toFixed(2)} €</p>
+                    <p className="text-lg font-semibold text-emerald-600">+{formatMoney(balance.net_balance)}</p>
                   </div>
                 ))}
               </div>
@@ -182,7 +184,7 @@ export function Balances() {
             <CardHeader title="Débiteurs" subtitle="Membres avec un solde négatif" action={
               <div className="flex items-center gap-2 text-red-500">
                 <TrendingDown className="w-5 h-5" />
-                <span className="text-base font-semibold">{negativeBalances.reduce((sum, b) => sum + b.net_balance, 0).toFixed(2)} €</span>
+                <span className="text-base font-semibold">{negativeBalances.reduce((sum, b) => sum + moneyToNumber(b.net_balance), 0).toFixed(2)} €</span>
               </div>
             } />
             {negativeBalances.length === 0 ? (
@@ -197,9 +199,9 @@ export function Balances() {
                         {balance.user?.prenom} {balance.user?.nom}
                         {balance.user_id === user?.id && <Badge variant="primary" size="sm" className="ml-2">Vous</Badge>}
                       </p>
-                      <p className="text-sm text-slate-400 mt-0.5">Doit {balance.total_owed.toFixed(2)} €</p>
+                      <p className="text-sm text-slate-400 mt-0.5">Doit {formatMoney(balance.total_owed)}</p>
                     </div>
-                    <p className="text-lg font-semibold text-red-500">{balance.net_balance.toFixed(2)} €</p>
+                    <p className="text-lg font-semibold text-red-500">{formatMoney(balance.net_balance)}</p>
                   </div>
                 ))}
               </div>
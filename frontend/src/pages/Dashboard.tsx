@@ -26,6 +26,7 @@ import { useAuth } from '../context/AuthContext';
 import { expenseApi, balanceApi, categoryApi, colocationApi } from '../api';
 import { Card, CardHeader, StatCard, Avatar, Badge, Button, Modal, Input } from '../components/ui';
 import type { Expense, UserBalance, CategoryStat, SimplifiedDebt } from '../types';
+import { moneyToNumber, formatMoney } from '../utils/money';
 
 export function Dashboard() {
   const { user } = useAuth();
@@ -65,7 +66,8 @@ export function Dashboard() {
   }, [currentColocation]);
 
   const userBalance = balances.find((b) => b.user_id === user?.id);
-  const totalExpenses = expenses.reduce((sum, e) => sum + e.amount, 0);
+  const netBalance = moneyToNumber(userBalance?.net_balance);
+  const totalExpenses = expenses.reduce((sum, e) => sum + moneyToNumber(e.amount), 0);
 
   const monthlyData = [
     { month: 'Jan', amount: 450 },
@@ -76,6 +78,8 @@ export function Dashboard() {
     { month: 'Juin', amount: 430 },
   ];
 
+  const categoryChartData = categoryStats.map((stat) => ({ ...stat, value: moneyToNumber(stat.total_amount) }));
+
   const COLORS = ['#5682F2', '#F1C086', '#10B981', '#F59E0B', '#EF4444', '#8B5CF6'];
 
   const handleCreateColocation = async (event: React.FormEvent) => {
@@ -163,9 +167,9 @@ export function Dashboard() {
       <motion.div initial={{ opacity: 0, y: 20 }} animate={{ opacity: 1, y: 0 }} transition={{ delay: 0.1 }} className="grid grid-cols-1 md:grid-cols-2 xl:grid-cols-4 gap-6">
         <StatCard
           label="Votre solde"
-          value={`${(userBalance?.net_balance || 0) >= 0 ? '+' : ''}${(userBalance?.net_balance || 0).toFixed(2)} €`}
+          value={`${netBalance >= 0 ? '+' : ''}${netBalance.toFixed(2)} €`}
           icon={<Wallet className="w-6 h-6" />}
-          color={(userBalance?.net_balance || 0) >= 0 ? 'success' : 'danger'}
+          color={netBalance >= 0 ? 'success' : 'danger'}
         />
         <StatCard
           label="Total des dépenses"
@@ -223,10 +227,10 @@ export function Dashboard() {
               <ResponsiveContainer width="100%" height="100%">
                 <PieChart>
                   <Pie
-                    data={categoryStats.length > 0 ? categoryStats : [{ name: 'Aucune', value: 1 }]}
+                    data={categoryStats.length > 0 ? categoryChartData : [{ name: 'Aucune', value: 1 }]}
                     cx="50%" cy="50%"
                     innerRadius={55} outerRadius={80}
-                    dataKey="total_amount" nameKey="category.name"
+                    dataKey="value" nameKey="category.name"
                     paddingAngle={2}
                   >
                     {categoryStats.map((_, index) => (
@@ -277,7 +281,7 @@ export function Dashboard() {
                       <p className="text-sm font-medium text-slate-800 truncate">{expense.title}</p>
                       <p className="text-sm text-slate-400">Payé par {expense.payer?.prenom || 'Inconnu'}</p>
                     </div>
-                    <span className="text-base font-semibold text-slate-800">{expense.amount.toFixed(2)} €</span>
+                    <span className="text-base font-semibold text-slate-800">{formatMoney(expense.amount)}</span>
                   </div>
                 ))
               )}
@@ -307,7 +311,7 @@ export function Dashboard() {
                       <span className="text-sm font-medium text-slate-700">{debt.to_user?.prenom || 'Utilisateur'}</span>
                     </div>
                     <div className="flex items-center gap-3">
-                      <span className="text-base font-semibold text-red-500">{debt.amount.toFixed(2)} €</span>
+                      <span className="text-base font-semibold text-red-500">{formatMoney(debt.amount)}</span>
                       <Button size="sm" variant="secondary">Payer</Button>
                     </div>
                   </div>
@@ -14,6 +14,7 @@ import { useColocation } from '../context/ColocationContext';
 import { expenseApi, categoryApi } from '../api';
 import { Card, CardHeader, Button, Input, Badge, Avatar, Modal } from '../components/ui';
 import type { Expense, Category, SplitType } from '../types';
+import { moneyToNumber, numberToMoney, formatMoney } from '../utils/money';
 
 export function Expenses() {
   const { currentColocation } = useColocation();
@@ -66,7 +67,7 @@ export function Expenses() {
       const expense = await expenseApi.create({
         colocation_id: currentColocation.id,
         title: newExpense.title,
-        amount: parseFloat(newExpense.amount),
+        amount: numberToMoney(parseFloat(newExpense.amount)),
         category_id: newExpense.category_id,
         split_type: newExpense.split_type,
         expense_date: newExpense.expense_date,
@@ -88,7 +89,7 @@ export function Expenses() {
     }
   };
 
-  const totalAmount = filteredExpenses.reduce((sum, e) => sum + e.amount, 0);
+  const totalAmount = filteredExpenses.reduce((sum, e) => sum + moneyToNumber(e.amount), 0);
 
   return (
     <div className="space-y-8">
@@ -184,9 +185,9 @@ export function Expenses() {
                     </div>
                   </div>
                   <div className="text-right">
-                    <p className="text-lg font-semibold text-slate-800">{expense.amount.toFixed(2)} €</p>
+                    <p className="text-lg font-semibold text-slate-800">{formatMoney(expense.amount)}</p>
                     {expense.splits && expense.splits.length > 0 && (
-                      <p className="text-sm text-slate-400 mt-0.5">{(expense.amount / expense.splits.length).toFixed(2)} €/pers</p>
+                      <p className="text-sm text-slate-400 mt-0.5">{(moneyToNumber(expense.amount) / expense.splits.length).toFixed(2)} €/pers</p>
                     )}
                   </div>
                   <div className="flex items-center gap-1">
@@ -1,4 +1,10 @@
-﻿// User types
+﻿// Money is an amount in minor units (cents); int64 units arrive as strings.
+export interface Money {
+  units: string | number;
+  currency: string;
+}
+
+// User types
 export interface User {
   id: string;
   email: string;
@@ -78,7 +84,7 @@ export interface Category {
 
 export interface CategoryStat {
   category: Category;
-  total_amount: number;
+  total_amount: Money;
   expense_count: number;
   percentage: number;
 }
@@ -91,7 +97,7 @@ export interface ExpenseSplit {
   id: string;
   expense_id: string;
   user_id: string;
-  amount: number;
+  amount: Money;
   percentage?: number;
   is_settled: boolean;
   user?: User;
@@ -104,7 +110,7 @@ export interface Expense {
   category_id: string;
   title: string;
   description?: string;
-  amount: number;
+  amount: Money;
   split_type: SplitType;
   expense_date: string;
   recurring_id?: string;
@@ -121,7 +127,7 @@ export interface RecurringExpense {
   category_id: string;
   title: string;
   description?: string;
-  amount: number;
+  amount: Money;
   split_type: SplitType;
   recurrence: Recurrence;
   next_due_date: string;
@@ -136,9 +142,9 @@ export interface RecurringExpense {
 export interface UserBalance {
   user_id: string;
   user?: User;
-  total_paid: number;
-  total_owed: number;
-  net_balance: number;
+  total_paid: Money;
+  total_owed: Money;
+  net_balance: Money;
 }
 
 export interface SimplifiedDebt {
@@ -146,7 +152,7 @@ export interface SimplifiedDebt {
   from_user?: User;
   to_user_id: string;
   to_user?: User;
-  amount: number;
+  amount: Money;
 }
 
 // Payment types
@@ -157,7 +163,7 @@ export interface Payment {
   colocation_id: string;
   from_user_id: string;
   to_user_id: string;
-  amount: number;
+  amount: Money;
   status: PaymentStatus;
   note?: string;
   confirmed_at?: string;
@@ -204,7 +210,7 @@ export interface FundContribution {
   id: string;
   fund_id: string;
   user_id: string;
-  amount: number;
+  amount: Money;
   note?: string;
   created_at: string;
   user?: User;
@@ -213,7 +219,7 @@ export interface FundContribution {
 export interface ContributorSummary {
   user_id: string;
   user?: User;
-  total_contributed: number;
+  total_contributed: Money;
 }
 
 export interface CommonFund {
@@ -221,8 +227,8 @@ export interface CommonFund {
   colocation_id: string;
   name: string;
   description?: string;
-  target_amount: number;
-  current_amount: number;
+  target_amount: Money;
+  current_amount: Money;
   is_active: boolean;
   created_by: string;
   created_at: string;
@@ -263,13 +269,13 @@ export interface Notification {
 export interface CategoryForecast {
   category_id: string;
   category?: Category;
-  amount: number;
+  amount: Money;
 }
 
 export interface MonthlyForecast {
   month: string;
   year: number;
-  total_amount: number;
+  total_amount: Money;
   categories: CategoryForecast[];
 }
 
@@ -0,0 +1,20 @@
+import type { Money } from '../types';
+
+export const DEFAULT_CURRENCY = 'EUR';
+
+// moneyToNumber converts a Money value from the API to a decimal amount.
+export function moneyToNumber(money?: Money | null): number {
+  if (!money) return 0;
+  return Number(money.units) / 100;
+}
+
+// numberToMoney converts a decimal amount entered by the user to Money.
+export function numberToMoney(amount: number, currency: string = DEFAULT_CURRENCY): Money {
+  return { units: Math.round(amount * 100), currency };
+}
+
+// formatMoney renders a Money value with two decimals and its currency symbol.
+export function formatMoney(money?: Money | null): string {
+  const symbol = !money || money.currency === DEFAULT_CURRENCY ? '€' : money.currency;
+  return `${moneyToNumber(money).toFixed(2)} ${symbol}`;
+}
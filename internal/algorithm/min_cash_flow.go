@@ -1,30 +1,23 @@
 package algorithm
 
-import "math"
-
-const (
-	debtSettlementThreshold = 0.01
-	roundingPrecisionFactor = 100
-)
-
 // DebtEdge represents a simplified debt from one person to another
 type DebtEdge struct {
 	FromIndex int
 	ToIndex   int
-	Amount    float64
+	Amount    int64 // in cents
 }
 
 // MinCashFlow implements the min-cash-flow algorithm to simplify debts.
-// It takes net balances for each person (positive = creditor, negative = debtor)
+// It takes net balances in cents for each person (positive = creditor, negative = debtor)
 // and returns the minimum set of transactions needed to settle all debts.
-func MinCashFlow(netBalances []float64) []DebtEdge {
+func MinCashFlow(netBalances []int64) []DebtEdge {
 	n := len(netBalances)
 	if n <= 1 {
 		return nil
 	}
 
 	// Create a copy to avoid modifying the original
-	balances := make([]float64, n)
+	balances := make([]int64, n)
 	copy(balances, netBalances)
 
 	var result []DebtEdge
@@ -32,25 +25,18 @@ func MinCashFlow(netBalances []float64) []DebtEdge {
 	return result
 }
 
-func minCashFlowRecursive(balances []float64, result *[]DebtEdge) {
+func minCashFlowRecursive(balances []int64, result *[]DebtEdge) {
 	// Find the person with the maximum credit (most owed to them)
 	maxCreditIdx := findMaxIndex(balances)
 	// Find the person with the maximum debit (owes the most)
 	maxDebitIdx := findMinIndex(balances)
 
-	// If both are effectively zero, we're done
-	if math.Abs(balances[maxCreditIdx]) < debtSettlementThreshold && math.Abs(balances[maxDebitIdx]) < debtSettlementThreshold {
-		return
-	}
-
 	// Find the minimum of the two absolute values
 	// This is the amount that can be settled in this transaction
-	minAmount := math.Min(-balances[maxDebitIdx], balances[maxCreditIdx])
-
-	// Round to 2 decimal places
-	minAmount = math.Round(minAmount*roundingPrecisionFactor) / roundingPrecisionFactor
+	minAmount := min(-balances[maxDebitIdx], balances[maxCreditIdx])
 
-	if minAmount < debtSettlementThreshold {
+	// Either everyone is settled or the balances do not sum to zero
+	if minAmount <= 0 {
 		return
 	}
 
@@ -70,7 +56,7 @@ func minCashFlowRecursive(balances []float64, result *[]DebtEdge) {
 }
 
 // findMaxIndex returns the index of the maximum value
-func findMaxIndex(arr []float64) int {
+func findMaxIndex(arr []int64) int {
 	maxIdx := 0
 	for i := 1; i < len(arr); i++ {
 		if arr[i] > arr[maxIdx] {
@@ -81,7 +67,7 @@ func findMaxIndex(arr []float64) int {
 }
 
 // findMinIndex returns the index of the minimum value
-func findMinIndex(arr []float64) int {
+func findMinIndex(arr []int64) int {
 	minIdx := 0
 	for i := 1; i < len(arr); i++ {
 		if arr[i] < arr[minIdx] {
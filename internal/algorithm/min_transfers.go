@@ -0,0 +1,143 @@
+package algorithm
+
+// Strategy identifies the algorithm used to simplify debts
+type Strategy string
+
+const (
+	// StrategyExact guarantees the minimum number of transactions
+	StrategyExact Strategy = "exact"
+	// StrategyGreedy pairs the largest creditor with the largest debtor
+	StrategyGreedy Strategy = "greedy"
+)
+
+// ExactMaxParticipants is the maximum number of people with a non-zero
+// balance handled by the exact solver. Its cost grows as n * 2^n, so larger
+// groups fall back to the greedy algorithm.
+const ExactMaxParticipants = 15
+
+// SimplifyDebts returns the transactions settling the net balances (in cents),
+// using the exact solver when the group is small enough and the greedy
+// min-cash-flow otherwise.
+func SimplifyDebts(netBalances []int64) ([]DebtEdge, Strategy) {
+	nonZero := 0
+	for _, b := range netBalances {
+		if b != 0 {
+			nonZero++
+		}
+	}
+
+	if nonZero > ExactMaxParticipants {
+		return MinCashFlow(netBalances), StrategyGreedy
+	}
+
+	return MinTransfers(netBalances), StrategyExact
+}
+
+// MinTransfers returns the minimum set of transactions settling the net
+// balances (in cents). A group of k people whose balances sum to zero can
+// always settle among themselves in k-1 transactions, so the minimum number
+// of transactions is the number of people with a non-zero balance minus the
+// maximum number of disjoint zero-sum subgroups. The subgroups are found with
+// a dynamic programming over subsets, then each one is settled with the greedy
+// pairing. Balances that do not sum to zero are settled as far as possible.
+func MinTransfers(netBalances []int64) []DebtEdge {
+	// Only people with a non-zero balance take part
+	var indexes []int
+	for i, b := range netBalances {
+		if b != 0 {
+			indexes = append(indexes, i)
+		}
+	}
+
+	n := len(indexes)
+	if n <= 1 {
+		return nil
+	}
+
+	full := 1<<n - 1
+
+	// sums[mask] is the total balance of the people in mask
+	sums := make([]int64, full+1)
+	for mask := 1; mask <= full; mask++ {
+		low := lowestBit(mask)
+		sums[mask] = sums[mask&^(1<<low)] + netBalances[indexes[low]]
+	}
+
+	// groups[mask] is the maximum number of zero-sum subgroups that mask can
+	// be split into, when people are added one at a time and a group is
+	// closed each time the running sum gets back to zero
+	groups := make([]int, full+1)
+	for mask := 1; mask <= full; mask++ {
+		best := 0
+		for rest := mask; rest != 0; rest &= rest - 1 {
+			i := lowestBit(rest)
+			if g := groups[mask&^(1<<i)]; g > best {
+				best = g
+			}
+		}
+		if sums[mask] == 0 {
+			best++
+		}
+		groups[mask] = best
+	}
+
+	// Rebuild the order in which people were added, from the last one
+	order := make([]int, 0, n)
+	for mask := full; mask != 0; {
+		target := groups[mask]
+		if sums[mask] == 0 {
+			target--
+		}
+		for rest := mask; rest != 0; rest &= rest - 1 {
+			i := lowestBit(rest)
+			if groups[mask&^(1<<i)] == target {
+				order = append(order, i)
+				mask &^= 1 << i
+				break
+			}
+		}
+	}
+
+	// Walk the order from the first person added, closing a group each time
+	// the running sum is zero, and settle each group on its own
+	var result []DebtEdge
+	var group []int
+	var running int64
+	for k := len(order) - 1; k >= 0; k-- {
+		idx := indexes[order[k]]
+		group = append(group, idx)
+		running += netBalances[idx]
+		if running == 0 || k == 0 {
+			result = append(result, settleGroup(netBalances, group)...)
+			group = nil
+		}
+	}
+
+	return result
+}
+
+// settleGroup settles a subgroup with the greedy pairing, mapping the edges
+// back to the indexes of the full balance slice
+func settleGroup(netBalances []int64, group []int) []DebtEdge {
+	balances := make([]int64, len(group))
+	for i, idx := range group {
+		balances[i] = netBalances[idx]
+	}
+
+	edges := MinCashFlow(balances)
+	for i := range edges {
+		edges[i].FromIndex = group[edges[i].FromIndex]
+		edges[i].ToIndex = group[edges[i].ToIndex]
+	}
+	return edges
+}
+
+// lowestBit returns the index of the lowest set bit of a non-zero mask
+func lowestBit(mask int) int {
+	i := 0
+	for mask&1 == 0 {
+		mask >>= 1
+		i++
+	}
+	return i
+}
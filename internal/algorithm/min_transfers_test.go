@@ -0,0 +1,121 @@
+package algorithm
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// randomBalances returns n zero-sum balances in cents. Small ranges make
+// zero-sum subgroups likely, large ones make them rare.
+func randomBalances(rng *rand.Rand, n int, maxAbs int64) []int64 {
+	balances := make([]int64, n)
+	var sum int64
+	for i := 0; i < n-1; i++ {
+		balances[i] = rng.Int63n(2*maxAbs+1) - maxAbs
+		sum += balances[i]
+	}
+	balances[n-1] = -sum
+	return balances
+}
+
+// assertSettles checks that applying the edges brings every balance to zero
+func assertSettles(t *testing.T, balances []int64, edges []DebtEdge) {
+	t.Helper()
+
+	remaining := make([]int64, len(balances))
+	copy(remaining, balances)
+	for _, e := range edges {
+		if e.Amount <= 0 {
+			t.Fatalf("balances %v: montant non positif dans %+v", balances, e)
+		}
+		if e.FromIndex == e.ToIndex {
+			t.Fatalf("balances %v: transfert vers soi-meme %+v", balances, e)
+		}
+		remaining[e.FromIndex] += e.Amount
+		remaining[e.ToIndex] -= e.Amount
+	}
+	for i, r := range remaining {
+		if r != 0 {
+			t.Fatalf("balances %v: solde %d non nul (%d) apres %+v", balances, i, r, edges)
+		}
+	}
+}
+
+func TestMinTransfersSettlesAndBeatsGreedy(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+
+	for n := 2; n <= ExactMaxParticipants; n++ {
+		for _, maxAbs := range []int64{5, 50, 100000} {
+			for iter := 0; iter < 20; iter++ {
+				balances := randomBalances(rng, n, maxAbs)
+
+				exact := MinTransfers(balances)
+				assertSettles(t, balances, exact)
+
+				greedy := MinCashFlow(balances)
+				assertSettles(t, balances, greedy)
+
+				if len(exact) > len(greedy) {
+					t.Fatalf("balances %v: %d transferts exacts contre %d gloutons", balances, len(exact), len(greedy))
+				}
+			}
+		}
+	}
+}
+
+func TestMinTransfersFindsZeroSumGroups(t *testing.T) {
+	// {7, -7} and {10, -5, -5} settle on their own in three transfers,
+	// where pairing the largest amounts first needs four
+	balances := []int64{10, 7, -7, -5, -5}
+
+	exact := MinTransfers(balances)
+	assertSettles(t, balances, exact)
+	if len(exact) != 3 {
+		t.Fatalf("attendu 3 transferts, obtenu %+v", exact)
+	}
+	if greedy := MinCashFlow(balances); len(greedy) != 4 {
+		t.Fatalf("attendu 4 transferts gloutons, obtenu %+v", greedy)
+	}
+}
+
+func TestSimplifyDebtsStrategy(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+
+	for n := 2; n <= 30; n++ {
+		balances := randomBalances(rng, n, 100000)
+		nonZero := 0
+		for _, b := range balances {
+			if b != 0 {
+				nonZero++
+			}
+		}
+
+		edges, strategy := SimplifyDebts(balances)
+		assertSettles(t, balances, edges)
+
+		want := StrategyExact
+		if nonZero > ExactMaxParticipants {
+			want = StrategyGreedy
+		}
+		if strategy != want {
+			t.Fatalf("%d participants: strategie %q, attendu %q", nonZero, strategy, want)
+		}
+		if strategy == StrategyGreedy && len(edges) != len(MinCashFlow(balances)) {
+			t.Fatalf("%d participants: le repli doit utiliser MinCashFlow", nonZero)
+		}
+	}
+}
+
+func TestSimplifyDebtsIgnoresSettledMembers(t *testing.T) {
+	// Members with a zero balance do not count towards the exact limit
+	balances := make([]int64, ExactMaxParticipants+5)
+	balances[0], balances[3] = 250, -250
+
+	edges, strategy := SimplifyDebts(balances)
+	if strategy != StrategyExact {
+		t.Fatalf("strategie %q, attendu %q", strategy, StrategyExact)
+	}
+	if len(edges) != 1 || edges[0] != (DebtEdge{FromIndex: 3, ToIndex: 0, Amount: 250}) {
+		t.Fatalf("transferts inattendus %+v", edges)
+	}
+}
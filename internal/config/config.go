@@ -17,9 +17,12 @@ const (
 
 // Config holds all application configuration
 type Config struct {
-	Database DatabaseConfig
-	Server   ServerConfig
-	JWT      JWTConfig
+	Database   DatabaseConfig
+	Server     ServerConfig
+	JWT        JWTConfig
+	Scheduler  SchedulerConfig
+	Storage    StorageConfig
+	SoftDelete SoftDeleteConfig
 }
 
 // DatabaseConfig holds database connection settings
@@ -45,6 +48,27 @@ type JWTConfig struct {
 	RefreshTokenExpiry time.Duration
 }
 
+// SchedulerConfig holds background job settings
+type SchedulerConfig struct {
+	Enabled                   bool
+	RecurringExpensesInterval time.Duration
+	PurgeDeletedInterval      time.Duration
+	StalePaymentsInterval     time.Duration
+}
+
+// StorageConfig holds file storage settings
+type StorageConfig struct {
+	AttachmentsDir    string
+	MaxAttachmentSize int64
+}
+
+// SoftDeleteConfig holds the undo window and retention of deleted expenses,
+// payments and fund contributions
+type SoftDeleteConfig struct {
+	UndoWindow time.Duration
+	Retention  time.Duration
+}
+
 // Load reads configuration from environment variables
 func Load() *Config {
 	return &Config{
@@ -65,6 +89,20 @@ func Load() *Config {
 			AccessTokenExpiry:  getDurationEnv("JWT_EXPIRY", constants.DefaultAccessTokenExpiry),
 			RefreshTokenExpiry: getDurationEnv("REFRESH_TOKEN_EXPIRY", constants.DefaultRefreshTokenExpiry),
 		},
+		Scheduler: SchedulerConfig{
+			Enabled:                   getEnv("SCHEDULER_ENABLED", "true") != "false",
+			RecurringExpensesInterval: getDurationEnv("RECURRING_EXPENSES_INTERVAL", constants.DefaultRecurringExpensesInterval),
+			PurgeDeletedInterval:      getDurationEnv("PURGE_DELETED_INTERVAL", constants.DefaultPurgeDeletedInterval),
+			StalePaymentsInterval:     getDurationEnv("STALE_PAYMENTS_INTERVAL", constants.DefaultStalePaymentsInterval),
+		},
+		Storage: StorageConfig{
+			AttachmentsDir:    getEnv("ATTACHMENTS_DIR", constants.DefaultAttachmentsDir),
+			MaxAttachmentSize: getInt64Env("ATTACHMENT_MAX_SIZE", constants.DefaultMaxAttachmentSize),
+		},
+		SoftDelete: SoftDeleteConfig{
+			UndoWindow: getDurationEnv("UNDO_WINDOW", constants.DefaultUndoWindow),
+			Retention:  getDurationEnv("DELETED_RETENTION", constants.DefaultDeletedRetention),
+		},
 	}
 }
 
@@ -91,3 +129,12 @@ func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
 	}
 	return defaultValue
 }
+
+func getInt64Env(key string, defaultValue int64) int64 {
+	if value := os.Getenv(key); value != "" {
+		if n, err := strconv.ParseInt(value, 10, 64); err == nil && n > 0 {
+			return n
+		}
+	}
+	return defaultValue
+}
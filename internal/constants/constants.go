@@ -19,20 +19,96 @@ const (
 // Percentage calculation constants
 const (
 	PercentageBase     = 100.0
-	PercentageTolerance = 0.01
-	PercentageMinBound  = 99.99
-	PercentageMaxBound  = 100.01
+	PercentageMinBound = 99.99
+	PercentageMaxBound = 100.01
 )
 
-// Amount validation constants
+// Currency defaults
 const (
-	AmountTolerance     = 0.01 // 1 cent tolerance for floating point comparisons
-	MinPositiveAmount   = 0.01 // Minimum amount for debt/payment
+	DefaultCurrency = "EUR"
+)
+
+// Search defaults and limits
+const (
+	DefaultSearchPageSize = 10 // Hits per entity type
+	MaxSearchPageSize     = 50
+	MaxSearchQueryLength  = 200
 )
 
 // Forecast defaults
 const (
-	DefaultForecastMonths = 3
+	DefaultForecastMonths       = 3
+	ForecastHistoryMonths       = 24   // Past months the history model is fitted on
+	ForecastMovingAverageMonths = 3    // Recent months averaged for the level of the model
+	ForecastIntervalZ           = 1.28 // Width of the confidence range in standard deviations (80%)
+)
+
+// Upcoming occurrences of a recurring expense
+const (
+	DefaultUpcomingOccurrences = 6
+	MaxUpcomingOccurrences     = 60
+)
+
+// Budget alert thresholds, in percent of the limit
+const (
+	BudgetWarningThreshold  = 80
+	BudgetExceededThreshold = 100
+)
+
+// Background job intervals
+const (
+	DefaultRecurringExpensesInterval = time.Hour
+	DefaultPurgeDeletedInterval      = 6 * time.Hour
+	DefaultStalePaymentsInterval     = time.Hour
+)
+
+// Graceful shutdown
+const (
+	ShutdownTimeout = 30 * time.Second // Time given to running requests once the server is stopping
+)
+
+// Soft delete defaults
+const (
+	DefaultUndoWindow       = 168 * time.Hour // 7 days to restore a deleted item
+	DefaultDeletedRetention = 720 * time.Hour // 30 days before deleted items are purged
+)
+
+// Attachment limits
+const (
+	DefaultMaxAttachmentSize = 10 << 20 // 10 MB
+	MaxAttachmentsPerExpense = 10
+	AttachmentChunkSize      = 64 << 10 // 64 KB per streamed message
+	ThumbnailMaxDimension    = 320      // Longest side of generated thumbnails, in pixels
+	DefaultAttachmentsDir    = "./data/attachments"
+)
+
+// Bank statement import limits
+const (
+	MaxStatementSize         = 2 << 20 // 2 MB, below the default gRPC message limit
+	MaxStatementTransactions = 1000
+	StatementDuplicateDays   = 3   // Days around a statement line searched for an existing expense
+	MaxExpenseTitleLength    = 255 // Length of expenses.title
+)
+
+// Tag limits
+const (
+	MaxTagNameLength = 50 // Length of tags.name
+)
+
+// Payment instructions and disputes
+const (
+	PaymentQRCodeSize   = 512     // Side of the SEPA transfer QR codes, in pixels
+	MaxPaymentProofSize = 2 << 20 // 2 MB, below the default gRPC message limit
+)
+
+// Pending payment policy
+const (
+	MaxPaymentPolicyDays = 365 // Longest reminder or expiry delay of a colocation
+)
+
+// Export limits
+const (
+	MaxExportSize = 64 << 20 // 64 MB, maximum message size received by the gateway
 )
 
 // Channel buffer sizes
@@ -0,0 +1,43 @@
+package domain
+
+import "time"
+
+// Allowed attachment content types
+const (
+	ContentTypeJPEG = "image/jpeg"
+	ContentTypePNG  = "image/png"
+	ContentTypeGIF  = "image/gif"
+	ContentTypePDF  = "application/pdf"
+)
+
+// ExpenseAttachment represents a file (receipt, invoice) attached to an expense
+type ExpenseAttachment struct {
+	ID           string    `json:"id" db:"id"`
+	ExpenseID    string    `json:"expense_id" db:"expense_id"`
+	UploadedBy   string    `json:"uploaded_by" db:"uploaded_by"`
+	FileName     string    `json:"file_name" db:"file_name"`
+	ContentType  string    `json:"content_type" db:"content_type"`
+	SizeBytes    int64     `json:"size_bytes" db:"size_bytes"`
+	StorageKey   string    `json:"-" db:"storage_key"`
+	ThumbnailKey *string   `json:"-" db:"thumbnail_key"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+
+	// Joined fields
+	UploadedByNom    string `json:"uploaded_by_nom,omitempty"`
+	UploadedByPrenom string `json:"uploaded_by_prenom,omitempty"`
+}
+
+// HasThumbnail returns true if a thumbnail was generated for the attachment
+func (a *ExpenseAttachment) HasThumbnail() bool {
+	return a.ThumbnailKey != nil
+}
+
+// IsImage returns true if the attachment is an image
+func (a *ExpenseAttachment) IsImage() bool {
+	switch a.ContentType {
+	case ContentTypeJPEG, ContentTypePNG, ContentTypeGIF:
+		return true
+	default:
+		return false
+	}
+}
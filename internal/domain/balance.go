@@ -8,9 +8,10 @@ type UserBalance struct {
 	UserNom    string  `json:"user_nom"`
 	UserPrenom string  `json:"user_prenom"`
 	AvatarURL  *string `json:"avatar_url,omitempty"`
-	TotalPaid  float64 `json:"total_paid"`  // Total amount paid by user
-	TotalOwed  float64 `json:"total_owed"`  // Total amount user owes
-	NetBalance float64 `json:"net_balance"` // Positive = others owe them, Negative = they owe others
+	TotalPaid  Money   `json:"total_paid"`  // Total amount paid by user
+	TotalOwed  Money   `json:"total_owed"`  // Total amount user owes
+	NetBalance Money   `json:"net_balance"` // Positive = others owe them, Negative = they owe others
+	Currency   string  `json:"currency"`    // Base currency of the colocation
 }
 
 // Debt represents a debt from one user to another
@@ -21,7 +22,8 @@ type Debt struct {
 	ToUserID       string  `json:"to_user_id"`
 	ToUserNom      string  `json:"to_user_nom"`
 	ToUserPrenom   string  `json:"to_user_prenom"`
-	Amount         float64 `json:"amount"`
+	Amount         Money   `json:"amount"`
+	Currency       string  `json:"currency"`
 }
 
 // SimplifiedDebt represents a simplified debt after min-cash-flow algorithm
@@ -34,17 +36,19 @@ type SimplifiedDebt struct {
 	ToUserNom      string  `json:"to_user_nom"`
 	ToUserPrenom   string  `json:"to_user_prenom"`
 	ToAvatarURL    *string `json:"to_avatar_url,omitempty"`
-	Amount         float64 `json:"amount"`
+	Amount         Money   `json:"amount"`
+	Currency       string  `json:"currency"`
 }
 
 // BalanceHistoryEntry represents an entry in balance history
 type BalanceHistoryEntry struct {
 	Date              time.Time `json:"date"`
-	CumulativeBalance float64   `json:"cumulative_balance"`
+	CumulativeBalance Money     `json:"cumulative_balance"`
 	EventType         string    `json:"event_type"` // "expense" or "payment"
 	EventID           string    `json:"event_id"`
 	Description       string    `json:"description"`
-	Amount            float64   `json:"amount"`
+	Amount            Money     `json:"amount"`
+	Currency          string    `json:"currency"`
 }
 
 // Balance represents a stored balance between two users
@@ -53,6 +57,6 @@ type Balance struct {
 	ColocationID string    `json:"colocation_id" db:"colocation_id"`
 	FromUserID   string    `json:"from_user_id" db:"from_user_id"`
 	ToUserID     string    `json:"to_user_id" db:"to_user_id"`
-	Amount       float64   `json:"amount" db:"amount"`
+	Amount       Money     `json:"amount" db:"amount"`
 	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
 }
@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// NormalizeIBAN removes the spaces of an IBAN, upper-cases it and checks its
+// check digits
+func NormalizeIBAN(iban string) (string, error) {
+	iban = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(iban), " ", ""))
+	if len(iban) < 15 || len(iban) > 34 {
+		return "", fmt.Errorf("IBAN invalide: longueur incorrecte")
+	}
+	for i := 0; i < len(iban); i++ {
+		c := iban[i]
+		isLetter := c >= 'A' && c <= 'Z'
+		isDigit := c >= '0' && c <= '9'
+		if (i < 2 && !isLetter) || (i >= 2 && i < 4 && !isDigit) || (!isLetter && !isDigit) {
+			return "", fmt.Errorf("IBAN invalide: format incorrect")
+		}
+	}
+
+	// ISO 13616: the country code and check digits moved to the end, letters
+	// converted to numbers from 10, the number modulo 97 is 1
+	rearranged := iban[4:] + iban[:4]
+	remainder := 0
+	for i := 0; i < len(rearranged); i++ {
+		c := rearranged[i]
+		if c >= 'A' && c <= 'Z' {
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		} else {
+			remainder = (remainder*10 + int(c-'0')) % 97
+		}
+	}
+	if remainder != 1 {
+		return "", fmt.Errorf("IBAN invalide: cle de controle incorrecte")
+	}
+
+	return iban, nil
+}
+
+// NormalizeBIC upper-cases a BIC and checks its format: 4 letters for the
+// bank, 2 for the country, 2 characters for the location and an optional
+// branch of 3
+func NormalizeBIC(bic string) (string, error) {
+	bic = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(bic), " ", ""))
+	if len(bic) != 8 && len(bic) != 11 {
+		return "", fmt.Errorf("BIC invalide: 8 ou 11 caracteres attendus")
+	}
+	for i := 0; i < len(bic); i++ {
+		c := bic[i]
+		isLetter := c >= 'A' && c <= 'Z'
+		isDigit := c >= '0' && c <= '9'
+		if (i < 6 && !isLetter) || (!isLetter && !isDigit) {
+			return "", fmt.Errorf("BIC invalide: format incorrect")
+		}
+	}
+	return bic, nil
+}
+
+// SEPATransfer is a SEPA credit transfer encoded in an EPC QR code
+type SEPATransfer struct {
+	BeneficiaryName string
+	IBAN            string
+	BIC             string // Optional
+	Amount          Money  // In euros
+	Remittance      string // Unstructured, truncated to 140 characters
+}
+
+// EPC069-12 limits
+const (
+	epcMaxNameLength       = 70
+	epcMaxRemittanceLength = 140
+	epcMaxAmount           = Money(99999999999) // 999 999 999.99 EUR
+	epcMaxPayloadBytes     = 331
+)
+
+// EPCPayload returns the content of the EPC069-12 QR code (version 002,
+// UTF-8) of the transfer, understood by most European banking apps
+func (t SEPATransfer) EPCPayload() (string, error) {
+	name := epcField(t.BeneficiaryName, epcMaxNameLength)
+	if name == "" {
+		return "", fmt.Errorf("le nom du beneficiaire est obligatoire")
+	}
+	if t.Amount <= 0 || t.Amount > epcMaxAmount {
+		return "", fmt.Errorf("montant invalide pour un virement SEPA")
+	}
+
+	lines := []string{
+		"BCD",
+		"002",
+		"1", // UTF-8
+		"SCT",
+		t.BIC,
+		name,
+		t.IBAN,
+		"EUR" + t.Amount.String(),
+		"", // Purpose
+		"", // Structured remittance
+		epcField(t.Remittance, epcMaxRemittanceLength),
+	}
+
+	payload := strings.Join(lines, "\n")
+	if len(payload) > epcMaxPayloadBytes {
+		return "", fmt.Errorf("donnees du virement trop longues pour un QR code")
+	}
+	return payload, nil
+}
+
+// epcField puts s on a single line and truncates it to at most n characters
+func epcField(s string, n int) string {
+	s = strings.TrimSpace(strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(s))
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
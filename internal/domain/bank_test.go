@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+const testIBAN = "FR7630006000011234567890189"
+
+func TestNormalizeIBAN(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: testIBAN, want: testIBAN},
+		{in: "fr76 3000 6000 0112 3456 7890 189", want: testIBAN},
+		{in: "DE89370400440532013000", want: "DE89370400440532013000"},
+		{in: "GB82 WEST 1234 5698 7654 32", want: "GB82WEST12345698765432"},
+		{in: "FR7630006000011234567890188", wantErr: true}, // Bad check digits
+		{in: "FR7730006000011234567890189", wantErr: true},
+		{in: "FR76300060000", wantErr: true},               // Too short
+		{in: "7630006000011234567890189FR", wantErr: true}, // No country code
+		{in: "FR76-3000-6000-0112-3456-7890-189", wantErr: true},
+		{in: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := NormalizeIBAN(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("NormalizeIBAN(%q) = %q, erreur attendue", tt.in, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NormalizeIBAN(%q): %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("NormalizeIBAN(%q) = %q, attendu %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEPCPayloadLayout(t *testing.T) {
+	transfer := SEPATransfer{
+		BeneficiaryName: "Alice\nMartin ",
+		IBAN:            testIBAN,
+		BIC:             "BNPAFRPP",
+		Amount:          1250,
+		Remittance:      "Loyer mars",
+	}
+
+	got, err := transfer.EPCPayload()
+	if err != nil {
+		t.Fatalf("EPCPayload: %v", err)
+	}
+
+	want := "BCD\n002\n1\nSCT\nBNPAFRPP\nAlice Martin\n" + testIBAN + "\nEUR12.50\n\n\nLoyer mars"
+	if got != want {
+		t.Fatalf("EPCPayload = %q, attendu %q", got, want)
+	}
+
+	// The BIC is optional in version 002, its line stays empty
+	transfer.BIC = ""
+	got, err = transfer.EPCPayload()
+	if err != nil {
+		t.Fatalf("EPCPayload sans BIC: %v", err)
+	}
+	if lines := strings.Split(got, "\n"); len(lines) != 11 || lines[4] != "" {
+		t.Fatalf("EPCPayload sans BIC = %q", got)
+	}
+}
+
+func TestEPCPayloadTruncation(t *testing.T) {
+	tests := []struct {
+		name       string
+		transfer   SEPATransfer
+		line       int
+		want       string
+		wantLength int
+	}{
+		{
+			name:       "beneficiary name",
+			transfer:   SEPATransfer{BeneficiaryName: strings.Repeat("é", 80), Remittance: "Loyer"},
+			line:       5,
+			want:       strings.Repeat("é", 70),
+			wantLength: epcMaxNameLength,
+		},
+		{
+			name:       "remittance",
+			transfer:   SEPATransfer{BeneficiaryName: "Alice", Remittance: strings.Repeat("ü", 10) + strings.Repeat("a", 140)},
+			line:       10,
+			want:       strings.Repeat("ü", 10) + strings.Repeat("a", 130),
+			wantLength: epcMaxRemittanceLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.transfer.IBAN = testIBAN
+			tt.transfer.Amount = 100
+
+			got, err := tt.transfer.EPCPayload()
+			if err != nil {
+				t.Fatalf("EPCPayload: %v", err)
+			}
+			if !utf8.ValidString(got) {
+				t.Fatalf("EPCPayload coupe un caractere: %q", got)
+			}
+
+			field := strings.Split(got, "\n")[tt.line]
+			if field != tt.want {
+				t.Fatalf("champ de %d caracteres, attendu %d", utf8.RuneCountInString(field), tt.wantLength)
+			}
+		})
+	}
+}
+
+func TestEPCPayloadLimits(t *testing.T) {
+	valid := SEPATransfer{BeneficiaryName: "Alice", IBAN: testIBAN, Amount: 100}
+
+	tests := []struct {
+		name   string
+		modify func(*SEPATransfer)
+	}{
+		{"missing name", func(tr *SEPATransfer) { tr.BeneficiaryName = " \n " }},
+		{"zero amount", func(tr *SEPATransfer) { tr.Amount = 0 }},
+		{"negative amount", func(tr *SEPATransfer) { tr.Amount = -100 }},
+		{"amount too large", func(tr *SEPATransfer) { tr.Amount = epcMaxAmount + 1 }},
+		{"payload over 331 bytes", func(tr *SEPATransfer) {
+			// Both fields fit in characters but take two bytes each
+			tr.BeneficiaryName = strings.Repeat("é", 70)
+			tr.Remittance = strings.Repeat("é", 140)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transfer := valid
+			tt.modify(&transfer)
+			if payload, err := transfer.EPCPayload(); err == nil {
+				t.Fatalf("EPCPayload = %q, erreur attendue", payload)
+			}
+		})
+	}
+
+	// The largest amount and a payload of exactly 331 bytes are accepted
+	transfer := valid
+	transfer.Amount = epcMaxAmount
+	payload, err := transfer.EPCPayload()
+	if err != nil {
+		t.Fatalf("EPCPayload au montant maximal: %v", err)
+	}
+	transfer.Remittance = strings.Repeat("a", epcMaxRemittanceLength)
+	room := epcMaxPayloadBytes - (len(payload) - len(transfer.BeneficiaryName)) - epcMaxRemittanceLength
+	transfer.BeneficiaryName = strings.Repeat("é", room/2) + strings.Repeat("a", room%2)
+	if payload, err = transfer.EPCPayload(); err != nil || len(payload) != epcMaxPayloadBytes {
+		t.Fatalf("EPCPayload de %d octets: %v", len(payload), err)
+	}
+
+	// One more byte is rejected
+	transfer.BeneficiaryName += "a"
+	if payload, err = transfer.EPCPayload(); err == nil {
+		t.Fatalf("EPCPayload de %d octets accepte", len(payload))
+	}
+}
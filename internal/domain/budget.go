@@ -0,0 +1,51 @@
+package domain
+
+import "time"
+
+// BudgetPeriod defines the period a budget limit applies to
+type BudgetPeriod string
+
+const (
+	BudgetPeriodWeekly  BudgetPeriod = "weekly" // Monday to Sunday
+	BudgetPeriodMonthly BudgetPeriod = "monthly"
+)
+
+// Budget is a spending limit of a colocation, for a category or for all the
+// expenses when CategoryID is nil
+type Budget struct {
+	ID           string       `json:"id" db:"id"`
+	ColocationID string       `json:"colocation_id" db:"colocation_id"`
+	CategoryID   *string      `json:"category_id,omitempty" db:"category_id"`
+	Amount       Money        `json:"amount" db:"amount"` // In the base currency
+	Period       BudgetPeriod `json:"period" db:"period"`
+	CreatedBy    *string      `json:"created_by,omitempty" db:"created_by"`
+	CreatedAt    time.Time    `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time    `json:"updated_at" db:"updated_at"`
+
+	// Joined fields
+	CategoryName string `json:"category_name,omitempty"`
+	Currency     string `json:"currency"` // Base currency of the colocation
+}
+
+// Bounds returns the first and last day of the period containing date
+func (p BudgetPeriod) Bounds(date time.Time) (time.Time, time.Time) {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	if p == BudgetPeriodWeekly {
+		// Weeks start on Monday
+		start := day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		return start, start.AddDate(0, 0, 6)
+	}
+	start := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, -1)
+}
+
+// BudgetStatus is the spending of the current period of a budget
+type BudgetStatus struct {
+	Budget      Budget    `json:"budget"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+	Spent       Money     `json:"spent"`
+	Projected   Money     `json:"projected"` // Expected spending at the end of the period
+	Remaining   Money     `json:"remaining"` // Negative once the limit is exceeded
+	Percentage  float64   `json:"percentage"`
+}
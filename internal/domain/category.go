@@ -23,7 +23,7 @@ type CategoryStat struct {
 	CategoryName string  `json:"category_name"`
 	Icon         *string `json:"icon,omitempty"`
 	Color        *string `json:"color,omitempty"`
-	TotalAmount  float64 `json:"total_amount"`
+	TotalAmount  Money   `json:"total_amount"`
 	ExpenseCount int     `json:"expense_count"`
 	Percentage   float64 `json:"percentage"`
 }
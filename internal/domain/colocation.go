@@ -4,14 +4,19 @@ import "time"
 
 // Colocation represents a shared housing
 type Colocation struct {
-	ID          string    `json:"id" db:"id"`
-	Name        string    `json:"name" db:"name"`
-	Description *string   `json:"description,omitempty" db:"description"`
-	Address     *string   `json:"address,omitempty" db:"address"`
-	CreatedBy   string    `json:"created_by" db:"created_by"`
-	InviteCode  string    `json:"invite_code" db:"invite_code"`
-	CreatedAt   time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+	ID           string    `json:"id" db:"id"`
+	Name         string    `json:"name" db:"name"`
+	Description  *string   `json:"description,omitempty" db:"description"`
+	Address      *string   `json:"address,omitempty" db:"address"`
+	CreatedBy    string    `json:"created_by" db:"created_by"`
+	InviteCode   string    `json:"invite_code" db:"invite_code"`
+	BaseCurrency string    `json:"base_currency" db:"base_currency"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+
+	// Pending payment policy, nil disables the reminder or the expiry
+	PaymentReminderDays *int `json:"payment_reminder_days,omitempty" db:"payment_reminder_days"`
+	PaymentExpiryDays   *int `json:"payment_expiry_days,omitempty" db:"payment_expiry_days"`
 }
 
 // ColocationMember represents a member of a colocation
@@ -0,0 +1,168 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateScale is the number of rate units in 1: rates are stored with 8 decimals
+const RateScale = 100_000_000
+
+// RateOne is the rate between a currency and itself
+const RateOne Rate = RateScale
+
+// Rate is an exact exchange rate with 8 decimals, matching the NUMERIC(18, 8)
+// columns of the database. A rate r between a currency and the base currency
+// means that 1 unit of the currency is worth r units of the base currency.
+type Rate int64
+
+// ParseRate parses a positive decimal string such as "1.0835".
+// Digits beyond the 8th decimal are rounded half up.
+func ParseRate(s string) (Rate, error) {
+	s = strings.TrimSpace(s)
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	if intPart == "" {
+		intPart = "0"
+	}
+
+	units, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil || units < 0 || units >= 1e10 {
+		return 0, fmt.Errorf("taux de change invalide: %q", s)
+	}
+
+	var frac int64
+	for i := 0; i < len(fracPart); i++ {
+		c := fracPart[i]
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("taux de change invalide: %q", s)
+		}
+		switch {
+		case i < 8:
+			frac = frac*10 + int64(c-'0')
+		case i == 8 && c >= '5':
+			frac++
+		}
+	}
+	for i := len(fracPart); i < 8; i++ {
+		frac *= 10
+	}
+
+	rate := Rate(units*RateScale + frac)
+	if rate <= 0 {
+		return 0, fmt.Errorf("le taux de change doit etre positif")
+	}
+	return rate, nil
+}
+
+// String formats the rate without trailing zeros, e.g. "1.0835"
+func (r Rate) String() string {
+	s := fmt.Sprintf("%d.%08d", int64(r)/RateScale, int64(r)%RateScale)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// Convert converts an amount into the base currency, rounding half away from
+// zero to the cent
+func (r Rate) Convert(m Money) Money {
+	if r == RateOne {
+		return m
+	}
+
+	product := new(big.Int).Mul(big.NewInt(int64(m)), big.NewInt(int64(r)))
+	scale := big.NewInt(RateScale)
+	quotient, remainder := new(big.Int).QuoRem(product, scale, new(big.Int))
+
+	// Round half away from zero
+	remainder.Abs(remainder).Mul(remainder, big.NewInt(2))
+	if remainder.Cmp(scale) >= 0 {
+		if product.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+
+	return Money(quotient.Int64())
+}
+
+// Scan implements sql.Scanner for NUMERIC columns
+func (r *Rate) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseRate(v)
+		if err != nil {
+			return err
+		}
+		*r = parsed
+		return nil
+	case []byte:
+		parsed, err := ParseRate(string(v))
+		if err != nil {
+			return err
+		}
+		*r = parsed
+		return nil
+	case int64:
+		*r = Rate(v * RateScale)
+		return nil
+	default:
+		return fmt.Errorf("impossible de convertir %T en taux de change", src)
+	}
+}
+
+// Value implements driver.Valuer, sending the rate as an exact decimal string
+func (r Rate) Value() (driver.Value, error) {
+	return r.String(), nil
+}
+
+// MarshalJSON encodes the rate as an exact decimal number, e.g. 1.0835
+func (r Rate) MarshalJSON() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalJSON decodes a decimal number or string
+func (r *Rate) UnmarshalJSON(data []byte) error {
+	parsed, err := ParseRate(strings.Trim(string(data), `"`))
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
+
+// NormalizeCurrency upper-cases an ISO 4217 currency code and checks its format
+func NormalizeCurrency(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) != 3 {
+		return "", fmt.Errorf("devise invalide: %q (code ISO 4217 attendu, ex: EUR)", code)
+	}
+	for i := 0; i < len(code); i++ {
+		if code[i] < 'A' || code[i] > 'Z' {
+			return "", fmt.Errorf("devise invalide: %q (code ISO 4217 attendu, ex: EUR)", code)
+		}
+	}
+	return code, nil
+}
+
+// Exchange rate sources
+const (
+	RateSourceImport = "import" // Imported by an admin of the colocation
+	RateSourceManual = "manual" // Entered with an expense or a payment
+)
+
+// ExchangeRate is a stored rate between a currency and the base currency of a colocation
+type ExchangeRate struct {
+	ID           string    `json:"id" db:"id"`
+	ColocationID string    `json:"colocation_id" db:"colocation_id"`
+	BaseCurrency string    `json:"base_currency" db:"base_currency"`
+	Currency     string    `json:"currency" db:"currency"`
+	Rate         Rate      `json:"rate" db:"rate"`
+	RateDate     time.Time `json:"rate_date" db:"rate_date"`
+	Source       string    `json:"source" db:"source"`
+	CreatedBy    *string   `json:"created_by,omitempty" db:"created_by"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
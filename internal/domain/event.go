@@ -0,0 +1,82 @@
+package domain
+
+import "time"
+
+// EventStatus represents the lifecycle status of an event
+type EventStatus string
+
+const (
+	EventStatusUpcoming  EventStatus = "upcoming"
+	EventStatusOngoing   EventStatus = "ongoing"
+	EventStatusCompleted EventStatus = "completed"
+	EventStatusCancelled EventStatus = "cancelled"
+)
+
+// CanTransitionTo reports whether the status lifecycle allows moving to next.
+// upcoming -> ongoing -> completed, and any non-final status can be cancelled.
+func (s EventStatus) CanTransitionTo(next EventStatus) bool {
+	if s == next {
+		return true
+	}
+	switch s {
+	case EventStatusUpcoming:
+		return next == EventStatusOngoing || next == EventStatusCancelled
+	case EventStatusOngoing:
+		return next == EventStatusCompleted || next == EventStatusCancelled
+	default:
+		return false
+	}
+}
+
+// IsFinal returns true if the event can no longer change
+func (s EventStatus) IsFinal() bool {
+	return s == EventStatusCompleted || s == EventStatusCancelled
+}
+
+// RSVPStatus represents a participant's answer to an event
+type RSVPStatus string
+
+const (
+	RSVPGoing    RSVPStatus = "going"
+	RSVPMaybe    RSVPStatus = "maybe"
+	RSVPNotGoing RSVPStatus = "not_going"
+)
+
+// Event represents an event, optionally linked to a common fund
+type Event struct {
+	ID           string      `json:"id" db:"id"`
+	ColocationID string      `json:"colocation_id" db:"colocation_id"`
+	FundID       *string     `json:"fund_id,omitempty" db:"fund_id"`
+	CreatedBy    string      `json:"created_by" db:"created_by"`
+	Title        string      `json:"title" db:"title"`
+	Description  *string     `json:"description,omitempty" db:"description"`
+	Budget       *Money      `json:"budget,omitempty" db:"budget"`
+	EventDate    time.Time   `json:"event_date" db:"event_date"`
+	Location     *string     `json:"location,omitempty" db:"location"`
+	Status       EventStatus `json:"status" db:"status"`
+	CreatedAt    time.Time   `json:"created_at" db:"created_at"`
+
+	// Joined fields
+	CreatedByNom    string      `json:"created_by_nom,omitempty"`
+	CreatedByPrenom string      `json:"created_by_prenom,omitempty"`
+	FundName        *string     `json:"fund_name,omitempty"`
+	UserRSVP        *RSVPStatus `json:"user_rsvp,omitempty"`
+	GoingCount      int         `json:"going_count"`
+	MaybeCount      int         `json:"maybe_count"`
+	NotGoingCount   int         `json:"not_going_count"`
+	Currency        string      `json:"currency"` // Base currency of the colocation
+}
+
+// EventParticipant represents a member's RSVP to an event
+type EventParticipant struct {
+	ID          string     `json:"id" db:"id"`
+	EventID     string     `json:"event_id" db:"event_id"`
+	UserID      string     `json:"user_id" db:"user_id"`
+	RSVP        RSVPStatus `json:"rsvp" db:"rsvp"`
+	RespondedAt time.Time  `json:"responded_at" db:"created_at"`
+
+	// Joined fields
+	UserNom    string  `json:"user_nom,omitempty"`
+	UserPrenom string  `json:"user_prenom,omitempty"`
+	AvatarURL  *string `json:"avatar_url,omitempty"`
+}
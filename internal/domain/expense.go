@@ -6,9 +6,12 @@ import "time"
 type SplitType string
 
 const (
-	SplitTypeEqual      SplitType = "equal"
-	SplitTypePercentage SplitType = "percentage"
-	SplitTypeCustom     SplitType = "custom"
+	SplitTypeEqual         SplitType = "equal"
+	SplitTypePercentage    SplitType = "percentage"
+	SplitTypeCustom        SplitType = "custom"
+	SplitTypeItemized      SplitType = "itemized"
+	SplitTypeEqualSelected SplitType = "equal_selected" // Equal among the given participants
+	SplitTypeShares        SplitType = "shares"         // By integer shares per participant
 )
 
 // Recurrence defines how often a recurring expense repeats
@@ -25,21 +28,51 @@ const (
 type Expense struct {
 	ID           string     `json:"id" db:"id"`
 	ColocationID string     `json:"colocation_id" db:"colocation_id"`
-	PaidBy       string     `json:"paid_by" db:"paid_by"`
+	PaidBy       string     `json:"paid_by" db:"paid_by"`             // Main payer, see Payers
+	CreatedBy    string     `json:"created_by" db:"created_by"`       // Member who recorded the expense
 	CategoryID   string     `json:"category_id" db:"category_id"`
 	Title        string     `json:"title" db:"title"`
 	Description  *string    `json:"description,omitempty" db:"description"`
-	Amount       float64    `json:"amount" db:"amount"`
+	Amount       Money      `json:"amount" db:"amount"`               // In Currency
+	Currency     string     `json:"currency" db:"currency"`
+	ExchangeRate Rate       `json:"exchange_rate" db:"exchange_rate"` // Frozen when the expense was recorded
+	BaseAmount   Money      `json:"base_amount" db:"base_amount"`     // In the colocation base currency
 	SplitType    SplitType  `json:"split_type" db:"split_type"`
 	ExpenseDate  time.Time  `json:"expense_date" db:"expense_date"`
 	RecurringID  *string    `json:"recurring_id,omitempty" db:"recurring_id"`
+	ImportRef    *string    `json:"import_ref,omitempty" db:"import_ref"` // Bank statement line it was imported from
 	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+	DeletedAt    *time.Time `json:"deleted_at,omitempty" db:"deleted_at"` // Soft delete, purged after the retention
+	DeletedBy    *string    `json:"deleted_by,omitempty" db:"deleted_by"`
 
 	// Joined fields
 	PaidByNom     string `json:"paid_by_nom,omitempty"`
 	PaidByPrenom  string `json:"paid_by_prenom,omitempty"`
 	CategoryName  string `json:"category_name,omitempty"`
+	BaseCurrency  string `json:"base_currency,omitempty"`
 	Splits        []ExpenseSplit `json:"splits,omitempty"`
+	Items         []ExpenseItem  `json:"items,omitempty"` // Itemized expenses only
+	Payers        []ExpensePayer `json:"payers,omitempty"`
+	Tags          []Tag          `json:"tags,omitempty"` // Replaced on save only when not nil
+}
+
+// ExpensePayer is the contribution of a member to the payment of an expense.
+// The contributions of an expense sum to its amount.
+type ExpensePayer struct {
+	ExpenseID  string `json:"expense_id" db:"expense_id"`
+	UserID     string `json:"user_id" db:"user_id"`
+	Amount     Money  `json:"amount" db:"amount"`           // In the expense currency
+	BaseAmount Money  `json:"base_amount" db:"base_amount"` // In the colocation base currency
+
+	// Joined fields
+	UserNom    string `json:"user_nom,omitempty"`
+	UserPrenom string `json:"user_prenom,omitempty"`
+}
+
+// ExpensePayerInput is used when creating/updating the payers of an expense
+type ExpensePayerInput struct {
+	UserID string `json:"user_id"`
+	Amount Money  `json:"amount"`
 }
 
 // ExpenseSplit represents how an expense is split for a specific user
@@ -47,15 +80,77 @@ type ExpenseSplit struct {
 	ID        string  `json:"id" db:"id"`
 	ExpenseID string  `json:"expense_id" db:"expense_id"`
 	UserID    string  `json:"user_id" db:"user_id"`
-	Amount    float64 `json:"amount" db:"amount"`
+	Amount    Money   `json:"amount" db:"amount"`
+	BaseAmount Money  `json:"base_amount" db:"base_amount"`
 	Percentage float64 `json:"percentage" db:"percentage"`
-	IsSettled bool    `json:"is_settled" db:"is_settled"`
+	Shares    int     `json:"shares,omitempty" db:"shares"` // Shares split type only
+	IsSettled bool    `json:"is_settled" db:"is_settled"` // Fully reimbursed to the payers, or paid by the user
+
+	// Joined fields
+	UserNom       string `json:"user_nom,omitempty"`
+	UserPrenom    string `json:"user_prenom,omitempty"`
+	SettledAmount Money  `json:"settled_amount"` // Confirmed payments allocated to this share, in the base currency
+}
+
+// SplitSettlementStatus tells how much of a split was reimbursed
+type SplitSettlementStatus string
+
+const (
+	SplitUnsettled        SplitSettlementStatus = "unsettled"
+	SplitPartiallySettled SplitSettlementStatus = "partially_settled"
+	SplitSettled          SplitSettlementStatus = "settled"
+)
+
+// SettlementStatus returns the settlement status of a split
+func (s *ExpenseSplit) SettlementStatus() SplitSettlementStatus {
+	switch {
+	case s.IsSettled:
+		return SplitSettled
+	case s.SettledAmount > 0:
+		return SplitPartiallySettled
+	default:
+		return SplitUnsettled
+	}
+}
+
+// ExpenseItem is a line item of an itemized expense
+type ExpenseItem struct {
+	ID             string `json:"id" db:"id"`
+	ExpenseID      string `json:"expense_id" db:"expense_id"`
+	Label          string `json:"label" db:"label"`
+	Amount         Money  `json:"amount" db:"amount"`
+	IsSharedCharge bool   `json:"is_shared_charge" db:"is_shared_charge"` // Tax or tip, distributed proportionally to the other items
+	Position       int    `json:"position" db:"position"`
+
+	// Joined fields
+	Participants []ExpenseItemParticipant `json:"participants,omitempty"`
+}
+
+// ExpenseItemParticipant is a member sharing a line item
+type ExpenseItemParticipant struct {
+	UserID string  `json:"user_id" db:"user_id"`
+	Weight float64 `json:"weight" db:"weight"`
 
 	// Joined fields
 	UserNom    string `json:"user_nom,omitempty"`
 	UserPrenom string `json:"user_prenom,omitempty"`
 }
 
+// ExpenseItemInput is used when creating/updating the line items of an
+// itemized expense. Weights default to 1.
+type ExpenseItemInput struct {
+	Label          string                        `json:"label"`
+	Amount         Money                         `json:"amount"`
+	IsSharedCharge bool                          `json:"is_shared_charge"`
+	Participants   []ExpenseItemParticipantInput `json:"participants"`
+}
+
+// ExpenseItemParticipantInput is a participant of a line item input
+type ExpenseItemParticipantInput struct {
+	UserID string  `json:"user_id"`
+	Weight float64 `json:"weight"`
+}
+
 // RecurringExpense represents a recurring expense template
 type RecurringExpense struct {
 	ID           string     `json:"id" db:"id"`
@@ -64,19 +159,22 @@ type RecurringExpense struct {
 	CategoryID   string     `json:"category_id" db:"category_id"`
 	Title        string     `json:"title" db:"title"`
 	Description  *string    `json:"description,omitempty" db:"description"`
-	Amount       float64    `json:"amount" db:"amount"`
+	Amount       Money      `json:"amount" db:"amount"`
 	SplitType    SplitType  `json:"split_type" db:"split_type"`
 	Recurrence   Recurrence `json:"recurrence" db:"recurrence"`
 	NextDueDate  time.Time  `json:"next_due_date" db:"next_due_date"`
 	EndDate      *time.Time `json:"end_date,omitempty" db:"end_date"`
 	IsActive     bool       `json:"is_active" db:"is_active"`
+	PausedUntil  *time.Time `json:"paused_until,omitempty" db:"paused_until"` // Occurrences before this date are not generated
 	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
 
 	// Joined fields
-	PaidByNom    string                 `json:"paid_by_nom,omitempty"`
-	PaidByPrenom string                 `json:"paid_by_prenom,omitempty"`
-	CategoryName string                 `json:"category_name,omitempty"`
-	Splits       []RecurringExpenseSplit `json:"splits,omitempty"`
+	PaidByNom    string                        `json:"paid_by_nom,omitempty"`
+	PaidByPrenom string                        `json:"paid_by_prenom,omitempty"`
+	CategoryName string                        `json:"category_name,omitempty"`
+	Splits       []RecurringExpenseSplit       `json:"splits,omitempty"`
+	Currency     string                        `json:"currency"`            // Base currency of the colocation
+	Overrides    []RecurringOccurrenceOverride `json:"overrides,omitempty"` // Occurrences not generated yet only
 }
 
 // RecurringExpenseSplit represents the split percentage for a recurring expense
@@ -85,6 +183,7 @@ type RecurringExpenseSplit struct {
 	RecurringID string  `json:"recurring_id" db:"recurring_id"`
 	UserID      string  `json:"user_id" db:"user_id"`
 	Percentage  float64 `json:"percentage" db:"percentage"`
+	Shares      int     `json:"shares,omitempty" db:"shares"` // Shares split type only
 
 	// Joined fields
 	UserNom    string `json:"user_nom,omitempty"`
@@ -94,20 +193,73 @@ type RecurringExpenseSplit struct {
 // ExpenseSplitInput is used when creating/updating an expense split
 type ExpenseSplitInput struct {
 	UserID     string  `json:"user_id"`
-	Amount     float64 `json:"amount"`
+	Amount     Money   `json:"amount"`
+	BaseAmount Money   `json:"base_amount"`
 	Percentage float64 `json:"percentage"`
+	Shares     int     `json:"shares"`
 }
 
-// MonthlyForecast represents a forecast for a specific month
+// ForecastMode defines how one-off spending is estimated in a forecast
+type ForecastMode string
+
+const (
+	ForecastModeRecurring ForecastMode = "recurring" // Recurring templates plus the average of recent expenses
+	ForecastModeHistory   ForecastMode = "history"   // Recurring templates plus a seasonal model fitted on past expenses
+)
+
+// MonthlyForecast represents a forecast for a specific month. The range is
+// the sum of the category ranges.
 type MonthlyForecast struct {
-	Month       string            `json:"month"` // Format: YYYY-MM
-	TotalAmount float64           `json:"total_amount"`
-	Categories  []CategoryForecast `json:"categories"`
+	Month           string             `json:"month"` // Format: YYYY-MM
+	Currency        string             `json:"currency"`
+	TotalAmount     Money              `json:"total_amount"`
+	RecurringAmount Money              `json:"recurring_amount"`
+	EstimatedAmount Money              `json:"estimated_amount"`
+	LowAmount       Money              `json:"low_amount"`
+	HighAmount      Money              `json:"high_amount"`
+	Categories      []CategoryForecast `json:"categories"`
 }
 
-// CategoryForecast represents a forecast for a specific category
+// Add adds a category forecast to the month, merging it with the forecast
+// already present for the same category
+func (f *MonthlyForecast) Add(c CategoryForecast) {
+	f.TotalAmount += c.Amount
+	f.RecurringAmount += c.RecurringAmount
+	f.EstimatedAmount += c.EstimatedAmount
+	f.LowAmount += c.LowAmount
+	f.HighAmount += c.HighAmount
+
+	for i := range f.Categories {
+		existing := &f.Categories[i]
+		if existing.CategoryID == c.CategoryID {
+			existing.Amount += c.Amount
+			existing.RecurringAmount += c.RecurringAmount
+			existing.EstimatedAmount += c.EstimatedAmount
+			existing.LowAmount += c.LowAmount
+			existing.HighAmount += c.HighAmount
+			return
+		}
+	}
+	f.Categories = append(f.Categories, c)
+}
+
+// CategoryForecast represents a forecast for a specific category. Amount is
+// the sum of the recurring occurrences and of the estimated one-off spending,
+// which is expected between LowAmount and HighAmount.
 type CategoryForecast struct {
-	CategoryID   string  `json:"category_id"`
-	CategoryName string  `json:"category_name"`
-	Amount       float64 `json:"amount"`
+	CategoryID      string `json:"category_id"`
+	CategoryName    string `json:"category_name"`
+	Amount          Money  `json:"amount"`
+	RecurringAmount Money  `json:"recurring_amount"`
+	EstimatedAmount Money  `json:"estimated_amount"`
+	LowAmount       Money  `json:"low_amount"`
+	HighAmount      Money  `json:"high_amount"`
+}
+
+// CategoryMonthTotal is the one-off spending of a category over a month
+type CategoryMonthTotal struct {
+	CategoryID   string
+	CategoryName string
+	Month        time.Time // First day of the month
+	Amount       Money
 }
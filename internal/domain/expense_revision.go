@@ -0,0 +1,108 @@
+package domain
+
+import "time"
+
+// RevisionAction is the change recorded by an expense revision
+type RevisionAction string
+
+const (
+	RevisionCreate  RevisionAction = "create"
+	RevisionUpdate  RevisionAction = "update"
+	RevisionDelete  RevisionAction = "delete"
+	RevisionRestore RevisionAction = "restore"
+)
+
+// ExpenseRevision is an entry of the append-only history of an expense
+type ExpenseRevision struct {
+	ID           string          `json:"id" db:"id"`
+	ExpenseID    string          `json:"expense_id" db:"expense_id"`
+	ColocationID string          `json:"colocation_id" db:"colocation_id"`
+	Revision     int             `json:"revision" db:"revision"`
+	Action       RevisionAction  `json:"action" db:"action"`
+	ChangedBy    *string         `json:"changed_by,omitempty" db:"changed_by"`
+	Snapshot     ExpenseSnapshot `json:"snapshot" db:"snapshot"`
+	CreatedAt    time.Time       `json:"created_at" db:"created_at"`
+
+	// Joined fields
+	ChangedByNom    string               `json:"changed_by_nom,omitempty"`
+	ChangedByPrenom string               `json:"changed_by_prenom,omitempty"`
+	Changes         []ExpenseFieldChange `json:"changes,omitempty"` // Compared to the previous revision
+}
+
+// ExpenseSnapshot is the state of an expense recorded in a revision, with
+// everything needed to restore it
+type ExpenseSnapshot struct {
+	PaidBy       string              `json:"paid_by"`
+	CreatedBy    string              `json:"created_by"`
+	CategoryID   string              `json:"category_id"`
+	Title        string              `json:"title"`
+	Description  *string             `json:"description,omitempty"`
+	Amount       Money               `json:"amount"`
+	Currency     string              `json:"currency"`
+	ExchangeRate Rate                `json:"exchange_rate"`
+	BaseAmount   Money               `json:"base_amount"`
+	SplitType    SplitType           `json:"split_type"`
+	ExpenseDate  time.Time           `json:"expense_date"`
+	RecurringID  *string             `json:"recurring_id,omitempty"`
+	ImportRef    *string             `json:"import_ref,omitempty"`
+	Payers       []ExpensePayer      `json:"payers"`
+	Splits       []ExpenseSplitInput `json:"splits"`
+	Items        []ExpenseItemInput  `json:"items,omitempty"`
+}
+
+// ExpenseFieldChange is a field of an expense changed by a revision.
+// Values are formatted for display.
+type ExpenseFieldChange struct {
+	Field    string `json:"field"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+}
+
+// NewExpenseSnapshot records the state of an expense with the given splits
+func NewExpenseSnapshot(e *Expense, splits []ExpenseSplitInput) ExpenseSnapshot {
+	snapshot := ExpenseSnapshot{
+		PaidBy:       e.PaidBy,
+		CreatedBy:    e.CreatedBy,
+		CategoryID:   e.CategoryID,
+		Title:        e.Title,
+		Description:  e.Description,
+		Amount:       e.Amount,
+		Currency:     e.Currency,
+		ExchangeRate: e.ExchangeRate,
+		BaseAmount:   e.BaseAmount,
+		SplitType:    e.SplitType,
+		ExpenseDate:  e.ExpenseDate,
+		RecurringID:  e.RecurringID,
+		ImportRef:    e.ImportRef,
+		Splits:       splits,
+	}
+
+	for _, p := range e.Payers {
+		snapshot.Payers = append(snapshot.Payers, ExpensePayer{UserID: p.UserID, Amount: p.Amount, BaseAmount: p.BaseAmount})
+	}
+
+	for _, item := range e.Items {
+		in := ExpenseItemInput{Label: item.Label, Amount: item.Amount, IsSharedCharge: item.IsSharedCharge}
+		for _, p := range item.Participants {
+			in.Participants = append(in.Participants, ExpenseItemParticipantInput{UserID: p.UserID, Weight: p.Weight})
+		}
+		snapshot.Items = append(snapshot.Items, in)
+	}
+
+	return snapshot
+}
+
+// SplitInputs returns the stored splits of an expense as inputs
+func (e *Expense) SplitInputs() []ExpenseSplitInput {
+	splits := make([]ExpenseSplitInput, len(e.Splits))
+	for i, s := range e.Splits {
+		splits[i] = ExpenseSplitInput{
+			UserID:     s.UserID,
+			Amount:     s.Amount,
+			BaseAmount: s.BaseAmount,
+			Percentage: s.Percentage,
+			Shares:     s.Shares,
+		}
+	}
+	return splits
+}
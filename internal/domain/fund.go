@@ -8,8 +8,8 @@ type CommonFund struct {
 	ColocationID  string   `json:"colocation_id" db:"colocation_id"`
 	Name          string   `json:"name" db:"name"`
 	Description   *string  `json:"description,omitempty" db:"description"`
-	TargetAmount  *float64 `json:"target_amount,omitempty" db:"target_amount"`
-	CurrentAmount float64  `json:"current_amount" db:"current_amount"`
+	TargetAmount  *Money   `json:"target_amount,omitempty" db:"target_amount"`
+	CurrentAmount Money    `json:"current_amount" db:"current_amount"`
 	IsActive      bool     `json:"is_active" db:"is_active"`
 	CreatedBy     string   `json:"created_by" db:"created_by"`
 	CreatedAt     time.Time `json:"created_at" db:"created_at"`
@@ -18,6 +18,7 @@ type CommonFund struct {
 	CreatedByNom       string               `json:"created_by_nom,omitempty"`
 	CreatedByPrenom    string               `json:"created_by_prenom,omitempty"`
 	ProgressPercentage float64              `json:"progress_percentage"`
+	Currency           string               `json:"currency"` // Base currency of the colocation
 	Contributors       []ContributorSummary `json:"contributors,omitempty"`
 }
 
@@ -26,50 +27,66 @@ type ContributorSummary struct {
 	UserID           string  `json:"user_id"`
 	UserNom          string  `json:"user_nom"`
 	UserPrenom       string  `json:"user_prenom"`
-	TotalContributed float64 `json:"total_contributed"`
+	TotalContributed Money   `json:"total_contributed"`
 }
 
 // FundContribution represents a single contribution to a fund
 type FundContribution struct {
-	ID        string    `json:"id" db:"id"`
-	FundID    string    `json:"fund_id" db:"fund_id"`
-	UserID    string    `json:"user_id" db:"user_id"`
-	Amount    float64   `json:"amount" db:"amount"`
-	Note      *string   `json:"note,omitempty" db:"note"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	ID        string     `json:"id" db:"id"`
+	FundID    string     `json:"fund_id" db:"fund_id"`
+	UserID    string     `json:"user_id" db:"user_id"`
+	Amount    Money      `json:"amount" db:"amount"`
+	Note      *string    `json:"note,omitempty" db:"note"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" db:"deleted_at"` // Soft delete, purged after the retention
+	DeletedBy *string    `json:"deleted_by,omitempty" db:"deleted_by"`
 
 	// Joined fields
 	UserNom    string `json:"user_nom,omitempty"`
 	UserPrenom string `json:"user_prenom,omitempty"`
+	Currency   string `json:"currency"`
 }
 
-// Event represents an event linked to a fund
-type Event struct {
-	ID           string     `json:"id" db:"id"`
-	ColocationID string     `json:"colocation_id" db:"colocation_id"`
-	FundID       *string    `json:"fund_id,omitempty" db:"fund_id"`
-	CreatedBy    string     `json:"created_by" db:"created_by"`
-	Title        string     `json:"title" db:"title"`
-	Description  *string    `json:"description,omitempty" db:"description"`
-	Budget       *float64   `json:"budget,omitempty" db:"budget"`
-	EventDate    *time.Time `json:"event_date,omitempty" db:"event_date"`
-	Location     *string    `json:"location,omitempty" db:"location"`
-	Status       string     `json:"status" db:"status"`
-	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+// FundWithdrawal represents money spent from a fund, for an expense paid by
+// the fund when ExpenseID is set. Expenses paid by a fund are left out of the
+// balances between members.
+type FundWithdrawal struct {
+	ID        string     `json:"id" db:"id"`
+	FundID    string     `json:"fund_id" db:"fund_id"`
+	UserID    string     `json:"user_id" db:"user_id"`
+	Amount    Money      `json:"amount" db:"amount"`
+	Note      *string    `json:"note,omitempty" db:"note"`
+	ExpenseID *string    `json:"expense_id,omitempty" db:"expense_id"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" db:"deleted_at"` // Soft delete, purged after the retention
+	DeletedBy *string    `json:"deleted_by,omitempty" db:"deleted_by"`
 
 	// Joined fields
-	CreatedByNom    string             `json:"created_by_nom,omitempty"`
-	CreatedByPrenom string             `json:"created_by_prenom,omitempty"`
-	Participants    []EventParticipant `json:"participants,omitempty"`
+	UserNom      string  `json:"user_nom,omitempty"`
+	UserPrenom   string  `json:"user_prenom,omitempty"`
+	ExpenseTitle *string `json:"expense_title,omitempty"`
+	Currency     string  `json:"currency"`
 }
 
-// EventParticipant represents a participant in an event
-type EventParticipant struct {
-	ID         string    `json:"id" db:"id"`
-	EventID    string    `json:"event_id" db:"event_id"`
-	UserID     string    `json:"user_id" db:"user_id"`
-	RSVP       string    `json:"rsvp" db:"rsvp"` // "yes", "no", "maybe"
-	CreatedAt  time.Time `json:"created_at" db:"created_at"`
-	UserNom    string    `json:"user_nom,omitempty"`
-	UserPrenom string    `json:"user_prenom,omitempty"`
+// FundTransactionType tells whether a fund transaction added or spent money
+type FundTransactionType string
+
+const (
+	FundTransactionContribution FundTransactionType = "contribution"
+	FundTransactionWithdrawal   FundTransactionType = "withdrawal"
+)
+
+// FundTransaction is a line of the ledger of a fund
+type FundTransaction struct {
+	ID           string              `json:"id"` // ID of the contribution or the withdrawal
+	Type         FundTransactionType `json:"type"`
+	UserID       string              `json:"user_id"`
+	UserNom      string              `json:"user_nom"`
+	UserPrenom   string              `json:"user_prenom"`
+	Amount       Money               `json:"amount"`  // Always positive
+	Balance      Money               `json:"balance"` // Fund amount right after the transaction
+	Note         *string             `json:"note,omitempty"`
+	ExpenseID    *string             `json:"expense_id,omitempty"`
+	ExpenseTitle *string             `json:"expense_title,omitempty"`
+	CreatedAt    time.Time           `json:"created_at"`
 }
@@ -0,0 +1,230 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Money is an exact amount of money expressed in cents.
+// It maps to the DECIMAL(10, 2) columns of the database without going
+// through floating point.
+type Money int64
+
+// MoneyFromFloat converts a decimal amount (e.g. 12.34) to Money, rounding to the nearest cent
+func MoneyFromFloat(amount float64) Money {
+	return Money(math.Round(amount * 100))
+}
+
+// ParseMoney parses a decimal string such as "12.34" or "-0.5".
+// Digits beyond the cent are rounded half away from zero.
+func ParseMoney(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("montant vide")
+	}
+
+	negative := false
+	switch s[0] {
+	case '-':
+		negative = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	if intPart == "" {
+		intPart = "0"
+	}
+
+	units, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("montant invalide: %q", s)
+	}
+
+	var cents int64
+	for i := 0; i < len(fracPart); i++ {
+		c := fracPart[i]
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("montant invalide: %q", s)
+		}
+		switch {
+		case i < 2:
+			cents = cents*10 + int64(c-'0')
+		case i == 2 && c >= '5':
+			cents++
+		}
+	}
+	if len(fracPart) == 1 {
+		cents *= 10
+	}
+
+	total := units*100 + cents
+	if negative {
+		total = -total
+	}
+	return Money(total), nil
+}
+
+// Cents returns the amount in cents
+func (m Money) Cents() int64 {
+	return int64(m)
+}
+
+// Float64 returns the amount as a decimal number, for display and ratios only
+func (m Money) Float64() float64 {
+	return float64(m) / 100
+}
+
+// Abs returns the absolute value of the amount
+func (m Money) Abs() Money {
+	if m < 0 {
+		return -m
+	}
+	return m
+}
+
+// String formats the amount with two decimals, e.g. "12.34"
+func (m Money) String() string {
+	sign := ""
+	v := int64(m)
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, v/100, v%100)
+}
+
+// Split divides the amount into n parts that sum exactly to the amount.
+// Remainder cents go one by one to the first parts, so the result is
+// deterministic for a given order.
+func (m Money) Split(n int) []Money {
+	if n <= 0 {
+		return nil
+	}
+
+	parts := make([]Money, n)
+	base := int64(m) / int64(n)
+	remainder := int64(m) % int64(n)
+
+	for i := range parts {
+		parts[i] = Money(base)
+	}
+
+	step := Money(1)
+	if remainder < 0 {
+		step = -1
+		remainder = -remainder
+	}
+	for i := int64(0); i < remainder; i++ {
+		parts[i] += step
+	}
+
+	return parts
+}
+
+// Allocate divides the amount proportionally to weights, using the largest
+// remainder method so that the parts sum exactly to the amount. Ties are
+// broken by position, which keeps the result deterministic.
+func (m Money) Allocate(weights []float64) []Money {
+	parts := make([]Money, len(weights))
+
+	var totalWeight float64
+	for _, w := range weights {
+		totalWeight += w
+	}
+	if totalWeight <= 0 {
+		return parts
+	}
+
+	type remainder struct {
+		index int
+		frac  float64
+	}
+
+	allocated := Money(0)
+	remainders := make([]remainder, len(weights))
+	for i, w := range weights {
+		exact := float64(m) * w / totalWeight
+		floor := math.Floor(exact)
+		parts[i] = Money(floor)
+		allocated += parts[i]
+		remainders[i] = remainder{index: i, frac: exact - floor}
+	}
+
+	// Hand out the leftover cents to the largest fractional parts
+	left := int64(m - allocated)
+	for left > 0 {
+		best := -1
+		for i, r := range remainders {
+			if r.frac < 0 {
+				continue
+			}
+			if best == -1 || r.frac > remainders[best].frac {
+				best = i
+			}
+		}
+		if best == -1 {
+			break
+		}
+		parts[remainders[best].index]++
+		remainders[best].frac = -1
+		left--
+	}
+
+	return parts
+}
+
+// Scan implements sql.Scanner for DECIMAL columns
+func (m *Money) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*m = 0
+		return nil
+	case string:
+		parsed, err := ParseMoney(v)
+		if err != nil {
+			return err
+		}
+		*m = parsed
+		return nil
+	case []byte:
+		parsed, err := ParseMoney(string(v))
+		if err != nil {
+			return err
+		}
+		*m = parsed
+		return nil
+	case int64:
+		*m = Money(v * 100)
+		return nil
+	case float64:
+		*m = MoneyFromFloat(v)
+		return nil
+	default:
+		return fmt.Errorf("impossible de convertir %T en montant", src)
+	}
+}
+
+// Value implements driver.Valuer, sending the amount as an exact decimal string
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+// MarshalJSON encodes the amount as an exact decimal number, e.g. 12.34
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON decodes a decimal number or string
+func (m *Money) UnmarshalJSON(data []byte) error {
+	parsed, err := ParseMoney(strings.Trim(string(data), `"`))
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
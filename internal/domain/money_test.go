@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func sumMoney(parts []Money) Money {
+	var total Money
+	for _, p := range parts {
+		total += p
+	}
+	return total
+}
+
+func TestMoneySplit(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount Money
+		n      int
+		want   []Money
+	}{
+		{"exact", 900, 3, []Money{300, 300, 300}},
+		{"100/3", 100, 3, []Money{34, 33, 33}},
+		{"remainder spread", 1002, 4, []Money{251, 251, 250, 250}},
+		{"fewer cents than parts", 2, 5, []Money{1, 1, 0, 0, 0}},
+		{"negative", -100, 3, []Money{-34, -33, -33}},
+		{"negative fewer cents than parts", -2, 3, []Money{-1, -1, 0}},
+		{"zero", 0, 2, []Money{0, 0}},
+		{"single part", 1234, 1, []Money{1234}},
+		{"no part", 100, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.amount.Split(tt.n)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Split(%d) de %s = %v, attendu %v", tt.n, tt.amount, got, tt.want)
+			}
+			if tt.n > 0 && sumMoney(got) != tt.amount {
+				t.Fatalf("Split(%d) de %s: total %s", tt.n, tt.amount, sumMoney(got))
+			}
+		})
+	}
+}
+
+func TestMoneyAllocate(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  Money
+		weights []float64
+		want    []Money
+		dropped bool // Nothing is allocated without a positive total weight
+	}{
+		{"equal weights", 100, []float64{1, 1, 1}, []Money{34, 33, 33}, false},
+		{"proportional", 1000, []float64{1, 2, 2}, []Money{200, 400, 400}, false},
+		{"largest remainder", 100, []float64{2, 3, 3}, []Money{25, 38, 37}, false},
+		{"fewer cents than parts", 2, []float64{1, 1, 1, 1}, []Money{1, 1, 0, 0}, false},
+		{"zero weight", 500, []float64{0, 1, 4}, []Money{0, 100, 400}, false},
+		{"negative", -100, []float64{1, 1, 1}, []Money{-33, -33, -34}, false},
+		{"no weight", 100, []float64{0, 0}, []Money{0, 0}, true},
+		{"percentages", 999, []float64{33.33, 33.33, 33.34}, []Money{333, 333, 333}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.amount.Allocate(tt.weights)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Allocate(%v) de %s = %v, attendu %v", tt.weights, tt.amount, got, tt.want)
+			}
+			if !tt.dropped && sumMoney(got) != tt.amount {
+				t.Fatalf("Allocate(%v) de %s: total %s", tt.weights, tt.amount, sumMoney(got))
+			}
+		})
+	}
+}
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Money
+		wantErr bool
+	}{
+		{in: "12.34", want: 1234},
+		{in: "12", want: 1200},
+		{in: "12.5", want: 1250},
+		{in: " 7.10 ", want: 710},
+		{in: "+3.2", want: 320},
+		{in: ".75", want: 75},
+		{in: "-0.5", want: -50},
+		{in: "1.005", want: 101},
+		{in: "1.004", want: 100},
+		{in: "0.995", want: 100},
+		{in: "-1.005", want: -101},
+		{in: "", wantErr: true},
+		{in: "abc", wantErr: true},
+		{in: "1.2x", wantErr: true},
+		{in: "1,50", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseMoney(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseMoney(%q) = %s, erreur attendue", tt.in, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseMoney(%q): %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("ParseMoney(%q) = %d, attendu %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := []struct {
+		amount Money
+		want   string
+	}{
+		{1234, "12.34"},
+		{5, "0.05"},
+		{-50, "-0.50"},
+		{0, "0.00"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.amount.String(); got != tt.want {
+			t.Fatalf("String(%d) = %q, attendu %q", int64(tt.amount), got, tt.want)
+		}
+		if parsed, err := ParseMoney(tt.want); err != nil || parsed != tt.amount {
+			t.Fatalf("ParseMoney(%q) = %d, %v", tt.want, parsed, err)
+		}
+	}
+}
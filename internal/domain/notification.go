@@ -12,6 +12,12 @@ const (
 	NotifPaymentReceived   NotificationType = "payment_received"
 	NotifPaymentConfirmed  NotificationType = "payment_confirmed"
 	NotifPaymentRejected   NotificationType = "payment_rejected"
+	NotifPaymentDisputeResponse NotificationType = "payment_dispute_response"
+	NotifPaymentEscalated  NotificationType = "payment_escalated"
+	NotifPaymentResolved   NotificationType = "payment_resolved"
+	NotifSettlementCreated NotificationType = "settlement_created"
+	NotifSettlementCompleted NotificationType = "settlement_completed"
+	NotifSettlementInvalidated NotificationType = "settlement_invalidated"
 	NotifMemberJoined      NotificationType = "member_joined"
 	NotifMemberLeft        NotificationType = "member_left"
 	NotifMemberRemoved     NotificationType = "member_removed"
@@ -23,11 +29,16 @@ const (
 	NotifFundCreated       NotificationType = "fund_created"
 	NotifFundContribution  NotificationType = "fund_contribution"
 	NotifFundGoalReached   NotificationType = "fund_goal_reached"
+	NotifFundWithdrawal    NotificationType = "fund_withdrawal"
 	NotifEventCreated      NotificationType = "event_created"
 	NotifEventUpdated      NotificationType = "event_updated"
 	NotifEventReminder     NotificationType = "event_reminder"
 	NotifEventCancelled    NotificationType = "event_cancelled"
 	NotifRecurringDue      NotificationType = "recurring_due"
+	NotifBudgetWarning     NotificationType = "budget_warning"
+	NotifBudgetExceeded    NotificationType = "budget_exceeded"
+	NotifPaymentReminder   NotificationType = "payment_reminder"
+	NotifPaymentExpired    NotificationType = "payment_expired"
 )
 
 // Notification represents a notification for a user
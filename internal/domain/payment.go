@@ -8,20 +8,57 @@ type PaymentStatus string
 const (
 	PaymentStatusPending   PaymentStatus = "pending"
 	PaymentStatusConfirmed PaymentStatus = "confirmed"
-	PaymentStatusRejected  PaymentStatus = "rejected"
+	PaymentStatusDisputed  PaymentStatus = "disputed"  // Rejected by the recipient, the sender can respond
+	PaymentStatusEscalated PaymentStatus = "escalated" // Submitted to the admins of the colocation
+	PaymentStatusVoid      PaymentStatus = "void"      // Never happened, final
+	PaymentStatusExpired   PaymentStatus = "expired"   // Left pending past the expiry of the colocation, final
 )
 
+// IsOpen reports whether a payment still waits for the recipient or the admins
+func (s PaymentStatus) IsOpen() bool {
+	return s == PaymentStatusPending || s == PaymentStatusDisputed || s == PaymentStatusEscalated
+}
+
+// PaymentMethod tells how a payment was made
+type PaymentMethod string
+
+const (
+	PaymentMethodBankTransfer PaymentMethod = "bank_transfer"
+	PaymentMethodCash         PaymentMethod = "cash"
+	PaymentMethodPayPal       PaymentMethod = "paypal"
+	PaymentMethodLydia        PaymentMethod = "lydia"
+	PaymentMethodWero         PaymentMethod = "wero"
+	PaymentMethodOther        PaymentMethod = "other"
+)
+
+// IsValid reports whether the payment method is known
+func (m PaymentMethod) IsValid() bool {
+	switch m {
+	case PaymentMethodBankTransfer, PaymentMethodCash, PaymentMethodPayPal, PaymentMethodLydia, PaymentMethodWero, PaymentMethodOther:
+		return true
+	}
+	return false
+}
+
 // Payment represents a reimbursement between two users
 type Payment struct {
-	ID           string        `json:"id" db:"id"`
-	ColocationID string        `json:"colocation_id" db:"colocation_id"`
-	FromUserID   string        `json:"from_user_id" db:"from_user_id"`
-	ToUserID     string        `json:"to_user_id" db:"to_user_id"`
-	Amount       float64       `json:"amount" db:"amount"`
-	Status       PaymentStatus `json:"status" db:"status"`
-	Note         *string       `json:"note,omitempty" db:"note"`
-	ConfirmedAt  *time.Time    `json:"confirmed_at,omitempty" db:"confirmed_at"`
-	CreatedAt    time.Time     `json:"created_at" db:"created_at"`
+	ID               string        `json:"id" db:"id"`
+	ColocationID     string        `json:"colocation_id" db:"colocation_id"`
+	FromUserID       string        `json:"from_user_id" db:"from_user_id"`
+	ToUserID         string        `json:"to_user_id" db:"to_user_id"`
+	Amount           Money         `json:"amount" db:"amount"` // In Currency
+	Currency         string        `json:"currency" db:"currency"`
+	ExchangeRate     Rate          `json:"exchange_rate" db:"exchange_rate"`
+	BaseAmount       Money         `json:"base_amount" db:"base_amount"` // In the colocation base currency
+	Status           PaymentStatus `json:"status" db:"status"`
+	Method           PaymentMethod `json:"method" db:"method"`
+	Note             *string       `json:"note,omitempty" db:"note"`
+	SettlementPlanID *string       `json:"settlement_plan_id,omitempty" db:"settlement_plan_id"`
+	ConfirmedAt      *time.Time    `json:"confirmed_at,omitempty" db:"confirmed_at"`
+	CreatedAt        time.Time     `json:"created_at" db:"created_at"`
+	DeletedAt        *time.Time    `json:"deleted_at,omitempty" db:"deleted_at"` // Cancelled, purged after the retention
+	DeletedBy        *string       `json:"deleted_by,omitempty" db:"deleted_by"`
+	AllocateTo       []string      `json:"allocate_to,omitempty" db:"allocate_to"` // Expenses to settle first, in order
 
 	// Joined fields
 	FromUserNom    string  `json:"from_user_nom,omitempty"`
@@ -30,4 +67,67 @@ type Payment struct {
 	ToUserNom      string  `json:"to_user_nom,omitempty"`
 	ToUserPrenom   string  `json:"to_user_prenom,omitempty"`
 	ToAvatarURL    *string `json:"to_avatar_url,omitempty"`
+	BaseCurrency   string  `json:"base_currency,omitempty"`
+
+	Allocations []PaymentAllocation `json:"allocations,omitempty"`
+	Events      []PaymentEvent      `json:"events,omitempty"` // Oldest first
+}
+
+// PaymentEventType is a transition of a payment
+type PaymentEventType string
+
+const (
+	PaymentEventCreated   PaymentEventType = "created"
+	PaymentEventConfirmed PaymentEventType = "confirmed"
+	PaymentEventDisputed  PaymentEventType = "disputed"
+	PaymentEventResponded PaymentEventType = "responded" // Comment or proof of the sender, the status is unchanged
+	PaymentEventEscalated PaymentEventType = "escalated"
+	PaymentEventVoided    PaymentEventType = "voided"
+	PaymentEventCancelled PaymentEventType = "cancelled"
+	PaymentEventRestored  PaymentEventType = "restored"
+	PaymentEventExpired   PaymentEventType = "expired" // Recorded by the expiry job, without actor
+)
+
+// PaymentEvent records a transition of a payment with the comment and proof
+// of its author
+type PaymentEvent struct {
+	ID               string           `json:"id" db:"id"`
+	PaymentID        string           `json:"payment_id" db:"payment_id"`
+	ActorID          *string          `json:"actor_id,omitempty" db:"actor_id"`
+	Type             PaymentEventType `json:"type" db:"type"`
+	Comment          *string          `json:"comment,omitempty" db:"comment"`
+	ProofFileName    *string          `json:"proof_file_name,omitempty" db:"proof_file_name"`
+	ProofContentType *string          `json:"proof_content_type,omitempty" db:"proof_content_type"`
+	ProofSizeBytes   *int64           `json:"proof_size_bytes,omitempty" db:"proof_size_bytes"`
+	ProofStorageKey  *string          `json:"-" db:"proof_storage_key"`
+	CreatedAt        time.Time        `json:"created_at" db:"created_at"`
+
+	// Joined fields
+	ActorNom    string `json:"actor_nom,omitempty"`
+	ActorPrenom string `json:"actor_prenom,omitempty"`
+}
+
+// PaymentInstructions tell the sender of a pending payment how to transfer
+// it to the bank account of the recipient
+type PaymentInstructions struct {
+	Payment         *Payment `json:"payment"`
+	BeneficiaryName string   `json:"beneficiary_name"`
+	IBAN            string   `json:"iban"`
+	BIC             *string  `json:"bic,omitempty"`
+	Remittance      string   `json:"remittance"`
+	EPCPayload      string   `json:"epc_payload"` // EPC069-12 SEPA credit transfer
+	QRCode          []byte   `json:"-"`           // PNG of EPCPayload
+}
+
+// PaymentAllocation is the part of a confirmed payment applied to the share of
+// its sender in an expense paid by its recipient
+type PaymentAllocation struct {
+	PaymentID string    `json:"payment_id" db:"payment_id"`
+	ExpenseID string    `json:"expense_id" db:"expense_id"`
+	Amount    Money     `json:"amount" db:"amount"` // In the colocation base currency
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+
+	// Joined fields
+	ExpenseTitle string    `json:"expense_title,omitempty"`
+	ExpenseDate  time.Time `json:"expense_date"`
 }
@@ -0,0 +1,112 @@
+package domain
+
+import "time"
+
+// Next returns the occurrence following current
+func (r Recurrence) Next(current time.Time) time.Time {
+	switch r {
+	case RecurrenceDaily:
+		return current.AddDate(0, 0, 1)
+	case RecurrenceWeekly:
+		return current.AddDate(0, 0, 7)
+	case RecurrenceMonthly:
+		return current.AddDate(0, 1, 0)
+	case RecurrenceYearly:
+		return current.AddDate(1, 0, 0)
+	default:
+		return current.AddDate(0, 1, 0)
+	}
+}
+
+// RecurringOccurrenceOverride changes a single occurrence of a recurring expense
+type RecurringOccurrenceOverride struct {
+	RecurringID    string    `json:"recurring_id" db:"recurring_id"`
+	OccurrenceDate time.Time `json:"occurrence_date" db:"occurrence_date"`
+	Skip           bool      `json:"skip" db:"skip"`
+	Amount         *Money    `json:"amount,omitempty" db:"amount"`   // Replaces the amount of the template
+	PaidBy         *string   `json:"paid_by,omitempty" db:"paid_by"` // Replaces the payer of the template
+	CreatedBy      *string   `json:"created_by,omitempty" db:"created_by"`
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+}
+
+// OccurrenceStatus tells whether an occurrence will be generated
+type OccurrenceStatus string
+
+const (
+	OccurrenceScheduled OccurrenceStatus = "scheduled"
+	OccurrenceSkipped   OccurrenceStatus = "skipped"
+	OccurrencePaused    OccurrenceStatus = "paused"
+)
+
+// RecurringOccurrence is an occurrence of a recurring expense with its
+// override applied
+type RecurringOccurrence struct {
+	RecurringID string           `json:"recurring_id"`
+	Date        time.Time        `json:"date"`
+	Amount      Money            `json:"amount"`
+	Currency    string           `json:"currency"`
+	PaidBy      string           `json:"paid_by"`
+	Status      OccurrenceStatus `json:"status"`
+	Overridden  bool             `json:"overridden"`
+}
+
+// Occurrence returns the occurrence of a recurring expense on a date
+func (re *RecurringExpense) Occurrence(date time.Time) RecurringOccurrence {
+	occ := RecurringOccurrence{
+		RecurringID: re.ID,
+		Date:        date,
+		Amount:      re.Amount,
+		Currency:    re.Currency,
+		PaidBy:      re.PaidBy,
+		Status:      OccurrenceScheduled,
+	}
+
+	for _, o := range re.Overrides {
+		if !o.OccurrenceDate.Equal(date) {
+			continue
+		}
+		occ.Overridden = true
+		if o.Amount != nil {
+			occ.Amount = *o.Amount
+		}
+		if o.PaidBy != nil {
+			occ.PaidBy = *o.PaidBy
+		}
+		if o.Skip {
+			occ.Status = OccurrenceSkipped
+		}
+	}
+
+	if re.PausedUntil != nil && date.Before(*re.PausedUntil) {
+		occ.Status = OccurrencePaused
+	}
+
+	return occ
+}
+
+// OccurrencesUntil returns the occurrences not generated yet up to a date
+// included, skipped and paused ones too, stopping after limit occurrences
+// when limit is positive
+func (re *RecurringExpense) OccurrencesUntil(end time.Time, limit int) []RecurringOccurrence {
+	var occurrences []RecurringOccurrence
+	for date := re.NextDueDate; !date.After(end); date = re.Recurrence.Next(date) {
+		if re.EndDate != nil && date.After(*re.EndDate) {
+			break
+		}
+		if limit > 0 && len(occurrences) >= limit {
+			break
+		}
+		occurrences = append(occurrences, re.Occurrence(date))
+	}
+	return occurrences
+}
+
+// IsOccurrence tells whether a date is an occurrence not generated yet
+func (re *RecurringExpense) IsOccurrence(date time.Time) bool {
+	for _, occ := range re.OccurrencesUntil(date, 0) {
+		if occ.Date.Equal(date) {
+			return true
+		}
+	}
+	return false
+}
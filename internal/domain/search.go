@@ -0,0 +1,59 @@
+package domain
+
+import "time"
+
+// SearchEntityType is the kind of entity returned by a search
+type SearchEntityType string
+
+const (
+	SearchEntityExpense  SearchEntityType = "expense"
+	SearchEntityDecision SearchEntityType = "decision"
+	SearchEntityFund     SearchEntityType = "fund"
+	SearchEntityEvent    SearchEntityType = "event"
+)
+
+// SearchEntityTypes lists the searchable entity types in the order their
+// groups are returned
+var SearchEntityTypes = []SearchEntityType{
+	SearchEntityExpense,
+	SearchEntityDecision,
+	SearchEntityFund,
+	SearchEntityEvent,
+}
+
+// SearchFilters restricts the hits of a search. Amounts are in the colocation
+// base currency: the base amount of expenses, the current amount of funds and
+// the budget of events. Decisions have no amount and are left out when an
+// amount filter is set.
+type SearchFilters struct {
+	MinAmount *Money
+	MaxAmount *Money
+	StartDate *time.Time // Expense date, event date, creation date otherwise
+	EndDate   *time.Time
+}
+
+// SearchHit is an entity matching a search
+type SearchHit struct {
+	EntityType SearchEntityType `json:"entity_type"`
+	ID         string           `json:"id"`
+	Title      string           `json:"title"`
+	Snippet    string           `json:"snippet"` // HTML-escaped text, matching words wrapped in <mark> tags
+	Amount     *Money           `json:"amount,omitempty"`
+	Currency   string           `json:"currency"`
+	Date       time.Time        `json:"date"`
+	Rank       float32          `json:"rank"`
+}
+
+// SearchGroup holds a page of hits of one entity type, best match first
+type SearchGroup struct {
+	EntityType SearchEntityType `json:"entity_type"`
+	Hits       []SearchHit      `json:"hits"`
+	NextCursor string           `json:"next_cursor,omitempty"` // Empty on the last page
+}
+
+// SearchCursor is the position of the last hit of a page
+type SearchCursor struct {
+	EntityType SearchEntityType `json:"t"`
+	Rank       float32          `json:"r"`
+	ID         string           `json:"id"`
+}
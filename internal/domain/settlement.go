@@ -0,0 +1,54 @@
+package domain
+
+import "time"
+
+// SettlementStatus represents the status of a settlement plan
+type SettlementStatus string
+
+const (
+	SettlementStatusActive      SettlementStatus = "active"
+	SettlementStatusCompleted   SettlementStatus = "completed"
+	SettlementStatusInvalidated SettlementStatus = "invalidated"
+)
+
+// SettlementPlan is a snapshot of the simplified debts of a colocation,
+// materialized as linked pending payments
+type SettlementPlan struct {
+	ID                 string           `json:"id" db:"id"`
+	ColocationID       string           `json:"colocation_id" db:"colocation_id"`
+	CreatedBy          string           `json:"created_by" db:"created_by"`
+	Status             SettlementStatus `json:"status" db:"status"`
+	BalanceFingerprint string           `json:"-" db:"balance_fingerprint"`
+	TotalAmount        Money            `json:"total_amount" db:"total_amount"`
+	CompletedAt        *time.Time       `json:"completed_at,omitempty" db:"completed_at"`
+	InvalidatedAt      *time.Time       `json:"invalidated_at,omitempty" db:"invalidated_at"`
+	CreatedAt          time.Time        `json:"created_at" db:"created_at"`
+
+	// Joined fields
+	CreatedByNom    string    `json:"created_by_nom,omitempty"`
+	CreatedByPrenom string    `json:"created_by_prenom,omitempty"`
+	Currency        string    `json:"currency"` // Base currency of the colocation
+	Payments        []Payment `json:"payments,omitempty"`
+}
+
+// ConfirmedCount returns the number of payments of the plan already confirmed
+func (p *SettlementPlan) ConfirmedCount() int {
+	count := 0
+	for _, payment := range p.Payments {
+		if payment.Status == PaymentStatusConfirmed {
+			count++
+		}
+	}
+	return count
+}
+
+// ConfirmedAmount returns the amount of the plan already confirmed
+func (p *SettlementPlan) ConfirmedAmount() Money {
+	var total Money
+	for _, payment := range p.Payments {
+		if payment.Status == PaymentStatusConfirmed {
+			total += payment.Amount
+		}
+	}
+	return total
+}
@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+// ExpenseDraft is a bank statement debit proposed as an expense, to be
+// reviewed and confirmed by the user before it is created
+type ExpenseDraft struct {
+	Reference   string    `json:"reference"` // Bank transaction ID or hash of the statement line
+	Title       string    `json:"title"`     // Cleaned up label, suggested as expense title
+	Label       string    `json:"label"`     // Raw label of the statement line
+	Amount      Money     `json:"amount"`
+	Currency    string    `json:"currency"`
+	ExpenseDate time.Time `json:"expense_date"`
+
+	SuggestedCategoryID   string `json:"suggested_category_id"`
+	SuggestedCategoryName string `json:"suggested_category_name"`
+
+	// Possible duplicate: the same line was already imported, or an expense
+	// with the same amount was recorded around the same date
+	AlreadyImported    bool    `json:"already_imported"`
+	DuplicateExpenseID *string `json:"duplicate_expense_id,omitempty"`
+}
+
+// IsDuplicate returns true if the draft probably matches an existing expense
+func (d *ExpenseDraft) IsDuplicate() bool {
+	return d.DuplicateExpenseID != nil
+}
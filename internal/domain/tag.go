@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// Tag is a free-form label of a colocation. Unlike categories, an expense can
+// have several tags.
+type Tag struct {
+	ID           string    `json:"id" db:"id"`
+	ColocationID string    `json:"colocation_id" db:"colocation_id"`
+	Name         string    `json:"name" db:"name"`
+	Color        *string   `json:"color,omitempty" db:"color"`
+	CreatedBy    *string   `json:"created_by,omitempty" db:"created_by"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+
+	// Joined fields
+	ExpenseCount int `json:"expense_count,omitempty"`
+}
+
+// TagStat represents statistics for a tag. Since an expense can have several
+// tags, the percentages are shares of the whole spending and do not add up
+// to 100.
+type TagStat struct {
+	TagID        string  `json:"tag_id"`
+	TagName      string  `json:"tag_name"`
+	Color        *string `json:"color,omitempty"`
+	TotalAmount  Money   `json:"total_amount"`
+	ExpenseCount int     `json:"expense_count"`
+	Percentage   float64 `json:"percentage"`
+}
@@ -11,6 +11,8 @@ type User struct {
 	Prenom       string    `json:"prenom" db:"prenom"`
 	Telephone    *string   `json:"telephone,omitempty" db:"telephone"`
 	AvatarURL    *string   `json:"avatar_url,omitempty" db:"avatar_url"`
+	IBAN         *string   `json:"iban,omitempty" db:"iban"` // Used for the SEPA QR codes of the payments made to the user
+	BIC          *string   `json:"bic,omitempty" db:"bic"`
 	IsActive     bool      `json:"is_active" db:"is_active"`
 	CreatedAt    time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
@@ -0,0 +1,44 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/vblanchet22/back_coloc/internal/domain"
+)
+
+// ArchiveVersion is incremented when the structure of the archive changes
+const ArchiveVersion = 1
+
+// Archive is the complete export of a colocation. Amounts are decimal
+// numbers, in the currency given next to them.
+type Archive struct {
+	Version           int                       `json:"version"`
+	ExportedAt        time.Time                 `json:"exported_at"`
+	ExportedBy        string                    `json:"exported_by"`
+	Colocation        *domain.Colocation        `json:"colocation"`
+	Members           []domain.ColocationMember `json:"members"`
+	Categories        []domain.ExpenseCategory  `json:"categories"`
+	Balances          []domain.UserBalance      `json:"balances"`
+	Expenses          []domain.Expense          `json:"expenses"`
+	RecurringExpenses []domain.RecurringExpense `json:"recurring_expenses"`
+	Payments          []domain.Payment          `json:"payments"`
+	Funds             []ArchivedFund            `json:"funds"`
+	Events            []domain.Event            `json:"events"`
+	Decisions         []domain.Decision         `json:"decisions"`
+	ExchangeRates     []domain.ExchangeRate     `json:"exchange_rates"`
+}
+
+// ArchivedFund is a common fund with the detail of its contributions
+type ArchivedFund struct {
+	domain.CommonFund
+	Contributions []domain.FundContribution `json:"contributions"`
+}
+
+// WriteArchive writes the archive as indented JSON
+func WriteArchive(w io.Writer, archive *Archive) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(archive)
+}
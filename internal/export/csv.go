@@ -0,0 +1,149 @@
+// Package export writes the data of a colocation as CSV files, a JSON archive
+// and monthly PDF statements.
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/vblanchet22/back_coloc/internal/domain"
+	"github.com/vblanchet22/back_coloc/internal/utils"
+)
+
+// CSV files use the French spreadsheet conventions: ";" as separator, ","
+// as decimal separator and a byte order mark so that accents display properly
+const (
+	csvSeparator = ';'
+	csvBOM       = "\xef\xbb\xbf"
+)
+
+// WriteExpensesCSV writes one row per expense split, the expense columns being
+// repeated on each row of the same expense
+func WriteExpensesCSV(w io.Writer, expenses []domain.Expense) error {
+	cw, err := newCSVWriter(w)
+	if err != nil {
+		return err
+	}
+
+	if err := cw.Write([]string{
+		"ID depense", "Date", "Titre", "Description", "Categorie", "Paye par",
+		"Montant", "Devise", "Taux de change", "Montant (devise de base)", "Devise de base", "Type de partage",
+		"Participant", "Part", "Part (devise de base)", "Pourcentage", "Regle",
+	}); err != nil {
+		return err
+	}
+
+	for _, e := range expenses {
+		expense := []string{
+			e.ID,
+			utils.FormatFrenchDate(e.ExpenseDate),
+			e.Title,
+			stringOrEmpty(e.Description),
+			e.CategoryName,
+			fullName(e.PaidByPrenom, e.PaidByNom),
+			FormatAmount(e.Amount),
+			e.Currency,
+			strings.Replace(e.ExchangeRate.String(), ".", ",", 1),
+			FormatAmount(e.BaseAmount),
+			e.BaseCurrency,
+			string(e.SplitType),
+		}
+
+		if len(e.Splits) == 0 {
+			if err := cw.Write(append(expense, "", "", "", "", "")); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, s := range e.Splits {
+			row := append(append([]string(nil), expense...),
+				fullName(s.UserPrenom, s.UserNom),
+				FormatAmount(s.Amount),
+				FormatAmount(s.BaseAmount),
+				strings.Replace(fmt.Sprintf("%.2f", s.Percentage), ".", ",", 1),
+				yesNo(s.IsSettled),
+			)
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// WritePaymentsCSV writes one row per payment
+func WritePaymentsCSV(w io.Writer, payments []domain.Payment) error {
+	cw, err := newCSVWriter(w)
+	if err != nil {
+		return err
+	}
+
+	if err := cw.Write([]string{
+		"ID paiement", "Date", "De", "A", "Montant", "Devise", "Taux de change",
+		"Montant (devise de base)", "Devise de base", "Statut", "Date de confirmation", "Note",
+	}); err != nil {
+		return err
+	}
+
+	for _, p := range payments {
+		confirmedAt := ""
+		if p.ConfirmedAt != nil {
+			confirmedAt = utils.FormatFrenchDate(*p.ConfirmedAt)
+		}
+		if err := cw.Write([]string{
+			p.ID,
+			utils.FormatFrenchDate(p.CreatedAt),
+			fullName(p.FromUserPrenom, p.FromUserNom),
+			fullName(p.ToUserPrenom, p.ToUserNom),
+			FormatAmount(p.Amount),
+			p.Currency,
+			strings.Replace(p.ExchangeRate.String(), ".", ",", 1),
+			FormatAmount(p.BaseAmount),
+			p.BaseCurrency,
+			string(p.Status),
+			confirmedAt,
+			stringOrEmpty(p.Note),
+		}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func newCSVWriter(w io.Writer) (*csv.Writer, error) {
+	if _, err := io.WriteString(w, csvBOM); err != nil {
+		return nil, err
+	}
+	cw := csv.NewWriter(w)
+	cw.Comma = csvSeparator
+	return cw, nil
+}
+
+// FormatAmount formats an amount with a decimal comma, e.g. "12,34"
+func FormatAmount(m domain.Money) string {
+	return strings.Replace(m.String(), ".", ",", 1)
+}
+
+func fullName(prenom, nom string) string {
+	return strings.TrimSpace(prenom + " " + nom)
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "oui"
+	}
+	return "non"
+}
@@ -0,0 +1,183 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/vblanchet22/back_coloc/internal/domain"
+	"github.com/vblanchet22/back_coloc/internal/utils"
+)
+
+// MemberStatement is the data of the monthly statement of a member
+type MemberStatement struct {
+	ColocationName string
+	MemberName     string
+	Currency       string // Base currency of the colocation
+	PeriodStart    time.Time
+	PeriodEnd      time.Time
+	GeneratedAt    time.Time
+
+	// Balance of the member when the statement is generated
+	Balance domain.UserBalance
+
+	// Operations of the month affecting the member, from the balance history
+	Entries []domain.BalanceHistoryEntry
+}
+
+// Layout of the statement, in points
+const (
+	statementLineHeight = 15.0
+	statementRowHeight  = 14.0
+	statementBodySize   = 10.0
+	statementTableSize  = 9.0
+)
+
+// Columns of the operation table: date, label, type, amount (right edge)
+var statementColumns = [...]float64{pageMargin, pageMargin + 70, pageMargin + 320, pageMargin + contentWidth}
+
+// WriteStatementPDF writes the monthly statement of a member as a PDF
+func WriteStatementPDF(w io.Writer, s *MemberStatement) error {
+	doc := newPDFDocument()
+	doc.addPage()
+	y := pageHeight - pageMargin
+
+	doc.text(pageMargin, y, 18, true, "Releve mensuel")
+	y -= 24
+	doc.text(pageMargin, y, 12, false, s.ColocationName)
+	y -= 2 * statementLineHeight
+
+	for _, row := range [][2]string{
+		{"Membre", s.MemberName},
+		{"Periode", fmt.Sprintf("du %s au %s", utils.FormatFrenchDate(s.PeriodStart), utils.FormatFrenchDate(s.PeriodEnd))},
+		{"Devise", s.Currency},
+		{"Edite le", utils.FormatFrenchDate(s.GeneratedAt)},
+	} {
+		doc.text(pageMargin, y, statementBodySize, true, row[0])
+		doc.text(pageMargin+90, y, statementBodySize, false, row[1])
+		y -= statementLineHeight
+	}
+	y -= statementLineHeight
+
+	// Totals of the month
+	var paid, owed, sent, received domain.Money
+	for _, e := range s.Entries {
+		switch {
+		case e.EventType == "expense" && e.Amount > 0:
+			paid += e.Amount
+		case e.EventType == "expense":
+			owed += e.Amount.Abs()
+		case e.Amount < 0:
+			sent += e.Amount.Abs()
+		default:
+			received += e.Amount
+		}
+	}
+
+	y = statementSection(doc, y, "Activite du mois")
+	for _, row := range []struct {
+		label  string
+		amount domain.Money
+	}{
+		{"Depenses payees", paid},
+		{"Parts dues sur les depenses des autres", owed},
+		{"Remboursements envoyes", sent},
+		{"Remboursements recus", received},
+	} {
+		doc.text(pageMargin, y, statementBodySize, false, row.label)
+		doc.textRight(pageMargin+contentWidth, y, statementBodySize, false, formatStatementAmount(row.amount, s.Currency))
+		y -= statementLineHeight
+	}
+	y -= statementLineHeight
+
+	y = statementSection(doc, y, fmt.Sprintf("Solde au %s", utils.FormatFrenchDate(s.GeneratedAt)))
+	for _, row := range []struct {
+		label  string
+		amount domain.Money
+		bold   bool
+	}{
+		{"Total paye", s.Balance.TotalPaid, false},
+		{"Total du (parts non reglees)", s.Balance.TotalOwed, false},
+		{"Solde net", s.Balance.NetBalance, true},
+	} {
+		doc.text(pageMargin, y, statementBodySize, row.bold, row.label)
+		doc.textRight(pageMargin+contentWidth, y, statementBodySize, row.bold, formatStatementAmount(row.amount, s.Currency))
+		y -= statementLineHeight
+	}
+	switch {
+	case s.Balance.NetBalance > 0:
+		doc.text(pageMargin, y, statementTableSize, false, "Solde positif : les autres membres vous doivent ce montant.")
+	case s.Balance.NetBalance < 0:
+		doc.text(pageMargin, y, statementTableSize, false, "Solde negatif : vous devez ce montant aux autres membres.")
+	default:
+		doc.text(pageMargin, y, statementTableSize, false, "Vos comptes sont equilibres.")
+	}
+	y -= 2 * statementLineHeight
+
+	y = statementSection(doc, y, "Detail des operations")
+	if len(s.Entries) == 0 {
+		doc.text(pageMargin, y, statementBodySize, false, "Aucune operation sur la periode.")
+	} else {
+		y = statementTableHeader(doc, y)
+		for _, e := range s.Entries {
+			if y < pageMargin+statementRowHeight {
+				doc.addPage()
+				y = statementTableHeader(doc, pageHeight-pageMargin)
+			}
+
+			label := truncateText(e.Description, statementTableSize, statementColumns[2]-statementColumns[1]-8)
+			doc.text(statementColumns[0], y, statementTableSize, false, utils.FormatFrenchDate(e.Date))
+			doc.text(statementColumns[1], y, statementTableSize, false, label)
+			doc.text(statementColumns[2], y, statementTableSize, false, statementEntryType(e))
+			doc.textRight(statementColumns[3], y, statementTableSize, false, formatStatementAmount(e.Amount.Abs(), s.Currency))
+			y -= statementRowHeight
+		}
+	}
+
+	// Page numbers, once the number of pages is known
+	for i, page := range doc.pages {
+		doc.current = page
+		doc.textRight(pageMargin+contentWidth, pageMargin/2, 8, false, fmt.Sprintf("Page %d/%d", i+1, len(doc.pages)))
+	}
+
+	return doc.writeTo(w)
+}
+
+// statementSection draws a section title and returns the next baseline
+func statementSection(doc *pdfDocument, y float64, title string) float64 {
+	if y < pageMargin+4*statementLineHeight {
+		doc.addPage()
+		y = pageHeight - pageMargin
+	}
+	doc.text(pageMargin, y, 12, true, title)
+	doc.line(pageMargin, pageMargin+contentWidth, y-5)
+	return y - 1.5*statementLineHeight
+}
+
+// statementTableHeader draws the header of the operation table
+func statementTableHeader(doc *pdfDocument, y float64) float64 {
+	doc.shade(pageMargin-4, y-4, contentWidth+8, statementRowHeight)
+	doc.text(statementColumns[0], y, statementTableSize, true, "Date")
+	doc.text(statementColumns[1], y, statementTableSize, true, "Libelle")
+	doc.text(statementColumns[2], y, statementTableSize, true, "Type")
+	doc.textRight(statementColumns[3], y, statementTableSize, true, "Montant")
+	return y - statementRowHeight - 2
+}
+
+// statementEntryType describes the effect of a history entry for the member
+func statementEntryType(e domain.BalanceHistoryEntry) string {
+	switch {
+	case e.EventType == "expense" && e.Amount > 0:
+		return "Depense payee"
+	case e.EventType == "expense":
+		return "Part due"
+	case e.Amount < 0:
+		return "Remboursement envoye"
+	default:
+		return "Remboursement recu"
+	}
+}
+
+func formatStatementAmount(m domain.Money, currency string) string {
+	return FormatAmount(m) + " " + currency
+}
@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/vblanchet22/back_coloc/internal/constants"
+	pb "github.com/vblanchet22/back_coloc/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	uploadAttachmentPattern   = "/api/colocations/{colocation_id}/expenses/{expense_id}/attachments"
+	downloadAttachmentPattern = "/api/colocations/{colocation_id}/expenses/{expense_id}/attachments/{id}/content"
+)
+
+// RegisterAttachmentRoutes registers the HTTP routes of the attachment
+// streaming RPCs, which the generated gateway cannot map: a multipart upload
+// and a raw file download
+func RegisterAttachmentRoutes(mux *runtime.ServeMux, client pb.AttachmentServiceClient) error {
+	if err := mux.HandlePath(http.MethodPost, uploadAttachmentPattern, uploadAttachmentHandler(mux, client)); err != nil {
+		return err
+	}
+	return mux.HandlePath(http.MethodGet, downloadAttachmentPattern, downloadAttachmentHandler(mux, client))
+}
+
+// uploadAttachmentHandler streams the "file" field of a multipart form to UploadAttachment
+func uploadAttachmentHandler(mux *runtime.ServeMux, client pb.AttachmentServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		_, marshaler := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, "/coloc.AttachmentService/UploadAttachment", runtime.WithHTTPPathPattern(uploadAttachmentPattern))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+			return
+		}
+
+		reader, err := r.MultipartReader()
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, status.Errorf(codes.InvalidArgument, "formulaire multipart attendu"))
+			return
+		}
+
+		// Skip the other fields until the file
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				runtime.HTTPError(ctx, mux, marshaler, w, r, status.Errorf(codes.InvalidArgument, "champ \"file\" manquant"))
+				return
+			}
+			if err != nil {
+				runtime.HTTPError(ctx, mux, marshaler, w, r, status.Errorf(codes.InvalidArgument, "formulaire multipart invalide: %v", err))
+				return
+			}
+			if part.FormName() != "file" {
+				part.Close()
+				continue
+			}
+
+			attachment, err := streamUpload(ctx, client, &pb.AttachmentMetadata{
+				ColocationId: params["colocation_id"],
+				ExpenseId:    params["expense_id"],
+				FileName:     part.FileName(),
+			}, part)
+			part.Close()
+			if err != nil {
+				runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+				return
+			}
+
+			runtime.ForwardResponseMessage(ctx, mux, marshaler, w, r, attachment)
+			return
+		}
+	}
+}
+
+// streamUpload sends the metadata then the content in chunks. The server may
+// reject the upload before the whole file is sent, in which case Send returns
+// io.EOF and the actual error comes from CloseAndRecv.
+func streamUpload(ctx context.Context, client pb.AttachmentServiceClient, meta *pb.AttachmentMetadata, content io.Reader) (*pb.Attachment, error) {
+	stream, err := client.UploadAttachment(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := stream.Send(&pb.UploadAttachmentRequest{Data: &pb.UploadAttachmentRequest_Metadata{Metadata: meta}}); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	buf := make([]byte, constants.AttachmentChunkSize)
+	for {
+		n, readErr := io.ReadFull(content, buf)
+		if n > 0 {
+			chunk := &pb.UploadAttachmentRequest{Data: &pb.UploadAttachmentRequest_Chunk{Chunk: buf[:n]}}
+			if err := stream.Send(chunk); err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		if readErr == io.EOF || errors.Is(readErr, io.ErrUnexpectedEOF) {
+			break
+		}
+		if readErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "erreur lors de la lecture du fichier: %v", readErr)
+		}
+	}
+
+	return stream.CloseAndRecv()
+}
+
+// downloadAttachmentHandler writes the content streamed by DownloadAttachment
+// as the HTTP response body
+func downloadAttachmentHandler(mux *runtime.ServeMux, client pb.AttachmentServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		_, marshaler := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, "/coloc.AttachmentService/DownloadAttachment", runtime.WithHTTPPathPattern(downloadAttachmentPattern))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+			return
+		}
+
+		thumbnail, _ := strconv.ParseBool(r.URL.Query().Get("thumbnail"))
+		stream, err := client.DownloadAttachment(ctx, &pb.DownloadAttachmentRequest{
+			ColocationId: params["colocation_id"],
+			ExpenseId:    params["expense_id"],
+			Id:           params["id"],
+			Thumbnail:    thumbnail,
+		})
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+			return
+		}
+
+		// The first message carries the attachment info, errors surface here
+		first, err := stream.Recv()
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+			return
+		}
+		info := first.GetAttachment()
+		if info == nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, status.Errorf(codes.Internal, "reponse de telechargement invalide"))
+			return
+		}
+
+		w.Header().Set("Content-Type", info.ContentType)
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": info.FileName}))
+		if !thumbnail {
+			w.Header().Set("Content-Length", strconv.FormatInt(info.SizeBytes, 10))
+		}
+		w.WriteHeader(http.StatusOK)
+
+		for {
+			msg, err := stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				// Headers are already sent, the client sees a truncated body
+				return
+			}
+			if _, err := w.Write(msg.GetChunk()); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/vblanchet22/back_coloc/internal/constants"
+	"github.com/vblanchet22/back_coloc/internal/domain"
+	"github.com/vblanchet22/back_coloc/internal/service"
+	"github.com/vblanchet22/back_coloc/internal/utils"
+	pb "github.com/vblanchet22/back_coloc/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AttachmentHandler implements the AttachmentService gRPC server
+type AttachmentHandler struct {
+	pb.UnimplementedAttachmentServiceServer
+	service *service.AttachmentService
+}
+
+// NewAttachmentHandler creates a new AttachmentHandler
+func NewAttachmentHandler(service *service.AttachmentService) *AttachmentHandler {
+	return &AttachmentHandler{service: service}
+}
+
+// UploadAttachment receives the metadata then the file content and stores the attachment
+func (h *AttachmentHandler) UploadAttachment(stream pb.AttachmentService_UploadAttachmentServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "metadonnees de la piece jointe manquantes")
+	}
+
+	meta := first.GetMetadata()
+	if meta == nil {
+		return status.Errorf(codes.InvalidArgument, "le premier message doit contenir les metadonnees")
+	}
+	if meta.ColocationId == "" || meta.ExpenseId == "" {
+		return status.Errorf(codes.InvalidArgument, "colocation_id et expense_id obligatoires")
+	}
+
+	attachment, err := h.service.Upload(stream.Context(), service.UploadAttachmentInput{
+		ColocationID: meta.ColocationId,
+		ExpenseID:    meta.ExpenseId,
+		FileName:     meta.FileName,
+		Content:      &uploadStreamReader{stream: stream},
+	})
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	return stream.SendAndClose(attachmentToProto(attachment))
+}
+
+// ListAttachments lists the attachments of an expense
+func (h *AttachmentHandler) ListAttachments(ctx context.Context, req *pb.ListAttachmentsRequest) (*pb.ListAttachmentsResponse, error) {
+	if req.ColocationId == "" || req.ExpenseId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et expense_id obligatoires")
+	}
+
+	attachments, err := h.service.List(ctx, req.ColocationId, req.ExpenseId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	pbAttachments := make([]*pb.Attachment, len(attachments))
+	for i, a := range attachments {
+		pbAttachments[i] = attachmentToProto(&a)
+	}
+
+	return &pb.ListAttachmentsResponse{Attachments: pbAttachments}, nil
+}
+
+// DownloadAttachment streams the attachment info then its content
+func (h *AttachmentHandler) DownloadAttachment(req *pb.DownloadAttachmentRequest, stream pb.AttachmentService_DownloadAttachmentServer) error {
+	if req.ColocationId == "" || req.ExpenseId == "" || req.Id == "" {
+		return status.Errorf(codes.InvalidArgument, "colocation_id, expense_id et id obligatoires")
+	}
+
+	attachment, content, err := h.service.Open(stream.Context(), req.ColocationId, req.ExpenseId, req.Id, req.Thumbnail)
+	if err != nil {
+		return status.Errorf(codes.NotFound, "%v", err)
+	}
+	defer content.Close()
+
+	info := attachmentToProto(attachment)
+	if req.Thumbnail {
+		info.ContentType = domain.ContentTypeJPEG
+	}
+	if err := stream.Send(&pb.AttachmentChunk{Data: &pb.AttachmentChunk_Attachment{Attachment: info}}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, constants.AttachmentChunkSize)
+	for {
+		n, err := content.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&pb.AttachmentChunk{Data: &pb.AttachmentChunk_Chunk{Chunk: buf[:n]}}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "erreur lors de la lecture du fichier: %v", err)
+		}
+	}
+}
+
+// DeleteAttachment deletes an attachment
+func (h *AttachmentHandler) DeleteAttachment(ctx context.Context, req *pb.DeleteAttachmentRequest) (*pb.DeleteAttachmentResponse, error) {
+	if req.ColocationId == "" || req.ExpenseId == "" || req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id, expense_id et id obligatoires")
+	}
+
+	if err := h.service.Delete(ctx, req.ColocationId, req.ExpenseId, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return &pb.DeleteAttachmentResponse{Success: true}, nil
+}
+
+// Helper functions
+
+// uploadStreamReader exposes the chunks of an upload stream as an io.Reader
+type uploadStreamReader struct {
+	stream pb.AttachmentService_UploadAttachmentServer
+	buf    []byte
+}
+
+func (r *uploadStreamReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		msg, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if msg.GetMetadata() != nil {
+			return 0, errors.New("metadonnees recues au milieu du fichier")
+		}
+		r.buf = msg.GetChunk()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func attachmentToProto(a *domain.ExpenseAttachment) *pb.Attachment {
+	return &pb.Attachment{
+		Id:               a.ID,
+		ExpenseId:        a.ExpenseID,
+		UploadedBy:       a.UploadedBy,
+		UploadedByNom:    a.UploadedByNom,
+		UploadedByPrenom: a.UploadedByPrenom,
+		FileName:         a.FileName,
+		ContentType:      a.ContentType,
+		SizeBytes:        a.SizeBytes,
+		HasThumbnail:     a.HasThumbnail(),
+		CreatedAt:        utils.FormatFrenchDateTime(a.CreatedAt),
+	}
+}
@@ -117,6 +117,8 @@ func DomainUserToProto(user *domain.User) *pb.User {
 		Prenom:    user.Prenom,
 		Telephone: user.Telephone,
 		AvatarUrl: user.AvatarURL,
+		Iban:      user.IBAN,
+		Bic:       user.BIC,
 		IsActive:  user.IsActive,
 		CreatedAt: utils.FormatFrenchDateTime(user.CreatedAt),
 		UpdatedAt: utils.FormatFrenchDateTime(user.UpdatedAt),
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/vblanchet22/back_coloc/internal/algorithm"
 	"github.com/vblanchet22/back_coloc/internal/service"
 	"github.com/vblanchet22/back_coloc/internal/utils"
 	pb "github.com/vblanchet22/back_coloc/proto/pb"
@@ -40,9 +41,9 @@ func (h *BalanceHandler) GetBalances(ctx context.Context, req *pb.GetBalancesReq
 			UserNom:    b.UserNom,
 			UserPrenom: b.UserPrenom,
 			AvatarUrl:  b.AvatarURL,
-			TotalPaid:  b.TotalPaid,
-			TotalOwed:  b.TotalOwed,
-			NetBalance: b.NetBalance,
+			TotalPaid:  moneyToProto(b.TotalPaid, b.Currency),
+			TotalOwed:  moneyToProto(b.TotalOwed, b.Currency),
+			NetBalance: moneyToProto(b.NetBalance, b.Currency),
 		})
 	}
 
@@ -55,7 +56,7 @@ func (h *BalanceHandler) GetBalances(ctx context.Context, req *pb.GetBalancesReq
 			ToUserId:       d.ToUserID,
 			ToUserNom:      d.ToUserNom,
 			ToUserPrenom:   d.ToUserPrenom,
-			Amount:         d.Amount,
+			Amount:         moneyToProto(d.Amount, d.Currency),
 		})
 	}
 
@@ -65,13 +66,13 @@ func (h *BalanceHandler) GetBalances(ctx context.Context, req *pb.GetBalancesReq
 	}, nil
 }
 
-// GetSimplifiedDebts returns simplified debts using min-cash-flow algorithm
+// GetSimplifiedDebts returns simplified debts and the strategy used to compute them
 func (h *BalanceHandler) GetSimplifiedDebts(ctx context.Context, req *pb.GetSimplifiedDebtsRequest) (*pb.GetSimplifiedDebtsResponse, error) {
 	if req.ColocationId == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "colocation_id obligatoire")
 	}
 
-	debts, err := h.service.GetSimplifiedDebts(ctx, req.ColocationId)
+	debts, strategy, err := h.service.GetSimplifiedDebts(ctx, req.ColocationId)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
@@ -87,11 +88,14 @@ func (h *BalanceHandler) GetSimplifiedDebts(ctx context.Context, req *pb.GetSimp
 			ToUserNom:      d.ToUserNom,
 			ToUserPrenom:   d.ToUserPrenom,
 			ToAvatarUrl:    d.ToAvatarURL,
-			Amount:         d.Amount,
+			Amount:         moneyToProto(d.Amount, d.Currency),
 		})
 	}
 
-	return &pb.GetSimplifiedDebtsResponse{Debts: pbDebts}, nil
+	return &pb.GetSimplifiedDebtsResponse{
+		Debts:    pbDebts,
+		Strategy: strategyToProto(strategy),
+	}, nil
 }
 
 // GetBalanceHistory returns balance history for the current user
@@ -125,13 +129,26 @@ func (h *BalanceHandler) GetBalanceHistory(ctx context.Context, req *pb.GetBalan
 	for _, e := range entries {
 		pbEntries = append(pbEntries, &pb.BalanceHistoryEntry{
 			Date:              utils.FormatFrenchDate(e.Date),
-			CumulativeBalance: e.CumulativeBalance,
+			CumulativeBalance: moneyToProto(e.CumulativeBalance, e.Currency),
 			EventType:         e.EventType,
 			EventId:           e.EventID,
 			Description:       e.Description,
-			Amount:            e.Amount,
+			Amount:            moneyToProto(e.Amount, e.Currency),
 		})
 	}
 
 	return &pb.GetBalanceHistoryResponse{Entries: pbEntries}, nil
 }
+
+// Helper functions
+
+func strategyToProto(s algorithm.Strategy) pb.SimplificationStrategy {
+	switch s {
+	case algorithm.StrategyExact:
+		return pb.SimplificationStrategy_SIMPLIFICATION_STRATEGY_EXACT
+	case algorithm.StrategyGreedy:
+		return pb.SimplificationStrategy_SIMPLIFICATION_STRATEGY_GREEDY
+	default:
+		return pb.SimplificationStrategy_SIMPLIFICATION_STRATEGY_UNSPECIFIED
+	}
+}
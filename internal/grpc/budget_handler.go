@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/vblanchet22/back_coloc/internal/domain"
+	"github.com/vblanchet22/back_coloc/internal/service"
+	"github.com/vblanchet22/back_coloc/internal/utils"
+	pb "github.com/vblanchet22/back_coloc/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BudgetHandler implements the BudgetService gRPC server
+type BudgetHandler struct {
+	pb.UnimplementedBudgetServiceServer
+	service *service.BudgetService
+}
+
+// NewBudgetHandler creates a new BudgetHandler
+func NewBudgetHandler(service *service.BudgetService) *BudgetHandler {
+	return &BudgetHandler{service: service}
+}
+
+// ListBudgets lists the budgets of a colocation
+func (h *BudgetHandler) ListBudgets(ctx context.Context, req *pb.ListBudgetsRequest) (*pb.ListBudgetsResponse, error) {
+	if req.ColocationId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id obligatoire")
+	}
+
+	budgets, err := h.service.List(ctx, req.ColocationId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	var pbBudgets []*pb.Budget
+	for _, b := range budgets {
+		pbBudgets = append(pbBudgets, budgetToProto(&b))
+	}
+
+	return &pb.ListBudgetsResponse{Budgets: pbBudgets}, nil
+}
+
+// CreateBudget creates a budget
+func (h *BudgetHandler) CreateBudget(ctx context.Context, req *pb.CreateBudgetRequest) (*pb.Budget, error) {
+	if req.ColocationId == "" || req.Amount.GetUnits() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et amount obligatoires")
+	}
+
+	period, ok := protoBudgetPeriodToDomain(req.Period)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "period invalide")
+	}
+
+	amount := moneyFromProto(req.Amount)
+	currency, err := currencyFromProto(req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	var categoryID *string
+	if req.CategoryId != nil && *req.CategoryId != "" {
+		categoryID = req.CategoryId
+	}
+
+	budget, err := h.service.Create(ctx, req.ColocationId, categoryID, amount, currency, period)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return budgetToProto(budget), nil
+}
+
+// UpdateBudget updates a budget
+func (h *BudgetHandler) UpdateBudget(ctx context.Context, req *pb.UpdateBudgetRequest) (*pb.Budget, error) {
+	if req.ColocationId == "" || req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et id obligatoires")
+	}
+
+	var period *domain.BudgetPeriod
+	if req.Period != nil {
+		p, ok := protoBudgetPeriodToDomain(*req.Period)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "period invalide")
+		}
+		period = &p
+	}
+
+	amount := optionalMoneyFromProto(req.Amount)
+	currency, err := currencyFromProto(req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	budget, err := h.service.Update(ctx, req.ColocationId, req.Id, amount, currency, period)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return budgetToProto(budget), nil
+}
+
+// DeleteBudget deletes a budget
+func (h *BudgetHandler) DeleteBudget(ctx context.Context, req *pb.DeleteBudgetRequest) (*pb.DeleteBudgetResponse, error) {
+	if req.ColocationId == "" || req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et id obligatoires")
+	}
+
+	if err := h.service.Delete(ctx, req.ColocationId, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return &pb.DeleteBudgetResponse{Success: true}, nil
+}
+
+// GetBudgetStatus returns the spending of the current period of every budget
+func (h *BudgetHandler) GetBudgetStatus(ctx context.Context, req *pb.GetBudgetStatusRequest) (*pb.GetBudgetStatusResponse, error) {
+	if req.ColocationId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id obligatoire")
+	}
+
+	statuses, err := h.service.GetStatus(ctx, req.ColocationId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	resp := &pb.GetBudgetStatusResponse{}
+	for _, st := range statuses {
+		currency := st.Budget.Currency
+		resp.Statuses = append(resp.Statuses, &pb.BudgetStatus{
+			Budget:      budgetToProto(&st.Budget),
+			PeriodStart: st.PeriodStart.Format("2006-01-02"),
+			PeriodEnd:   st.PeriodEnd.Format("2006-01-02"),
+			Spent:       moneyToProto(st.Spent, currency),
+			Projected:   moneyToProto(st.Projected, currency),
+			Remaining:   moneyToProto(st.Remaining, currency),
+			Percentage:  st.Percentage,
+		})
+	}
+
+	return resp, nil
+}
+
+// Helper functions
+
+func budgetToProto(b *domain.Budget) *pb.Budget {
+	return &pb.Budget{
+		Id:           b.ID,
+		CategoryId:   b.CategoryID,
+		CategoryName: b.CategoryName,
+		Amount:       moneyToProto(b.Amount, b.Currency),
+		Period:       domainBudgetPeriodToProto(b.Period),
+		CreatedAt:    utils.FormatFrenchDateTime(b.CreatedAt),
+		UpdatedAt:    utils.FormatFrenchDateTime(b.UpdatedAt),
+	}
+}
+
+func protoBudgetPeriodToDomain(p pb.BudgetPeriod) (domain.BudgetPeriod, bool) {
+	switch p {
+	case pb.BudgetPeriod_BUDGET_PERIOD_WEEKLY:
+		return domain.BudgetPeriodWeekly, true
+	case pb.BudgetPeriod_BUDGET_PERIOD_MONTHLY:
+		return domain.BudgetPeriodMonthly, true
+	default:
+		return "", false
+	}
+}
+
+func domainBudgetPeriodToProto(p domain.BudgetPeriod) pb.BudgetPeriod {
+	switch p {
+	case domain.BudgetPeriodWeekly:
+		return pb.BudgetPeriod_BUDGET_PERIOD_WEEKLY
+	case domain.BudgetPeriodMonthly:
+		return pb.BudgetPeriod_BUDGET_PERIOD_MONTHLY
+	default:
+		return pb.BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED
+	}
+}
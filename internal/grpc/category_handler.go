@@ -104,19 +104,19 @@ func (h *CategoryHandler) GetCategoryStats(ctx context.Context, req *pb.GetCateg
 		endDate = &t
 	}
 
-	stats, totalAmount, err := h.service.GetStats(ctx, req.ColocationId, startDate, endDate)
+	stats, totalAmount, currency, err := h.service.GetStats(ctx, req.ColocationId, startDate, endDate)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
 
 	var pbStats []*pb.CategoryStat
 	for _, s := range stats {
-		pbStats = append(pbStats, categoryStatToProto(&s))
+		pbStats = append(pbStats, categoryStatToProto(&s, currency))
 	}
 
 	return &pb.GetCategoryStatsResponse{
 		Stats:       pbStats,
-		TotalAmount: totalAmount,
+		TotalAmount: moneyToProto(totalAmount, currency),
 	}, nil
 }
 
@@ -136,13 +136,13 @@ func categoryToProto(c *domain.ExpenseCategory) *pb.Category {
 	return cat
 }
 
-func categoryStatToProto(s *domain.CategoryStat) *pb.CategoryStat {
+func categoryStatToProto(s *domain.CategoryStat, currency string) *pb.CategoryStat {
 	return &pb.CategoryStat{
 		CategoryId:   s.CategoryID,
 		CategoryName: s.CategoryName,
 		Icon:         s.Icon,
 		Color:        s.Color,
-		TotalAmount:  s.TotalAmount,
+		TotalAmount:  moneyToProto(s.TotalAmount, currency),
 		ExpenseCount: int32(s.ExpenseCount),
 		Percentage:   s.Percentage,
 	}
@@ -28,7 +28,7 @@ func (h *ColocationHandler) CreateColocation(ctx context.Context, req *pb.Create
 		return nil, status.Errorf(codes.InvalidArgument, "nom obligatoire")
 	}
 
-	result, err := h.service.Create(ctx, req.Name, req.Description, req.Address)
+	result, err := h.service.Create(ctx, req.Name, req.Description, req.Address, req.BaseCurrency)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
@@ -71,7 +71,17 @@ func (h *ColocationHandler) UpdateColocation(ctx context.Context, req *pb.Update
 		return nil, status.Errorf(codes.InvalidArgument, "id obligatoire")
 	}
 
-	result, err := h.service.Update(ctx, req.Id, req.Name, req.Description, req.Address)
+	var reminderDays, expiryDays *int
+	if req.PaymentReminderDays != nil {
+		days := int(*req.PaymentReminderDays)
+		reminderDays = &days
+	}
+	if req.PaymentExpiryDays != nil {
+		days := int(*req.PaymentExpiryDays)
+		expiryDays = &days
+	}
+
+	result, err := h.service.Update(ctx, req.Id, req.Name, req.Description, req.Address, req.BaseCurrency, reminderDays, expiryDays)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
@@ -229,7 +239,7 @@ func (h *ColocationHandler) CancelInvitation(ctx context.Context, req *pb.Cancel
 // Helper functions
 
 func colocationWithRoleToProto(c *service.ColocationWithRole) *pb.Colocation {
-	return &pb.Colocation{
+	coloc := &pb.Colocation{
 		Id:              c.ID,
 		Name:            c.Name,
 		Description:     c.Description,
@@ -240,7 +250,19 @@ func colocationWithRoleToProto(c *service.ColocationWithRole) *pb.Colocation {
 		UpdatedAt:       utils.FormatFrenchDateTime(c.UpdatedAt),
 		CurrentUserRole: stringToProtoRole(c.CurrentUserRole),
 		MemberCount:     int32(c.MemberCount),
+		BaseCurrency:    c.BaseCurrency,
 	}
+
+	if c.PaymentReminderDays != nil {
+		days := int32(*c.PaymentReminderDays)
+		coloc.PaymentReminderDays = &days
+	}
+	if c.PaymentExpiryDays != nil {
+		days := int32(*c.PaymentExpiryDays)
+		coloc.PaymentExpiryDays = &days
+	}
+
+	return coloc
 }
 
 func memberToProto(m *domain.ColocationMember) *pb.ColocationMember {
@@ -0,0 +1,323 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/vblanchet22/back_coloc/internal/domain"
+	"github.com/vblanchet22/back_coloc/internal/service"
+	"github.com/vblanchet22/back_coloc/internal/utils"
+	pb "github.com/vblanchet22/back_coloc/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EventHandler implements the EventService gRPC server
+type EventHandler struct {
+	pb.UnimplementedEventServiceServer
+	service *service.EventService
+}
+
+// NewEventHandler creates a new EventHandler
+func NewEventHandler(service *service.EventService) *EventHandler {
+	return &EventHandler{service: service}
+}
+
+// CreateEvent creates a new event
+func (h *EventHandler) CreateEvent(ctx context.Context, req *pb.CreateEventRequest) (*pb.Event, error) {
+	if req.ColocationId == "" || req.Title == "" || req.EventDate == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id, title et event_date obligatoires")
+	}
+
+	eventDate, err := time.Parse("2006-01-02 15:04", req.EventDate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "format event_date invalide (attendu: YYYY-MM-DD HH:MM)")
+	}
+
+	budget := optionalMoneyFromProto(req.Budget)
+	currency, err := currencyFromProto(req.Budget)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := h.service.Create(ctx, service.CreateEventInput{
+		ColocationID: req.ColocationId,
+		Title:        req.Title,
+		Description:  req.Description,
+		EventDate:    eventDate,
+		Location:     req.Location,
+		Budget:       budget,
+		Currency:     currency,
+		FundID:       req.FundId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return eventToProto(event), nil
+}
+
+// GetEvent retrieves an event by ID
+func (h *EventHandler) GetEvent(ctx context.Context, req *pb.GetEventRequest) (*pb.Event, error) {
+	if req.ColocationId == "" || req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et id obligatoires")
+	}
+
+	event, err := h.service.GetByID(ctx, req.ColocationId, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+
+	return eventToProto(event), nil
+}
+
+// ListEvents lists events for a colocation
+func (h *EventHandler) ListEvents(ctx context.Context, req *pb.ListEventsRequest) (*pb.ListEventsResponse, error) {
+	if req.ColocationId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id obligatoire")
+	}
+
+	var statusFilter *domain.EventStatus
+	if req.Status != nil && *req.Status != pb.EventStatus_EVENT_STATUS_UNSPECIFIED {
+		s := protoEventStatusToDomain(*req.Status)
+		statusFilter = &s
+	}
+
+	var startDate, endDate *time.Time
+	if req.StartDate != nil && *req.StartDate != "" {
+		t, err := time.Parse("2006-01-02", *req.StartDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "format start_date invalide")
+		}
+		startDate = &t
+	}
+	if req.EndDate != nil && *req.EndDate != "" {
+		t, err := time.Parse("2006-01-02", *req.EndDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "format end_date invalide")
+		}
+		endDate = &t
+	}
+
+	page := int32(1)
+	pageSize := int32(20)
+	if req.Page != nil && *req.Page > 0 {
+		page = *req.Page
+	}
+	if req.PageSize != nil && *req.PageSize > 0 {
+		pageSize = *req.PageSize
+	}
+
+	events, totalCount, err := h.service.List(ctx, service.ListEventsInput{
+		ColocationID: req.ColocationId,
+		Status:       statusFilter,
+		StartDate:    startDate,
+		EndDate:      endDate,
+		Page:         int(page),
+		PageSize:     int(pageSize),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	var pbEvents []*pb.Event
+	for _, e := range events {
+		pbEvents = append(pbEvents, eventToProto(&e))
+	}
+
+	return &pb.ListEventsResponse{
+		Events:     pbEvents,
+		TotalCount: int32(totalCount),
+		Page:       page,
+		PageSize:   pageSize,
+	}, nil
+}
+
+// UpdateEvent updates an event
+func (h *EventHandler) UpdateEvent(ctx context.Context, req *pb.UpdateEventRequest) (*pb.Event, error) {
+	if req.ColocationId == "" || req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et id obligatoires")
+	}
+
+	var eventDate *time.Time
+	if req.EventDate != nil && *req.EventDate != "" {
+		t, err := time.Parse("2006-01-02 15:04", *req.EventDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "format event_date invalide")
+		}
+		eventDate = &t
+	}
+
+	var newStatus *domain.EventStatus
+	if req.Status != nil && *req.Status != pb.EventStatus_EVENT_STATUS_UNSPECIFIED {
+		s := protoEventStatusToDomain(*req.Status)
+		newStatus = &s
+	}
+
+	budget := optionalMoneyFromProto(req.Budget)
+	currency, err := currencyFromProto(req.Budget)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := h.service.Update(ctx, service.UpdateEventInput{
+		ColocationID: req.ColocationId,
+		EventID:      req.Id,
+		Title:        req.Title,
+		Description:  req.Description,
+		EventDate:    eventDate,
+		Location:     req.Location,
+		Budget:       budget,
+		Currency:     currency,
+		FundID:       req.FundId,
+		Status:       newStatus,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return eventToProto(event), nil
+}
+
+// DeleteEvent deletes an event
+func (h *EventHandler) DeleteEvent(ctx context.Context, req *pb.DeleteEventRequest) (*pb.DeleteEventResponse, error) {
+	if req.ColocationId == "" || req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et id obligatoires")
+	}
+
+	if err := h.service.Delete(ctx, req.ColocationId, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return &pb.DeleteEventResponse{Success: true}, nil
+}
+
+// RSVP records the current user's answer to an event
+func (h *EventHandler) RSVP(ctx context.Context, req *pb.RSVPRequest) (*pb.RSVPResponse, error) {
+	if req.ColocationId == "" || req.EventId == "" || req.Status == pb.RSVPStatus_RSVP_STATUS_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id, event_id et status obligatoires")
+	}
+
+	if err := h.service.RSVP(ctx, req.ColocationId, req.EventId, protoRSVPStatusToDomain(req.Status)); err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return &pb.RSVPResponse{Success: true}, nil
+}
+
+// GetParticipants returns the participants of an event
+func (h *EventHandler) GetParticipants(ctx context.Context, req *pb.GetParticipantsRequest) (*pb.GetParticipantsResponse, error) {
+	if req.ColocationId == "" || req.EventId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et event_id obligatoires")
+	}
+
+	participants, err := h.service.GetParticipants(ctx, req.ColocationId, req.EventId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	resp := &pb.GetParticipantsResponse{}
+	for _, p := range participants {
+		resp.Participants = append(resp.Participants, &pb.EventParticipant{
+			UserId:      p.UserID,
+			UserNom:     p.UserNom,
+			UserPrenom:  p.UserPrenom,
+			AvatarUrl:   p.AvatarURL,
+			RsvpStatus:  domainRSVPStatusToProto(p.RSVP),
+			RespondedAt: utils.FormatFrenchDateTime(p.RespondedAt),
+		})
+
+		switch p.RSVP {
+		case domain.RSVPGoing:
+			resp.GoingCount++
+		case domain.RSVPMaybe:
+			resp.MaybeCount++
+		case domain.RSVPNotGoing:
+			resp.NotGoingCount++
+		}
+	}
+
+	return resp, nil
+}
+
+// Helper functions
+
+func eventToProto(e *domain.Event) *pb.Event {
+	event := &pb.Event{
+		Id:              e.ID,
+		ColocationId:    e.ColocationID,
+		CreatedBy:       e.CreatedBy,
+		CreatedByNom:    e.CreatedByNom,
+		CreatedByPrenom: e.CreatedByPrenom,
+		Title:           e.Title,
+		Description:     e.Description,
+		EventDate:       e.EventDate.Format("2006-01-02 15:04"),
+		Location:        e.Location,
+		Budget:          optionalMoneyToProto(e.Budget, e.Currency),
+		FundId:          e.FundID,
+		FundName:        e.FundName,
+		Status:          domainEventStatusToProto(e.Status),
+		CreatedAt:       utils.FormatFrenchDateTime(e.CreatedAt),
+		GoingCount:      int32(e.GoingCount),
+		MaybeCount:      int32(e.MaybeCount),
+		NotGoingCount:   int32(e.NotGoingCount),
+	}
+
+	if e.UserRSVP != nil {
+		event.UserRsvp = domainRSVPStatusToProto(*e.UserRSVP)
+	}
+
+	return event
+}
+
+func domainEventStatusToProto(s domain.EventStatus) pb.EventStatus {
+	switch s {
+	case domain.EventStatusUpcoming:
+		return pb.EventStatus_EVENT_STATUS_UPCOMING
+	case domain.EventStatusOngoing:
+		return pb.EventStatus_EVENT_STATUS_ONGOING
+	case domain.EventStatusCompleted:
+		return pb.EventStatus_EVENT_STATUS_COMPLETED
+	case domain.EventStatusCancelled:
+		return pb.EventStatus_EVENT_STATUS_CANCELLED
+	default:
+		return pb.EventStatus_EVENT_STATUS_UNSPECIFIED
+	}
+}
+
+func protoEventStatusToDomain(s pb.EventStatus) domain.EventStatus {
+	switch s {
+	case pb.EventStatus_EVENT_STATUS_ONGOING:
+		return domain.EventStatusOngoing
+	case pb.EventStatus_EVENT_STATUS_COMPLETED:
+		return domain.EventStatusCompleted
+	case pb.EventStatus_EVENT_STATUS_CANCELLED:
+		return domain.EventStatusCancelled
+	default:
+		return domain.EventStatusUpcoming
+	}
+}
+
+func domainRSVPStatusToProto(s domain.RSVPStatus) pb.RSVPStatus {
+	switch s {
+	case domain.RSVPGoing:
+		return pb.RSVPStatus_RSVP_STATUS_GOING
+	case domain.RSVPMaybe:
+		return pb.RSVPStatus_RSVP_STATUS_MAYBE
+	case domain.RSVPNotGoing:
+		return pb.RSVPStatus_RSVP_STATUS_NOT_GOING
+	default:
+		return pb.RSVPStatus_RSVP_STATUS_UNSPECIFIED
+	}
+}
+
+func protoRSVPStatusToDomain(s pb.RSVPStatus) domain.RSVPStatus {
+	switch s {
+	case pb.RSVPStatus_RSVP_STATUS_GOING:
+		return domain.RSVPGoing
+	case pb.RSVPStatus_RSVP_STATUS_MAYBE:
+		return domain.RSVPMaybe
+	default:
+		return domain.RSVPNotGoing
+	}
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/vblanchet22/back_coloc/internal/domain"
+	"github.com/vblanchet22/back_coloc/internal/service"
+	"github.com/vblanchet22/back_coloc/internal/utils"
+	pb "github.com/vblanchet22/back_coloc/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExchangeRateHandler implements the ExchangeRateService gRPC server
+type ExchangeRateHandler struct {
+	pb.UnimplementedExchangeRateServiceServer
+	service *service.ExchangeRateService
+}
+
+// NewExchangeRateHandler creates a new ExchangeRateHandler
+func NewExchangeRateHandler(service *service.ExchangeRateService) *ExchangeRateHandler {
+	return &ExchangeRateHandler{service: service}
+}
+
+// ImportExchangeRates imports exchange rates towards the base currency
+func (h *ExchangeRateHandler) ImportExchangeRates(ctx context.Context, req *pb.ImportExchangeRatesRequest) (*pb.ImportExchangeRatesResponse, error) {
+	if req.ColocationId == "" || len(req.Rates) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et rates obligatoires")
+	}
+
+	inputs := make([]service.ImportRateInput, 0, len(req.Rates))
+	for _, r := range req.Rates {
+		rate, err := domain.ParseRate(r.Rate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		rateDate, err := time.Parse("2006-01-02", r.RateDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "format rate_date invalide (attendu: YYYY-MM-DD)")
+		}
+		inputs = append(inputs, service.ImportRateInput{
+			Currency: r.Currency,
+			Rate:     rate,
+			RateDate: rateDate,
+		})
+	}
+
+	rates, err := h.service.Import(ctx, req.ColocationId, inputs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return &pb.ImportExchangeRatesResponse{
+		ImportedCount: int32(len(rates)),
+		BaseCurrency:  rates[0].BaseCurrency,
+	}, nil
+}
+
+// ListExchangeRates lists the exchange rates of a colocation
+func (h *ExchangeRateHandler) ListExchangeRates(ctx context.Context, req *pb.ListExchangeRatesRequest) (*pb.ListExchangeRatesResponse, error) {
+	if req.ColocationId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id obligatoire")
+	}
+
+	rates, baseCurrency, err := h.service.List(ctx, req.ColocationId, req.Currency)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	var pbRates []*pb.ExchangeRate
+	for _, r := range rates {
+		pbRates = append(pbRates, exchangeRateToProto(&r))
+	}
+
+	return &pb.ListExchangeRatesResponse{
+		Rates:        pbRates,
+		BaseCurrency: baseCurrency,
+	}, nil
+}
+
+// Helper functions
+
+func exchangeRateToProto(r *domain.ExchangeRate) *pb.ExchangeRate {
+	return &pb.ExchangeRate{
+		Id:           r.ID,
+		BaseCurrency: r.BaseCurrency,
+		Currency:     r.Currency,
+		Rate:         r.Rate.String(),
+		RateDate:     r.RateDate.Format("2006-01-02"),
+		Source:       r.Source,
+		CreatedBy:    r.CreatedBy,
+		CreatedAt:    utils.FormatFrenchDateTime(r.CreatedAt),
+	}
+}
@@ -25,33 +25,45 @@ func NewExpenseHandler(service *service.ExpenseService) *ExpenseHandler {
 
 // CreateExpense creates a new expense
 func (h *ExpenseHandler) CreateExpense(ctx context.Context, req *pb.CreateExpenseRequest) (*pb.Expense, error) {
-	if req.ColocationId == "" || req.Title == "" || req.Amount <= 0 || req.CategoryId == "" {
+	if req.ColocationId == "" || req.Title == "" || req.Amount.GetUnits() <= 0 || req.CategoryId == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "colocation_id, title, amount et category_id sont obligatoires")
 	}
 
+	amount := moneyFromProto(req.Amount)
+	currency, err := currencyFromProto(req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
 	expenseDate, err := time.Parse("2006-01-02", req.ExpenseDate)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "format de date invalide (attendu: YYYY-MM-DD)")
 	}
 
-	var splits []domain.ExpenseSplitInput
-	for _, s := range req.Splits {
-		splits = append(splits, domain.ExpenseSplitInput{
-			UserID:     s.UserId,
-			Amount:     s.Amount,
-			Percentage: s.Percentage,
-		})
+	exchangeRate, err := optionalRateFromProto(req.ExchangeRate)
+	if err != nil {
+		return nil, err
 	}
 
+	splits := splitInputsFromProto(req.Splits)
+	items := itemInputsFromProto(req.Items)
+	payers := payerInputsFromProto(req.Payers)
+
 	expense, err := h.service.Create(ctx, service.CreateExpenseInput{
 		ColocationID: req.ColocationId,
+		PaidBy:       req.GetPaidBy(),
+		Payers:       payers,
 		Title:        req.Title,
 		Description:  req.Description,
-		Amount:       req.Amount,
+		Amount:       amount,
+		Currency:     currency,
 		CategoryID:   req.CategoryId,
 		SplitType:    protoSplitTypeToDomain(req.SplitType),
 		Splits:       splits,
+		Items:        items,
+		TagIDs:       req.TagIds,
 		ExpenseDate:  expenseDate,
+		ExchangeRate: exchangeRate,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%v", err)
@@ -111,6 +123,8 @@ func (h *ExpenseHandler) ListExpenses(ctx context.Context, req *pb.ListExpensesR
 		PaidBy:       req.PaidBy,
 		StartDate:    startDate,
 		EndDate:      endDate,
+		TagIDs:       req.TagIds,
+		MatchAllTags: req.MatchAllTags,
 		Page:         int(page),
 		PageSize:     int(pageSize),
 	})
@@ -152,25 +166,38 @@ func (h *ExpenseHandler) UpdateExpense(ctx context.Context, req *pb.UpdateExpens
 		splitType = &st
 	}
 
-	var splits []domain.ExpenseSplitInput
-	for _, s := range req.Splits {
-		splits = append(splits, domain.ExpenseSplitInput{
-			UserID:     s.UserId,
-			Amount:     s.Amount,
-			Percentage: s.Percentage,
-		})
+	amount := optionalMoneyFromProto(req.Amount)
+	currency, err := currencyFromProto(req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	exchangeRate, err := optionalRateFromProto(req.ExchangeRate)
+	if err != nil {
+		return nil, err
 	}
 
+	splits := splitInputsFromProto(req.Splits)
+	items := itemInputsFromProto(req.Items)
+	payers := payerInputsFromProto(req.Payers)
+
 	expense, err := h.service.Update(ctx, service.UpdateExpenseInput{
 		ColocationID: req.ColocationId,
 		ExpenseID:    req.Id,
+		PaidBy:       req.PaidBy,
+		Payers:       payers,
 		Title:        req.Title,
 		Description:  req.Description,
-		Amount:       req.Amount,
+		Amount:       amount,
+		Currency:     currency,
 		CategoryID:   req.CategoryId,
 		SplitType:    splitType,
 		Splits:       splits,
+		Items:        items,
+		TagIDs:       req.TagIds,
+		ClearTags:    req.ClearTags,
 		ExpenseDate:  expenseDate,
+		ExchangeRate: exchangeRate,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%v", err)
@@ -185,19 +212,102 @@ func (h *ExpenseHandler) DeleteExpense(ctx context.Context, req *pb.DeleteExpens
 		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et id obligatoires")
 	}
 
-	if err := h.service.Delete(ctx, req.ColocationId, req.Id); err != nil {
+	restorableUntil, err := h.service.Delete(ctx, req.ColocationId, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return &pb.DeleteExpenseResponse{
+		Success:         true,
+		RestorableUntil: utils.FormatFrenchDateTime(restorableUntil),
+	}, nil
+}
+
+// RestoreExpense restores a deleted expense
+func (h *ExpenseHandler) RestoreExpense(ctx context.Context, req *pb.RestoreExpenseRequest) (*pb.Expense, error) {
+	if req.ColocationId == "" || req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et id obligatoires")
+	}
+
+	expense, err := h.service.Restore(ctx, req.ColocationId, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return expenseToProto(expense), nil
+}
+
+// ListDeletedExpenses lists the deleted expenses that can still be restored
+func (h *ExpenseHandler) ListDeletedExpenses(ctx context.Context, req *pb.ListDeletedExpensesRequest) (*pb.ListDeletedExpensesResponse, error) {
+	if req.ColocationId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id obligatoire")
+	}
+
+	expenses, err := h.service.ListDeleted(ctx, req.ColocationId)
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
 
-	return &pb.DeleteExpenseResponse{Success: true}, nil
+	var pbExpenses []*pb.Expense
+	for i := range expenses {
+		expense := expenseToProto(&expenses[i])
+		if deletedAt := expenses[i].DeletedAt; deletedAt != nil {
+			deleted := utils.FormatFrenchDateTime(*deletedAt)
+			restorableUntil := utils.FormatFrenchDateTime(h.service.RestorableUntil(*deletedAt))
+			expense.DeletedAt = &deleted
+			expense.RestorableUntil = &restorableUntil
+		}
+		pbExpenses = append(pbExpenses, expense)
+	}
+
+	return &pb.ListDeletedExpensesResponse{Expenses: pbExpenses}, nil
+}
+
+// ListExpenseHistory lists the revisions of an expense
+func (h *ExpenseHandler) ListExpenseHistory(ctx context.Context, req *pb.ListExpenseHistoryRequest) (*pb.ListExpenseHistoryResponse, error) {
+	if req.ColocationId == "" || req.ExpenseId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et expense_id obligatoires")
+	}
+
+	revisions, err := h.service.ListHistory(ctx, req.ColocationId, req.ExpenseId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	var pbRevisions []*pb.ExpenseRevision
+	for _, rev := range revisions {
+		pbRevisions = append(pbRevisions, expenseRevisionToProto(&rev))
+	}
+
+	return &pb.ListExpenseHistoryResponse{Revisions: pbRevisions}, nil
+}
+
+// RestoreExpenseRevision restores an expense to an earlier revision
+func (h *ExpenseHandler) RestoreExpenseRevision(ctx context.Context, req *pb.RestoreExpenseRevisionRequest) (*pb.Expense, error) {
+	if req.ColocationId == "" || req.ExpenseId == "" || req.Revision <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id, expense_id et revision obligatoires")
+	}
+
+	expense, err := h.service.RestoreRevision(ctx, req.ColocationId, req.ExpenseId, int(req.Revision))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return expenseToProto(expense), nil
 }
 
 // CreateRecurringExpense creates a recurring expense template
 func (h *ExpenseHandler) CreateRecurringExpense(ctx context.Context, req *pb.CreateRecurringExpenseRequest) (*pb.RecurringExpense, error) {
-	if req.ColocationId == "" || req.Title == "" || req.Amount <= 0 || req.CategoryId == "" {
+	if req.ColocationId == "" || req.Title == "" || req.Amount.GetUnits() <= 0 || req.CategoryId == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "colocation_id, title, amount et category_id sont obligatoires")
 	}
 
+	amount := moneyFromProto(req.Amount)
+	currency, err := currencyFromProto(req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
 	startDate, err := time.Parse("2006-01-02", req.StartDate)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "format start_date invalide")
@@ -212,20 +322,14 @@ func (h *ExpenseHandler) CreateRecurringExpense(ctx context.Context, req *pb.Cre
 		endDate = &t
 	}
 
-	var splits []domain.ExpenseSplitInput
-	for _, s := range req.Splits {
-		splits = append(splits, domain.ExpenseSplitInput{
-			UserID:     s.UserId,
-			Amount:     s.Amount,
-			Percentage: s.Percentage,
-		})
-	}
+	splits := splitInputsFromProto(req.Splits)
 
 	recurring, err := h.service.CreateRecurring(ctx, service.CreateRecurringInput{
 		ColocationID: req.ColocationId,
 		Title:        req.Title,
 		Description:  req.Description,
-		Amount:       req.Amount,
+		Amount:       amount,
+		Currency:     currency,
 		CategoryID:   req.CategoryId,
 		SplitType:    protoSplitTypeToDomain(req.SplitType),
 		Splits:       splits,
@@ -286,21 +390,21 @@ func (h *ExpenseHandler) UpdateRecurringExpense(ctx context.Context, req *pb.Upd
 		recurrence = &r
 	}
 
-	var splits []domain.ExpenseSplitInput
-	for _, s := range req.Splits {
-		splits = append(splits, domain.ExpenseSplitInput{
-			UserID:     s.UserId,
-			Amount:     s.Amount,
-			Percentage: s.Percentage,
-		})
+	amount := optionalMoneyFromProto(req.Amount)
+	currency, err := currencyFromProto(req.Amount)
+	if err != nil {
+		return nil, err
 	}
 
+	splits := splitInputsFromProto(req.Splits)
+
 	recurring, err := h.service.UpdateRecurring(ctx, service.UpdateRecurringInput{
 		ColocationID: req.ColocationId,
 		RecurringID:  req.Id,
 		Title:        req.Title,
 		Description:  req.Description,
-		Amount:       req.Amount,
+		Amount:       amount,
+		Currency:     currency,
 		CategoryID:   req.CategoryId,
 		SplitType:    splitType,
 		Splits:       splits,
@@ -328,6 +432,100 @@ func (h *ExpenseHandler) DeleteRecurringExpense(ctx context.Context, req *pb.Del
 	return &pb.DeleteRecurringExpenseResponse{Success: true}, nil
 }
 
+// PauseRecurringExpense pauses a recurring expense until a date, or resumes it
+func (h *ExpenseHandler) PauseRecurringExpense(ctx context.Context, req *pb.PauseRecurringExpenseRequest) (*pb.RecurringExpense, error) {
+	if req.ColocationId == "" || req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et id obligatoires")
+	}
+
+	var until *time.Time
+	if req.Until != nil && *req.Until != "" {
+		t, err := time.Parse("2006-01-02", *req.Until)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "format until invalide")
+		}
+		until = &t
+	}
+
+	recurring, err := h.service.PauseRecurring(ctx, req.ColocationId, req.Id, until)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return recurringExpenseToProto(recurring), nil
+}
+
+// ListUpcomingOccurrences lists the next occurrences of a recurring expense
+func (h *ExpenseHandler) ListUpcomingOccurrences(ctx context.Context, req *pb.ListUpcomingOccurrencesRequest) (*pb.ListUpcomingOccurrencesResponse, error) {
+	if req.ColocationId == "" || req.RecurringId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et recurring_id obligatoires")
+	}
+
+	occurrences, err := h.service.ListUpcomingOccurrences(ctx, req.ColocationId, req.RecurringId, int(req.Count))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	resp := &pb.ListUpcomingOccurrencesResponse{}
+	for i := range occurrences {
+		resp.Occurrences = append(resp.Occurrences, occurrenceToProto(&occurrences[i]))
+	}
+
+	return resp, nil
+}
+
+// SetOccurrenceOverride skips an occurrence or changes its amount or payer
+func (h *ExpenseHandler) SetOccurrenceOverride(ctx context.Context, req *pb.SetOccurrenceOverrideRequest) (*pb.RecurringOccurrence, error) {
+	if req.ColocationId == "" || req.RecurringId == "" || req.OccurrenceDate == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id, recurring_id et occurrence_date obligatoires")
+	}
+
+	occurrenceDate, err := time.Parse("2006-01-02", req.OccurrenceDate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "format occurrence_date invalide")
+	}
+
+	amount := optionalMoneyFromProto(req.Amount)
+	currency, err := currencyFromProto(req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrence, err := h.service.SetOccurrenceOverride(ctx, service.OccurrenceOverrideInput{
+		ColocationID:   req.ColocationId,
+		RecurringID:    req.RecurringId,
+		OccurrenceDate: occurrenceDate,
+		Skip:           req.Skip,
+		Amount:         amount,
+		Currency:       currency,
+		PaidBy:         req.PaidBy,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return occurrenceToProto(occurrence), nil
+}
+
+// ClearOccurrenceOverride restores an occurrence to the recurring expense
+func (h *ExpenseHandler) ClearOccurrenceOverride(ctx context.Context, req *pb.ClearOccurrenceOverrideRequest) (*pb.RecurringOccurrence, error) {
+	if req.ColocationId == "" || req.RecurringId == "" || req.OccurrenceDate == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id, recurring_id et occurrence_date obligatoires")
+	}
+
+	occurrenceDate, err := time.Parse("2006-01-02", req.OccurrenceDate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "format occurrence_date invalide")
+	}
+
+	occurrence, err := h.service.ClearOccurrenceOverride(ctx, req.ColocationId, req.RecurringId, occurrenceDate)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return occurrenceToProto(occurrence), nil
+}
+
 // GetForecast returns expense forecast
 func (h *ExpenseHandler) GetForecast(ctx context.Context, req *pb.GetForecastRequest) (*pb.GetForecastResponse, error) {
 	if req.ColocationId == "" {
@@ -339,7 +537,17 @@ func (h *ExpenseHandler) GetForecast(ctx context.Context, req *pb.GetForecastReq
 		monthsAhead = 3
 	}
 
-	forecasts, err := h.service.GetForecast(ctx, req.ColocationId, monthsAhead)
+	var mode domain.ForecastMode
+	switch req.Mode {
+	case pb.ForecastMode_FORECAST_MODE_UNSPECIFIED, pb.ForecastMode_FORECAST_MODE_RECURRING:
+		mode = domain.ForecastModeRecurring
+	case pb.ForecastMode_FORECAST_MODE_HISTORY:
+		mode = domain.ForecastModeHistory
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "mode invalide")
+	}
+
+	forecasts, err := h.service.GetForecast(ctx, req.ColocationId, monthsAhead, mode)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
@@ -349,15 +557,23 @@ func (h *ExpenseHandler) GetForecast(ctx context.Context, req *pb.GetForecastReq
 		var categories []*pb.CategoryForecast
 		for _, c := range f.Categories {
 			categories = append(categories, &pb.CategoryForecast{
-				CategoryId:   c.CategoryID,
-				CategoryName: c.CategoryName,
-				Amount:       c.Amount,
+				CategoryId:      c.CategoryID,
+				CategoryName:    c.CategoryName,
+				Amount:          moneyToProto(c.Amount, f.Currency),
+				RecurringAmount: moneyToProto(c.RecurringAmount, f.Currency),
+				EstimatedAmount: moneyToProto(c.EstimatedAmount, f.Currency),
+				LowAmount:       moneyToProto(c.LowAmount, f.Currency),
+				HighAmount:      moneyToProto(c.HighAmount, f.Currency),
 			})
 		}
 		pbForecasts = append(pbForecasts, &pb.MonthlyForecast{
-			Month:       f.Month,
-			TotalAmount: f.TotalAmount,
-			Categories:  categories,
+			Month:           f.Month,
+			TotalAmount:     moneyToProto(f.TotalAmount, f.Currency),
+			RecurringAmount: moneyToProto(f.RecurringAmount, f.Currency),
+			EstimatedAmount: moneyToProto(f.EstimatedAmount, f.Currency),
+			LowAmount:       moneyToProto(f.LowAmount, f.Currency),
+			HighAmount:      moneyToProto(f.HighAmount, f.Currency),
+			Categories:      categories,
 		})
 	}
 
@@ -366,6 +582,49 @@ func (h *ExpenseHandler) GetForecast(ctx context.Context, req *pb.GetForecastReq
 
 // Helper functions
 
+func splitInputsFromProto(pbSplits []*pb.ExpenseSplitInput) []domain.ExpenseSplitInput {
+	var splits []domain.ExpenseSplitInput
+	for _, s := range pbSplits {
+		splits = append(splits, domain.ExpenseSplitInput{
+			UserID:     s.UserId,
+			Amount:     moneyFromProto(s.Amount),
+			Percentage: s.Percentage,
+			Shares:     int(s.Shares),
+		})
+	}
+	return splits
+}
+
+func payerInputsFromProto(pbPayers []*pb.ExpensePayerInput) []domain.ExpensePayerInput {
+	var payers []domain.ExpensePayerInput
+	for _, p := range pbPayers {
+		payers = append(payers, domain.ExpensePayerInput{
+			UserID: p.UserId,
+			Amount: moneyFromProto(p.Amount),
+		})
+	}
+	return payers
+}
+
+func itemInputsFromProto(pbItems []*pb.ExpenseItemInput) []domain.ExpenseItemInput {
+	var items []domain.ExpenseItemInput
+	for _, i := range pbItems {
+		item := domain.ExpenseItemInput{
+			Label:          i.Label,
+			Amount:         moneyFromProto(i.Amount),
+			IsSharedCharge: i.IsSharedCharge,
+		}
+		for _, p := range i.Participants {
+			item.Participants = append(item.Participants, domain.ExpenseItemParticipantInput{
+				UserID: p.UserId,
+				Weight: p.Weight,
+			})
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
 func expenseToProto(e *domain.Expense) *pb.Expense {
 	expense := &pb.Expense{
 		Id:           e.ID,
@@ -373,11 +632,14 @@ func expenseToProto(e *domain.Expense) *pb.Expense {
 		PaidBy:       e.PaidBy,
 		PaidByNom:    e.PaidByNom,
 		PaidByPrenom: e.PaidByPrenom,
+		CreatedBy:    e.CreatedBy,
 		CategoryId:   e.CategoryID,
 		CategoryName: e.CategoryName,
 		Title:        e.Title,
 		Description:  e.Description,
-		Amount:       e.Amount,
+		Amount:       moneyToProto(e.Amount, e.Currency),
+		BaseAmount:   moneyToProto(e.BaseAmount, e.BaseCurrency),
+		ExchangeRate: e.ExchangeRate.String(),
 		SplitType:    domainSplitTypeToProto(e.SplitType),
 		ExpenseDate:  e.ExpenseDate.Format("2006-01-02"),
 		RecurringId:  e.RecurringID,
@@ -386,18 +648,90 @@ func expenseToProto(e *domain.Expense) *pb.Expense {
 
 	for _, s := range e.Splits {
 		expense.Splits = append(expense.Splits, &pb.ExpenseSplit{
-			UserId:     s.UserID,
-			Amount:     s.Amount,
-			Percentage: s.Percentage,
-			IsSettled:  s.IsSettled,
-			UserNom:    s.UserNom,
-			UserPrenom: s.UserPrenom,
+			UserId:           s.UserID,
+			Amount:           moneyToProto(s.Amount, e.Currency),
+			BaseAmount:       moneyToProto(s.BaseAmount, e.BaseCurrency),
+			Percentage:       s.Percentage,
+			Shares:           int32(s.Shares),
+			IsSettled:        s.IsSettled,
+			UserNom:          s.UserNom,
+			UserPrenom:       s.UserPrenom,
+			SettledAmount:    moneyToProto(s.SettledAmount, e.BaseCurrency),
+			SettlementStatus: domainSplitSettlementStatusToProto(s.SettlementStatus()),
+		})
+	}
+
+	for _, p := range e.Payers {
+		expense.Payers = append(expense.Payers, &pb.ExpensePayer{
+			UserId:     p.UserID,
+			Amount:     moneyToProto(p.Amount, e.Currency),
+			BaseAmount: moneyToProto(p.BaseAmount, e.BaseCurrency),
+			UserNom:    p.UserNom,
+			UserPrenom: p.UserPrenom,
 		})
 	}
 
+	for _, i := range e.Items {
+		item := &pb.ExpenseItem{
+			Id:             i.ID,
+			Label:          i.Label,
+			Amount:         moneyToProto(i.Amount, e.Currency),
+			IsSharedCharge: i.IsSharedCharge,
+		}
+		for _, p := range i.Participants {
+			item.Participants = append(item.Participants, &pb.ExpenseItemParticipant{
+				UserId:     p.UserID,
+				Weight:     p.Weight,
+				UserNom:    p.UserNom,
+				UserPrenom: p.UserPrenom,
+			})
+		}
+		expense.Items = append(expense.Items, item)
+	}
+
+	for _, t := range e.Tags {
+		expense.Tags = append(expense.Tags, tagToProto(&t))
+	}
+
 	return expense
 }
 
+func expenseRevisionToProto(rev *domain.ExpenseRevision) *pb.ExpenseRevision {
+	revision := &pb.ExpenseRevision{
+		Revision:        int32(rev.Revision),
+		Action:          domainRevisionActionToProto(rev.Action),
+		ChangedBy:       rev.ChangedBy,
+		ChangedByNom:    rev.ChangedByNom,
+		ChangedByPrenom: rev.ChangedByPrenom,
+		CreatedAt:       utils.FormatFrenchDateTime(rev.CreatedAt),
+	}
+
+	for _, c := range rev.Changes {
+		revision.Changes = append(revision.Changes, &pb.ExpenseFieldChange{
+			Field:    c.Field,
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+		})
+	}
+
+	return revision
+}
+
+func domainRevisionActionToProto(a domain.RevisionAction) pb.RevisionAction {
+	switch a {
+	case domain.RevisionCreate:
+		return pb.RevisionAction_REVISION_ACTION_CREATE
+	case domain.RevisionUpdate:
+		return pb.RevisionAction_REVISION_ACTION_UPDATE
+	case domain.RevisionDelete:
+		return pb.RevisionAction_REVISION_ACTION_DELETE
+	case domain.RevisionRestore:
+		return pb.RevisionAction_REVISION_ACTION_RESTORE
+	default:
+		return pb.RevisionAction_REVISION_ACTION_UNSPECIFIED
+	}
+}
+
 func recurringExpenseToProto(re *domain.RecurringExpense) *pb.RecurringExpense {
 	recurring := &pb.RecurringExpense{
 		Id:           re.ID,
@@ -409,7 +743,7 @@ func recurringExpenseToProto(re *domain.RecurringExpense) *pb.RecurringExpense {
 		CategoryName: re.CategoryName,
 		Title:        re.Title,
 		Description:  re.Description,
-		Amount:       re.Amount,
+		Amount:       moneyToProto(re.Amount, re.Currency),
 		SplitType:    domainSplitTypeToProto(re.SplitType),
 		Recurrence:   domainRecurrenceToProto(re.Recurrence),
 		NextDueDate:  re.NextDueDate.Format("2006-01-02"),
@@ -421,11 +755,16 @@ func recurringExpenseToProto(re *domain.RecurringExpense) *pb.RecurringExpense {
 		endDate := re.EndDate.Format("2006-01-02")
 		recurring.EndDate = &endDate
 	}
+	if re.PausedUntil != nil {
+		pausedUntil := re.PausedUntil.Format("2006-01-02")
+		recurring.PausedUntil = &pausedUntil
+	}
 
 	for _, s := range re.Splits {
 		recurring.Splits = append(recurring.Splits, &pb.RecurringExpenseSplit{
 			UserId:     s.UserID,
 			Percentage: s.Percentage,
+			Shares:     int32(s.Shares),
 			UserNom:    s.UserNom,
 			UserPrenom: s.UserPrenom,
 		})
@@ -434,6 +773,40 @@ func recurringExpenseToProto(re *domain.RecurringExpense) *pb.RecurringExpense {
 	return recurring
 }
 
+func occurrenceToProto(o *domain.RecurringOccurrence) *pb.RecurringOccurrence {
+	occurrence := &pb.RecurringOccurrence{
+		RecurringId: o.RecurringID,
+		Date:        o.Date.Format("2006-01-02"),
+		Amount:      moneyToProto(o.Amount, o.Currency),
+		PaidBy:      o.PaidBy,
+		Overridden:  o.Overridden,
+	}
+
+	switch o.Status {
+	case domain.OccurrenceScheduled:
+		occurrence.Status = pb.OccurrenceStatus_OCCURRENCE_STATUS_SCHEDULED
+	case domain.OccurrenceSkipped:
+		occurrence.Status = pb.OccurrenceStatus_OCCURRENCE_STATUS_SKIPPED
+	case domain.OccurrencePaused:
+		occurrence.Status = pb.OccurrenceStatus_OCCURRENCE_STATUS_PAUSED
+	}
+
+	return occurrence
+}
+
+func domainSplitSettlementStatusToProto(st domain.SplitSettlementStatus) pb.SplitSettlementStatus {
+	switch st {
+	case domain.SplitUnsettled:
+		return pb.SplitSettlementStatus_SPLIT_SETTLEMENT_STATUS_UNSETTLED
+	case domain.SplitPartiallySettled:
+		return pb.SplitSettlementStatus_SPLIT_SETTLEMENT_STATUS_PARTIALLY_SETTLED
+	case domain.SplitSettled:
+		return pb.SplitSettlementStatus_SPLIT_SETTLEMENT_STATUS_SETTLED
+	default:
+		return pb.SplitSettlementStatus_SPLIT_SETTLEMENT_STATUS_UNSPECIFIED
+	}
+}
+
 func domainSplitTypeToProto(st domain.SplitType) pb.SplitType {
 	switch st {
 	case domain.SplitTypeEqual:
@@ -442,6 +815,12 @@ func domainSplitTypeToProto(st domain.SplitType) pb.SplitType {
 		return pb.SplitType_SPLIT_TYPE_PERCENTAGE
 	case domain.SplitTypeCustom:
 		return pb.SplitType_SPLIT_TYPE_CUSTOM
+	case domain.SplitTypeItemized:
+		return pb.SplitType_SPLIT_TYPE_ITEMIZED
+	case domain.SplitTypeEqualSelected:
+		return pb.SplitType_SPLIT_TYPE_EQUAL_SELECTED
+	case domain.SplitTypeShares:
+		return pb.SplitType_SPLIT_TYPE_SHARES
 	default:
 		return pb.SplitType_SPLIT_TYPE_UNSPECIFIED
 	}
@@ -455,6 +834,12 @@ func protoSplitTypeToDomain(st pb.SplitType) domain.SplitType {
 		return domain.SplitTypePercentage
 	case pb.SplitType_SPLIT_TYPE_CUSTOM:
 		return domain.SplitTypeCustom
+	case pb.SplitType_SPLIT_TYPE_ITEMIZED:
+		return domain.SplitTypeItemized
+	case pb.SplitType_SPLIT_TYPE_EQUAL_SELECTED:
+		return domain.SplitTypeEqualSelected
+	case pb.SplitType_SPLIT_TYPE_SHARES:
+		return domain.SplitTypeShares
 	default:
 		return domain.SplitTypeEqual
 	}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	pb "github.com/vblanchet22/back_coloc/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const downloadExportPattern = "/api/colocations/{colocation_id}/export/download"
+
+// RegisterExportRoutes registers the route downloading an export as a raw
+// file instead of a JSON message with base64 content
+func RegisterExportRoutes(mux *runtime.ServeMux, client pb.ExportServiceClient) error {
+	return mux.HandlePath(http.MethodGet, downloadExportPattern, downloadExportHandler(mux, client))
+}
+
+// downloadExportHandler calls ExportColocation with the query parameters
+// (format, start_date, end_date, month, user_id) and writes the file
+func downloadExportHandler(mux *runtime.ServeMux, client pb.ExportServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		_, marshaler := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, "/coloc.ExportService/ExportColocation", runtime.WithHTTPPathPattern(downloadExportPattern))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+			return
+		}
+
+		query := r.URL.Query()
+		format, ok := pb.ExportFormat_value["EXPORT_FORMAT_"+strings.ToUpper(query.Get("format"))]
+		if !ok {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, status.Errorf(codes.InvalidArgument, "format d'export invalide: %q", query.Get("format")))
+			return
+		}
+
+		req := &pb.ExportColocationRequest{
+			ColocationId: params["colocation_id"],
+			Format:       pb.ExportFormat(format),
+		}
+		for name, target := range map[string]**string{
+			"start_date": &req.StartDate,
+			"end_date":   &req.EndDate,
+			"month":      &req.Month,
+			"user_id":    &req.UserId,
+		} {
+			if value := query.Get(name); value != "" {
+				*target = &value
+			}
+		}
+
+		file, err := client.ExportColocation(ctx, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", file.ContentType)
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": file.FileName}))
+		w.Header().Set("Content-Length", strconv.Itoa(len(file.Content)))
+		w.WriteHeader(http.StatusOK)
+		w.Write(file.Content)
+	}
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/vblanchet22/back_coloc/internal/service"
+	pb "github.com/vblanchet22/back_coloc/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportHandler implements the ExportService gRPC server
+type ExportHandler struct {
+	pb.UnimplementedExportServiceServer
+	service *service.ExportService
+}
+
+// NewExportHandler creates a new ExportHandler
+func NewExportHandler(service *service.ExportService) *ExportHandler {
+	return &ExportHandler{service: service}
+}
+
+// ExportColocation exports the colocation as a file
+func (h *ExportHandler) ExportColocation(ctx context.Context, req *pb.ExportColocationRequest) (*pb.ExportFile, error) {
+	if req.ColocationId == "" || req.Format == pb.ExportFormat_EXPORT_FORMAT_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et format obligatoires")
+	}
+
+	input := service.ExportInput{
+		ColocationID: req.ColocationId,
+		Format:       protoExportFormatToDomain(req.Format),
+		UserID:       req.GetUserId(),
+	}
+
+	if req.StartDate != nil {
+		startDate, err := time.Parse("2006-01-02", *req.StartDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "format start_date invalide (attendu: YYYY-MM-DD)")
+		}
+		input.StartDate = &startDate
+	}
+
+	if req.EndDate != nil {
+		endDate, err := time.Parse("2006-01-02", *req.EndDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "format end_date invalide (attendu: YYYY-MM-DD)")
+		}
+		input.EndDate = &endDate
+	}
+
+	if req.Month != nil {
+		month, err := time.Parse("2006-01", *req.Month)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "format month invalide (attendu: YYYY-MM)")
+		}
+		input.Month = month
+	}
+
+	file, err := h.service.Export(ctx, input)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return &pb.ExportFile{
+		FileName:    file.FileName,
+		ContentType: file.ContentType,
+		Content:     file.Content,
+	}, nil
+}
+
+// Helper functions
+
+func protoExportFormatToDomain(f pb.ExportFormat) service.ExportFormat {
+	switch f {
+	case pb.ExportFormat_EXPORT_FORMAT_EXPENSES_CSV:
+		return service.ExportExpensesCSV
+	case pb.ExportFormat_EXPORT_FORMAT_PAYMENTS_CSV:
+		return service.ExportPaymentsCSV
+	case pb.ExportFormat_EXPORT_FORMAT_JSON:
+		return service.ExportJSON
+	case pb.ExportFormat_EXPORT_FORMAT_STATEMENT_PDF:
+		return service.ExportStatementPDF
+	default:
+		return ""
+	}
+}
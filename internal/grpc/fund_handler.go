@@ -28,7 +28,13 @@ func (h *FundHandler) CreateFund(ctx context.Context, req *pb.CreateFundRequest)
 		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et name obligatoires")
 	}
 
-	fund, err := h.service.Create(ctx, req.ColocationId, req.Name, req.Description, req.TargetAmount)
+	targetAmount := optionalMoneyFromProto(req.TargetAmount)
+	currency, err := currencyFromProto(req.TargetAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	fund, err := h.service.Create(ctx, req.ColocationId, req.Name, req.Description, targetAmount, currency)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
@@ -75,7 +81,13 @@ func (h *FundHandler) UpdateFund(ctx context.Context, req *pb.UpdateFundRequest)
 		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et id obligatoires")
 	}
 
-	fund, err := h.service.Update(ctx, req.ColocationId, req.Id, req.Name, req.Description, req.TargetAmount, req.IsActive)
+	targetAmount := optionalMoneyFromProto(req.TargetAmount)
+	currency, err := currencyFromProto(req.TargetAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	fund, err := h.service.Update(ctx, req.ColocationId, req.Id, req.Name, req.Description, targetAmount, currency, req.IsActive)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
@@ -98,11 +110,17 @@ func (h *FundHandler) DeleteFund(ctx context.Context, req *pb.DeleteFundRequest)
 
 // AddContribution adds a contribution to a fund
 func (h *FundHandler) AddContribution(ctx context.Context, req *pb.AddContributionRequest) (*pb.Contribution, error) {
-	if req.ColocationId == "" || req.FundId == "" || req.Amount <= 0 {
+	if req.ColocationId == "" || req.FundId == "" || req.Amount.GetUnits() <= 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "colocation_id, fund_id et amount obligatoires")
 	}
 
-	contribution, err := h.service.AddContribution(ctx, req.ColocationId, req.FundId, req.Amount, req.Note)
+	amount := moneyFromProto(req.Amount)
+	currency, err := currencyFromProto(req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	contribution, err := h.service.AddContribution(ctx, req.ColocationId, req.FundId, amount, currency, req.Note)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
@@ -135,11 +153,102 @@ func (h *FundHandler) DeleteContribution(ctx context.Context, req *pb.DeleteCont
 		return nil, status.Errorf(codes.InvalidArgument, "colocation_id, fund_id et id obligatoires")
 	}
 
-	if err := h.service.DeleteContribution(ctx, req.ColocationId, req.FundId, req.Id); err != nil {
+	restorableUntil, err := h.service.DeleteContribution(ctx, req.ColocationId, req.FundId, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return &pb.DeleteContributionResponse{
+		Success:         true,
+		RestorableUntil: utils.FormatFrenchDateTime(restorableUntil),
+	}, nil
+}
+
+// RestoreContribution restores a deleted contribution
+func (h *FundHandler) RestoreContribution(ctx context.Context, req *pb.RestoreContributionRequest) (*pb.Contribution, error) {
+	if req.ColocationId == "" || req.FundId == "" || req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id, fund_id et id obligatoires")
+	}
+
+	contribution, err := h.service.RestoreContribution(ctx, req.ColocationId, req.FundId, req.Id)
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
 
-	return &pb.DeleteContributionResponse{Success: true}, nil
+	return contributionToProto(contribution), nil
+}
+
+// WithdrawFromFund records money spent from a fund
+func (h *FundHandler) WithdrawFromFund(ctx context.Context, req *pb.WithdrawFromFundRequest) (*pb.Withdrawal, error) {
+	if req.ColocationId == "" || req.FundId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et fund_id obligatoires")
+	}
+	if req.Amount == nil && req.ExpenseId == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "amount ou expense_id obligatoire")
+	}
+
+	amount := optionalMoneyFromProto(req.Amount)
+	currency, err := currencyFromProto(req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawal, err := h.service.Withdraw(ctx, req.ColocationId, req.FundId, amount, currency, req.Note, req.ExpenseId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return withdrawalToProto(withdrawal), nil
+}
+
+// DeleteWithdrawal deletes a withdrawal
+func (h *FundHandler) DeleteWithdrawal(ctx context.Context, req *pb.DeleteWithdrawalRequest) (*pb.DeleteWithdrawalResponse, error) {
+	if req.ColocationId == "" || req.FundId == "" || req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id, fund_id et id obligatoires")
+	}
+
+	restorableUntil, err := h.service.DeleteWithdrawal(ctx, req.ColocationId, req.FundId, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return &pb.DeleteWithdrawalResponse{
+		Success:         true,
+		RestorableUntil: utils.FormatFrenchDateTime(restorableUntil),
+	}, nil
+}
+
+// RestoreWithdrawal restores a deleted withdrawal
+func (h *FundHandler) RestoreWithdrawal(ctx context.Context, req *pb.RestoreWithdrawalRequest) (*pb.Withdrawal, error) {
+	if req.ColocationId == "" || req.FundId == "" || req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id, fund_id et id obligatoires")
+	}
+
+	withdrawal, err := h.service.RestoreWithdrawal(ctx, req.ColocationId, req.FundId, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return withdrawalToProto(withdrawal), nil
+}
+
+// ListFundTransactions lists the ledger of a fund
+func (h *FundHandler) ListFundTransactions(ctx context.Context, req *pb.ListFundTransactionsRequest) (*pb.ListFundTransactionsResponse, error) {
+	if req.ColocationId == "" || req.FundId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et fund_id obligatoires")
+	}
+
+	transactions, currency, err := h.service.ListTransactions(ctx, req.ColocationId, req.FundId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	var pbTransactions []*pb.FundTransaction
+	for _, t := range transactions {
+		pbTransactions = append(pbTransactions, fundTransactionToProto(&t, currency))
+	}
+
+	return &pb.ListFundTransactionsResponse{Transactions: pbTransactions}, nil
 }
 
 // Helper functions
@@ -150,8 +259,8 @@ func fundToProto(f *domain.CommonFund) *pb.Fund {
 		ColocationId:       f.ColocationID,
 		Name:               f.Name,
 		Description:        f.Description,
-		TargetAmount:       f.TargetAmount,
-		CurrentAmount:      f.CurrentAmount,
+		TargetAmount:       optionalMoneyToProto(f.TargetAmount, f.Currency),
+		CurrentAmount:      moneyToProto(f.CurrentAmount, f.Currency),
 		IsActive:           f.IsActive,
 		CreatedBy:          f.CreatedBy,
 		CreatedByNom:       f.CreatedByNom,
@@ -165,7 +274,7 @@ func fundToProto(f *domain.CommonFund) *pb.Fund {
 			UserId:           c.UserID,
 			UserNom:          c.UserNom,
 			UserPrenom:       c.UserPrenom,
-			TotalContributed: c.TotalContributed,
+			TotalContributed: moneyToProto(c.TotalContributed, f.Currency),
 		})
 	}
 
@@ -179,8 +288,44 @@ func contributionToProto(c *domain.FundContribution) *pb.Contribution {
 		UserId:    c.UserID,
 		UserNom:   c.UserNom,
 		UserPrenom: c.UserPrenom,
-		Amount:    c.Amount,
+		Amount:    moneyToProto(c.Amount, c.Currency),
 		Note:      c.Note,
 		CreatedAt: utils.FormatFrenchDateTime(c.CreatedAt),
 	}
 }
+
+func withdrawalToProto(w *domain.FundWithdrawal) *pb.Withdrawal {
+	return &pb.Withdrawal{
+		Id:           w.ID,
+		FundId:       w.FundID,
+		UserId:       w.UserID,
+		UserNom:      w.UserNom,
+		UserPrenom:   w.UserPrenom,
+		Amount:       moneyToProto(w.Amount, w.Currency),
+		Note:         w.Note,
+		ExpenseId:    w.ExpenseID,
+		ExpenseTitle: w.ExpenseTitle,
+		CreatedAt:    utils.FormatFrenchDateTime(w.CreatedAt),
+	}
+}
+
+func fundTransactionToProto(t *domain.FundTransaction, currency string) *pb.FundTransaction {
+	transactionType := pb.FundTransactionType_FUND_TRANSACTION_TYPE_CONTRIBUTION
+	if t.Type == domain.FundTransactionWithdrawal {
+		transactionType = pb.FundTransactionType_FUND_TRANSACTION_TYPE_WITHDRAWAL
+	}
+
+	return &pb.FundTransaction{
+		Id:           t.ID,
+		Type:         transactionType,
+		UserId:       t.UserID,
+		UserNom:      t.UserNom,
+		UserPrenom:   t.UserPrenom,
+		Amount:       moneyToProto(t.Amount, currency),
+		Balance:      moneyToProto(t.Balance, currency),
+		Note:         t.Note,
+		ExpenseId:    t.ExpenseID,
+		ExpenseTitle: t.ExpenseTitle,
+		CreatedAt:    utils.FormatFrenchDateTime(t.CreatedAt),
+	}
+}
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"github.com/vblanchet22/back_coloc/internal/domain"
+	pb "github.com/vblanchet22/back_coloc/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// moneyToProto converts an amount in cents to its proto representation
+func moneyToProto(m domain.Money, currency string) *pb.Money {
+	return &pb.Money{Units: m.Cents(), Currency: currency}
+}
+
+// optionalMoneyToProto converts an optional amount, keeping nil as nil
+func optionalMoneyToProto(m *domain.Money, currency string) *pb.Money {
+	if m == nil {
+		return nil
+	}
+	return moneyToProto(*m, currency)
+}
+
+// moneyFromProto converts a proto amount to cents. A missing amount is zero;
+// the currency is read separately with currencyFromProto.
+func moneyFromProto(m *pb.Money) domain.Money {
+	if m == nil {
+		return 0
+	}
+	return domain.Money(m.Units)
+}
+
+// optionalMoneyFromProto converts an optional proto amount, keeping nil as nil
+func optionalMoneyFromProto(m *pb.Money) *domain.Money {
+	if m == nil {
+		return nil
+	}
+	amount := moneyFromProto(m)
+	return &amount
+}
+
+// currencyFromProto returns the normalized currency of a proto amount. An empty
+// string means the base currency of the colocation.
+func currencyFromProto(m *pb.Money) (string, error) {
+	if m == nil || m.Currency == "" {
+		return "", nil
+	}
+	currency, err := domain.NormalizeCurrency(m.Currency)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return currency, nil
+}
+
+// optionalRateFromProto parses an optional decimal exchange rate
+func optionalRateFromProto(s *string) (*domain.Rate, error) {
+	if s == nil || *s == "" {
+		return nil, nil
+	}
+	rate, err := domain.ParseRate(*s)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return &rate, nil
+}
@@ -168,6 +168,22 @@ func domainNotifTypeToProto(t domain.NotificationType) pb.NotificationType {
 		return pb.NotificationType_NOTIFICATION_TYPE_PAYMENT_CONFIRMED
 	case domain.NotifPaymentRejected:
 		return pb.NotificationType_NOTIFICATION_TYPE_PAYMENT_REJECTED
+	case domain.NotifPaymentDisputeResponse:
+		return pb.NotificationType_NOTIFICATION_TYPE_PAYMENT_DISPUTE_RESPONSE
+	case domain.NotifPaymentEscalated:
+		return pb.NotificationType_NOTIFICATION_TYPE_PAYMENT_ESCALATED
+	case domain.NotifPaymentResolved:
+		return pb.NotificationType_NOTIFICATION_TYPE_PAYMENT_RESOLVED
+	case domain.NotifPaymentReminder:
+		return pb.NotificationType_NOTIFICATION_TYPE_PAYMENT_REMINDER
+	case domain.NotifPaymentExpired:
+		return pb.NotificationType_NOTIFICATION_TYPE_PAYMENT_EXPIRED
+	case domain.NotifSettlementCreated:
+		return pb.NotificationType_NOTIFICATION_TYPE_SETTLEMENT_CREATED
+	case domain.NotifSettlementCompleted:
+		return pb.NotificationType_NOTIFICATION_TYPE_SETTLEMENT_COMPLETED
+	case domain.NotifSettlementInvalidated:
+		return pb.NotificationType_NOTIFICATION_TYPE_SETTLEMENT_INVALIDATED
 	case domain.NotifMemberJoined:
 		return pb.NotificationType_NOTIFICATION_TYPE_MEMBER_JOINED
 	case domain.NotifMemberLeft:
@@ -190,6 +206,8 @@ func domainNotifTypeToProto(t domain.NotificationType) pb.NotificationType {
 		return pb.NotificationType_NOTIFICATION_TYPE_FUND_CONTRIBUTION
 	case domain.NotifFundGoalReached:
 		return pb.NotificationType_NOTIFICATION_TYPE_FUND_GOAL_REACHED
+	case domain.NotifFundWithdrawal:
+		return pb.NotificationType_NOTIFICATION_TYPE_FUND_WITHDRAWAL
 	case domain.NotifEventCreated:
 		return pb.NotificationType_NOTIFICATION_TYPE_EVENT_CREATED
 	case domain.NotifEventUpdated:
@@ -200,6 +218,10 @@ func domainNotifTypeToProto(t domain.NotificationType) pb.NotificationType {
 		return pb.NotificationType_NOTIFICATION_TYPE_EVENT_CANCELLED
 	case domain.NotifRecurringDue:
 		return pb.NotificationType_NOTIFICATION_TYPE_RECURRING_DUE
+	case domain.NotifBudgetWarning:
+		return pb.NotificationType_NOTIFICATION_TYPE_BUDGET_WARNING
+	case domain.NotifBudgetExceeded:
+		return pb.NotificationType_NOTIFICATION_TYPE_BUDGET_EXCEEDED
 	default:
 		return pb.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
 	}
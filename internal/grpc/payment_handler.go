@@ -1,7 +1,10 @@
 package handler
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"strings"
 
 	"github.com/vblanchet22/back_coloc/internal/domain"
 	"github.com/vblanchet22/back_coloc/internal/service"
@@ -24,11 +27,30 @@ func NewPaymentHandler(service *service.PaymentService) *PaymentHandler {
 
 // CreatePayment creates a new payment
 func (h *PaymentHandler) CreatePayment(ctx context.Context, req *pb.CreatePaymentRequest) (*pb.Payment, error) {
-	if req.ColocationId == "" || req.ToUserId == "" || req.Amount <= 0 {
+	if req.ColocationId == "" || req.ToUserId == "" || req.Amount.GetUnits() <= 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "colocation_id, to_user_id et amount sont obligatoires")
 	}
 
-	payment, err := h.service.Create(ctx, req.ColocationId, req.ToUserId, req.Amount, req.Note)
+	amount := moneyFromProto(req.Amount)
+	currency, err := currencyFromProto(req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	exchangeRate, err := optionalRateFromProto(req.ExchangeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err := h.service.Create(ctx, service.CreatePaymentInput{
+		ColocationID: req.ColocationId,
+		ToUserID:     req.ToUserId,
+		Amount:       amount,
+		Currency:     currency,
+		ExchangeRate: exchangeRate,
+		Method:       protoPaymentMethodToDomain(req.Method),
+		Note:         req.Note,
+		AllocateTo:   req.ExpenseIds,
+	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
@@ -111,13 +133,17 @@ func (h *PaymentHandler) ConfirmPayment(ctx context.Context, req *pb.ConfirmPaym
 	return paymentToProto(payment), nil
 }
 
-// RejectPayment rejects a payment
+// RejectPayment disputes a pending payment
 func (h *PaymentHandler) RejectPayment(ctx context.Context, req *pb.RejectPaymentRequest) (*pb.Payment, error) {
 	if req.ColocationId == "" || req.Id == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et id obligatoires")
 	}
 
-	payment, err := h.service.Reject(ctx, req.ColocationId, req.Id)
+	if req.Reason == nil || strings.TrimSpace(*req.Reason) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reason obligatoire")
+	}
+
+	payment, err := h.service.Reject(ctx, req.ColocationId, req.Id, *req.Reason)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
@@ -125,37 +151,172 @@ func (h *PaymentHandler) RejectPayment(ctx context.Context, req *pb.RejectPaymen
 	return paymentToProto(payment), nil
 }
 
+// RespondToPaymentDispute answers the dispute of a payment
+func (h *PaymentHandler) RespondToPaymentDispute(ctx context.Context, req *pb.RespondToPaymentDisputeRequest) (*pb.Payment, error) {
+	if req.ColocationId == "" || req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et id obligatoires")
+	}
+
+	input := service.RespondToDisputeInput{
+		ColocationID: req.ColocationId,
+		PaymentID:    req.Id,
+	}
+	if req.Comment != nil {
+		input.Comment = *req.Comment
+	}
+	if len(req.Proof) > 0 {
+		if req.ProofFileName == nil || *req.ProofFileName == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "proof_file_name obligatoire avec une preuve")
+		}
+		input.ProofFileName = *req.ProofFileName
+		input.Proof = bytes.NewReader(req.Proof)
+	}
+
+	payment, err := h.service.RespondToDispute(ctx, input)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return paymentToProto(payment), nil
+}
+
+// EscalatePaymentDispute hands a disputed payment over to the admins
+func (h *PaymentHandler) EscalatePaymentDispute(ctx context.Context, req *pb.EscalatePaymentDisputeRequest) (*pb.Payment, error) {
+	if req.ColocationId == "" || req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et id obligatoires")
+	}
+
+	payment, err := h.service.Escalate(ctx, req.ColocationId, req.Id, req.Comment)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return paymentToProto(payment), nil
+}
+
+// ResolvePaymentDispute confirms or voids an escalated payment
+func (h *PaymentHandler) ResolvePaymentDispute(ctx context.Context, req *pb.ResolvePaymentDisputeRequest) (*pb.Payment, error) {
+	if req.ColocationId == "" || req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et id obligatoires")
+	}
+
+	var resolution service.PaymentResolution
+	switch req.Resolution {
+	case pb.PaymentResolution_PAYMENT_RESOLUTION_CONFIRMED:
+		resolution = service.PaymentResolutionConfirmed
+	case pb.PaymentResolution_PAYMENT_RESOLUTION_VOID:
+		resolution = service.PaymentResolutionVoid
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "resolution obligatoire")
+	}
+
+	payment, err := h.service.Resolve(ctx, req.ColocationId, req.Id, resolution, req.Comment)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return paymentToProto(payment), nil
+}
+
+// GetPaymentProof returns the proof attached to a dispute response
+func (h *PaymentHandler) GetPaymentProof(ctx context.Context, req *pb.GetPaymentProofRequest) (*pb.GetPaymentProofResponse, error) {
+	if req.ColocationId == "" || req.PaymentId == "" || req.EventId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id, payment_id et event_id obligatoires")
+	}
+
+	event, content, err := h.service.OpenProof(ctx, req.ColocationId, req.PaymentId, req.EventId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	defer content.Close()
+
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "erreur lors de la lecture du fichier: %v", err)
+	}
+
+	return &pb.GetPaymentProofResponse{
+		FileName:    *event.ProofFileName,
+		ContentType: *event.ProofContentType,
+		Content:     data,
+	}, nil
+}
+
 // CancelPayment cancels a pending payment
 func (h *PaymentHandler) CancelPayment(ctx context.Context, req *pb.CancelPaymentRequest) (*pb.CancelPaymentResponse, error) {
 	if req.ColocationId == "" || req.Id == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et id obligatoires")
 	}
 
-	if err := h.service.Cancel(ctx, req.ColocationId, req.Id); err != nil {
+	restorableUntil, err := h.service.Cancel(ctx, req.ColocationId, req.Id)
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
 
-	return &pb.CancelPaymentResponse{Success: true}, nil
+	return &pb.CancelPaymentResponse{
+		Success:         true,
+		RestorableUntil: utils.FormatFrenchDateTime(restorableUntil),
+	}, nil
+}
+
+// RestorePayment restores a cancelled payment
+func (h *PaymentHandler) RestorePayment(ctx context.Context, req *pb.RestorePaymentRequest) (*pb.Payment, error) {
+	if req.ColocationId == "" || req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et id obligatoires")
+	}
+
+	payment, err := h.service.Restore(ctx, req.ColocationId, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return paymentToProto(payment), nil
+}
+
+// GetPaymentInstructions returns the SEPA transfer QR code of a pending payment
+func (h *PaymentHandler) GetPaymentInstructions(ctx context.Context, req *pb.GetPaymentInstructionsRequest) (*pb.PaymentInstructions, error) {
+	if req.ColocationId == "" || req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et id obligatoires")
+	}
+
+	instructions, err := h.service.GetInstructions(ctx, req.ColocationId, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return &pb.PaymentInstructions{
+		Payment:         paymentToProto(instructions.Payment),
+		BeneficiaryName: instructions.BeneficiaryName,
+		Iban:            instructions.IBAN,
+		Bic:             instructions.BIC,
+		Remittance:      instructions.Remittance,
+		EpcPayload:      instructions.EPCPayload,
+		QrCode:          instructions.QRCode,
+	}, nil
 }
 
 // Helper functions
 
 func paymentToProto(p *domain.Payment) *pb.Payment {
 	payment := &pb.Payment{
-		Id:             p.ID,
-		ColocationId:   p.ColocationID,
-		FromUserId:     p.FromUserID,
-		FromUserNom:    p.FromUserNom,
-		FromUserPrenom: p.FromUserPrenom,
-		FromAvatarUrl:  p.FromAvatarURL,
-		ToUserId:       p.ToUserID,
-		ToUserNom:      p.ToUserNom,
-		ToUserPrenom:   p.ToUserPrenom,
-		ToAvatarUrl:    p.ToAvatarURL,
-		Amount:         p.Amount,
-		Status:         domainPaymentStatusToProto(p.Status),
-		Note:           p.Note,
-		CreatedAt:      utils.FormatFrenchDateTime(p.CreatedAt),
+		Id:               p.ID,
+		ColocationId:     p.ColocationID,
+		FromUserId:       p.FromUserID,
+		FromUserNom:      p.FromUserNom,
+		FromUserPrenom:   p.FromUserPrenom,
+		FromAvatarUrl:    p.FromAvatarURL,
+		ToUserId:         p.ToUserID,
+		ToUserNom:        p.ToUserNom,
+		ToUserPrenom:     p.ToUserPrenom,
+		ToAvatarUrl:      p.ToAvatarURL,
+		Amount:           moneyToProto(p.Amount, p.Currency),
+		BaseAmount:       moneyToProto(p.BaseAmount, p.BaseCurrency),
+		ExchangeRate:     p.ExchangeRate.String(),
+		Status:           domainPaymentStatusToProto(p.Status),
+		Method:           domainPaymentMethodToProto(p.Method),
+		Note:             p.Note,
+		SettlementPlanId: p.SettlementPlanID,
+		CreatedAt:        utils.FormatFrenchDateTime(p.CreatedAt),
 	}
 
 	if p.ConfirmedAt != nil {
@@ -163,17 +324,77 @@ func paymentToProto(p *domain.Payment) *pb.Payment {
 		payment.ConfirmedAt = &confirmedAt
 	}
 
+	payment.ExpenseIds = p.AllocateTo
+	for _, a := range p.Allocations {
+		payment.Allocations = append(payment.Allocations, &pb.PaymentAllocation{
+			ExpenseId:    a.ExpenseID,
+			ExpenseTitle: a.ExpenseTitle,
+			ExpenseDate:  a.ExpenseDate.Format("2006-01-02"),
+			Amount:       moneyToProto(a.Amount, p.BaseCurrency),
+		})
+	}
+
+	for i := range p.Events {
+		payment.Events = append(payment.Events, paymentEventToProto(&p.Events[i]))
+	}
+
 	return payment
 }
 
+func paymentEventToProto(e *domain.PaymentEvent) *pb.PaymentEvent {
+	return &pb.PaymentEvent{
+		Id:               e.ID,
+		Type:             domainPaymentEventTypeToProto(e.Type),
+		ActorId:          e.ActorID,
+		ActorNom:         e.ActorNom,
+		ActorPrenom:      e.ActorPrenom,
+		Comment:          e.Comment,
+		ProofFileName:    e.ProofFileName,
+		ProofContentType: e.ProofContentType,
+		ProofSizeBytes:   e.ProofSizeBytes,
+		CreatedAt:        utils.FormatFrenchDateTime(e.CreatedAt),
+	}
+}
+
+func domainPaymentEventTypeToProto(t domain.PaymentEventType) pb.PaymentEventType {
+	switch t {
+	case domain.PaymentEventCreated:
+		return pb.PaymentEventType_PAYMENT_EVENT_TYPE_CREATED
+	case domain.PaymentEventConfirmed:
+		return pb.PaymentEventType_PAYMENT_EVENT_TYPE_CONFIRMED
+	case domain.PaymentEventDisputed:
+		return pb.PaymentEventType_PAYMENT_EVENT_TYPE_DISPUTED
+	case domain.PaymentEventResponded:
+		return pb.PaymentEventType_PAYMENT_EVENT_TYPE_RESPONDED
+	case domain.PaymentEventEscalated:
+		return pb.PaymentEventType_PAYMENT_EVENT_TYPE_ESCALATED
+	case domain.PaymentEventVoided:
+		return pb.PaymentEventType_PAYMENT_EVENT_TYPE_VOIDED
+	case domain.PaymentEventCancelled:
+		return pb.PaymentEventType_PAYMENT_EVENT_TYPE_CANCELLED
+	case domain.PaymentEventRestored:
+		return pb.PaymentEventType_PAYMENT_EVENT_TYPE_RESTORED
+	case domain.PaymentEventExpired:
+		return pb.PaymentEventType_PAYMENT_EVENT_TYPE_EXPIRED
+	default:
+		return pb.PaymentEventType_PAYMENT_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
 func domainPaymentStatusToProto(s domain.PaymentStatus) pb.PaymentStatus {
 	switch s {
 	case domain.PaymentStatusPending:
 		return pb.PaymentStatus_PAYMENT_STATUS_PENDING
 	case domain.PaymentStatusConfirmed:
 		return pb.PaymentStatus_PAYMENT_STATUS_CONFIRMED
-	case domain.PaymentStatusRejected:
-		return pb.PaymentStatus_PAYMENT_STATUS_REJECTED
+	case domain.PaymentStatusDisputed:
+		return pb.PaymentStatus_PAYMENT_STATUS_DISPUTED
+	case domain.PaymentStatusEscalated:
+		return pb.PaymentStatus_PAYMENT_STATUS_ESCALATED
+	case domain.PaymentStatusVoid:
+		return pb.PaymentStatus_PAYMENT_STATUS_VOID
+	case domain.PaymentStatusExpired:
+		return pb.PaymentStatus_PAYMENT_STATUS_EXPIRED
 	default:
 		return pb.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
 	}
@@ -185,9 +406,54 @@ func protoPaymentStatusToDomain(s pb.PaymentStatus) domain.PaymentStatus {
 		return domain.PaymentStatusPending
 	case pb.PaymentStatus_PAYMENT_STATUS_CONFIRMED:
 		return domain.PaymentStatusConfirmed
-	case pb.PaymentStatus_PAYMENT_STATUS_REJECTED:
-		return domain.PaymentStatusRejected
+	case pb.PaymentStatus_PAYMENT_STATUS_REJECTED, pb.PaymentStatus_PAYMENT_STATUS_DISPUTED:
+		// Rejected payments became disputed
+		return domain.PaymentStatusDisputed
+	case pb.PaymentStatus_PAYMENT_STATUS_ESCALATED:
+		return domain.PaymentStatusEscalated
+	case pb.PaymentStatus_PAYMENT_STATUS_VOID:
+		return domain.PaymentStatusVoid
+	case pb.PaymentStatus_PAYMENT_STATUS_EXPIRED:
+		return domain.PaymentStatusExpired
 	default:
 		return domain.PaymentStatusPending
 	}
 }
+
+func domainPaymentMethodToProto(m domain.PaymentMethod) pb.PaymentMethod {
+	switch m {
+	case domain.PaymentMethodBankTransfer:
+		return pb.PaymentMethod_PAYMENT_METHOD_BANK_TRANSFER
+	case domain.PaymentMethodCash:
+		return pb.PaymentMethod_PAYMENT_METHOD_CASH
+	case domain.PaymentMethodPayPal:
+		return pb.PaymentMethod_PAYMENT_METHOD_PAYPAL
+	case domain.PaymentMethodLydia:
+		return pb.PaymentMethod_PAYMENT_METHOD_LYDIA
+	case domain.PaymentMethodWero:
+		return pb.PaymentMethod_PAYMENT_METHOD_WERO
+	case domain.PaymentMethodOther:
+		return pb.PaymentMethod_PAYMENT_METHOD_OTHER
+	default:
+		return pb.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+	}
+}
+
+func protoPaymentMethodToDomain(m pb.PaymentMethod) domain.PaymentMethod {
+	switch m {
+	case pb.PaymentMethod_PAYMENT_METHOD_BANK_TRANSFER:
+		return domain.PaymentMethodBankTransfer
+	case pb.PaymentMethod_PAYMENT_METHOD_CASH:
+		return domain.PaymentMethodCash
+	case pb.PaymentMethod_PAYMENT_METHOD_PAYPAL:
+		return domain.PaymentMethodPayPal
+	case pb.PaymentMethod_PAYMENT_METHOD_LYDIA:
+		return domain.PaymentMethodLydia
+	case pb.PaymentMethod_PAYMENT_METHOD_WERO:
+		return domain.PaymentMethodWero
+	case pb.PaymentMethod_PAYMENT_METHOD_OTHER:
+		return domain.PaymentMethodOther
+	default:
+		return ""
+	}
+}
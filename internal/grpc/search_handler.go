@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/vblanchet22/back_coloc/internal/domain"
+	"github.com/vblanchet22/back_coloc/internal/service"
+	pb "github.com/vblanchet22/back_coloc/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SearchHandler implements the SearchService gRPC server
+type SearchHandler struct {
+	pb.UnimplementedSearchServiceServer
+	service *service.SearchService
+}
+
+// NewSearchHandler creates a new SearchHandler
+func NewSearchHandler(service *service.SearchService) *SearchHandler {
+	return &SearchHandler{service: service}
+}
+
+// SearchColocation searches the expenses, decisions, funds and events of a colocation
+func (h *SearchHandler) SearchColocation(ctx context.Context, req *pb.SearchColocationRequest) (*pb.SearchColocationResponse, error) {
+	if req.ColocationId == "" || req.Query == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et query obligatoires")
+	}
+
+	var types []domain.SearchEntityType
+	for _, t := range req.Types {
+		entityType, ok := protoSearchEntityTypeToDomain(t)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "type de recherche invalide")
+		}
+		types = append(types, entityType)
+	}
+
+	var filters domain.SearchFilters
+	filters.MinAmount = optionalMoneyFromProto(req.MinAmount)
+	filters.MaxAmount = optionalMoneyFromProto(req.MaxAmount)
+	if req.StartDate != nil && *req.StartDate != "" {
+		t, err := time.Parse("2006-01-02", *req.StartDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "format start_date invalide")
+		}
+		filters.StartDate = &t
+	}
+	if req.EndDate != nil && *req.EndDate != "" {
+		t, err := time.Parse("2006-01-02", *req.EndDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "format end_date invalide")
+		}
+		// Events are timestamped, the whole end day is included
+		t = t.Add(24*time.Hour - time.Microsecond)
+		filters.EndDate = &t
+	}
+
+	cursor := ""
+	if req.Cursor != nil {
+		cursor = *req.Cursor
+	}
+
+	groups, err := h.service.Search(ctx, service.SearchInput{
+		ColocationID: req.ColocationId,
+		Query:        req.Query,
+		Types:        types,
+		Filters:      filters,
+		PageSize:     int(req.PageSize),
+		Cursor:       cursor,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	resp := &pb.SearchColocationResponse{}
+	for _, g := range groups {
+		group := &pb.SearchGroup{
+			Type:       domainSearchEntityTypeToProto(g.EntityType),
+			NextCursor: g.NextCursor,
+		}
+		for _, hit := range g.Hits {
+			group.Hits = append(group.Hits, &pb.SearchHit{
+				Id:      hit.ID,
+				Title:   hit.Title,
+				Snippet: hit.Snippet,
+				Amount:  optionalMoneyToProto(hit.Amount, hit.Currency),
+				Date:    hit.Date.Format("2006-01-02"),
+				Rank:    hit.Rank,
+			})
+		}
+		resp.Groups = append(resp.Groups, group)
+	}
+
+	return resp, nil
+}
+
+// Helper functions
+
+func protoSearchEntityTypeToDomain(t pb.SearchEntityType) (domain.SearchEntityType, bool) {
+	switch t {
+	case pb.SearchEntityType_SEARCH_ENTITY_TYPE_EXPENSE:
+		return domain.SearchEntityExpense, true
+	case pb.SearchEntityType_SEARCH_ENTITY_TYPE_DECISION:
+		return domain.SearchEntityDecision, true
+	case pb.SearchEntityType_SEARCH_ENTITY_TYPE_FUND:
+		return domain.SearchEntityFund, true
+	case pb.SearchEntityType_SEARCH_ENTITY_TYPE_EVENT:
+		return domain.SearchEntityEvent, true
+	default:
+		return "", false
+	}
+}
+
+func domainSearchEntityTypeToProto(t domain.SearchEntityType) pb.SearchEntityType {
+	switch t {
+	case domain.SearchEntityExpense:
+		return pb.SearchEntityType_SEARCH_ENTITY_TYPE_EXPENSE
+	case domain.SearchEntityDecision:
+		return pb.SearchEntityType_SEARCH_ENTITY_TYPE_DECISION
+	case domain.SearchEntityFund:
+		return pb.SearchEntityType_SEARCH_ENTITY_TYPE_FUND
+	case domain.SearchEntityEvent:
+		return pb.SearchEntityType_SEARCH_ENTITY_TYPE_EVENT
+	default:
+		return pb.SearchEntityType_SEARCH_ENTITY_TYPE_UNSPECIFIED
+	}
+}
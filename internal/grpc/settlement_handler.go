@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/vblanchet22/back_coloc/internal/domain"
+	"github.com/vblanchet22/back_coloc/internal/service"
+	"github.com/vblanchet22/back_coloc/internal/utils"
+	pb "github.com/vblanchet22/back_coloc/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SettlementHandler implements the SettlementService gRPC server
+type SettlementHandler struct {
+	pb.UnimplementedSettlementServiceServer
+	service *service.SettlementService
+}
+
+// NewSettlementHandler creates a new SettlementHandler
+func NewSettlementHandler(service *service.SettlementService) *SettlementHandler {
+	return &SettlementHandler{service: service}
+}
+
+// SettleUp creates a settlement plan from the current simplified debts
+func (h *SettlementHandler) SettleUp(ctx context.Context, req *pb.SettleUpRequest) (*pb.SettlementPlan, error) {
+	if req.ColocationId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id obligatoire")
+	}
+
+	plan, err := h.service.SettleUp(ctx, req.ColocationId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return settlementPlanToProto(plan), nil
+}
+
+// GetSettlementPlan retrieves a settlement plan by ID
+func (h *SettlementHandler) GetSettlementPlan(ctx context.Context, req *pb.GetSettlementPlanRequest) (*pb.SettlementPlan, error) {
+	if req.ColocationId == "" || req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et id obligatoires")
+	}
+
+	plan, err := h.service.GetPlan(ctx, req.ColocationId, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+
+	return settlementPlanToProto(plan), nil
+}
+
+// Helper functions
+
+func settlementPlanToProto(p *domain.SettlementPlan) *pb.SettlementPlan {
+	plan := &pb.SettlementPlan{
+		Id:              p.ID,
+		ColocationId:    p.ColocationID,
+		CreatedBy:       p.CreatedBy,
+		CreatedByNom:    p.CreatedByNom,
+		CreatedByPrenom: p.CreatedByPrenom,
+		Status:          domainSettlementStatusToProto(p.Status),
+		TotalAmount:     moneyToProto(p.TotalAmount, p.Currency),
+		ConfirmedAmount: moneyToProto(p.ConfirmedAmount(), p.Currency),
+		PaymentCount:    int32(len(p.Payments)),
+		ConfirmedCount:  int32(p.ConfirmedCount()),
+		CreatedAt:       utils.FormatFrenchDateTime(p.CreatedAt),
+	}
+
+	for _, payment := range p.Payments {
+		plan.Payments = append(plan.Payments, paymentToProto(&payment))
+	}
+
+	if p.CompletedAt != nil {
+		completedAt := utils.FormatFrenchDateTime(*p.CompletedAt)
+		plan.CompletedAt = &completedAt
+	}
+
+	if p.InvalidatedAt != nil {
+		invalidatedAt := utils.FormatFrenchDateTime(*p.InvalidatedAt)
+		plan.InvalidatedAt = &invalidatedAt
+	}
+
+	return plan
+}
+
+func domainSettlementStatusToProto(s domain.SettlementStatus) pb.SettlementStatus {
+	switch s {
+	case domain.SettlementStatusActive:
+		return pb.SettlementStatus_SETTLEMENT_STATUS_ACTIVE
+	case domain.SettlementStatusCompleted:
+		return pb.SettlementStatus_SETTLEMENT_STATUS_COMPLETED
+	case domain.SettlementStatusInvalidated:
+		return pb.SettlementStatus_SETTLEMENT_STATUS_INVALIDATED
+	default:
+		return pb.SettlementStatus_SETTLEMENT_STATUS_UNSPECIFIED
+	}
+}
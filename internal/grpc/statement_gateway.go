@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/vblanchet22/back_coloc/internal/constants"
+	pb "github.com/vblanchet22/back_coloc/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const uploadStatementPattern = "/api/colocations/{colocation_id}/statement-imports/upload"
+
+// RegisterStatementRoutes registers the multipart upload route of
+// ImportStatement, so that a statement file can be sent as is
+func RegisterStatementRoutes(mux *runtime.ServeMux, client pb.StatementServiceClient) error {
+	return mux.HandlePath(http.MethodPost, uploadStatementPattern, uploadStatementHandler(mux, client))
+}
+
+// uploadStatementHandler reads a multipart form with a "file" field, an
+// optional "format" field (csv, ofx, camt053) and an optional "csv_mapping"
+// field holding a CsvMapping as JSON, and calls ImportStatement
+func uploadStatementHandler(mux *runtime.ServeMux, client pb.StatementServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		_, marshaler := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, "/coloc.StatementService/ImportStatement", runtime.WithHTTPPathPattern(uploadStatementPattern))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+			return
+		}
+
+		reader, err := r.MultipartReader()
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, status.Errorf(codes.InvalidArgument, "formulaire multipart attendu"))
+			return
+		}
+
+		req := &pb.ImportStatementRequest{ColocationId: params["colocation_id"]}
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				runtime.HTTPError(ctx, mux, marshaler, w, r, status.Errorf(codes.InvalidArgument, "formulaire multipart invalide: %v", err))
+				return
+			}
+
+			// One byte more than allowed to detect oversized files
+			value, err := io.ReadAll(io.LimitReader(part, constants.MaxStatementSize+1))
+			part.Close()
+			if err != nil {
+				runtime.HTTPError(ctx, mux, marshaler, w, r, status.Errorf(codes.InvalidArgument, "erreur lors de la lecture du formulaire: %v", err))
+				return
+			}
+
+			switch part.FormName() {
+			case "file":
+				if len(value) > constants.MaxStatementSize {
+					runtime.HTTPError(ctx, mux, marshaler, w, r, status.Errorf(codes.InvalidArgument, "releve trop volumineux (maximum %d Mo)", constants.MaxStatementSize>>20))
+					return
+				}
+				req.Content = value
+			case "format":
+				format, ok := pb.StatementFormat_value["STATEMENT_FORMAT_"+strings.ToUpper(strings.TrimSpace(string(value)))]
+				if !ok {
+					runtime.HTTPError(ctx, mux, marshaler, w, r, status.Errorf(codes.InvalidArgument, "format de releve non supporte: %s", value))
+					return
+				}
+				req.Format = pb.StatementFormat(format)
+			case "csv_mapping":
+				mapping := &pb.CsvMapping{}
+				if err := protojson.Unmarshal(value, mapping); err != nil {
+					runtime.HTTPError(ctx, mux, marshaler, w, r, status.Errorf(codes.InvalidArgument, "csv_mapping invalide: %v", err))
+					return
+				}
+				req.CsvMapping = mapping
+			}
+		}
+
+		if len(req.Content) == 0 {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, status.Errorf(codes.InvalidArgument, "champ \"file\" manquant"))
+			return
+		}
+
+		resp, err := client.ImportStatement(ctx, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+			return
+		}
+
+		runtime.ForwardResponseMessage(ctx, mux, marshaler, w, r, resp)
+	}
+}
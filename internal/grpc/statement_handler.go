@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/vblanchet22/back_coloc/internal/domain"
+	"github.com/vblanchet22/back_coloc/internal/service"
+	"github.com/vblanchet22/back_coloc/internal/statement"
+	pb "github.com/vblanchet22/back_coloc/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StatementHandler implements the StatementService gRPC server
+type StatementHandler struct {
+	pb.UnimplementedStatementServiceServer
+	service *service.StatementService
+}
+
+// NewStatementHandler creates a new StatementHandler
+func NewStatementHandler(service *service.StatementService) *StatementHandler {
+	return &StatementHandler{service: service}
+}
+
+// ImportStatement parses a bank statement into expense drafts
+func (h *StatementHandler) ImportStatement(ctx context.Context, req *pb.ImportStatementRequest) (*pb.ImportStatementResponse, error) {
+	if req.ColocationId == "" || len(req.Content) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et content obligatoires")
+	}
+
+	drafts, ignoredCredits, err := h.service.Import(ctx, service.ImportStatementInput{
+		ColocationID: req.ColocationId,
+		Content:      req.Content,
+		Format:       protoStatementFormatToDomain(req.Format),
+		CSVMapping:   csvMappingFromProto(req.CsvMapping),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	resp := &pb.ImportStatementResponse{IgnoredCredits: int32(ignoredCredits)}
+	for i := range drafts {
+		resp.Drafts = append(resp.Drafts, expenseDraftToProto(&drafts[i]))
+	}
+
+	return resp, nil
+}
+
+// ConfirmStatementImport creates the selected drafts as expenses
+func (h *StatementHandler) ConfirmStatementImport(ctx context.Context, req *pb.ConfirmStatementImportRequest) (*pb.ConfirmStatementImportResponse, error) {
+	if req.ColocationId == "" || len(req.Drafts) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et drafts obligatoires")
+	}
+
+	drafts := make([]service.ConfirmDraftInput, 0, len(req.Drafts))
+	for _, d := range req.Drafts {
+		if d.Reference == "" || d.Title == "" || d.Amount.GetUnits() <= 0 || d.CategoryId == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "reference, title, amount et category_id sont obligatoires")
+		}
+
+		amount := moneyFromProto(d.Amount)
+		currency, err := currencyFromProto(d.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		expenseDate, err := time.Parse("2006-01-02", d.ExpenseDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "format de date invalide (attendu: YYYY-MM-DD)")
+		}
+
+		exchangeRate, err := optionalRateFromProto(d.ExchangeRate)
+		if err != nil {
+			return nil, err
+		}
+
+		splits := splitInputsFromProto(d.Splits)
+
+		drafts = append(drafts, service.ConfirmDraftInput{
+			Reference:    d.Reference,
+			Title:        d.Title,
+			Description:  d.Description,
+			Amount:       amount,
+			Currency:     currency,
+			ExchangeRate: exchangeRate,
+			CategoryID:   d.CategoryId,
+			SplitType:    protoSplitTypeToDomain(d.SplitType),
+			Splits:       splits,
+			ExpenseDate:  expenseDate,
+		})
+	}
+
+	expenses, err := h.service.Confirm(ctx, req.ColocationId, drafts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	resp := &pb.ConfirmStatementImportResponse{}
+	for i := range expenses {
+		resp.Expenses = append(resp.Expenses, expenseToProto(&expenses[i]))
+	}
+
+	return resp, nil
+}
+
+// Helper functions
+
+func expenseDraftToProto(d *domain.ExpenseDraft) *pb.ExpenseDraft {
+	return &pb.ExpenseDraft{
+		Reference:             d.Reference,
+		Title:                 d.Title,
+		Label:                 d.Label,
+		Amount:                moneyToProto(d.Amount, d.Currency),
+		ExpenseDate:           d.ExpenseDate.Format("2006-01-02"),
+		SuggestedCategoryId:   d.SuggestedCategoryID,
+		SuggestedCategoryName: d.SuggestedCategoryName,
+		AlreadyImported:       d.AlreadyImported,
+		DuplicateExpenseId:    d.DuplicateExpenseID,
+	}
+}
+
+func csvMappingFromProto(m *pb.CsvMapping) *statement.CSVMapping {
+	if m == nil {
+		return nil
+	}
+	return &statement.CSVMapping{
+		Delimiter:         m.GetDelimiter(),
+		NoHeader:          m.NoHeader,
+		SkipRows:          int(m.SkipRows),
+		DateColumn:        m.GetDateColumn(),
+		DescriptionColumn: m.GetDescriptionColumn(),
+		AmountColumn:      m.GetAmountColumn(),
+		DebitColumn:       m.GetDebitColumn(),
+		CreditColumn:      m.GetCreditColumn(),
+		CurrencyColumn:    m.GetCurrencyColumn(),
+		ReferenceColumn:   m.GetReferenceColumn(),
+		DateFormat:        m.GetDateFormat(),
+		DecimalSeparator:  m.GetDecimalSeparator(),
+	}
+}
+
+func protoStatementFormatToDomain(f pb.StatementFormat) statement.Format {
+	switch f {
+	case pb.StatementFormat_STATEMENT_FORMAT_CSV:
+		return statement.FormatCSV
+	case pb.StatementFormat_STATEMENT_FORMAT_OFX:
+		return statement.FormatOFX
+	case pb.StatementFormat_STATEMENT_FORMAT_CAMT053:
+		return statement.FormatCAMT053
+	default:
+		return ""
+	}
+}
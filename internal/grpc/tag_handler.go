@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/vblanchet22/back_coloc/internal/domain"
+	"github.com/vblanchet22/back_coloc/internal/service"
+	pb "github.com/vblanchet22/back_coloc/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TagHandler implements the TagService gRPC server
+type TagHandler struct {
+	pb.UnimplementedTagServiceServer
+	service *service.TagService
+}
+
+// NewTagHandler creates a new TagHandler
+func NewTagHandler(service *service.TagService) *TagHandler {
+	return &TagHandler{service: service}
+}
+
+// ListTags lists the tags of a colocation
+func (h *TagHandler) ListTags(ctx context.Context, req *pb.ListTagsRequest) (*pb.ListTagsResponse, error) {
+	if req.ColocationId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id obligatoire")
+	}
+
+	tags, err := h.service.List(ctx, req.ColocationId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	var pbTags []*pb.Tag
+	for _, t := range tags {
+		pbTags = append(pbTags, tagToProto(&t))
+	}
+
+	return &pb.ListTagsResponse{Tags: pbTags}, nil
+}
+
+// CreateTag creates a new tag
+func (h *TagHandler) CreateTag(ctx context.Context, req *pb.CreateTagRequest) (*pb.Tag, error) {
+	if req.ColocationId == "" || req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et name obligatoires")
+	}
+
+	tag, err := h.service.Create(ctx, req.ColocationId, req.Name, req.Color)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return tagToProto(tag), nil
+}
+
+// UpdateTag renames a tag or changes its color
+func (h *TagHandler) UpdateTag(ctx context.Context, req *pb.UpdateTagRequest) (*pb.Tag, error) {
+	if req.ColocationId == "" || req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et id obligatoires")
+	}
+
+	tag, err := h.service.Update(ctx, req.ColocationId, req.Id, req.Name, req.Color)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return tagToProto(tag), nil
+}
+
+// MergeTags merges tags into a target tag
+func (h *TagHandler) MergeTags(ctx context.Context, req *pb.MergeTagsRequest) (*pb.Tag, error) {
+	if req.ColocationId == "" || req.TargetId == "" || len(req.SourceIds) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id, target_id et source_ids obligatoires")
+	}
+
+	tag, err := h.service.Merge(ctx, req.ColocationId, req.TargetId, req.SourceIds)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return tagToProto(tag), nil
+}
+
+// DeleteTag deletes a tag
+func (h *TagHandler) DeleteTag(ctx context.Context, req *pb.DeleteTagRequest) (*pb.DeleteTagResponse, error) {
+	if req.ColocationId == "" || req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id et id obligatoires")
+	}
+
+	if err := h.service.Delete(ctx, req.ColocationId, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return &pb.DeleteTagResponse{Success: true}, nil
+}
+
+// GetTagStats returns tag statistics
+func (h *TagHandler) GetTagStats(ctx context.Context, req *pb.GetTagStatsRequest) (*pb.GetTagStatsResponse, error) {
+	if req.ColocationId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "colocation_id obligatoire")
+	}
+
+	var startDate, endDate *time.Time
+	if req.StartDate != nil && *req.StartDate != "" {
+		t, err := time.Parse("2006-01-02", *req.StartDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "format de date invalide pour start_date")
+		}
+		startDate = &t
+	}
+	if req.EndDate != nil && *req.EndDate != "" {
+		t, err := time.Parse("2006-01-02", *req.EndDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "format de date invalide pour end_date")
+		}
+		endDate = &t
+	}
+
+	stats, totalAmount, currency, err := h.service.GetStats(ctx, req.ColocationId, startDate, endDate)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	var pbStats []*pb.TagStat
+	for _, s := range stats {
+		pbStats = append(pbStats, tagStatToProto(&s, currency))
+	}
+
+	return &pb.GetTagStatsResponse{
+		Stats:       pbStats,
+		TotalAmount: moneyToProto(totalAmount, currency),
+	}, nil
+}
+
+// Helper functions
+
+func tagToProto(t *domain.Tag) *pb.Tag {
+	return &pb.Tag{
+		Id:           t.ID,
+		Name:         t.Name,
+		Color:        t.Color,
+		ExpenseCount: int32(t.ExpenseCount),
+	}
+}
+
+func tagStatToProto(s *domain.TagStat, currency string) *pb.TagStat {
+	return &pb.TagStat{
+		TagId:        s.TagID,
+		TagName:      s.TagName,
+		Color:        s.Color,
+		TotalAmount:  moneyToProto(s.TotalAmount, currency),
+		ExpenseCount: int32(s.ExpenseCount),
+		Percentage:   s.Percentage,
+	}
+}
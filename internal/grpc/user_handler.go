@@ -46,6 +46,12 @@ func (h *UserHandler) UpdateCurrentUser(ctx context.Context, req *pb.UpdateUserR
 	if req.AvatarUrl != nil {
 		input.AvatarURL = req.AvatarUrl
 	}
+	if req.Iban != nil {
+		input.IBAN = req.Iban
+	}
+	if req.Bic != nil {
+		input.BIC = req.Bic
+	}
 	if req.CurrentPassword != nil {
 		input.CurrentPassword = req.CurrentPassword
 	}
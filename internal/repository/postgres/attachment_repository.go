@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vblanchet22/back_coloc/internal/domain"
+)
+
+// AttachmentRepository handles expense attachment database operations
+type AttachmentRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAttachmentRepository creates a new AttachmentRepository
+func NewAttachmentRepository(pool *pgxpool.Pool) *AttachmentRepository {
+	return &AttachmentRepository{pool: pool}
+}
+
+// Create creates a new attachment record
+func (r *AttachmentRepository) Create(ctx context.Context, attachment *domain.ExpenseAttachment) error {
+	query := `
+		INSERT INTO expense_attachments (expense_id, uploaded_by, file_name, content_type, size_bytes, storage_key, thumbnail_key)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at
+	`
+
+	return r.pool.QueryRow(ctx, query,
+		attachment.ExpenseID,
+		attachment.UploadedBy,
+		attachment.FileName,
+		attachment.ContentType,
+		attachment.SizeBytes,
+		attachment.StorageKey,
+		attachment.ThumbnailKey,
+	).Scan(&attachment.ID, &attachment.CreatedAt)
+}
+
+const attachmentSelect = `
+	SELECT a.id, a.expense_id, a.uploaded_by, a.file_name, a.content_type, a.size_bytes,
+	       a.storage_key, a.thumbnail_key, a.created_at,
+	       u.nom, u.prenom
+	FROM expense_attachments a
+	INNER JOIN users u ON a.uploaded_by = u.id
+`
+
+func scanAttachment(row pgx.Row) (*domain.ExpenseAttachment, error) {
+	var a domain.ExpenseAttachment
+	err := row.Scan(
+		&a.ID, &a.ExpenseID, &a.UploadedBy, &a.FileName, &a.ContentType, &a.SizeBytes,
+		&a.StorageKey, &a.ThumbnailKey, &a.CreatedAt,
+		&a.UploadedByNom, &a.UploadedByPrenom,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// attachmentFilesSelect selects the stored files of the attachments of
+// expenses about to be hard-deleted, the rows going away by CASCADE
+const attachmentFilesSelect = `
+	SELECT a.id, a.expense_id, a.storage_key, a.thumbnail_key
+	FROM expense_attachments a
+	INNER JOIN expenses e ON a.expense_id = e.id
+`
+
+// listAttachmentFiles returns the attachments matching a condition on the
+// expense, within the transaction that deletes them
+func listAttachmentFiles(ctx context.Context, tx pgx.Tx, where string, args ...interface{}) ([]domain.ExpenseAttachment, error) {
+	rows, err := tx.Query(ctx, attachmentFilesSelect+" WHERE "+where, args...)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des pieces jointes: %w", err)
+	}
+	defer rows.Close()
+
+	var attachments []domain.ExpenseAttachment
+	for rows.Next() {
+		var a domain.ExpenseAttachment
+		if err := rows.Scan(&a.ID, &a.ExpenseID, &a.StorageKey, &a.ThumbnailKey); err != nil {
+			return nil, fmt.Errorf("erreur lors du scan de la piece jointe: %w", err)
+		}
+		attachments = append(attachments, a)
+	}
+
+	return attachments, rows.Err()
+}
+
+// GetByID retrieves an attachment by ID
+func (r *AttachmentRepository) GetByID(ctx context.Context, id string) (*domain.ExpenseAttachment, error) {
+	a, err := scanAttachment(r.pool.QueryRow(ctx, attachmentSelect+" WHERE a.id = $1", id))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation de la piece jointe: %w", err)
+	}
+
+	return a, nil
+}
+
+// ListByExpense lists the attachments of an expense
+func (r *AttachmentRepository) ListByExpense(ctx context.Context, expenseID string) ([]domain.ExpenseAttachment, error) {
+	rows, err := r.pool.Query(ctx, attachmentSelect+" WHERE a.expense_id = $1 ORDER BY a.created_at ASC", expenseID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des pieces jointes: %w", err)
+	}
+	defer rows.Close()
+
+	var attachments []domain.ExpenseAttachment
+	for rows.Next() {
+		a, err := scanAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("erreur lors du scan de la piece jointe: %w", err)
+		}
+		attachments = append(attachments, *a)
+	}
+
+	return attachments, rows.Err()
+}
+
+// CountByExpense returns the number of attachments of an expense
+func (r *AttachmentRepository) CountByExpense(ctx context.Context, expenseID string) (int, error) {
+	var count int
+	err := r.pool.QueryRow(ctx, "SELECT COUNT(*) FROM expense_attachments WHERE expense_id = $1", expenseID).Scan(&count)
+	return count, err
+}
+
+// Delete deletes an attachment record
+func (r *AttachmentRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.pool.Exec(ctx, "DELETE FROM expense_attachments WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("erreur lors de la suppression de la piece jointe: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("piece jointe introuvable")
+	}
+
+	return nil
+}
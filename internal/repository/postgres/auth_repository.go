@@ -50,7 +50,7 @@ func (r *AuthRepository) CreateUser(ctx context.Context, user *domain.User) erro
 // GetUserByEmail retrieves a user by email
 func (r *AuthRepository) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
 	query := `
-		SELECT id, email, password_hash, nom, prenom, telephone, avatar_url, is_active, created_at, updated_at
+		SELECT id, email, password_hash, nom, prenom, telephone, avatar_url, iban, bic, is_active, created_at, updated_at
 		FROM users
 		WHERE email = $1 AND is_active = true
 	`
@@ -64,6 +64,8 @@ func (r *AuthRepository) GetUserByEmail(ctx context.Context, email string) (*dom
 		&user.Prenom,
 		&user.Telephone,
 		&user.AvatarURL,
+		&user.IBAN,
+		&user.BIC,
 		&user.IsActive,
 		&user.CreatedAt,
 		&user.UpdatedAt,
@@ -82,7 +84,7 @@ func (r *AuthRepository) GetUserByEmail(ctx context.Context, email string) (*dom
 // GetUserByID retrieves a user by ID
 func (r *AuthRepository) GetUserByID(ctx context.Context, id string) (*domain.User, error) {
 	query := `
-		SELECT id, email, password_hash, nom, prenom, telephone, avatar_url, is_active, created_at, updated_at
+		SELECT id, email, password_hash, nom, prenom, telephone, avatar_url, iban, bic, is_active, created_at, updated_at
 		FROM users
 		WHERE id = $1 AND is_active = true
 	`
@@ -96,6 +98,8 @@ func (r *AuthRepository) GetUserByID(ctx context.Context, id string) (*domain.Us
 		&user.Prenom,
 		&user.Telephone,
 		&user.AvatarURL,
+		&user.IBAN,
+		&user.BIC,
 		&user.IsActive,
 		&user.CreatedAt,
 		&user.UpdatedAt,
@@ -206,8 +210,8 @@ func (r *AuthRepository) CleanExpiredTokens(ctx context.Context) error {
 func (r *AuthRepository) UpdateUser(ctx context.Context, user *domain.User) error {
 	query := `
 		UPDATE users
-		SET nom = $1, prenom = $2, telephone = $3, avatar_url = $4, password_hash = $5, updated_at = NOW()
-		WHERE id = $6
+		SET nom = $1, prenom = $2, telephone = $3, avatar_url = $4, password_hash = $5, iban = $6, bic = $7, updated_at = NOW()
+		WHERE id = $8
 		RETURNING updated_at
 	`
 
@@ -219,6 +223,8 @@ func (r *AuthRepository) UpdateUser(ctx context.Context, user *domain.User) erro
 		user.Telephone,
 		user.AvatarURL,
 		user.PasswordHash,
+		user.IBAN,
+		user.BIC,
 		user.ID,
 	).Scan(&user.UpdatedAt)
 
@@ -18,32 +18,47 @@ func NewBalanceRepository(pool *pgxpool.Pool) *BalanceRepository {
 	return &BalanceRepository{pool: pool}
 }
 
-// GetUserBalances calculates balances for all members of a colocation
+// unfundedExpense is the SQL condition leaving out the expense aliased e when a
+// common fund paid it: its payer was reimbursed from the fund, so nobody owes
+// them anything for it
+const unfundedExpense = `NOT EXISTS (
+	SELECT 1 FROM fund_withdrawals fw WHERE fw.expense_id = e.id AND fw.deleted_at IS NULL
+)`
+
+// owedShare is the SQL amount of the split aliased es owed to the payer
+// aliased ep of the expense aliased e, in proportion to their contribution and
+// rounded to the cent like the payments allocated to it
+const owedShare = `ROUND(es.base_amount * ep.base_amount / NULLIF(e.base_amount, 0), 2)`
+
+// GetUserBalances calculates balances for all members of a colocation, in its
+// base currency. Settled splits are still counted since the payments settling
+// them are, expenses paid by a fund are not.
 func (r *BalanceRepository) GetUserBalances(ctx context.Context, colocationID string) ([]domain.UserBalance, error) {
 	query := `
 		WITH member_paid AS (
-			SELECT e.paid_by as user_id, COALESCE(SUM(e.amount), 0) as total_paid
-			FROM expenses e
-			WHERE e.colocation_id = $1
-			GROUP BY e.paid_by
+			SELECT ep.user_id, COALESCE(SUM(ep.base_amount), 0) as total_paid
+			FROM expense_payers ep
+			INNER JOIN expenses e ON ep.expense_id = e.id
+			WHERE e.colocation_id = $1 AND e.deleted_at IS NULL AND ` + unfundedExpense + `
+			GROUP BY ep.user_id
 		),
 		member_owed AS (
-			SELECT es.user_id, COALESCE(SUM(es.amount), 0) as total_owed
+			SELECT es.user_id, COALESCE(SUM(es.base_amount), 0) as total_owed
 			FROM expense_splits es
 			INNER JOIN expenses e ON es.expense_id = e.id
-			WHERE e.colocation_id = $1 AND es.is_settled = false
+			WHERE e.colocation_id = $1 AND e.deleted_at IS NULL AND ` + unfundedExpense + `
 			GROUP BY es.user_id
 		),
 		payments_made AS (
-			SELECT p.from_user_id as user_id, COALESCE(SUM(p.amount), 0) as total
+			SELECT p.from_user_id as user_id, COALESCE(SUM(p.base_amount), 0) as total
 			FROM payments p
-			WHERE p.colocation_id = $1 AND p.status = 'confirmed'
+			WHERE p.colocation_id = $1 AND p.deleted_at IS NULL AND p.status = 'confirmed'
 			GROUP BY p.from_user_id
 		),
 		payments_received AS (
-			SELECT p.to_user_id as user_id, COALESCE(SUM(p.amount), 0) as total
+			SELECT p.to_user_id as user_id, COALESCE(SUM(p.base_amount), 0) as total
 			FROM payments p
-			WHERE p.colocation_id = $1 AND p.status = 'confirmed'
+			WHERE p.colocation_id = $1 AND p.deleted_at IS NULL AND p.status = 'confirmed'
 			GROUP BY p.to_user_id
 		)
 		SELECT
@@ -53,9 +68,11 @@ func (r *BalanceRepository) GetUserBalances(ctx context.Context, colocationID st
 			u.avatar_url,
 			COALESCE(mp.total_paid, 0) as total_paid,
 			COALESCE(mo.total_owed, 0) as total_owed,
-			(COALESCE(mp.total_paid, 0) - COALESCE(mo.total_owed, 0) + COALESCE(pm.total, 0) - COALESCE(pr.total, 0)) as net_balance
+			(COALESCE(mp.total_paid, 0) - COALESCE(mo.total_owed, 0) + COALESCE(pm.total, 0) - COALESCE(pr.total, 0)) as net_balance,
+			c.base_currency
 		FROM colocation_members cm
 		INNER JOIN users u ON cm.user_id = u.id
+		INNER JOIN colocations c ON cm.colocation_id = c.id
 		LEFT JOIN member_paid mp ON cm.user_id = mp.user_id
 		LEFT JOIN member_owed mo ON cm.user_id = mo.user_id
 		LEFT JOIN payments_made pm ON cm.user_id = pm.user_id
@@ -75,7 +92,7 @@ func (r *BalanceRepository) GetUserBalances(ctx context.Context, colocationID st
 		var b domain.UserBalance
 		if err := rows.Scan(
 			&b.UserID, &b.UserNom, &b.UserPrenom, &b.AvatarURL,
-			&b.TotalPaid, &b.TotalOwed, &b.NetBalance,
+			&b.TotalPaid, &b.TotalOwed, &b.NetBalance, &b.Currency,
 		); err != nil {
 			return nil, err
 		}
@@ -85,26 +102,43 @@ func (r *BalanceRepository) GetUserBalances(ctx context.Context, colocationID st
 	return balances, rows.Err()
 }
 
-// GetRawDebts returns all unsettled debts between members
+// GetRawDebts returns all unsettled debts between members, in the base currency.
+// Each split is owed to the payers of its expense in proportion to their
+// contributions, less the confirmed payments allocated to it. Expenses paid by
+// a fund are left out.
 func (r *BalanceRepository) GetRawDebts(ctx context.Context, colocationID string) ([]domain.Debt, error) {
 	query := `
+		WITH owed AS (
+			SELECT es.user_id as from_user_id, ep.user_id as to_user_id,
+			       ` + owedShare + ` - COALESCE((
+					SELECT SUM(pa.amount)
+					FROM payment_allocations pa
+					INNER JOIN payments p ON pa.payment_id = p.id
+					WHERE pa.expense_id = e.id AND p.from_user_id = es.user_id AND p.to_user_id = ep.user_id
+					  AND p.status = 'confirmed' AND p.deleted_at IS NULL
+			       ), 0) as amount
+			FROM expense_splits es
+			INNER JOIN expenses e ON es.expense_id = e.id
+			INNER JOIN expense_payers ep ON ep.expense_id = e.id
+			WHERE e.colocation_id = $1 AND e.deleted_at IS NULL AND ` + unfundedExpense + `
+			  AND es.is_settled = false
+			  AND es.user_id != ep.user_id
+		)
 		SELECT
-			es.user_id as from_user_id,
+			o.from_user_id,
 			fu.nom as from_user_nom,
 			fu.prenom as from_user_prenom,
-			e.paid_by as to_user_id,
+			o.to_user_id,
 			tu.nom as to_user_nom,
 			tu.prenom as to_user_prenom,
-			SUM(es.amount) as amount
-		FROM expense_splits es
-		INNER JOIN expenses e ON es.expense_id = e.id
-		INNER JOIN users fu ON es.user_id = fu.id
-		INNER JOIN users tu ON e.paid_by = tu.id
-		WHERE e.colocation_id = $1
-		  AND es.is_settled = false
-		  AND es.user_id != e.paid_by
-		GROUP BY es.user_id, fu.nom, fu.prenom, e.paid_by, tu.nom, tu.prenom
-		HAVING SUM(es.amount) > 0.01
+			ROUND(SUM(o.amount), 2) as amount,
+			c.base_currency
+		FROM owed o
+		INNER JOIN colocations c ON c.id = $1
+		INNER JOIN users fu ON o.from_user_id = fu.id
+		INNER JOIN users tu ON o.to_user_id = tu.id
+		GROUP BY o.from_user_id, fu.nom, fu.prenom, o.to_user_id, tu.nom, tu.prenom, c.base_currency
+		HAVING ROUND(SUM(o.amount), 2) > 0
 		ORDER BY amount DESC
 	`
 
@@ -120,7 +154,7 @@ func (r *BalanceRepository) GetRawDebts(ctx context.Context, colocationID string
 		if err := rows.Scan(
 			&d.FromUserID, &d.FromUserNom, &d.FromUserPrenom,
 			&d.ToUserID, &d.ToUserNom, &d.ToUserPrenom,
-			&d.Amount,
+			&d.Amount, &d.Currency,
 		); err != nil {
 			return nil, err
 		}
@@ -166,34 +200,36 @@ func (r *BalanceRepository) GetMembersInfo(ctx context.Context, colocationID str
 	return members, rows.Err()
 }
 
-// GetBalanceHistory returns the balance history for a user in a colocation
+// GetBalanceHistory returns the balance history for a user in a colocation, in its base currency
 func (r *BalanceRepository) GetBalanceHistory(ctx context.Context, colocationID, userID string, startDate, endDate *time.Time) ([]domain.BalanceHistoryEntry, error) {
 	query := `
 		WITH events AS (
-			-- Expenses where user paid
+			-- Expenses where user paid, for the amount of their contribution
 			SELECT
 				e.expense_date as date,
 				'expense' as event_type,
 				e.id as event_id,
 				e.title as description,
-				e.amount as amount
-			FROM expenses e
-			WHERE e.colocation_id = $1 AND e.paid_by = $2
+				ep.base_amount as amount
+			FROM expense_payers ep
+			INNER JOIN expenses e ON ep.expense_id = e.id
+			WHERE e.colocation_id = $1 AND e.deleted_at IS NULL AND ep.user_id = $2 AND ` + unfundedExpense + `
 				AND ($3::timestamp IS NULL OR e.expense_date >= $3)
 				AND ($4::timestamp IS NULL OR e.expense_date <= $4)
 
 			UNION ALL
 
-			-- Expense splits where user owes
+			-- Expense splits where user owes, including their own share of
+			-- the expenses they paid
 			SELECT
 				e.expense_date as date,
 				'expense' as event_type,
 				e.id as event_id,
 				e.title as description,
-				-es.amount as amount
+				-es.base_amount as amount
 			FROM expense_splits es
 			INNER JOIN expenses e ON es.expense_id = e.id
-			WHERE e.colocation_id = $1 AND es.user_id = $2 AND e.paid_by != $2
+			WHERE e.colocation_id = $1 AND e.deleted_at IS NULL AND es.user_id = $2 AND ` + unfundedExpense + `
 				AND ($3::timestamp IS NULL OR e.expense_date >= $3)
 				AND ($4::timestamp IS NULL OR e.expense_date <= $4)
 
@@ -205,29 +241,30 @@ func (r *BalanceRepository) GetBalanceHistory(ctx context.Context, colocationID,
 				'payment' as event_type,
 				p.id as event_id,
 				COALESCE(p.note, 'Paiement') as description,
-				-p.amount as amount
+				p.base_amount as amount
 			FROM payments p
-			WHERE p.colocation_id = $1 AND p.from_user_id = $2 AND p.status = 'confirmed'
+			WHERE p.colocation_id = $1 AND p.deleted_at IS NULL AND p.from_user_id = $2 AND p.status = 'confirmed'
 				AND ($3::timestamp IS NULL OR p.created_at >= $3)
 				AND ($4::timestamp IS NULL OR p.created_at <= $4)
 
 			UNION ALL
 
-			-- Payments received
+			-- Payments received (negative for recipient)
 			SELECT
 				p.created_at as date,
 				'payment' as event_type,
 				p.id as event_id,
 				COALESCE(p.note, 'Paiement recu') as description,
-				p.amount as amount
+				-p.base_amount as amount
 			FROM payments p
-			WHERE p.colocation_id = $1 AND p.to_user_id = $2 AND p.status = 'confirmed'
+			WHERE p.colocation_id = $1 AND p.deleted_at IS NULL AND p.to_user_id = $2 AND p.status = 'confirmed'
 				AND ($3::timestamp IS NULL OR p.created_at >= $3)
 				AND ($4::timestamp IS NULL OR p.created_at <= $4)
 		)
-		SELECT date, event_type, event_id, description, amount
-		FROM events
-		ORDER BY date ASC
+		SELECT ev.date, ev.event_type, ev.event_id, ev.description, ev.amount, c.base_currency
+		FROM events ev
+		INNER JOIN colocations c ON c.id = $1
+		ORDER BY ev.date ASC
 	`
 
 	rows, err := r.pool.Query(ctx, query, colocationID, userID, startDate, endDate)
@@ -237,11 +274,11 @@ func (r *BalanceRepository) GetBalanceHistory(ctx context.Context, colocationID,
 	defer rows.Close()
 
 	var entries []domain.BalanceHistoryEntry
-	var cumulative float64
+	var cumulative domain.Money
 
 	for rows.Next() {
 		var e domain.BalanceHistoryEntry
-		if err := rows.Scan(&e.Date, &e.EventType, &e.EventID, &e.Description, &e.Amount); err != nil {
+		if err := rows.Scan(&e.Date, &e.EventType, &e.EventID, &e.Description, &e.Amount, &e.Currency); err != nil {
 			return nil, err
 		}
 		cumulative += e.Amount
@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vblanchet22/back_coloc/internal/domain"
+)
+
+// BudgetRepository handles budget database operations
+type BudgetRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewBudgetRepository creates a new BudgetRepository
+func NewBudgetRepository(pool *pgxpool.Pool) *BudgetRepository {
+	return &BudgetRepository{pool: pool}
+}
+
+const budgetColumns = `
+	b.id, b.colocation_id, b.category_id, b.amount, b.period, b.created_by, b.created_at, b.updated_at,
+	COALESCE(c.name, ''), co.base_currency
+`
+
+const budgetJoins = `
+	FROM budgets b
+	LEFT JOIN expense_categories c ON b.category_id = c.id
+	INNER JOIN colocations co ON b.colocation_id = co.id
+`
+
+func scanBudget(row pgx.Row) (*domain.Budget, error) {
+	var b domain.Budget
+	err := row.Scan(
+		&b.ID, &b.ColocationID, &b.CategoryID, &b.Amount, &b.Period, &b.CreatedBy, &b.CreatedAt, &b.UpdatedAt,
+		&b.CategoryName, &b.Currency,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// Create creates a new budget
+func (r *BudgetRepository) Create(ctx context.Context, budget *domain.Budget) error {
+	query := `
+		INSERT INTO budgets (colocation_id, category_id, amount, period, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, updated_at
+	`
+
+	return r.pool.QueryRow(ctx, query,
+		budget.ColocationID,
+		budget.CategoryID,
+		budget.Amount,
+		budget.Period,
+		budget.CreatedBy,
+	).Scan(&budget.ID, &budget.CreatedAt, &budget.UpdatedAt)
+}
+
+// GetByID retrieves a budget by ID
+func (r *BudgetRepository) GetByID(ctx context.Context, id string) (*domain.Budget, error) {
+	query := "SELECT " + budgetColumns + budgetJoins + " WHERE b.id = $1"
+
+	budget, err := scanBudget(r.pool.QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation du budget: %w", err)
+	}
+
+	return budget, nil
+}
+
+// GetByCategory retrieves the budget of a category, or the overall budget when
+// categoryID is nil
+func (r *BudgetRepository) GetByCategory(ctx context.Context, colocationID string, categoryID *string) (*domain.Budget, error) {
+	query := "SELECT " + budgetColumns + budgetJoins + " WHERE b.colocation_id = $1 AND b.category_id IS NOT DISTINCT FROM $2"
+
+	budget, err := scanBudget(r.pool.QueryRow(ctx, query, colocationID, categoryID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation du budget: %w", err)
+	}
+
+	return budget, nil
+}
+
+// ListByColocation lists the budgets of a colocation, the overall one first
+func (r *BudgetRepository) ListByColocation(ctx context.Context, colocationID string) ([]domain.Budget, error) {
+	query := "SELECT " + budgetColumns + budgetJoins + `
+		WHERE b.colocation_id = $1
+		ORDER BY b.category_id IS NOT NULL, c.name
+	`
+
+	rows, err := r.pool.Query(ctx, query, colocationID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des budgets: %w", err)
+	}
+	defer rows.Close()
+
+	var budgets []domain.Budget
+	for rows.Next() {
+		budget, err := scanBudget(rows)
+		if err != nil {
+			return nil, fmt.Errorf("erreur lors du scan du budget: %w", err)
+		}
+		budgets = append(budgets, *budget)
+	}
+
+	return budgets, rows.Err()
+}
+
+// Update updates the limit and period of a budget
+func (r *BudgetRepository) Update(ctx context.Context, budget *domain.Budget) error {
+	query := `
+		UPDATE budgets SET amount = $1, period = $2, updated_at = NOW()
+		WHERE id = $3
+		RETURNING updated_at
+	`
+
+	if err := r.pool.QueryRow(ctx, query, budget.Amount, budget.Period, budget.ID).Scan(&budget.UpdatedAt); err != nil {
+		return fmt.Errorf("erreur lors de la mise a jour du budget: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes a budget
+func (r *BudgetRepository) Delete(ctx context.Context, id string) error {
+	if _, err := r.pool.Exec(ctx, "DELETE FROM budgets WHERE id = $1", id); err != nil {
+		return fmt.Errorf("erreur lors de la suppression du budget: %w", err)
+	}
+	return nil
+}
+
+// GetSpent returns the spending of a colocation between two dates included, in
+// its base currency, for a category or for all of them when categoryID is nil.
+// Generated occurrences are left out when nonRecurringOnly is set.
+func (r *BudgetRepository) GetSpent(ctx context.Context, colocationID string, categoryID *string, startDate, endDate time.Time, nonRecurringOnly bool) (domain.Money, error) {
+	query := `
+		SELECT COALESCE(SUM(base_amount), 0)
+		FROM expenses
+		WHERE colocation_id = $1
+		  AND deleted_at IS NULL
+		  AND ($2::uuid IS NULL OR category_id = $2)
+		  AND expense_date >= $3 AND expense_date <= $4
+		  AND (NOT $5 OR recurring_id IS NULL)
+	`
+
+	var spent domain.Money
+	if err := r.pool.QueryRow(ctx, query, colocationID, categoryID, startDate, endDate, nonRecurringOnly).Scan(&spent); err != nil {
+		return 0, fmt.Errorf("erreur lors du calcul des depenses: %w", err)
+	}
+	return spent, nil
+}
+
+// RecordAlert records that a threshold of a budget was reached for a period.
+// It returns false when the alert was already recorded.
+func (r *BudgetRepository) RecordAlert(ctx context.Context, budgetID string, periodStart time.Time, threshold int) (bool, error) {
+	query := `
+		INSERT INTO budget_alerts (budget_id, period_start, threshold)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
+	`
+
+	tag, err := r.pool.Exec(ctx, query, budgetID, periodStart, threshold)
+	if err != nil {
+		return false, fmt.Errorf("erreur lors de l'enregistrement de l'alerte: %w", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
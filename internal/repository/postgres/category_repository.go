@@ -105,19 +105,20 @@ func (r *CategoryRepository) Delete(ctx context.Context, id string) error {
 	return err
 }
 
-// GetStats returns category statistics for a colocation within a date range
-func (r *CategoryRepository) GetStats(ctx context.Context, colocationID string, startDate, endDate *time.Time) ([]domain.CategoryStat, float64, error) {
+// GetStats returns category statistics for a colocation within a date range, in its base currency
+func (r *CategoryRepository) GetStats(ctx context.Context, colocationID string, startDate, endDate *time.Time) ([]domain.CategoryStat, domain.Money, error) {
 	query := `
 		SELECT
 			c.id,
 			c.name,
 			c.icon,
 			c.color,
-			COALESCE(SUM(e.amount), 0) as total_amount,
+			COALESCE(SUM(e.base_amount), 0) as total_amount,
 			COUNT(e.id) as expense_count
 		FROM expense_categories c
 		LEFT JOIN expenses e ON e.category_id = c.id
 			AND e.colocation_id = $1
+			AND e.deleted_at IS NULL
 			AND ($2::timestamp IS NULL OR e.expense_date >= $2)
 			AND ($3::timestamp IS NULL OR e.expense_date <= $3)
 		WHERE c.colocation_id IS NULL OR c.colocation_id = $1
@@ -132,7 +133,7 @@ func (r *CategoryRepository) GetStats(ctx context.Context, colocationID string,
 	defer rows.Close()
 
 	var stats []domain.CategoryStat
-	var totalAmount float64
+	var totalAmount domain.Money
 
 	for rows.Next() {
 		var s domain.CategoryStat
@@ -153,7 +154,7 @@ func (r *CategoryRepository) GetStats(ctx context.Context, colocationID string,
 	// Calculate percentages
 	for i := range stats {
 		if totalAmount > 0 {
-			stats[i].Percentage = (stats[i].TotalAmount / totalAmount) * 100
+			stats[i].Percentage = (stats[i].TotalAmount.Float64() / totalAmount.Float64()) * 100
 		}
 	}
 
@@ -33,9 +33,9 @@ func (r *ColocationRepository) Create(ctx context.Context, coloc *domain.Colocat
 	coloc.InviteCode = generateInviteCode()
 
 	query := `
-		INSERT INTO colocations (name, description, address, created_by, invite_code)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING id, created_at, updated_at
+		INSERT INTO colocations (name, description, address, created_by, invite_code, base_currency)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, payment_reminder_days, payment_expiry_days, created_at, updated_at
 	`
 
 	err := r.pool.QueryRow(
@@ -46,7 +46,8 @@ func (r *ColocationRepository) Create(ctx context.Context, coloc *domain.Colocat
 		coloc.Address,
 		coloc.CreatedBy,
 		coloc.InviteCode,
-	).Scan(&coloc.ID, &coloc.CreatedAt, &coloc.UpdatedAt)
+		coloc.BaseCurrency,
+	).Scan(&coloc.ID, &coloc.PaymentReminderDays, &coloc.PaymentExpiryDays, &coloc.CreatedAt, &coloc.UpdatedAt)
 
 	if err != nil {
 		return fmt.Errorf("erreur lors de la creation de la colocation: %w", err)
@@ -58,7 +59,8 @@ func (r *ColocationRepository) Create(ctx context.Context, coloc *domain.Colocat
 // GetByID retrieves a colocation by ID
 func (r *ColocationRepository) GetByID(ctx context.Context, id string) (*domain.Colocation, error) {
 	query := `
-		SELECT id, name, description, address, created_by, invite_code, created_at, updated_at
+		SELECT id, name, description, address, created_by, invite_code, base_currency,
+		       payment_reminder_days, payment_expiry_days, created_at, updated_at
 		FROM colocations
 		WHERE id = $1
 	`
@@ -71,6 +73,9 @@ func (r *ColocationRepository) GetByID(ctx context.Context, id string) (*domain.
 		&coloc.Address,
 		&coloc.CreatedBy,
 		&coloc.InviteCode,
+		&coloc.BaseCurrency,
+		&coloc.PaymentReminderDays,
+		&coloc.PaymentExpiryDays,
 		&coloc.CreatedAt,
 		&coloc.UpdatedAt,
 	)
@@ -88,7 +93,8 @@ func (r *ColocationRepository) GetByID(ctx context.Context, id string) (*domain.
 // GetByInviteCode retrieves a colocation by invite code
 func (r *ColocationRepository) GetByInviteCode(ctx context.Context, code string) (*domain.Colocation, error) {
 	query := `
-		SELECT id, name, description, address, created_by, invite_code, created_at, updated_at
+		SELECT id, name, description, address, created_by, invite_code, base_currency,
+		       payment_reminder_days, payment_expiry_days, created_at, updated_at
 		FROM colocations
 		WHERE invite_code = $1
 	`
@@ -101,6 +107,9 @@ func (r *ColocationRepository) GetByInviteCode(ctx context.Context, code string)
 		&coloc.Address,
 		&coloc.CreatedBy,
 		&coloc.InviteCode,
+		&coloc.BaseCurrency,
+		&coloc.PaymentReminderDays,
+		&coloc.PaymentExpiryDays,
 		&coloc.CreatedAt,
 		&coloc.UpdatedAt,
 	)
@@ -118,7 +127,8 @@ func (r *ColocationRepository) GetByInviteCode(ctx context.Context, code string)
 // ListByUserID retrieves all colocations for a user
 func (r *ColocationRepository) ListByUserID(ctx context.Context, userID string) ([]domain.Colocation, error) {
 	query := `
-		SELECT c.id, c.name, c.description, c.address, c.created_by, c.invite_code, c.created_at, c.updated_at
+		SELECT c.id, c.name, c.description, c.address, c.created_by, c.invite_code, c.base_currency,
+		       c.payment_reminder_days, c.payment_expiry_days, c.created_at, c.updated_at
 		FROM colocations c
 		INNER JOIN colocation_members cm ON c.id = cm.colocation_id
 		WHERE cm.user_id = $1
@@ -141,6 +151,9 @@ func (r *ColocationRepository) ListByUserID(ctx context.Context, userID string)
 			&coloc.Address,
 			&coloc.CreatedBy,
 			&coloc.InviteCode,
+			&coloc.BaseCurrency,
+			&coloc.PaymentReminderDays,
+			&coloc.PaymentExpiryDays,
 			&coloc.CreatedAt,
 			&coloc.UpdatedAt,
 		)
@@ -157,8 +170,9 @@ func (r *ColocationRepository) ListByUserID(ctx context.Context, userID string)
 func (r *ColocationRepository) Update(ctx context.Context, coloc *domain.Colocation) error {
 	query := `
 		UPDATE colocations
-		SET name = $1, description = $2, address = $3, updated_at = NOW()
-		WHERE id = $4
+		SET name = $1, description = $2, address = $3, base_currency = $4,
+		    payment_reminder_days = $5, payment_expiry_days = $6, updated_at = NOW()
+		WHERE id = $7
 		RETURNING updated_at
 	`
 
@@ -168,6 +182,9 @@ func (r *ColocationRepository) Update(ctx context.Context, coloc *domain.Colocat
 		coloc.Name,
 		coloc.Description,
 		coloc.Address,
+		coloc.BaseCurrency,
+		coloc.PaymentReminderDays,
+		coloc.PaymentExpiryDays,
 		coloc.ID,
 	).Scan(&coloc.UpdatedAt)
 
@@ -178,20 +195,64 @@ func (r *ColocationRepository) Update(ctx context.Context, coloc *domain.Colocat
 	return nil
 }
 
-// Delete deletes a colocation
-func (r *ColocationRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM colocations WHERE id = $1`
+// HasAmounts checks if a colocation already recorded amounts expressed in its
+// base currency (expenses, payments, funds or event budgets)
+func (r *ColocationRepository) HasAmounts(ctx context.Context, id string) (bool, error) {
+	query := `
+		SELECT EXISTS(SELECT 1 FROM expenses WHERE colocation_id = $1)
+		    OR EXISTS(SELECT 1 FROM recurring_expenses WHERE colocation_id = $1)
+		    OR EXISTS(SELECT 1 FROM payments WHERE colocation_id = $1)
+		    OR EXISTS(SELECT 1 FROM common_funds WHERE colocation_id = $1)
+		    OR EXISTS(SELECT 1 FROM events WHERE colocation_id = $1 AND budget IS NOT NULL)
+	`
+
+	var exists bool
+	err := r.pool.QueryRow(ctx, query, id).Scan(&exists)
+	return exists, err
+}
 
-	result, err := r.pool.Exec(ctx, query, id)
+// GetBaseCurrency returns the base currency of a colocation
+func (r *ColocationRepository) GetBaseCurrency(ctx context.Context, id string) (string, error) {
+	var currency string
+	err := r.pool.QueryRow(ctx, "SELECT base_currency FROM colocations WHERE id = $1", id).Scan(&currency)
+	if err == pgx.ErrNoRows {
+		return "", fmt.Errorf("colocation introuvable")
+	}
 	if err != nil {
-		return fmt.Errorf("erreur lors de la suppression de la colocation: %w", err)
+		return "", fmt.Errorf("erreur lors de la recuperation de la devise: %w", err)
+	}
+	return currency, nil
+}
+
+// Delete deletes a colocation with everything it contains. It returns the
+// attachments of its expenses so that their files can be removed.
+func (r *ColocationRepository) Delete(ctx context.Context, id string) ([]domain.ExpenseAttachment, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors du demarrage de la transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	attachments, err := listAttachmentFiles(ctx, tx, "e.colocation_id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Expenses, payments, funds and their attachments are deleted by CASCADE
+	result, err := tx.Exec(ctx, `DELETE FROM colocations WHERE id = $1`, id)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la suppression de la colocation: %w", err)
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("colocation introuvable")
+		return nil, fmt.Errorf("colocation introuvable")
 	}
 
-	return nil
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
 }
 
 // RegenerateInviteCode regenerates the invite code
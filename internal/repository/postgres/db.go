@@ -24,3 +24,33 @@ func Connect(cfg *config.DatabaseConfig) (*pgxpool.Pool, error) {
 	log.Println("Connexion a la base de donnees etablie")
 	return pool, nil
 }
+
+// TryAdvisoryLock tries to take a session-level Postgres advisory lock identified
+// by name. It returns false if another session already holds it. The returned
+// unlock function must be called to release the lock and its connection.
+func TryAdvisoryLock(ctx context.Context, pool *pgxpool.Pool, name string) (bool, func(), error) {
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return false, nil, fmt.Errorf("erreur lors de l'acquisition d'une connexion: %w", err)
+	}
+
+	var acquired bool
+	if err := conn.QueryRow(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", name).Scan(&acquired); err != nil {
+		conn.Release()
+		return false, nil, fmt.Errorf("erreur lors de la prise du verrou: %w", err)
+	}
+
+	if !acquired {
+		conn.Release()
+		return false, func() {}, nil
+	}
+
+	unlock := func() {
+		if _, err := conn.Exec(context.Background(), "SELECT pg_advisory_unlock(hashtext($1))", name); err != nil {
+			log.Printf("Erreur lors de la liberation du verrou %s: %v", name, err)
+		}
+		conn.Release()
+	}
+
+	return true, unlock, nil
+}
@@ -0,0 +1,235 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vblanchet22/back_coloc/internal/domain"
+)
+
+// EventRepository handles event database operations
+type EventRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewEventRepository creates a new EventRepository
+func NewEventRepository(pool *pgxpool.Pool) *EventRepository {
+	return &EventRepository{pool: pool}
+}
+
+// eventSelectColumns returns the columns scanned by scanEvent, userArg being
+// the placeholder index bound to the current user ID
+func eventSelectColumns(userArg int) string {
+	return fmt.Sprintf(`
+	SELECT ev.id, ev.colocation_id, ev.fund_id, ev.created_by, ev.title, ev.description,
+	       ev.budget, ev.event_date, ev.location, ev.status, ev.created_at,
+	       u.nom, u.prenom, f.name, c.base_currency,
+	       (SELECT ep.rsvp FROM event_participants ep WHERE ep.event_id = ev.id AND ep.user_id = $%d) as user_rsvp,
+	       (SELECT COUNT(*) FROM event_participants ep WHERE ep.event_id = ev.id AND ep.rsvp = 'going') as going_count,
+	       (SELECT COUNT(*) FROM event_participants ep WHERE ep.event_id = ev.id AND ep.rsvp = 'maybe') as maybe_count,
+	       (SELECT COUNT(*) FROM event_participants ep WHERE ep.event_id = ev.id AND ep.rsvp = 'not_going') as not_going_count
+`, userArg)
+}
+
+const eventFromClause = `
+	FROM events ev
+	INNER JOIN users u ON ev.created_by = u.id
+	INNER JOIN colocations c ON ev.colocation_id = c.id
+	LEFT JOIN common_funds f ON ev.fund_id = f.id
+`
+
+func scanEvent(row pgx.Row) (*domain.Event, error) {
+	var ev domain.Event
+	err := row.Scan(
+		&ev.ID, &ev.ColocationID, &ev.FundID, &ev.CreatedBy, &ev.Title, &ev.Description,
+		&ev.Budget, &ev.EventDate, &ev.Location, &ev.Status, &ev.CreatedAt,
+		&ev.CreatedByNom, &ev.CreatedByPrenom, &ev.FundName, &ev.Currency,
+		&ev.UserRSVP, &ev.GoingCount, &ev.MaybeCount, &ev.NotGoingCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &ev, nil
+}
+
+// Create creates a new event
+func (r *EventRepository) Create(ctx context.Context, event *domain.Event) error {
+	query := `
+		INSERT INTO events (colocation_id, fund_id, created_by, title, description, budget, event_date, location)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, status, created_at
+	`
+
+	return r.pool.QueryRow(ctx, query,
+		event.ColocationID,
+		event.FundID,
+		event.CreatedBy,
+		event.Title,
+		event.Description,
+		event.Budget,
+		event.EventDate,
+		event.Location,
+	).Scan(&event.ID, &event.Status, &event.CreatedAt)
+}
+
+// GetByID retrieves an event by ID, including the current user's RSVP and participant counts
+func (r *EventRepository) GetByID(ctx context.Context, id, currentUserID string) (*domain.Event, error) {
+	query := eventSelectColumns(2) + eventFromClause + " WHERE ev.id = $1"
+
+	ev, err := scanEvent(r.pool.QueryRow(ctx, query, id, currentUserID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation de l'evenement: %w", err)
+	}
+
+	return ev, nil
+}
+
+// ListByColocation lists events for a colocation with filters
+func (r *EventRepository) ListByColocation(ctx context.Context, colocationID, currentUserID string, status *domain.EventStatus, startDate, endDate *time.Time, page, pageSize int) ([]domain.Event, int, error) {
+	baseQuery := eventFromClause + " WHERE ev.colocation_id = $1"
+
+	args := []interface{}{colocationID}
+	argIndex := 2
+
+	if status != nil {
+		baseQuery += fmt.Sprintf(" AND ev.status = $%d", argIndex)
+		args = append(args, *status)
+		argIndex++
+	}
+
+	if startDate != nil {
+		baseQuery += fmt.Sprintf(" AND ev.event_date >= $%d", argIndex)
+		args = append(args, *startDate)
+		argIndex++
+	}
+
+	if endDate != nil {
+		// end_date is a day: include every event happening on that day
+		baseQuery += fmt.Sprintf(" AND ev.event_date < $%d", argIndex)
+		args = append(args, endDate.AddDate(0, 0, 1))
+		argIndex++
+	}
+
+	// Count
+	var totalCount int
+	if err := r.pool.QueryRow(ctx, "SELECT COUNT(*) "+baseQuery, args...).Scan(&totalCount); err != nil {
+		return nil, 0, fmt.Errorf("erreur lors du comptage des evenements: %w", err)
+	}
+
+	// Select
+	selectQuery := eventSelectColumns(argIndex) + baseQuery + fmt.Sprintf(" ORDER BY ev.event_date ASC LIMIT $%d OFFSET $%d", argIndex+1, argIndex+2)
+	args = append(args, currentUserID, pageSize, (page-1)*pageSize)
+
+	rows, err := r.pool.Query(ctx, selectQuery, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("erreur lors de la recuperation des evenements: %w", err)
+	}
+	defer rows.Close()
+
+	var events []domain.Event
+	for rows.Next() {
+		ev, err := scanEvent(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("erreur lors du scan de l'evenement: %w", err)
+		}
+		events = append(events, *ev)
+	}
+
+	return events, totalCount, rows.Err()
+}
+
+// Update updates an event
+func (r *EventRepository) Update(ctx context.Context, event *domain.Event) error {
+	query := `
+		UPDATE events
+		SET title = $1, description = $2, budget = $3, event_date = $4, location = $5, fund_id = $6, status = $7
+		WHERE id = $8
+	`
+
+	result, err := r.pool.Exec(ctx, query,
+		event.Title,
+		event.Description,
+		event.Budget,
+		event.EventDate,
+		event.Location,
+		event.FundID,
+		event.Status,
+		event.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("erreur lors de la mise a jour de l'evenement: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("evenement introuvable")
+	}
+
+	return nil
+}
+
+// Delete deletes an event and its participants
+func (r *EventRepository) Delete(ctx context.Context, id string) error {
+	// Participants are deleted by CASCADE
+	query := `DELETE FROM events WHERE id = $1`
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("erreur lors de la suppression de l'evenement: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("evenement introuvable")
+	}
+
+	return nil
+}
+
+// UpsertRSVP records or replaces a user's RSVP for an event
+func (r *EventRepository) UpsertRSVP(ctx context.Context, eventID, userID string, rsvp domain.RSVPStatus) error {
+	query := `
+		INSERT INTO event_participants (event_id, user_id, rsvp)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (event_id, user_id)
+		DO UPDATE SET rsvp = EXCLUDED.rsvp, created_at = NOW()
+	`
+
+	_, err := r.pool.Exec(ctx, query, eventID, userID, rsvp)
+	return err
+}
+
+// ListParticipants lists the RSVPs for an event
+func (r *EventRepository) ListParticipants(ctx context.Context, eventID string) ([]domain.EventParticipant, error) {
+	query := `
+		SELECT ep.id, ep.event_id, ep.user_id, ep.rsvp, ep.created_at,
+		       u.nom, u.prenom, u.avatar_url
+		FROM event_participants ep
+		INNER JOIN users u ON ep.user_id = u.id
+		WHERE ep.event_id = $1
+		ORDER BY ep.created_at ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des participants: %w", err)
+	}
+	defer rows.Close()
+
+	var participants []domain.EventParticipant
+	for rows.Next() {
+		var p domain.EventParticipant
+		if err := rows.Scan(
+			&p.ID, &p.EventID, &p.UserID, &p.RSVP, &p.RespondedAt,
+			&p.UserNom, &p.UserPrenom, &p.AvatarURL,
+		); err != nil {
+			return nil, fmt.Errorf("erreur lors du scan du participant: %w", err)
+		}
+		participants = append(participants, p)
+	}
+
+	return participants, rows.Err()
+}
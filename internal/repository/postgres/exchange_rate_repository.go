@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vblanchet22/back_coloc/internal/domain"
+)
+
+// ExchangeRateRepository handles exchange rate database operations
+type ExchangeRateRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewExchangeRateRepository creates a new ExchangeRateRepository
+func NewExchangeRateRepository(pool *pgxpool.Pool) *ExchangeRateRepository {
+	return &ExchangeRateRepository{pool: pool}
+}
+
+// Import upserts imported rates in one transaction. An imported rate replaces
+// any rate already stored for the same currency and day.
+func (r *ExchangeRateRepository) Import(ctx context.Context, rates []domain.ExchangeRate) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("erreur lors du demarrage de la transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO exchange_rates (colocation_id, base_currency, currency, rate, rate_date, source, created_by)
+		VALUES ($1, $2, $3, $4, $5, 'import', $6)
+		ON CONFLICT (colocation_id, base_currency, currency, rate_date)
+		DO UPDATE SET rate = EXCLUDED.rate, source = 'import', created_by = EXCLUDED.created_by, created_at = NOW()
+	`
+
+	for _, rate := range rates {
+		if _, err := tx.Exec(ctx, query,
+			rate.ColocationID, rate.BaseCurrency, rate.Currency, rate.Rate, rate.RateDate, rate.CreatedBy,
+		); err != nil {
+			return fmt.Errorf("erreur lors de l'import du taux %s du %s: %w", rate.Currency, rate.RateDate.Format("2006-01-02"), err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// SaveManual records a rate entered with an expense or a payment. It never
+// replaces a rate already stored for the same currency and day.
+func (r *ExchangeRateRepository) SaveManual(ctx context.Context, rate *domain.ExchangeRate) error {
+	query := `
+		INSERT INTO exchange_rates (colocation_id, base_currency, currency, rate, rate_date, source, created_by)
+		VALUES ($1, $2, $3, $4, $5, 'manual', $6)
+		ON CONFLICT (colocation_id, base_currency, currency, rate_date) DO NOTHING
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		rate.ColocationID, rate.BaseCurrency, rate.Currency, rate.Rate, rate.RateDate, rate.CreatedBy,
+	)
+	if err != nil {
+		return fmt.Errorf("erreur lors de l'enregistrement du taux de change: %w", err)
+	}
+	return nil
+}
+
+const exchangeRateSelect = `
+	SELECT id, colocation_id, base_currency, currency, rate, rate_date, source, created_by, created_at
+	FROM exchange_rates
+`
+
+func scanExchangeRate(row pgx.Row) (*domain.ExchangeRate, error) {
+	var rate domain.ExchangeRate
+	err := row.Scan(
+		&rate.ID, &rate.ColocationID, &rate.BaseCurrency, &rate.Currency, &rate.Rate,
+		&rate.RateDate, &rate.Source, &rate.CreatedBy, &rate.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rate, nil
+}
+
+// FindLatest returns the most recent rate of a currency on or before a date
+func (r *ExchangeRateRepository) FindLatest(ctx context.Context, colocationID, baseCurrency, currency string, date time.Time) (*domain.ExchangeRate, error) {
+	query := exchangeRateSelect + `
+		WHERE colocation_id = $1 AND base_currency = $2 AND currency = $3 AND rate_date <= $4
+		ORDER BY rate_date DESC
+		LIMIT 1
+	`
+
+	rate, err := scanExchangeRate(r.pool.QueryRow(ctx, query, colocationID, baseCurrency, currency, date))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation du taux de change: %w", err)
+	}
+
+	return rate, nil
+}
+
+// List lists the rates of a colocation against its base currency, most recent first
+func (r *ExchangeRateRepository) List(ctx context.Context, colocationID, baseCurrency string, currency *string) ([]domain.ExchangeRate, error) {
+	query := exchangeRateSelect + " WHERE colocation_id = $1 AND base_currency = $2"
+	args := []interface{}{colocationID, baseCurrency}
+
+	if currency != nil {
+		query += " AND currency = $3"
+		args = append(args, *currency)
+	}
+	query += " ORDER BY rate_date DESC, currency ASC"
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des taux de change: %w", err)
+	}
+	defer rows.Close()
+
+	var rates []domain.ExchangeRate
+	for rows.Next() {
+		rate, err := scanExchangeRate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("erreur lors du scan du taux de change: %w", err)
+		}
+		rates = append(rates, *rate)
+	}
+
+	return rates, rows.Err()
+}
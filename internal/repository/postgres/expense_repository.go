@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -28,71 +29,236 @@ func (r *ExpenseRepository) Create(ctx context.Context, expense *domain.Expense,
 	}
 	defer tx.Rollback(ctx)
 
-	// Insert expense
+	if err := insertExpense(ctx, tx, expense, splits, domain.RevisionCreate, &expense.CreatedBy); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// CreateMany creates several expenses with their splits in a single
+// transaction: either all of them are created or none
+func (r *ExpenseRepository) CreateMany(ctx context.Context, expenses []*domain.Expense, splits [][]domain.ExpenseSplitInput) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("erreur lors du demarrage de la transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for i, expense := range expenses {
+		if err := insertExpense(ctx, tx, expense, splits[i], domain.RevisionCreate, &expense.CreatedBy); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// insertExpense inserts an expense, its payers and its splits within a
+// transaction, and records the given revision. Without payers, PaidBy pays the
+// whole amount; without CreatedBy, the expense is recorded by PaidBy. An
+// expense with an ID keeps it, so that a deleted expense can be restored.
+func insertExpense(ctx context.Context, tx pgx.Tx, expense *domain.Expense, splits []domain.ExpenseSplitInput, action domain.RevisionAction, changedBy *string) error {
+	if expense.CreatedBy == "" {
+		expense.CreatedBy = expense.PaidBy
+	}
+	if len(expense.Payers) == 0 {
+		expense.Payers = []domain.ExpensePayer{{UserID: expense.PaidBy, Amount: expense.Amount, BaseAmount: expense.BaseAmount}}
+	}
+
 	query := `
-		INSERT INTO expenses (colocation_id, paid_by, category_id, title, description, amount, split_type, expense_date)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO expenses (id, colocation_id, paid_by, created_by, category_id, title, description, amount, currency, exchange_rate, base_amount, split_type, expense_date, recurring_id, import_ref)
+		VALUES (COALESCE(NULLIF($1, '')::uuid, gen_random_uuid()), $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 		RETURNING id, created_at
 	`
 
-	err = tx.QueryRow(ctx, query,
+	err := tx.QueryRow(ctx, query,
+		expense.ID,
 		expense.ColocationID,
 		expense.PaidBy,
+		expense.CreatedBy,
 		expense.CategoryID,
 		expense.Title,
 		expense.Description,
 		expense.Amount,
+		expense.Currency,
+		expense.ExchangeRate,
+		expense.BaseAmount,
 		expense.SplitType,
 		expense.ExpenseDate,
+		expense.RecurringID,
+		expense.ImportRef,
 	).Scan(&expense.ID, &expense.CreatedAt)
 
 	if err != nil {
 		return fmt.Errorf("erreur lors de la creation de la depense: %w", err)
 	}
 
-	// Insert splits
+	if err := insertPayers(ctx, tx, expense.ID, expense.Payers); err != nil {
+		return err
+	}
+
+	if err := insertItems(ctx, tx, expense.ID, expense.Items); err != nil {
+		return err
+	}
+
+	if err := insertSplits(ctx, tx, expense.ID, splits); err != nil {
+		return err
+	}
+
+	if err := insertTags(ctx, tx, expense.ID, expense.Tags); err != nil {
+		return err
+	}
+
+	return insertRevision(ctx, tx, expense, splits, action, changedBy)
+}
+
+// insertRevision appends the state of an expense to its history within a
+// transaction. Revisions are numbered per expense; concurrent changes of the
+// same expense are serialized by the lock on its row.
+func insertRevision(ctx context.Context, tx pgx.Tx, expense *domain.Expense, splits []domain.ExpenseSplitInput, action domain.RevisionAction, changedBy *string) error {
+	snapshot, err := json.Marshal(domain.NewExpenseSnapshot(expense, splits))
+	if err != nil {
+		return fmt.Errorf("erreur lors de l'encodage de la version: %w", err)
+	}
+
+	query := `
+		INSERT INTO expense_revisions (expense_id, colocation_id, revision, action, changed_by, snapshot)
+		SELECT $1, $2, COALESCE(MAX(revision), 0) + 1, $3, $4, $5
+		FROM expense_revisions
+		WHERE expense_id = $1
+	`
+	if _, err := tx.Exec(ctx, query, expense.ID, expense.ColocationID, action, changedBy, snapshot); err != nil {
+		return fmt.Errorf("erreur lors de l'enregistrement de l'historique: %w", err)
+	}
+
+	return nil
+}
+
+// insertPayers inserts the payer contributions of an expense within a transaction
+func insertPayers(ctx context.Context, tx pgx.Tx, expenseID string, payers []domain.ExpensePayer) error {
+	for i := range payers {
+		payers[i].ExpenseID = expenseID
+		payerQuery := `
+			INSERT INTO expense_payers (expense_id, user_id, amount, base_amount)
+			VALUES ($1, $2, $3, $4)
+		`
+		_, err := tx.Exec(ctx, payerQuery, expenseID, payers[i].UserID, payers[i].Amount, payers[i].BaseAmount)
+		if err != nil {
+			return fmt.Errorf("erreur lors de la creation du payeur: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// insertSplits inserts the splits of an expense within a transaction
+func insertSplits(ctx context.Context, tx pgx.Tx, expenseID string, splits []domain.ExpenseSplitInput) error {
 	for _, split := range splits {
 		splitQuery := `
-			INSERT INTO expense_splits (expense_id, user_id, amount, percentage)
-			VALUES ($1, $2, $3, $4)
+			INSERT INTO expense_splits (expense_id, user_id, amount, base_amount, percentage, shares)
+			VALUES ($1, $2, $3, $4, $5, NULLIF($6, 0))
 		`
-		_, err = tx.Exec(ctx, splitQuery, expense.ID, split.UserID, split.Amount, split.Percentage)
+		_, err := tx.Exec(ctx, splitQuery, expenseID, split.UserID, split.Amount, split.BaseAmount, split.Percentage, split.Shares)
 		if err != nil {
 			return fmt.Errorf("erreur lors de la creation du split: %w", err)
 		}
 	}
 
-	return tx.Commit(ctx)
+	// Replaced splits keep the payments allocated to the expense
+	return refreshSettledSplits(ctx, tx, []string{expenseID})
+}
+
+// insertItems inserts the line items of an itemized expense within a transaction
+func insertItems(ctx context.Context, tx pgx.Tx, expenseID string, items []domain.ExpenseItem) error {
+	for i := range items {
+		item := &items[i]
+		item.ExpenseID = expenseID
+		item.Position = i + 1
+
+		itemQuery := `
+			INSERT INTO expense_items (expense_id, label, amount, is_shared_charge, position)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING id
+		`
+		err := tx.QueryRow(ctx, itemQuery, expenseID, item.Label, item.Amount, item.IsSharedCharge, item.Position).Scan(&item.ID)
+		if err != nil {
+			return fmt.Errorf("erreur lors de la creation de la ligne: %w", err)
+		}
+
+		for _, p := range item.Participants {
+			participantQuery := `
+				INSERT INTO expense_item_participants (item_id, user_id, weight)
+				VALUES ($1, $2, $3)
+			`
+			if _, err := tx.Exec(ctx, participantQuery, item.ID, p.UserID, p.Weight); err != nil {
+				return fmt.Errorf("erreur lors de la creation du participant: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// insertTags links tags to an expense within a transaction
+func insertTags(ctx context.Context, tx pgx.Tx, expenseID string, tags []domain.Tag) error {
+	for _, t := range tags {
+		query := `INSERT INTO expense_tags (expense_id, tag_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+		if _, err := tx.Exec(ctx, query, expenseID, t.ID); err != nil {
+			return fmt.Errorf("erreur lors de l'ajout du tag: %w", err)
+		}
+	}
+	return nil
 }
 
-// GetByID retrieves an expense by ID with all its details
+// GetByID retrieves an expense by ID with all its details, soft-deleted
+// expenses excluded
 func (r *ExpenseRepository) GetByID(ctx context.Context, id string) (*domain.Expense, error) {
+	return r.getByID(ctx, id, false)
+}
+
+// GetDeletedByID retrieves a soft-deleted expense by ID with all its details
+func (r *ExpenseRepository) GetDeletedByID(ctx context.Context, id string) (*domain.Expense, error) {
+	return r.getByID(ctx, id, true)
+}
+
+// getByID retrieves an expense which is soft-deleted or not depending on deleted
+func (r *ExpenseRepository) getByID(ctx context.Context, id string, deleted bool) (*domain.Expense, error) {
 	query := `
-		SELECT e.id, e.colocation_id, e.paid_by, e.category_id, e.title, e.description,
-		       e.amount, e.split_type, e.expense_date, e.recurring_id, e.created_at,
-		       u.nom, u.prenom, c.name
+		SELECT e.id, e.colocation_id, e.paid_by, COALESCE(e.created_by, e.paid_by), e.category_id, e.title, e.description,
+		       e.amount, e.currency, e.exchange_rate, e.base_amount, e.split_type, e.expense_date, e.recurring_id, e.import_ref, e.created_at,
+		       e.deleted_at, e.deleted_by, u.nom, u.prenom, c.name, co.base_currency
 		FROM expenses e
 		INNER JOIN users u ON e.paid_by = u.id
 		INNER JOIN expense_categories c ON e.category_id = c.id
-		WHERE e.id = $1
+		INNER JOIN colocations co ON e.colocation_id = co.id
+		WHERE e.id = $1 AND (e.deleted_at IS NOT NULL) = $2
 	`
 
 	var expense domain.Expense
-	err := r.pool.QueryRow(ctx, query, id).Scan(
+	err := r.pool.QueryRow(ctx, query, id, deleted).Scan(
 		&expense.ID,
 		&expense.ColocationID,
 		&expense.PaidBy,
+		&expense.CreatedBy,
 		&expense.CategoryID,
 		&expense.Title,
 		&expense.Description,
 		&expense.Amount,
+		&expense.Currency,
+		&expense.ExchangeRate,
+		&expense.BaseAmount,
 		&expense.SplitType,
 		&expense.ExpenseDate,
 		&expense.RecurringID,
+		&expense.ImportRef,
 		&expense.CreatedAt,
+		&expense.DeletedAt,
+		&expense.DeletedBy,
 		&expense.PaidByNom,
 		&expense.PaidByPrenom,
 		&expense.CategoryName,
+		&expense.BaseCurrency,
 	)
 
 	if err == pgx.ErrNoRows {
@@ -109,14 +275,62 @@ func (r *ExpenseRepository) GetByID(ctx context.Context, id string) (*domain.Exp
 	}
 	expense.Splits = splits
 
+	payers, err := r.GetPayers(ctx, expense.ID)
+	if err != nil {
+		return nil, err
+	}
+	expense.Payers = payers
+
+	if expense.SplitType == domain.SplitTypeItemized {
+		items, err := r.GetItems(ctx, expense.ID)
+		if err != nil {
+			return nil, err
+		}
+		expense.Items = items
+	}
+
+	tags, err := r.GetTags(ctx, expense.ID)
+	if err != nil {
+		return nil, err
+	}
+	expense.Tags = tags
+
 	return &expense, nil
 }
 
+// GetTags retrieves the tags of an expense by name
+func (r *ExpenseRepository) GetTags(ctx context.Context, expenseID string) ([]domain.Tag, error) {
+	query := `
+		SELECT t.id, t.colocation_id, t.name, t.color, t.created_by, t.created_at
+		FROM expense_tags et
+		INNER JOIN tags t ON et.tag_id = t.id
+		WHERE et.expense_id = $1
+		ORDER BY LOWER(t.name)
+	`
+
+	rows, err := r.pool.Query(ctx, query, expenseID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des tags: %w", err)
+	}
+	defer rows.Close()
+
+	var tags []domain.Tag
+	for rows.Next() {
+		var t domain.Tag
+		if err := rows.Scan(&t.ID, &t.ColocationID, &t.Name, &t.Color, &t.CreatedBy, &t.CreatedAt); err != nil {
+			return nil, fmt.Errorf("erreur lors du scan du tag: %w", err)
+		}
+		tags = append(tags, t)
+	}
+
+	return tags, rows.Err()
+}
+
 // GetSplits retrieves all splits for an expense
 func (r *ExpenseRepository) GetSplits(ctx context.Context, expenseID string) ([]domain.ExpenseSplit, error) {
 	query := `
-		SELECT es.id, es.expense_id, es.user_id, es.amount, es.percentage, es.is_settled,
-		       u.nom, u.prenom
+		SELECT es.id, es.expense_id, es.user_id, es.amount, es.base_amount, es.percentage, COALESCE(es.shares, 0), es.is_settled,
+		       u.nom, u.prenom, ` + splitSettledAmount + `
 		FROM expense_splits es
 		INNER JOIN users u ON es.user_id = u.id
 		WHERE es.expense_id = $1
@@ -132,8 +346,8 @@ func (r *ExpenseRepository) GetSplits(ctx context.Context, expenseID string) ([]
 	for rows.Next() {
 		var s domain.ExpenseSplit
 		if err := rows.Scan(
-			&s.ID, &s.ExpenseID, &s.UserID, &s.Amount, &s.Percentage, &s.IsSettled,
-			&s.UserNom, &s.UserPrenom,
+			&s.ID, &s.ExpenseID, &s.UserID, &s.Amount, &s.BaseAmount, &s.Percentage, &s.Shares, &s.IsSettled,
+			&s.UserNom, &s.UserPrenom, &s.SettledAmount,
 		); err != nil {
 			return nil, fmt.Errorf("erreur lors du scan du split: %w", err)
 		}
@@ -143,14 +357,91 @@ func (r *ExpenseRepository) GetSplits(ctx context.Context, expenseID string) ([]
 	return splits, rows.Err()
 }
 
-// ListByColocation lists expenses for a colocation with filters
-func (r *ExpenseRepository) ListByColocation(ctx context.Context, colocationID string, categoryID, paidBy *string, startDate, endDate *time.Time, page, pageSize int) ([]domain.Expense, int, error) {
+// GetPayers retrieves the payer contributions of an expense, largest first
+func (r *ExpenseRepository) GetPayers(ctx context.Context, expenseID string) ([]domain.ExpensePayer, error) {
+	query := `
+		SELECT ep.expense_id, ep.user_id, ep.amount, ep.base_amount, u.nom, u.prenom
+		FROM expense_payers ep
+		INNER JOIN users u ON ep.user_id = u.id
+		WHERE ep.expense_id = $1
+		ORDER BY ep.amount DESC, u.prenom, u.nom
+	`
+
+	rows, err := r.pool.Query(ctx, query, expenseID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des payeurs: %w", err)
+	}
+	defer rows.Close()
+
+	var payers []domain.ExpensePayer
+	for rows.Next() {
+		var p domain.ExpensePayer
+		if err := rows.Scan(&p.ExpenseID, &p.UserID, &p.Amount, &p.BaseAmount, &p.UserNom, &p.UserPrenom); err != nil {
+			return nil, fmt.Errorf("erreur lors du scan du payeur: %w", err)
+		}
+		payers = append(payers, p)
+	}
+
+	return payers, rows.Err()
+}
+
+// GetItems retrieves the line items of an expense with their participants
+func (r *ExpenseRepository) GetItems(ctx context.Context, expenseID string) ([]domain.ExpenseItem, error) {
+	query := `
+		SELECT ei.id, ei.expense_id, ei.label, ei.amount, ei.is_shared_charge, ei.position,
+		       p.user_id, p.weight, u.nom, u.prenom
+		FROM expense_items ei
+		LEFT JOIN expense_item_participants p ON p.item_id = ei.id
+		LEFT JOIN users u ON p.user_id = u.id
+		WHERE ei.expense_id = $1
+		ORDER BY ei.position, u.prenom, u.nom
+	`
+
+	rows, err := r.pool.Query(ctx, query, expenseID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des lignes: %w", err)
+	}
+	defer rows.Close()
+
+	var items []domain.ExpenseItem
+	for rows.Next() {
+		var item domain.ExpenseItem
+		var userID, nom, prenom *string
+		var weight *float64
+		if err := rows.Scan(
+			&item.ID, &item.ExpenseID, &item.Label, &item.Amount, &item.IsSharedCharge, &item.Position,
+			&userID, &weight, &nom, &prenom,
+		); err != nil {
+			return nil, fmt.Errorf("erreur lors du scan de la ligne: %w", err)
+		}
+
+		if len(items) == 0 || items[len(items)-1].ID != item.ID {
+			items = append(items, item)
+		}
+		if userID != nil {
+			last := &items[len(items)-1]
+			last.Participants = append(last.Participants, domain.ExpenseItemParticipant{
+				UserID:     *userID,
+				Weight:     *weight,
+				UserNom:    *nom,
+				UserPrenom: *prenom,
+			})
+		}
+	}
+
+	return items, rows.Err()
+}
+
+// ListByColocation lists expenses for a colocation with filters. Expenses
+// with any of tagIDs are kept, or with all of them when matchAllTags is set.
+func (r *ExpenseRepository) ListByColocation(ctx context.Context, colocationID string, categoryID, paidBy *string, startDate, endDate *time.Time, tagIDs []string, matchAllTags bool, page, pageSize int) ([]domain.Expense, int, error) {
 	// Base query
 	baseQuery := `
 		FROM expenses e
 		INNER JOIN users u ON e.paid_by = u.id
 		INNER JOIN expense_categories c ON e.category_id = c.id
-		WHERE e.colocation_id = $1
+		INNER JOIN colocations co ON e.colocation_id = co.id
+		WHERE e.colocation_id = $1 AND e.deleted_at IS NULL
 	`
 
 	args := []interface{}{colocationID}
@@ -163,11 +454,23 @@ func (r *ExpenseRepository) ListByColocation(ctx context.Context, colocationID s
 	}
 
 	if paidBy != nil {
-		baseQuery += fmt.Sprintf(" AND e.paid_by = $%d", argIndex)
+		baseQuery += fmt.Sprintf(" AND EXISTS (SELECT 1 FROM expense_payers ep WHERE ep.expense_id = e.id AND ep.user_id = $%d)", argIndex)
 		args = append(args, *paidBy)
 		argIndex++
 	}
 
+	if len(tagIDs) > 0 {
+		if matchAllTags {
+			baseQuery += fmt.Sprintf(" AND (SELECT COUNT(*) FROM expense_tags et WHERE et.expense_id = e.id AND et.tag_id = ANY($%d)) = $%d", argIndex, argIndex+1)
+			args = append(args, tagIDs, len(tagIDs))
+			argIndex += 2
+		} else {
+			baseQuery += fmt.Sprintf(" AND EXISTS (SELECT 1 FROM expense_tags et WHERE et.expense_id = e.id AND et.tag_id = ANY($%d))", argIndex)
+			args = append(args, tagIDs)
+			argIndex++
+		}
+	}
+
 	if startDate != nil {
 		baseQuery += fmt.Sprintf(" AND e.expense_date >= $%d", argIndex)
 		args = append(args, *startDate)
@@ -190,9 +493,9 @@ func (r *ExpenseRepository) ListByColocation(ctx context.Context, colocationID s
 
 	// Get expenses
 	selectQuery := `
-		SELECT e.id, e.colocation_id, e.paid_by, e.category_id, e.title, e.description,
-		       e.amount, e.split_type, e.expense_date, e.recurring_id, e.created_at,
-		       u.nom, u.prenom, c.name
+		SELECT e.id, e.colocation_id, e.paid_by, COALESCE(e.created_by, e.paid_by), e.category_id, e.title, e.description,
+		       e.amount, e.currency, e.exchange_rate, e.base_amount, e.split_type, e.expense_date, e.recurring_id, e.created_at,
+		       u.nom, u.prenom, c.name, co.base_currency
 	` + baseQuery + fmt.Sprintf(" ORDER BY e.expense_date DESC LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
 
 	args = append(args, pageSize, (page-1)*pageSize)
@@ -207,98 +510,529 @@ func (r *ExpenseRepository) ListByColocation(ctx context.Context, colocationID s
 	for rows.Next() {
 		var e domain.Expense
 		if err := rows.Scan(
-			&e.ID, &e.ColocationID, &e.PaidBy, &e.CategoryID, &e.Title, &e.Description,
-			&e.Amount, &e.SplitType, &e.ExpenseDate, &e.RecurringID, &e.CreatedAt,
-			&e.PaidByNom, &e.PaidByPrenom, &e.CategoryName,
+			&e.ID, &e.ColocationID, &e.PaidBy, &e.CreatedBy, &e.CategoryID, &e.Title, &e.Description,
+			&e.Amount, &e.Currency, &e.ExchangeRate, &e.BaseAmount, &e.SplitType, &e.ExpenseDate, &e.RecurringID, &e.CreatedAt,
+			&e.PaidByNom, &e.PaidByPrenom, &e.CategoryName, &e.BaseCurrency,
 		); err != nil {
 			return nil, 0, fmt.Errorf("erreur lors du scan de la depense: %w", err)
 		}
 
-		// Get splits for each expense
+		// Get splits and payers for each expense
 		splits, err := r.GetSplits(ctx, e.ID)
 		if err != nil {
 			return nil, 0, err
 		}
 		e.Splits = splits
 
+		payers, err := r.GetPayers(ctx, e.ID)
+		if err != nil {
+			return nil, 0, err
+		}
+		e.Payers = payers
+
+		tags, err := r.GetTags(ctx, e.ID)
+		if err != nil {
+			return nil, 0, err
+		}
+		e.Tags = tags
+
 		expenses = append(expenses, e)
 	}
 
 	return expenses, totalCount, rows.Err()
 }
 
-// Update updates an expense and its splits
-func (r *ExpenseRepository) Update(ctx context.Context, expense *domain.Expense, splits []domain.ExpenseSplitInput) error {
+// Update updates an expense and its splits, recording the change made by
+// changedBy in its history
+func (r *ExpenseRepository) Update(ctx context.Context, expense *domain.Expense, splits []domain.ExpenseSplitInput, changedBy string) error {
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("erreur lors du demarrage de la transaction: %w", err)
 	}
 	defer tx.Rollback(ctx)
 
+	if err := updateExpense(ctx, tx, expense, splits); err != nil {
+		return err
+	}
+
+	if err := insertRevision(ctx, tx, expense, splits, domain.RevisionUpdate, &changedBy); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// updateExpense replaces an expense, its payers, its splits and its line
+// items within a transaction. A soft-deleted expense is brought back.
+func updateExpense(ctx context.Context, tx pgx.Tx, expense *domain.Expense, splits []domain.ExpenseSplitInput) error {
 	// Update expense
 	query := `
 		UPDATE expenses
-		SET title = $1, description = $2, amount = $3, category_id = $4, split_type = $5, expense_date = $6
-		WHERE id = $7
+		SET title = $1, description = $2, amount = $3, currency = $4, exchange_rate = $5, base_amount = $6,
+		    category_id = $7, split_type = $8, expense_date = $9, paid_by = $10,
+		    deleted_at = NULL, deleted_by = NULL
+		WHERE id = $11
 	`
 
-	_, err = tx.Exec(ctx, query,
+	_, err := tx.Exec(ctx, query,
 		expense.Title,
 		expense.Description,
 		expense.Amount,
+		expense.Currency,
+		expense.ExchangeRate,
+		expense.BaseAmount,
 		expense.CategoryID,
 		expense.SplitType,
 		expense.ExpenseDate,
+		expense.PaidBy,
 		expense.ID,
 	)
 	if err != nil {
 		return fmt.Errorf("erreur lors de la mise a jour de la depense: %w", err)
 	}
 
+	// Replace the payers
+	_, err = tx.Exec(ctx, "DELETE FROM expense_payers WHERE expense_id = $1", expense.ID)
+	if err != nil {
+		return fmt.Errorf("erreur lors de la suppression des payeurs: %w", err)
+	}
+
+	if err := insertPayers(ctx, tx, expense.ID, expense.Payers); err != nil {
+		return err
+	}
+
 	// Delete old splits and insert new ones
 	_, err = tx.Exec(ctx, "DELETE FROM expense_splits WHERE expense_id = $1", expense.ID)
 	if err != nil {
 		return fmt.Errorf("erreur lors de la suppression des splits: %w", err)
 	}
 
-	for _, split := range splits {
-		splitQuery := `
-			INSERT INTO expense_splits (expense_id, user_id, amount, percentage)
-			VALUES ($1, $2, $3, $4)
-		`
-		_, err = tx.Exec(ctx, splitQuery, expense.ID, split.UserID, split.Amount, split.Percentage)
-		if err != nil {
-			return fmt.Errorf("erreur lors de la creation du split: %w", err)
-		}
+	if err := insertSplits(ctx, tx, expense.ID, splits); err != nil {
+		return err
 	}
 
-	return tx.Commit(ctx)
+	// Same for the line items, participants are deleted by CASCADE
+	_, err = tx.Exec(ctx, "DELETE FROM expense_items WHERE expense_id = $1", expense.ID)
+	if err != nil {
+		return fmt.Errorf("erreur lors de la suppression des lignes: %w", err)
+	}
+
+	if err := insertItems(ctx, tx, expense.ID, expense.Items); err != nil {
+		return err
+	}
+
+	// Tags are not part of the history, they are kept unless replaced
+	if expense.Tags == nil {
+		return nil
+	}
+
+	_, err = tx.Exec(ctx, "DELETE FROM expense_tags WHERE expense_id = $1", expense.ID)
+	if err != nil {
+		return fmt.Errorf("erreur lors de la suppression des tags: %w", err)
+	}
+
+	return insertTags(ctx, tx, expense.ID, expense.Tags)
 }
 
-// Delete deletes an expense and its splits
-func (r *ExpenseRepository) Delete(ctx context.Context, id string) error {
-	// Splits are deleted by CASCADE
-	query := `DELETE FROM expenses WHERE id = $1`
-	result, err := r.pool.Exec(ctx, query, id)
+// Delete soft-deletes an expense, which stays restorable until it is purged.
+// Its last state is kept in its history with the change made by changedBy.
+func (r *ExpenseRepository) Delete(ctx context.Context, expense *domain.Expense, changedBy string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("erreur lors du demarrage de la transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE expenses SET deleted_at = NOW(), deleted_by = $2
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING deleted_at
+	`
+	err = tx.QueryRow(ctx, query, expense.ID, changedBy).Scan(&expense.DeletedAt)
+	if err == pgx.ErrNoRows {
+		return fmt.Errorf("depense introuvable")
+	}
 	if err != nil {
 		return fmt.Errorf("erreur lors de la suppression de la depense: %w", err)
 	}
+	expense.DeletedBy = &changedBy
+
+	if err := insertRevision(ctx, tx, expense, expense.SplitInputs(), domain.RevisionDelete, &changedBy); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Undelete brings back a soft-deleted expense as it was when deleted and
+// records the change made by changedBy in its history
+func (r *ExpenseRepository) Undelete(ctx context.Context, expense *domain.Expense, changedBy string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("erreur lors du demarrage de la transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `UPDATE expenses SET deleted_at = NULL, deleted_by = NULL WHERE id = $1 AND deleted_at IS NOT NULL`
+	result, err := tx.Exec(ctx, query, expense.ID)
+	if err != nil {
+		return fmt.Errorf("erreur lors de la restauration de la depense: %w", err)
+	}
 
 	if result.RowsAffected() == 0 {
 		return fmt.Errorf("depense introuvable")
 	}
 
-	return nil
+	if err := insertRevision(ctx, tx, expense, expense.SplitInputs(), domain.RevisionRestore, &changedBy); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ListDeleted lists the soft-deleted expenses of a colocation deleted on or
+// after since, most recently deleted first
+func (r *ExpenseRepository) ListDeleted(ctx context.Context, colocationID string, since time.Time) ([]domain.Expense, error) {
+	query := `
+		SELECT e.id, e.colocation_id, e.paid_by, COALESCE(e.created_by, e.paid_by), e.category_id, e.title, e.description,
+		       e.amount, e.currency, e.exchange_rate, e.base_amount, e.split_type, e.expense_date, e.recurring_id, e.created_at,
+		       e.deleted_at, e.deleted_by, u.nom, u.prenom, c.name, co.base_currency
+		FROM expenses e
+		INNER JOIN users u ON e.paid_by = u.id
+		INNER JOIN expense_categories c ON e.category_id = c.id
+		INNER JOIN colocations co ON e.colocation_id = co.id
+		WHERE e.colocation_id = $1 AND e.deleted_at >= $2
+		ORDER BY e.deleted_at DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, colocationID, since)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des depenses supprimees: %w", err)
+	}
+	defer rows.Close()
+
+	var expenses []domain.Expense
+	for rows.Next() {
+		var e domain.Expense
+		if err := rows.Scan(
+			&e.ID, &e.ColocationID, &e.PaidBy, &e.CreatedBy, &e.CategoryID, &e.Title, &e.Description,
+			&e.Amount, &e.Currency, &e.ExchangeRate, &e.BaseAmount, &e.SplitType, &e.ExpenseDate, &e.RecurringID, &e.CreatedAt,
+			&e.DeletedAt, &e.DeletedBy, &e.PaidByNom, &e.PaidByPrenom, &e.CategoryName, &e.BaseCurrency,
+		); err != nil {
+			return nil, fmt.Errorf("erreur lors du scan de la depense: %w", err)
+		}
+		expenses = append(expenses, e)
+	}
+
+	return expenses, rows.Err()
+}
+
+// PurgeDeleted hard-deletes the expenses soft-deleted before a date, with
+// their splits and history. It returns the attachments of the purged
+// expenses so that their files can be removed.
+func (r *ExpenseRepository) PurgeDeleted(ctx context.Context, before time.Time) (int, []domain.ExpenseAttachment, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, nil, fmt.Errorf("erreur lors du demarrage de la transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	attachments, err := listAttachmentFiles(ctx, tx, "e.deleted_at < $1", before)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// Splits, payers, items and attachments are deleted by CASCADE
+	_, err = tx.Exec(ctx, `
+		DELETE FROM expense_revisions
+		WHERE expense_id IN (SELECT id FROM expenses WHERE deleted_at < $1)
+	`, before)
+	if err != nil {
+		return 0, nil, fmt.Errorf("erreur lors de la purge de l'historique: %w", err)
+	}
+
+	result, err := tx.Exec(ctx, "DELETE FROM expenses WHERE deleted_at < $1", before)
+	if err != nil {
+		return 0, nil, fmt.Errorf("erreur lors de la purge des depenses: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, nil, err
+	}
+
+	return int(result.RowsAffected()), attachments, nil
+}
+
+// Restore brings an expense back to an earlier state, recreating it when it
+// was deleted, and records the change made by changedBy in its history
+func (r *ExpenseRepository) Restore(ctx context.Context, expense *domain.Expense, splits []domain.ExpenseSplitInput, changedBy string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("erreur lors du demarrage de la transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var exists bool
+	err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM expenses WHERE id = $1)", expense.ID).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("erreur lors de la verification de la depense: %w", err)
+	}
+
+	if !exists {
+		if err := insertExpense(ctx, tx, expense, splits, domain.RevisionRestore, &changedBy); err != nil {
+			return err
+		}
+		return tx.Commit(ctx)
+	}
+
+	if err := updateExpense(ctx, tx, expense, splits); err != nil {
+		return err
+	}
+
+	if err := insertRevision(ctx, tx, expense, splits, domain.RevisionRestore, &changedBy); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ListRevisions lists the history of an expense, oldest first
+func (r *ExpenseRepository) ListRevisions(ctx context.Context, expenseID string) ([]domain.ExpenseRevision, error) {
+	query := `
+		SELECT er.id, er.expense_id, er.colocation_id, er.revision, er.action, er.changed_by, er.snapshot, er.created_at,
+		       COALESCE(u.nom, ''), COALESCE(u.prenom, '')
+		FROM expense_revisions er
+		LEFT JOIN users u ON er.changed_by = u.id
+		WHERE er.expense_id = $1
+		ORDER BY er.revision ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, expenseID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation de l'historique: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []domain.ExpenseRevision
+	for rows.Next() {
+		var rev domain.ExpenseRevision
+		var snapshotJSON []byte
+		if err := rows.Scan(
+			&rev.ID, &rev.ExpenseID, &rev.ColocationID, &rev.Revision, &rev.Action, &rev.ChangedBy, &snapshotJSON, &rev.CreatedAt,
+			&rev.ChangedByNom, &rev.ChangedByPrenom,
+		); err != nil {
+			return nil, fmt.Errorf("erreur lors du scan de la version: %w", err)
+		}
+		if err := json.Unmarshal(snapshotJSON, &rev.Snapshot); err != nil {
+			return nil, fmt.Errorf("erreur lors du decodage de la version: %w", err)
+		}
+		revisions = append(revisions, rev)
+	}
+
+	return revisions, rows.Err()
+}
+
+// GetRevision retrieves a revision of an expense by number
+func (r *ExpenseRepository) GetRevision(ctx context.Context, expenseID string, revision int) (*domain.ExpenseRevision, error) {
+	query := `
+		SELECT er.id, er.expense_id, er.colocation_id, er.revision, er.action, er.changed_by, er.snapshot, er.created_at,
+		       COALESCE(u.nom, ''), COALESCE(u.prenom, '')
+		FROM expense_revisions er
+		LEFT JOIN users u ON er.changed_by = u.id
+		WHERE er.expense_id = $1 AND er.revision = $2
+	`
+
+	var rev domain.ExpenseRevision
+	var snapshotJSON []byte
+	err := r.pool.QueryRow(ctx, query, expenseID, revision).Scan(
+		&rev.ID, &rev.ExpenseID, &rev.ColocationID, &rev.Revision, &rev.Action, &rev.ChangedBy, &snapshotJSON, &rev.CreatedAt,
+		&rev.ChangedByNom, &rev.ChangedByPrenom,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation de la version: %w", err)
+	}
+
+	if err := json.Unmarshal(snapshotJSON, &rev.Snapshot); err != nil {
+		return nil, fmt.Errorf("erreur lors du decodage de la version: %w", err)
+	}
+
+	return &rev, nil
 }
 
 // BelongsToColocation checks if an expense belongs to a colocation
 func (r *ExpenseRepository) BelongsToColocation(ctx context.Context, expenseID, colocationID string) (bool, error) {
-	query := `SELECT EXISTS(SELECT 1 FROM expenses WHERE id = $1 AND colocation_id = $2)`
+	query := `SELECT EXISTS(SELECT 1 FROM expenses WHERE id = $1 AND colocation_id = $2 AND deleted_at IS NULL)`
 	var exists bool
 	err := r.pool.QueryRow(ctx, query, expenseID, colocationID).Scan(&exists)
 	return exists, err
 }
 
+// ListForExport lists all the expenses of a colocation between two optional
+// dates, oldest first, with their splits and payers
+func (r *ExpenseRepository) ListForExport(ctx context.Context, colocationID string, startDate, endDate *time.Time) ([]domain.Expense, error) {
+	query := `
+		SELECT e.id, e.colocation_id, e.paid_by, COALESCE(e.created_by, e.paid_by), e.category_id, e.title, e.description,
+		       e.amount, e.currency, e.exchange_rate, e.base_amount, e.split_type, e.expense_date, e.recurring_id, e.import_ref, e.created_at,
+		       u.nom, u.prenom, c.name, co.base_currency
+		FROM expenses e
+		INNER JOIN users u ON e.paid_by = u.id
+		INNER JOIN expense_categories c ON e.category_id = c.id
+		INNER JOIN colocations co ON e.colocation_id = co.id
+		WHERE e.colocation_id = $1 AND e.deleted_at IS NULL
+			AND ($2::timestamp IS NULL OR e.expense_date >= $2)
+			AND ($3::timestamp IS NULL OR e.expense_date <= $3)
+		ORDER BY e.expense_date ASC, e.created_at ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, colocationID, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des depenses: %w", err)
+	}
+	defer rows.Close()
+
+	var expenses []domain.Expense
+	index := make(map[string]int)
+	for rows.Next() {
+		var e domain.Expense
+		if err := rows.Scan(
+			&e.ID, &e.ColocationID, &e.PaidBy, &e.CreatedBy, &e.CategoryID, &e.Title, &e.Description,
+			&e.Amount, &e.Currency, &e.ExchangeRate, &e.BaseAmount, &e.SplitType, &e.ExpenseDate, &e.RecurringID, &e.ImportRef, &e.CreatedAt,
+			&e.PaidByNom, &e.PaidByPrenom, &e.CategoryName, &e.BaseCurrency,
+		); err != nil {
+			return nil, fmt.Errorf("erreur lors du scan de la depense: %w", err)
+		}
+		index[e.ID] = len(expenses)
+		expenses = append(expenses, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(expenses) == 0 {
+		return expenses, nil
+	}
+
+	// Splits of all the expenses in a single query
+	ids := make([]string, len(expenses))
+	for i, e := range expenses {
+		ids[i] = e.ID
+	}
+	splitRows, err := r.pool.Query(ctx, `
+		SELECT es.id, es.expense_id, es.user_id, es.amount, es.base_amount, es.percentage, COALESCE(es.shares, 0), es.is_settled,
+		       u.nom, u.prenom, `+splitSettledAmount+`
+		FROM expense_splits es
+		INNER JOIN users u ON es.user_id = u.id
+		WHERE es.expense_id = ANY($1)
+		ORDER BY u.prenom, u.nom
+	`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des splits: %w", err)
+	}
+	defer splitRows.Close()
+
+	for splitRows.Next() {
+		var s domain.ExpenseSplit
+		if err := splitRows.Scan(
+			&s.ID, &s.ExpenseID, &s.UserID, &s.Amount, &s.BaseAmount, &s.Percentage, &s.Shares, &s.IsSettled,
+			&s.UserNom, &s.UserPrenom, &s.SettledAmount,
+		); err != nil {
+			return nil, fmt.Errorf("erreur lors du scan du split: %w", err)
+		}
+		e := &expenses[index[s.ExpenseID]]
+		e.Splits = append(e.Splits, s)
+	}
+	if err := splitRows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Same for the payers
+	payerRows, err := r.pool.Query(ctx, `
+		SELECT ep.expense_id, ep.user_id, ep.amount, ep.base_amount, u.nom, u.prenom
+		FROM expense_payers ep
+		INNER JOIN users u ON ep.user_id = u.id
+		WHERE ep.expense_id = ANY($1)
+		ORDER BY ep.amount DESC, u.prenom, u.nom
+	`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des payeurs: %w", err)
+	}
+	defer payerRows.Close()
+
+	for payerRows.Next() {
+		var p domain.ExpensePayer
+		if err := payerRows.Scan(&p.ExpenseID, &p.UserID, &p.Amount, &p.BaseAmount, &p.UserNom, &p.UserPrenom); err != nil {
+			return nil, fmt.Errorf("erreur lors du scan du payeur: %w", err)
+		}
+		e := &expenses[index[p.ExpenseID]]
+		e.Payers = append(e.Payers, p)
+	}
+
+	return expenses, payerRows.Err()
+}
+
+// ListSince lists the expenses of a colocation dated on or after since,
+// without their splits. Used to detect duplicates and suggest categories when
+// importing a bank statement.
+func (r *ExpenseRepository) ListSince(ctx context.Context, colocationID string, since time.Time) ([]domain.Expense, error) {
+	query := `
+		SELECT e.id, e.colocation_id, e.paid_by, e.category_id, e.title, e.amount, e.currency,
+		       e.expense_date, e.import_ref, c.name
+		FROM expenses e
+		INNER JOIN expense_categories c ON e.category_id = c.id
+		WHERE e.colocation_id = $1 AND e.expense_date >= $2 AND e.deleted_at IS NULL
+		ORDER BY e.expense_date DESC, e.created_at DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, colocationID, since)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des depenses: %w", err)
+	}
+	defer rows.Close()
+
+	var expenses []domain.Expense
+	for rows.Next() {
+		var e domain.Expense
+		if err := rows.Scan(
+			&e.ID, &e.ColocationID, &e.PaidBy, &e.CategoryID, &e.Title, &e.Amount, &e.Currency,
+			&e.ExpenseDate, &e.ImportRef, &e.CategoryName,
+		); err != nil {
+			return nil, fmt.Errorf("erreur lors du scan de la depense: %w", err)
+		}
+		expenses = append(expenses, e)
+	}
+
+	return expenses, rows.Err()
+}
+
+// GetByImportRefs returns the IDs of the expenses already imported from the
+// given statement references, keyed by reference
+func (r *ExpenseRepository) GetByImportRefs(ctx context.Context, colocationID string, refs []string) (map[string]string, error) {
+	query := `
+		SELECT import_ref, id
+		FROM expenses
+		WHERE colocation_id = $1 AND import_ref = ANY($2)
+	`
+
+	rows, err := r.pool.Query(ctx, query, colocationID, refs)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recherche des imports: %w", err)
+	}
+	defer rows.Close()
+
+	imported := make(map[string]string)
+	for rows.Next() {
+		var ref, id string
+		if err := rows.Scan(&ref, &id); err != nil {
+			return nil, fmt.Errorf("erreur lors du scan de l'import: %w", err)
+		}
+		imported[ref] = id
+	}
+
+	return imported, rows.Err()
+}
+
 // CreateRecurring creates a recurring expense template
 func (r *ExpenseRepository) CreateRecurring(ctx context.Context, recurring *domain.RecurringExpense, splits []domain.ExpenseSplitInput) error {
 	tx, err := r.pool.Begin(ctx)
@@ -330,13 +1064,13 @@ func (r *ExpenseRepository) CreateRecurring(ctx context.Context, recurring *doma
 		return fmt.Errorf("erreur lors de la creation de la depense recurrente: %w", err)
 	}
 
-	// Insert splits (percentages only for recurring)
+	// Insert splits (percentages and shares only for recurring)
 	for _, split := range splits {
 		splitQuery := `
-			INSERT INTO recurring_expense_splits (recurring_id, user_id, percentage)
-			VALUES ($1, $2, $3)
+			INSERT INTO recurring_expense_splits (recurring_id, user_id, percentage, shares)
+			VALUES ($1, $2, $3, NULLIF($4, 0))
 		`
-		_, err = tx.Exec(ctx, splitQuery, recurring.ID, split.UserID, split.Percentage)
+		_, err = tx.Exec(ctx, splitQuery, recurring.ID, split.UserID, split.Percentage, split.Shares)
 		if err != nil {
 			return fmt.Errorf("erreur lors de la creation du split recurrent: %w", err)
 		}
@@ -349,19 +1083,20 @@ func (r *ExpenseRepository) CreateRecurring(ctx context.Context, recurring *doma
 func (r *ExpenseRepository) GetRecurringByID(ctx context.Context, id string) (*domain.RecurringExpense, error) {
 	query := `
 		SELECT re.id, re.colocation_id, re.paid_by, re.category_id, re.title, re.description,
-		       re.amount, re.split_type, re.recurrence, re.next_due_date, re.end_date, re.is_active, re.created_at,
-		       u.nom, u.prenom, c.name
+		       re.amount, re.split_type, re.recurrence, re.next_due_date, re.end_date, re.is_active, re.paused_until, re.created_at,
+		       u.nom, u.prenom, c.name, co.base_currency
 		FROM recurring_expenses re
 		INNER JOIN users u ON re.paid_by = u.id
 		INNER JOIN expense_categories c ON re.category_id = c.id
+		INNER JOIN colocations co ON re.colocation_id = co.id
 		WHERE re.id = $1
 	`
 
 	var re domain.RecurringExpense
 	err := r.pool.QueryRow(ctx, query, id).Scan(
 		&re.ID, &re.ColocationID, &re.PaidBy, &re.CategoryID, &re.Title, &re.Description,
-		&re.Amount, &re.SplitType, &re.Recurrence, &re.NextDueDate, &re.EndDate, &re.IsActive, &re.CreatedAt,
-		&re.PaidByNom, &re.PaidByPrenom, &re.CategoryName,
+		&re.Amount, &re.SplitType, &re.Recurrence, &re.NextDueDate, &re.EndDate, &re.IsActive, &re.PausedUntil, &re.CreatedAt,
+		&re.PaidByNom, &re.PaidByPrenom, &re.CategoryName, &re.Currency,
 	)
 
 	if err == pgx.ErrNoRows {
@@ -378,13 +1113,86 @@ func (r *ExpenseRepository) GetRecurringByID(ctx context.Context, id string) (*d
 	}
 	re.Splits = splits
 
+	overrides, err := r.GetRecurringOverrides(ctx, re.ID, re.NextDueDate)
+	if err != nil {
+		return nil, err
+	}
+	re.Overrides = overrides
+
 	return &re, nil
 }
 
+// GetRecurringOverrides retrieves the overrides of the occurrences of a
+// recurring expense from a date
+func (r *ExpenseRepository) GetRecurringOverrides(ctx context.Context, recurringID string, since time.Time) ([]domain.RecurringOccurrenceOverride, error) {
+	query := `
+		SELECT recurring_id, occurrence_date, skip, amount, paid_by, created_by, created_at
+		FROM recurring_occurrence_overrides
+		WHERE recurring_id = $1 AND occurrence_date >= $2
+		ORDER BY occurrence_date
+	`
+
+	rows, err := r.pool.Query(ctx, query, recurringID, since)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des modifications d'occurrences: %w", err)
+	}
+	defer rows.Close()
+
+	var overrides []domain.RecurringOccurrenceOverride
+	for rows.Next() {
+		var o domain.RecurringOccurrenceOverride
+		if err := rows.Scan(&o.RecurringID, &o.OccurrenceDate, &o.Skip, &o.Amount, &o.PaidBy, &o.CreatedBy, &o.CreatedAt); err != nil {
+			return nil, fmt.Errorf("erreur lors du scan: %w", err)
+		}
+		overrides = append(overrides, o)
+	}
+
+	return overrides, rows.Err()
+}
+
+// SetRecurringOverride creates or replaces the override of an occurrence
+func (r *ExpenseRepository) SetRecurringOverride(ctx context.Context, o *domain.RecurringOccurrenceOverride) error {
+	query := `
+		INSERT INTO recurring_occurrence_overrides (recurring_id, occurrence_date, skip, amount, paid_by, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (recurring_id, occurrence_date) DO UPDATE
+		SET skip = EXCLUDED.skip, amount = EXCLUDED.amount, paid_by = EXCLUDED.paid_by,
+		    created_by = EXCLUDED.created_by, created_at = NOW()
+		RETURNING created_at
+	`
+
+	err := r.pool.QueryRow(ctx, query, o.RecurringID, o.OccurrenceDate, o.Skip, o.Amount, o.PaidBy, o.CreatedBy).Scan(&o.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("erreur lors de la modification de l'occurrence: %w", err)
+	}
+	return nil
+}
+
+// DeleteRecurringOverride removes the override of an occurrence
+func (r *ExpenseRepository) DeleteRecurringOverride(ctx context.Context, recurringID string, occurrenceDate time.Time) error {
+	_, err := r.pool.Exec(ctx,
+		"DELETE FROM recurring_occurrence_overrides WHERE recurring_id = $1 AND occurrence_date = $2",
+		recurringID, occurrenceDate,
+	)
+	if err != nil {
+		return fmt.Errorf("erreur lors de la suppression de la modification: %w", err)
+	}
+	return nil
+}
+
+// SetRecurringPause pauses a recurring expense until a date, or resumes it
+// when until is nil
+func (r *ExpenseRepository) SetRecurringPause(ctx context.Context, recurringID string, until *time.Time) error {
+	if _, err := r.pool.Exec(ctx, "UPDATE recurring_expenses SET paused_until = $1 WHERE id = $2", until, recurringID); err != nil {
+		return fmt.Errorf("erreur lors de la mise en pause: %w", err)
+	}
+	return nil
+}
+
 // GetRecurringSplits retrieves all splits for a recurring expense
 func (r *ExpenseRepository) GetRecurringSplits(ctx context.Context, recurringID string) ([]domain.RecurringExpenseSplit, error) {
 	query := `
-		SELECT res.id, res.recurring_id, res.user_id, res.percentage, u.nom, u.prenom
+		SELECT res.id, res.recurring_id, res.user_id, res.percentage, COALESCE(res.shares, 0), u.nom, u.prenom
 		FROM recurring_expense_splits res
 		INNER JOIN users u ON res.user_id = u.id
 		WHERE res.recurring_id = $1
@@ -399,7 +1207,7 @@ func (r *ExpenseRepository) GetRecurringSplits(ctx context.Context, recurringID
 	var splits []domain.RecurringExpenseSplit
 	for rows.Next() {
 		var s domain.RecurringExpenseSplit
-		if err := rows.Scan(&s.ID, &s.RecurringID, &s.UserID, &s.Percentage, &s.UserNom, &s.UserPrenom); err != nil {
+		if err := rows.Scan(&s.ID, &s.RecurringID, &s.UserID, &s.Percentage, &s.Shares, &s.UserNom, &s.UserPrenom); err != nil {
 			return nil, fmt.Errorf("erreur lors du scan du split: %w", err)
 		}
 		splits = append(splits, s)
@@ -412,11 +1220,12 @@ func (r *ExpenseRepository) GetRecurringSplits(ctx context.Context, recurringID
 func (r *ExpenseRepository) ListRecurringByColocation(ctx context.Context, colocationID string) ([]domain.RecurringExpense, error) {
 	query := `
 		SELECT re.id, re.colocation_id, re.paid_by, re.category_id, re.title, re.description,
-		       re.amount, re.split_type, re.recurrence, re.next_due_date, re.end_date, re.is_active, re.created_at,
-		       u.nom, u.prenom, c.name
+		       re.amount, re.split_type, re.recurrence, re.next_due_date, re.end_date, re.is_active, re.paused_until, re.created_at,
+		       u.nom, u.prenom, c.name, co.base_currency
 		FROM recurring_expenses re
 		INNER JOIN users u ON re.paid_by = u.id
 		INNER JOIN expense_categories c ON re.category_id = c.id
+		INNER JOIN colocations co ON re.colocation_id = co.id
 		WHERE re.colocation_id = $1
 		ORDER BY re.created_at DESC
 	`
@@ -432,8 +1241,8 @@ func (r *ExpenseRepository) ListRecurringByColocation(ctx context.Context, coloc
 		var re domain.RecurringExpense
 		if err := rows.Scan(
 			&re.ID, &re.ColocationID, &re.PaidBy, &re.CategoryID, &re.Title, &re.Description,
-			&re.Amount, &re.SplitType, &re.Recurrence, &re.NextDueDate, &re.EndDate, &re.IsActive, &re.CreatedAt,
-			&re.PaidByNom, &re.PaidByPrenom, &re.CategoryName,
+			&re.Amount, &re.SplitType, &re.Recurrence, &re.NextDueDate, &re.EndDate, &re.IsActive, &re.PausedUntil, &re.CreatedAt,
+			&re.PaidByNom, &re.PaidByPrenom, &re.CategoryName, &re.Currency,
 		); err != nil {
 			return nil, fmt.Errorf("erreur lors du scan: %w", err)
 		}
@@ -445,6 +1254,12 @@ func (r *ExpenseRepository) ListRecurringByColocation(ctx context.Context, coloc
 		}
 		re.Splits = splits
 
+		overrides, err := r.GetRecurringOverrides(ctx, re.ID, re.NextDueDate)
+		if err != nil {
+			return nil, err
+		}
+		re.Overrides = overrides
+
 		recurrings = append(recurrings, re)
 	}
 
@@ -489,10 +1304,10 @@ func (r *ExpenseRepository) UpdateRecurring(ctx context.Context, recurring *doma
 
 		for _, split := range splits {
 			splitQuery := `
-				INSERT INTO recurring_expense_splits (recurring_id, user_id, percentage)
-				VALUES ($1, $2, $3)
+				INSERT INTO recurring_expense_splits (recurring_id, user_id, percentage, shares)
+				VALUES ($1, $2, $3, NULLIF($4, 0))
 			`
-			_, err = tx.Exec(ctx, splitQuery, recurring.ID, split.UserID, split.Percentage)
+			_, err = tx.Exec(ctx, splitQuery, recurring.ID, split.UserID, split.Percentage, split.Shares)
 			if err != nil {
 				return fmt.Errorf("erreur lors de la creation du split: %w", err)
 			}
@@ -529,13 +1344,14 @@ func (r *ExpenseRepository) RecurringBelongsToColocation(ctx context.Context, re
 func (r *ExpenseRepository) GetActiveRecurringDue(ctx context.Context, dueDate time.Time) ([]domain.RecurringExpense, error) {
 	query := `
 		SELECT re.id, re.colocation_id, re.paid_by, re.category_id, re.title, re.description,
-		       re.amount, re.split_type, re.recurrence, re.next_due_date, re.end_date, re.is_active, re.created_at,
-		       u.nom, u.prenom, c.name
+		       re.amount, re.split_type, re.recurrence, re.next_due_date, re.end_date, re.is_active, re.paused_until, re.created_at,
+		       u.nom, u.prenom, c.name, co.base_currency
 		FROM recurring_expenses re
 		INNER JOIN users u ON re.paid_by = u.id
 		INNER JOIN expense_categories c ON re.category_id = c.id
+		INNER JOIN colocations co ON re.colocation_id = co.id
 		WHERE re.is_active = true AND re.next_due_date <= $1
-		  AND (re.end_date IS NULL OR re.end_date >= $1)
+		  AND (re.end_date IS NULL OR re.end_date >= re.next_due_date)
 	`
 
 	rows, err := r.pool.Query(ctx, query, dueDate)
@@ -549,8 +1365,8 @@ func (r *ExpenseRepository) GetActiveRecurringDue(ctx context.Context, dueDate t
 		var re domain.RecurringExpense
 		if err := rows.Scan(
 			&re.ID, &re.ColocationID, &re.PaidBy, &re.CategoryID, &re.Title, &re.Description,
-			&re.Amount, &re.SplitType, &re.Recurrence, &re.NextDueDate, &re.EndDate, &re.IsActive, &re.CreatedAt,
-			&re.PaidByNom, &re.PaidByPrenom, &re.CategoryName,
+			&re.Amount, &re.SplitType, &re.Recurrence, &re.NextDueDate, &re.EndDate, &re.IsActive, &re.PausedUntil, &re.CreatedAt,
+			&re.PaidByNom, &re.PaidByPrenom, &re.CategoryName, &re.Currency,
 		); err != nil {
 			return nil, err
 		}
@@ -561,64 +1377,141 @@ func (r *ExpenseRepository) GetActiveRecurringDue(ctx context.Context, dueDate t
 		}
 		re.Splits = splits
 
+		overrides, err := r.GetRecurringOverrides(ctx, re.ID, re.NextDueDate)
+		if err != nil {
+			return nil, err
+		}
+		re.Overrides = overrides
+
 		recurrings = append(recurrings, re)
 	}
 
 	return recurrings, rows.Err()
 }
 
-// UpdateNextDueDate updates the next due date for a recurring expense
-func (r *ExpenseRepository) UpdateNextDueDate(ctx context.Context, id string, nextDueDate time.Time) error {
-	query := `UPDATE recurring_expenses SET next_due_date = $1 WHERE id = $2`
-	_, err := r.pool.Exec(ctx, query, nextDueDate, id)
-	return err
-}
+// CreateFromRecurring creates the expense for one occurrence of a recurring
+// template and advances its next due date in the same transaction.
+// The template row is locked so that concurrent server instances cannot
+// generate the same occurrence twice; nil is returned when the occurrence
+// was already generated, or is skipped or paused.
+func (r *ExpenseRepository) CreateFromRecurring(ctx context.Context, recurring *domain.RecurringExpense, occurrence domain.RecurringOccurrence, nextDueDate time.Time) (*domain.Expense, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors du demarrage de la transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var currentDueDate time.Time
+	err = tx.QueryRow(ctx,
+		"SELECT next_due_date FROM recurring_expenses WHERE id = $1 FOR UPDATE",
+		recurring.ID,
+	).Scan(&currentDueDate)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors du verrouillage de la recurrence: %w", err)
+	}
 
-// CreateFromRecurring creates an expense from a recurring template
-func (r *ExpenseRepository) CreateFromRecurring(ctx context.Context, recurring *domain.RecurringExpense) (*domain.Expense, error) {
-	expense := &domain.Expense{
-		ColocationID: recurring.ColocationID,
-		PaidBy:       recurring.PaidBy,
-		CategoryID:   recurring.CategoryID,
-		Title:        recurring.Title,
-		Description:  recurring.Description,
-		Amount:       recurring.Amount,
-		SplitType:    recurring.SplitType,
-		ExpenseDate:  recurring.NextDueDate,
-		RecurringID:  &recurring.ID,
+	occurrenceDate := occurrence.Date
+
+	// Another instance already processed this occurrence
+	if !currentDueDate.Equal(occurrenceDate) {
+		return nil, nil
 	}
 
-	// Convert recurring splits to expense splits
-	var splits []domain.ExpenseSplitInput
-	for _, rs := range recurring.Splits {
-		splits = append(splits, domain.ExpenseSplitInput{
-			UserID:     rs.UserID,
-			Percentage: rs.Percentage,
-			Amount:     recurring.Amount * rs.Percentage / 100,
-		})
+	var exists bool
+	err = tx.QueryRow(ctx,
+		"SELECT EXISTS(SELECT 1 FROM expenses WHERE recurring_id = $1 AND expense_date = $2)",
+		recurring.ID, occurrenceDate,
+	).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la verification de l'occurrence: %w", err)
 	}
 
-	if err := r.Create(ctx, expense, splits); err != nil {
+	var expense *domain.Expense
+	if !exists && occurrence.Status == domain.OccurrenceScheduled {
+		// Recurring templates are expressed in the base currency
+		var baseCurrency string
+		if err := tx.QueryRow(ctx,
+			"SELECT base_currency FROM colocations WHERE id = $1",
+			recurring.ColocationID,
+		).Scan(&baseCurrency); err != nil {
+			return nil, fmt.Errorf("erreur lors de la recuperation de la devise: %w", err)
+		}
+
+		expense = &domain.Expense{
+			ColocationID: recurring.ColocationID,
+			PaidBy:       occurrence.PaidBy,
+			CategoryID:   recurring.CategoryID,
+			Title:        recurring.Title,
+			Description:  recurring.Description,
+			Amount:       occurrence.Amount,
+			Currency:     baseCurrency,
+			ExchangeRate: domain.RateOne,
+			BaseAmount:   occurrence.Amount,
+			SplitType:    recurring.SplitType,
+			ExpenseDate:  occurrenceDate,
+			RecurringID:  &recurring.ID,
+		}
+
+		// Convert recurring splits to expense splits, keeping the exact total.
+		// Shares are exact where percentages are rounded.
+		weights := make([]float64, len(recurring.Splits))
+		for i, rs := range recurring.Splits {
+			weights[i] = rs.Percentage
+			if rs.Shares > 0 {
+				weights[i] = float64(rs.Shares)
+			}
+		}
+		amounts := occurrence.Amount.Allocate(weights)
+
+		var splits []domain.ExpenseSplitInput
+		for i, rs := range recurring.Splits {
+			splits = append(splits, domain.ExpenseSplitInput{
+				UserID:     rs.UserID,
+				Percentage: rs.Percentage,
+				Shares:     rs.Shares,
+				Amount:     amounts[i],
+				BaseAmount: amounts[i],
+			})
+		}
+
+		// Generated by the scheduler, not by a member
+		if err := insertExpense(ctx, tx, expense, splits, domain.RevisionCreate, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := tx.Exec(ctx,
+		"UPDATE recurring_expenses SET next_due_date = $1 WHERE id = $2",
+		nextDueDate, recurring.ID,
+	); err != nil {
+		return nil, fmt.Errorf("erreur lors de la mise a jour de l'echeance: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
 		return nil, err
 	}
 
 	return expense, nil
 }
 
-// GetForecastData returns data needed for expense forecast
+// GetForecastData returns data needed for expense forecast: the recurring
+// occurrences of each month plus the average of recent one-off expenses
 func (r *ExpenseRepository) GetForecastData(ctx context.Context, colocationID string, months int) ([]domain.MonthlyForecast, error) {
-	// Get recurring expenses for forecast
-	recurrings, err := r.ListRecurringByColocation(ctx, colocationID)
+	forecasts, err := r.GetRecurringForecast(ctx, colocationID, months)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get historical monthly averages by category
 	query := `
-		SELECT c.id, c.name, AVG(e.amount) as avg_amount
+		SELECT c.id, c.name, AVG(e.base_amount) as avg_amount
 		FROM expenses e
 		INNER JOIN expense_categories c ON e.category_id = c.id
 		WHERE e.colocation_id = $1
+		  AND e.deleted_at IS NULL
 		  AND e.expense_date >= NOW() - INTERVAL '3 months'
 		  AND e.recurring_id IS NULL
 		GROUP BY c.id, c.name
@@ -630,88 +1523,111 @@ func (r *ExpenseRepository) GetForecastData(ctx context.Context, colocationID st
 	}
 	defer rows.Close()
 
-	historicalAvg := make(map[string]float64)
-	categoryNames := make(map[string]string)
+	var averages []domain.CategoryForecast
 	for rows.Next() {
-		var catID, catName string
-		var avg float64
-		if err := rows.Scan(&catID, &catName, &avg); err != nil {
+		var c domain.CategoryForecast
+		if err := rows.Scan(&c.CategoryID, &c.CategoryName, &c.EstimatedAmount); err != nil {
 			return nil, err
 		}
-		historicalAvg[catID] = avg
-		categoryNames[catID] = catName
+		c.Amount, c.LowAmount, c.HighAmount = c.EstimatedAmount, c.EstimatedAmount, c.EstimatedAmount
+		averages = append(averages, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
 
-	// Build forecasts
-	var forecasts []domain.MonthlyForecast
-	now := time.Now()
-
-	for i := 0; i < months; i++ {
-		month := now.AddDate(0, i+1, 0)
-		monthStr := month.Format("2006-01")
+	// Add historical averages for non-recurring
+	for i := range forecasts {
+		for _, c := range averages {
+			forecasts[i].Add(c)
+		}
+	}
 
-		categoryTotals := make(map[string]float64)
+	return forecasts, nil
+}
 
-		// Add recurring expenses
-		for _, re := range recurrings {
-			if !re.IsActive {
-				continue
-			}
-			if re.EndDate != nil && re.EndDate.Before(month) {
-				continue
-			}
+// GetRecurringForecast returns the occurrences of the active recurring
+// expenses of a colocation for each of the next months, in its base currency
+func (r *ExpenseRepository) GetRecurringForecast(ctx context.Context, colocationID string, months int) ([]domain.MonthlyForecast, error) {
+	// Get recurring expenses for forecast
+	recurrings, err := r.ListRecurringByColocation(ctx, colocationID)
+	if err != nil {
+		return nil, err
+	}
 
-			// Count occurrences in this month
-			occurrences := countOccurrencesInMonth(re.Recurrence, month)
-			total := re.Amount * float64(occurrences)
+	var baseCurrency string
+	if err := r.pool.QueryRow(ctx, "SELECT base_currency FROM colocations WHERE id = $1", colocationID).Scan(&baseCurrency); err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation de la devise: %w", err)
+	}
 
-			categoryTotals[re.CategoryID] += total
-			categoryNames[re.CategoryID] = re.CategoryName
+	// Build forecasts, starting next month
+	now := time.Now()
+	firstMonth := time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+	forecasts := make([]domain.MonthlyForecast, months)
+	for i := range forecasts {
+		forecasts[i] = domain.MonthlyForecast{
+			Month:    firstMonth.AddDate(0, i, 0).Format("2006-01"),
+			Currency: baseCurrency,
 		}
+	}
+	end := firstMonth.AddDate(0, months, -1)
 
-		// Add historical averages for non-recurring
-		for catID, avg := range historicalAvg {
-			categoryTotals[catID] += avg
+	// Add the occurrences of recurring expenses, with their overrides
+	for _, re := range recurrings {
+		if !re.IsActive {
+			continue
 		}
 
-		// Build category forecasts
-		var categories []domain.CategoryForecast
-		var totalAmount float64
-		for catID, amount := range categoryTotals {
-			categories = append(categories, domain.CategoryForecast{
-				CategoryID:   catID,
-				CategoryName: categoryNames[catID],
-				Amount:       amount,
+		for _, occ := range re.OccurrencesUntil(end, 0) {
+			if occ.Status != domain.OccurrenceScheduled || occ.Date.Before(firstMonth) {
+				continue
+			}
+
+			i := (occ.Date.Year()-firstMonth.Year())*12 + int(occ.Date.Month()-firstMonth.Month())
+			forecasts[i].Add(domain.CategoryForecast{
+				CategoryID:      re.CategoryID,
+				CategoryName:    re.CategoryName,
+				Amount:          occ.Amount,
+				RecurringAmount: occ.Amount,
+				LowAmount:       occ.Amount,
+				HighAmount:      occ.Amount,
 			})
-			totalAmount += amount
 		}
-
-		forecasts = append(forecasts, domain.MonthlyForecast{
-			Month:       monthStr,
-			TotalAmount: totalAmount,
-			Categories:  categories,
-		})
 	}
 
 	return forecasts, nil
 }
 
-// countOccurrencesInMonth counts how many times a recurring expense occurs in a given month
-func countOccurrencesInMonth(recurrence domain.Recurrence, month time.Time) int {
-	switch recurrence {
-	case domain.RecurrenceDaily:
-		return daysInMonth(month)
-	case domain.RecurrenceWeekly:
-		return daysInMonth(month) / 7
-	case domain.RecurrenceMonthly:
-		return 1
-	case domain.RecurrenceYearly:
-		return 0 // Simplified: assume yearly expenses don't occur every month
-	default:
-		return 0
+// ListMonthlyCategoryTotals returns the one-off spending of a colocation per
+// category and month since a date, in its base currency. Months without
+// spending are left out.
+func (r *ExpenseRepository) ListMonthlyCategoryTotals(ctx context.Context, colocationID string, since time.Time) ([]domain.CategoryMonthTotal, error) {
+	query := `
+		SELECT c.id, c.name, date_trunc('month', e.expense_date)::date AS month, SUM(e.base_amount)
+		FROM expenses e
+		INNER JOIN expense_categories c ON e.category_id = c.id
+		WHERE e.colocation_id = $1
+		  AND e.deleted_at IS NULL
+		  AND e.recurring_id IS NULL
+		  AND e.expense_date >= $2
+		GROUP BY c.id, c.name, month
+		ORDER BY month
+	`
+
+	rows, err := r.pool.Query(ctx, query, colocationID, since)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation de l'historique: %w", err)
+	}
+	defer rows.Close()
+
+	var totals []domain.CategoryMonthTotal
+	for rows.Next() {
+		var t domain.CategoryMonthTotal
+		if err := rows.Scan(&t.CategoryID, &t.CategoryName, &t.Month, &t.Amount); err != nil {
+			return nil, fmt.Errorf("erreur lors du scan: %w", err)
+		}
+		totals = append(totals, t)
 	}
-}
 
-func daysInMonth(t time.Time) int {
-	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+	return totals, rows.Err()
 }
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -41,9 +42,10 @@ func (r *FundRepository) GetByID(ctx context.Context, id string) (*domain.Common
 	query := `
 		SELECT f.id, f.colocation_id, f.name, f.description, f.target_amount, f.current_amount,
 		       f.is_active, f.created_by, f.created_at,
-		       u.nom, u.prenom
+		       u.nom, u.prenom, c.base_currency
 		FROM common_funds f
 		INNER JOIN users u ON f.created_by = u.id
+		INNER JOIN colocations c ON f.colocation_id = c.id
 		WHERE f.id = $1
 	`
 
@@ -51,7 +53,7 @@ func (r *FundRepository) GetByID(ctx context.Context, id string) (*domain.Common
 	err := r.pool.QueryRow(ctx, query, id).Scan(
 		&f.ID, &f.ColocationID, &f.Name, &f.Description, &f.TargetAmount, &f.CurrentAmount,
 		&f.IsActive, &f.CreatedBy, &f.CreatedAt,
-		&f.CreatedByNom, &f.CreatedByPrenom,
+		&f.CreatedByNom, &f.CreatedByPrenom, &f.Currency,
 	)
 
 	if err == pgx.ErrNoRows {
@@ -63,7 +65,7 @@ func (r *FundRepository) GetByID(ctx context.Context, id string) (*domain.Common
 
 	// Calculate progress
 	if f.TargetAmount != nil && *f.TargetAmount > 0 {
-		f.ProgressPercentage = (f.CurrentAmount / *f.TargetAmount) * 100
+		f.ProgressPercentage = (f.CurrentAmount.Float64() / f.TargetAmount.Float64()) * 100
 	}
 
 	// Get contributors
@@ -82,7 +84,7 @@ func (r *FundRepository) GetContributors(ctx context.Context, fundID string) ([]
 		SELECT fc.user_id, u.nom, u.prenom, SUM(fc.amount) as total
 		FROM fund_contributions fc
 		INNER JOIN users u ON fc.user_id = u.id
-		WHERE fc.fund_id = $1
+		WHERE fc.fund_id = $1 AND fc.deleted_at IS NULL
 		GROUP BY fc.user_id, u.nom, u.prenom
 		ORDER BY total DESC
 	`
@@ -110,9 +112,10 @@ func (r *FundRepository) ListByColocation(ctx context.Context, colocationID stri
 	query := `
 		SELECT f.id, f.colocation_id, f.name, f.description, f.target_amount, f.current_amount,
 		       f.is_active, f.created_by, f.created_at,
-		       u.nom, u.prenom
+		       u.nom, u.prenom, c.base_currency
 		FROM common_funds f
 		INNER JOIN users u ON f.created_by = u.id
+		INNER JOIN colocations c ON f.colocation_id = c.id
 		WHERE f.colocation_id = $1
 	`
 
@@ -136,13 +139,13 @@ func (r *FundRepository) ListByColocation(ctx context.Context, colocationID stri
 		if err := rows.Scan(
 			&f.ID, &f.ColocationID, &f.Name, &f.Description, &f.TargetAmount, &f.CurrentAmount,
 			&f.IsActive, &f.CreatedBy, &f.CreatedAt,
-			&f.CreatedByNom, &f.CreatedByPrenom,
+			&f.CreatedByNom, &f.CreatedByPrenom, &f.Currency,
 		); err != nil {
 			return nil, err
 		}
 
 		if f.TargetAmount != nil && *f.TargetAmount > 0 {
-			f.ProgressPercentage = (f.CurrentAmount / *f.TargetAmount) * 100
+			f.ProgressPercentage = (f.CurrentAmount.Float64() / f.TargetAmount.Float64()) * 100
 		}
 
 		contributors, err := r.GetContributors(ctx, f.ID)
@@ -223,10 +226,12 @@ func (r *FundRepository) AddContribution(ctx context.Context, contribution *doma
 func (r *FundRepository) ListContributions(ctx context.Context, fundID string) ([]domain.FundContribution, error) {
 	query := `
 		SELECT fc.id, fc.fund_id, fc.user_id, fc.amount, fc.note, fc.created_at,
-		       u.nom, u.prenom
+		       u.nom, u.prenom, c.base_currency
 		FROM fund_contributions fc
 		INNER JOIN users u ON fc.user_id = u.id
-		WHERE fc.fund_id = $1
+		INNER JOIN common_funds f ON fc.fund_id = f.id
+		INNER JOIN colocations c ON f.colocation_id = c.id
+		WHERE fc.fund_id = $1 AND fc.deleted_at IS NULL
 		ORDER BY fc.created_at DESC
 	`
 
@@ -241,7 +246,7 @@ func (r *FundRepository) ListContributions(ctx context.Context, fundID string) (
 		var c domain.FundContribution
 		if err := rows.Scan(
 			&c.ID, &c.FundID, &c.UserID, &c.Amount, &c.Note, &c.CreatedAt,
-			&c.UserNom, &c.UserPrenom,
+			&c.UserNom, &c.UserPrenom, &c.Currency,
 		); err != nil {
 			return nil, err
 		}
@@ -251,20 +256,33 @@ func (r *FundRepository) ListContributions(ctx context.Context, fundID string) (
 	return contributions, rows.Err()
 }
 
-// GetContribution retrieves a contribution by ID
+// GetContribution retrieves a contribution by ID, deleted ones excluded
 func (r *FundRepository) GetContribution(ctx context.Context, id string) (*domain.FundContribution, error) {
+	return r.getContribution(ctx, id, false)
+}
+
+// GetDeletedContribution retrieves a soft-deleted contribution by ID
+func (r *FundRepository) GetDeletedContribution(ctx context.Context, id string) (*domain.FundContribution, error) {
+	return r.getContribution(ctx, id, true)
+}
+
+// getContribution retrieves a contribution which is soft-deleted or not
+// depending on deleted
+func (r *FundRepository) getContribution(ctx context.Context, id string, deleted bool) (*domain.FundContribution, error) {
 	query := `
-		SELECT fc.id, fc.fund_id, fc.user_id, fc.amount, fc.note, fc.created_at,
-		       u.nom, u.prenom
+		SELECT fc.id, fc.fund_id, fc.user_id, fc.amount, fc.note, fc.created_at, fc.deleted_at, fc.deleted_by,
+		       u.nom, u.prenom, c.base_currency
 		FROM fund_contributions fc
 		INNER JOIN users u ON fc.user_id = u.id
-		WHERE fc.id = $1
+		INNER JOIN common_funds f ON fc.fund_id = f.id
+		INNER JOIN colocations c ON f.colocation_id = c.id
+		WHERE fc.id = $1 AND (fc.deleted_at IS NOT NULL) = $2
 	`
 
 	var c domain.FundContribution
-	err := r.pool.QueryRow(ctx, query, id).Scan(
-		&c.ID, &c.FundID, &c.UserID, &c.Amount, &c.Note, &c.CreatedAt,
-		&c.UserNom, &c.UserPrenom,
+	err := r.pool.QueryRow(ctx, query, id, deleted).Scan(
+		&c.ID, &c.FundID, &c.UserID, &c.Amount, &c.Note, &c.CreatedAt, &c.DeletedAt, &c.DeletedBy,
+		&c.UserNom, &c.UserPrenom, &c.Currency,
 	)
 
 	if err == pgx.ErrNoRows {
@@ -277,15 +295,59 @@ func (r *FundRepository) GetContribution(ctx context.Context, id string) (*domai
 	return &c, nil
 }
 
-// DeleteContribution deletes a contribution and updates the fund amount
-func (r *FundRepository) DeleteContribution(ctx context.Context, id, fundID string, amount float64) error {
+// DeleteContribution soft-deletes a contribution, which stays restorable
+// until it is purged, updates the fund amount and returns the deletion date.
+// It fails when the fund no longer holds the amount of the contribution.
+func (r *FundRepository) DeleteContribution(ctx context.Context, id, fundID string, amount domain.Money, deletedBy string) (time.Time, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	// The contribution cannot be taken back once it was spent
+	if err := lockFundBalance(ctx, tx, fundID, amount); err != nil {
+		return time.Time{}, err
+	}
+
+	var deletedAt time.Time
+	err = tx.QueryRow(ctx, `
+		UPDATE fund_contributions SET deleted_at = NOW(), deleted_by = $2
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING deleted_at
+	`, id, deletedBy).Scan(&deletedAt)
+	if err == pgx.ErrNoRows {
+		return time.Time{}, fmt.Errorf("contribution introuvable")
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// Update fund current_amount
+	_, err = tx.Exec(ctx,
+		"UPDATE common_funds SET current_amount = current_amount - $1 WHERE id = $2",
+		amount, fundID,
+	)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return deletedAt, tx.Commit(ctx)
+}
+
+// RestoreContribution brings back a soft-deleted contribution and updates the
+// fund amount
+func (r *FundRepository) RestoreContribution(ctx context.Context, id, fundID string, amount domain.Money) error {
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback(ctx)
 
-	result, err := tx.Exec(ctx, "DELETE FROM fund_contributions WHERE id = $1", id)
+	result, err := tx.Exec(ctx,
+		"UPDATE fund_contributions SET deleted_at = NULL, deleted_by = NULL WHERE id = $1 AND deleted_at IS NOT NULL",
+		id,
+	)
 	if err != nil {
 		return err
 	}
@@ -294,7 +356,181 @@ func (r *FundRepository) DeleteContribution(ctx context.Context, id, fundID stri
 		return fmt.Errorf("contribution introuvable")
 	}
 
-	// Update fund current_amount
+	_, err = tx.Exec(ctx,
+		"UPDATE common_funds SET current_amount = current_amount + $1 WHERE id = $2",
+		amount, fundID,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// PurgeDeletedContributions hard-deletes the contributions soft-deleted
+// before a date and returns their number
+func (r *FundRepository) PurgeDeletedContributions(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.pool.Exec(ctx, "DELETE FROM fund_contributions WHERE deleted_at < $1", before)
+	if err != nil {
+		return 0, fmt.Errorf("erreur lors de la purge des contributions: %w", err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+// lockFundBalance locks the row of a fund until the end of the transaction and
+// checks that it holds at least amount, so that concurrent withdrawals cannot
+// overdraw it
+func lockFundBalance(ctx context.Context, tx pgx.Tx, fundID string, amount domain.Money) error {
+	var current domain.Money
+	err := tx.QueryRow(ctx, "SELECT current_amount FROM common_funds WHERE id = $1 FOR UPDATE", fundID).Scan(&current)
+	if err == pgx.ErrNoRows {
+		return fmt.Errorf("fonds introuvable")
+	}
+	if err != nil {
+		return err
+	}
+
+	if current < amount {
+		return fmt.Errorf("solde du fonds insuffisant (%s disponibles)", current)
+	}
+	return nil
+}
+
+// AddWithdrawal records money spent from a fund and updates the fund amount
+func (r *FundRepository) AddWithdrawal(ctx context.Context, withdrawal *domain.FundWithdrawal) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockFundBalance(ctx, tx, withdrawal.FundID, withdrawal.Amount); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO fund_withdrawals (fund_id, user_id, amount, note, expense_id)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+
+	err = tx.QueryRow(ctx, query,
+		withdrawal.FundID, withdrawal.UserID, withdrawal.Amount, withdrawal.Note, withdrawal.ExpenseID,
+	).Scan(&withdrawal.ID, &withdrawal.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx,
+		"UPDATE common_funds SET current_amount = current_amount - $1 WHERE id = $2",
+		withdrawal.Amount, withdrawal.FundID,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetWithdrawal retrieves a withdrawal by ID, deleted ones excluded
+func (r *FundRepository) GetWithdrawal(ctx context.Context, id string) (*domain.FundWithdrawal, error) {
+	return r.getWithdrawal(ctx, id, false)
+}
+
+// GetDeletedWithdrawal retrieves a soft-deleted withdrawal by ID
+func (r *FundRepository) GetDeletedWithdrawal(ctx context.Context, id string) (*domain.FundWithdrawal, error) {
+	return r.getWithdrawal(ctx, id, true)
+}
+
+// getWithdrawal retrieves a withdrawal which is soft-deleted or not
+// depending on deleted
+func (r *FundRepository) getWithdrawal(ctx context.Context, id string, deleted bool) (*domain.FundWithdrawal, error) {
+	query := `
+		SELECT fw.id, fw.fund_id, fw.user_id, fw.amount, fw.note, fw.expense_id, fw.created_at, fw.deleted_at, fw.deleted_by,
+		       u.nom, u.prenom, e.title, c.base_currency
+		FROM fund_withdrawals fw
+		INNER JOIN users u ON fw.user_id = u.id
+		INNER JOIN common_funds f ON fw.fund_id = f.id
+		INNER JOIN colocations c ON f.colocation_id = c.id
+		LEFT JOIN expenses e ON fw.expense_id = e.id
+		WHERE fw.id = $1 AND (fw.deleted_at IS NOT NULL) = $2
+	`
+
+	var w domain.FundWithdrawal
+	err := r.pool.QueryRow(ctx, query, id, deleted).Scan(
+		&w.ID, &w.FundID, &w.UserID, &w.Amount, &w.Note, &w.ExpenseID, &w.CreatedAt, &w.DeletedAt, &w.DeletedBy,
+		&w.UserNom, &w.UserPrenom, &w.ExpenseTitle, &w.Currency,
+	)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &w, nil
+}
+
+// DeleteWithdrawal soft-deletes a withdrawal, which stays restorable until it
+// is purged, puts its amount back into the fund and returns the deletion date
+func (r *FundRepository) DeleteWithdrawal(ctx context.Context, id, fundID string, amount domain.Money, deletedBy string) (time.Time, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	var deletedAt time.Time
+	err = tx.QueryRow(ctx, `
+		UPDATE fund_withdrawals SET deleted_at = NOW(), deleted_by = $2
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING deleted_at
+	`, id, deletedBy).Scan(&deletedAt)
+	if err == pgx.ErrNoRows {
+		return time.Time{}, fmt.Errorf("retrait introuvable")
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	_, err = tx.Exec(ctx,
+		"UPDATE common_funds SET current_amount = current_amount + $1 WHERE id = $2",
+		amount, fundID,
+	)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return deletedAt, tx.Commit(ctx)
+}
+
+// RestoreWithdrawal brings back a soft-deleted withdrawal and spends its
+// amount again. It fails when the fund no longer holds the amount.
+func (r *FundRepository) RestoreWithdrawal(ctx context.Context, id, fundID string, amount domain.Money) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockFundBalance(ctx, tx, fundID, amount); err != nil {
+		return err
+	}
+
+	result, err := tx.Exec(ctx,
+		"UPDATE fund_withdrawals SET deleted_at = NULL, deleted_by = NULL WHERE id = $1 AND deleted_at IS NOT NULL",
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("retrait introuvable")
+	}
+
 	_, err = tx.Exec(ctx,
 		"UPDATE common_funds SET current_amount = current_amount - $1 WHERE id = $2",
 		amount, fundID,
@@ -305,3 +541,68 @@ func (r *FundRepository) DeleteContribution(ctx context.Context, id, fundID stri
 
 	return tx.Commit(ctx)
 }
+
+// PurgeDeletedWithdrawals hard-deletes the withdrawals soft-deleted before a
+// date and returns their number
+func (r *FundRepository) PurgeDeletedWithdrawals(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.pool.Exec(ctx, "DELETE FROM fund_withdrawals WHERE deleted_at < $1", before)
+	if err != nil {
+		return 0, fmt.Errorf("erreur lors de la purge des retraits: %w", err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+// IsExpenseFunded checks if an expense is already paid by a fund
+func (r *FundRepository) IsExpenseFunded(ctx context.Context, expenseID string) (bool, error) {
+	var exists bool
+	err := r.pool.QueryRow(ctx,
+		"SELECT EXISTS(SELECT 1 FROM fund_withdrawals WHERE expense_id = $1 AND deleted_at IS NULL)",
+		expenseID,
+	).Scan(&exists)
+	return exists, err
+}
+
+// ListTransactions returns the ledger of a fund, its contributions and
+// withdrawals with the fund amount after each of them, newest first
+func (r *FundRepository) ListTransactions(ctx context.Context, fundID string) ([]domain.FundTransaction, error) {
+	query := `
+		SELECT t.id, t.type, t.user_id, u.nom, u.prenom, t.amount,
+		       SUM(CASE WHEN t.type = 'withdrawal' THEN -t.amount ELSE t.amount END)
+		           OVER (ORDER BY t.created_at, t.id),
+		       t.note, t.expense_id, e.title, t.created_at
+		FROM (
+			SELECT id, 'contribution' AS type, user_id, amount, note, NULL::uuid AS expense_id, created_at
+			FROM fund_contributions
+			WHERE fund_id = $1 AND deleted_at IS NULL
+			UNION ALL
+			SELECT id, 'withdrawal', user_id, amount, note, expense_id, created_at
+			FROM fund_withdrawals
+			WHERE fund_id = $1 AND deleted_at IS NULL
+		) t
+		INNER JOIN users u ON t.user_id = u.id
+		LEFT JOIN expenses e ON t.expense_id = e.id
+		ORDER BY t.created_at DESC, t.id DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, fundID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transactions []domain.FundTransaction
+	for rows.Next() {
+		var t domain.FundTransaction
+		if err := rows.Scan(
+			&t.ID, &t.Type, &t.UserID, &t.UserNom, &t.UserPrenom, &t.Amount,
+			&t.Balance,
+			&t.Note, &t.ExpenseID, &t.ExpenseTitle, &t.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, t)
+	}
+
+	return transactions, rows.Err()
+}
@@ -186,7 +186,7 @@ func (r *NotificationRepository) GetUnreadCount(ctx context.Context, userID stri
 }
 
 // CreateForColocationMembers creates notifications for all members of a colocation except the sender
-func (r *NotificationRepository) CreateForColocationMembers(ctx context.Context, colocationID, excludeUserID string, notifType domain.NotificationType, title, body string, data map[string]string) error {
+func (r *NotificationRepository) CreateForColocationMembers(ctx context.Context, colocationID, excludeUserID string, notifType domain.NotificationType, title, body string, data map[string]string) ([]domain.Notification, error) {
 	dataJSON, err := json.Marshal(data)
 	if err != nil {
 		dataJSON = []byte("{}")
@@ -196,9 +196,30 @@ func (r *NotificationRepository) CreateForColocationMembers(ctx context.Context,
 		INSERT INTO notifications (user_id, colocation_id, type, title, body, data)
 		SELECT cm.user_id, $1, $2, $3, $4, $5
 		FROM colocation_members cm
-		WHERE cm.colocation_id = $1 AND cm.user_id != $6
+		WHERE cm.colocation_id = $1 AND cm.user_id::text != $6
+		RETURNING id, user_id, is_read, created_at
 	`
 
-	_, err = r.pool.Exec(ctx, query, colocationID, notifType, title, body, dataJSON, excludeUserID)
-	return err
+	rows, err := r.pool.Query(ctx, query, colocationID, notifType, title, body, dataJSON, excludeUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []domain.Notification
+	for rows.Next() {
+		n := domain.Notification{
+			ColocationID: &colocationID,
+			Type:         notifType,
+			Title:        title,
+			Body:         body,
+			Data:         data,
+		}
+		if err := rows.Scan(&n.ID, &n.UserID, &n.IsRead, &n.CreatedAt); err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, n)
+	}
+
+	return notifications, rows.Err()
 }
@@ -20,40 +20,75 @@ func NewPaymentRepository(pool *pgxpool.Pool) *PaymentRepository {
 	return &PaymentRepository{pool: pool}
 }
 
-// Create creates a new payment
+// Create creates a new payment and records its creation by its sender
 func (r *PaymentRepository) Create(ctx context.Context, payment *domain.Payment) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("erreur lors du demarrage de la transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
 	query := `
-		INSERT INTO payments (colocation_id, from_user_id, to_user_id, amount, note)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO payments (colocation_id, from_user_id, to_user_id, amount, currency, exchange_rate, base_amount, method, note, allocate_to)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, COALESCE($10::uuid[], '{}'))
 		RETURNING id, status, created_at
 	`
 
-	return r.pool.QueryRow(ctx, query,
+	err = tx.QueryRow(ctx, query,
 		payment.ColocationID,
 		payment.FromUserID,
 		payment.ToUserID,
 		payment.Amount,
+		payment.Currency,
+		payment.ExchangeRate,
+		payment.BaseAmount,
+		payment.Method,
 		payment.Note,
+		payment.AllocateTo,
 	).Scan(&payment.ID, &payment.Status, &payment.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	event := &domain.PaymentEvent{PaymentID: payment.ID, ActorID: &payment.FromUserID, Type: domain.PaymentEventCreated}
+	if err := insertPaymentEvent(ctx, tx, event); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
 
-// GetByID retrieves a payment by ID with user details
+// GetByID retrieves a payment by ID with user details, allocations and
+// events, cancelled payments excluded
 func (r *PaymentRepository) GetByID(ctx context.Context, id string) (*domain.Payment, error) {
+	return r.getByID(ctx, id, false)
+}
+
+// GetDeletedByID retrieves a cancelled payment by ID with user details
+func (r *PaymentRepository) GetDeletedByID(ctx context.Context, id string) (*domain.Payment, error) {
+	return r.getByID(ctx, id, true)
+}
+
+// getByID retrieves a payment which is cancelled or not depending on deleted
+func (r *PaymentRepository) getByID(ctx context.Context, id string, deleted bool) (*domain.Payment, error) {
 	query := `
-		SELECT p.id, p.colocation_id, p.from_user_id, p.to_user_id, p.amount, p.status, p.note, p.confirmed_at, p.created_at,
+		SELECT p.id, p.colocation_id, p.from_user_id, p.to_user_id, p.amount, p.currency, p.exchange_rate, p.base_amount, p.status, p.method, p.note, p.settlement_plan_id, p.confirmed_at, p.created_at,
+		       p.deleted_at, p.deleted_by, p.allocate_to,
 		       fu.nom, fu.prenom, fu.avatar_url,
-		       tu.nom, tu.prenom, tu.avatar_url
+		       tu.nom, tu.prenom, tu.avatar_url, c.base_currency
 		FROM payments p
 		INNER JOIN users fu ON p.from_user_id = fu.id
 		INNER JOIN users tu ON p.to_user_id = tu.id
-		WHERE p.id = $1
+		INNER JOIN colocations c ON p.colocation_id = c.id
+		WHERE p.id = $1 AND (p.deleted_at IS NOT NULL) = $2
 	`
 
 	var p domain.Payment
-	err := r.pool.QueryRow(ctx, query, id).Scan(
-		&p.ID, &p.ColocationID, &p.FromUserID, &p.ToUserID, &p.Amount, &p.Status, &p.Note, &p.ConfirmedAt, &p.CreatedAt,
+	err := r.pool.QueryRow(ctx, query, id, deleted).Scan(
+		&p.ID, &p.ColocationID, &p.FromUserID, &p.ToUserID, &p.Amount, &p.Currency, &p.ExchangeRate, &p.BaseAmount, &p.Status, &p.Method, &p.Note, &p.SettlementPlanID, &p.ConfirmedAt, &p.CreatedAt,
+		&p.DeletedAt, &p.DeletedBy, &p.AllocateTo,
 		&p.FromUserNom, &p.FromUserPrenom, &p.FromAvatarURL,
-		&p.ToUserNom, &p.ToUserPrenom, &p.ToAvatarURL,
+		&p.ToUserNom, &p.ToUserPrenom, &p.ToAvatarURL, &p.BaseCurrency,
 	)
 
 	if err == pgx.ErrNoRows {
@@ -63,16 +98,28 @@ func (r *PaymentRepository) GetByID(ctx context.Context, id string) (*domain.Pay
 		return nil, fmt.Errorf("erreur lors de la recuperation du paiement: %w", err)
 	}
 
+	p.Allocations, err = r.GetAllocations(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Events, err = r.GetEvents(ctx, []string{p.ID})
+	if err != nil {
+		return nil, err
+	}
+
 	return &p, nil
 }
 
-// ListByColocation lists payments for a colocation with filters
+// ListByColocation lists payments for a colocation with filters, with their
+// events
 func (r *PaymentRepository) ListByColocation(ctx context.Context, colocationID string, status, fromUserID, toUserID *string, page, pageSize int) ([]domain.Payment, int, error) {
 	baseQuery := `
 		FROM payments p
 		INNER JOIN users fu ON p.from_user_id = fu.id
 		INNER JOIN users tu ON p.to_user_id = tu.id
-		WHERE p.colocation_id = $1
+		INNER JOIN colocations c ON p.colocation_id = c.id
+		WHERE p.colocation_id = $1 AND p.deleted_at IS NULL
 	`
 
 	args := []interface{}{colocationID}
@@ -105,9 +152,9 @@ func (r *PaymentRepository) ListByColocation(ctx context.Context, colocationID s
 
 	// Select
 	selectQuery := `
-		SELECT p.id, p.colocation_id, p.from_user_id, p.to_user_id, p.amount, p.status, p.note, p.confirmed_at, p.created_at,
+		SELECT p.id, p.colocation_id, p.from_user_id, p.to_user_id, p.amount, p.currency, p.exchange_rate, p.base_amount, p.status, p.method, p.note, p.settlement_plan_id, p.confirmed_at, p.created_at,
 		       fu.nom, fu.prenom, fu.avatar_url,
-		       tu.nom, tu.prenom, tu.avatar_url
+		       tu.nom, tu.prenom, tu.avatar_url, c.base_currency
 	` + baseQuery + fmt.Sprintf(" ORDER BY p.created_at DESC LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
 
 	args = append(args, pageSize, (page-1)*pageSize)
@@ -122,90 +169,536 @@ func (r *PaymentRepository) ListByColocation(ctx context.Context, colocationID s
 	for rows.Next() {
 		var p domain.Payment
 		if err := rows.Scan(
-			&p.ID, &p.ColocationID, &p.FromUserID, &p.ToUserID, &p.Amount, &p.Status, &p.Note, &p.ConfirmedAt, &p.CreatedAt,
+			&p.ID, &p.ColocationID, &p.FromUserID, &p.ToUserID, &p.Amount, &p.Currency, &p.ExchangeRate, &p.BaseAmount, &p.Status, &p.Method, &p.Note, &p.SettlementPlanID, &p.ConfirmedAt, &p.CreatedAt,
 			&p.FromUserNom, &p.FromUserPrenom, &p.FromAvatarURL,
-			&p.ToUserNom, &p.ToUserPrenom, &p.ToAvatarURL,
+			&p.ToUserNom, &p.ToUserPrenom, &p.ToAvatarURL, &p.BaseCurrency,
 		); err != nil {
 			return nil, 0, err
 		}
 		payments = append(payments, p)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.attachEvents(ctx, payments); err != nil {
+		return nil, 0, err
+	}
+
+	return payments, totalCount, nil
+}
+
+// ListForExport lists all the payments of a colocation created between two
+// optional dates, oldest first
+func (r *PaymentRepository) ListForExport(ctx context.Context, colocationID string, startDate, endDate *time.Time) ([]domain.Payment, error) {
+	query := `
+		SELECT p.id, p.colocation_id, p.from_user_id, p.to_user_id, p.amount, p.currency, p.exchange_rate, p.base_amount, p.status, p.method, p.note, p.settlement_plan_id, p.confirmed_at, p.created_at,
+		       fu.nom, fu.prenom, fu.avatar_url,
+		       tu.nom, tu.prenom, tu.avatar_url, c.base_currency
+		FROM payments p
+		INNER JOIN users fu ON p.from_user_id = fu.id
+		INNER JOIN users tu ON p.to_user_id = tu.id
+		INNER JOIN colocations c ON p.colocation_id = c.id
+		WHERE p.colocation_id = $1 AND p.deleted_at IS NULL
+			AND ($2::timestamp IS NULL OR p.created_at >= $2)
+			AND ($3::timestamp IS NULL OR p.created_at <= $3)
+		ORDER BY p.created_at ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, colocationID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var payments []domain.Payment
+	for rows.Next() {
+		var p domain.Payment
+		if err := rows.Scan(
+			&p.ID, &p.ColocationID, &p.FromUserID, &p.ToUserID, &p.Amount, &p.Currency, &p.ExchangeRate, &p.BaseAmount, &p.Status, &p.Method, &p.Note, &p.SettlementPlanID, &p.ConfirmedAt, &p.CreatedAt,
+			&p.FromUserNom, &p.FromUserPrenom, &p.FromAvatarURL,
+			&p.ToUserNom, &p.ToUserPrenom, &p.ToAvatarURL, &p.BaseCurrency,
+		); err != nil {
+			return nil, err
+		}
+		payments = append(payments, p)
+	}
 
-	return payments, totalCount, rows.Err()
+	return payments, rows.Err()
 }
 
-// UpdateStatus updates the status of a payment
-func (r *PaymentRepository) UpdateStatus(ctx context.Context, id, status string) error {
-	var query string
-	if status == "confirmed" {
-		query = `UPDATE payments SET status = $1, confirmed_at = NOW() WHERE id = $2`
-	} else {
-		query = `UPDATE payments SET status = $1 WHERE id = $2`
+// UpdateStatus changes the status of a payment which is still in one of the
+// statuses from and records the transition
+func (r *PaymentRepository) UpdateStatus(ctx context.Context, id string, from []domain.PaymentStatus, status domain.PaymentStatus, event *domain.PaymentEvent) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("erreur lors du demarrage de la transaction: %w", err)
 	}
+	defer tx.Rollback(ctx)
 
-	result, err := r.pool.Exec(ctx, query, status, id)
+	query := `UPDATE payments SET status = $1 WHERE id = $2 AND status = ANY($3) AND deleted_at IS NULL`
+	result, err := tx.Exec(ctx, query, status, id, statusStrings(from))
 	if err != nil {
 		return fmt.Errorf("erreur lors de la mise a jour du statut: %w", err)
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("paiement introuvable")
+		return fmt.Errorf("paiement introuvable ou deja traite")
 	}
 
-	return nil
+	if err := insertPaymentEvent(ctx, tx, event); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func statusStrings(statuses []domain.PaymentStatus) []string {
+	values := make([]string, len(statuses))
+	for i, st := range statuses {
+		values[i] = string(st)
+	}
+	return values
+}
+
+// Delete soft-deletes a pending payment, which stays restorable until it is
+// purged, and returns the deletion date
+func (r *PaymentRepository) Delete(ctx context.Context, id, deletedBy string) (time.Time, error) {
+	query := `
+		UPDATE payments SET deleted_at = NOW(), deleted_by = $2
+		WHERE id = $1 AND status = 'pending' AND deleted_at IS NULL
+		RETURNING deleted_at
+	`
+
+	var deletedAt time.Time
+	err := r.pool.QueryRow(ctx, query, id, deletedBy).Scan(&deletedAt)
+	if err == pgx.ErrNoRows {
+		return time.Time{}, fmt.Errorf("paiement introuvable ou deja traite")
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("erreur lors de la suppression: %w", err)
+	}
+
+	return deletedAt, nil
 }
 
-// Delete deletes a payment
-func (r *PaymentRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM payments WHERE id = $1 AND status = 'pending'`
+// Restore brings back a cancelled payment
+func (r *PaymentRepository) Restore(ctx context.Context, id string) error {
+	query := `UPDATE payments SET deleted_at = NULL, deleted_by = NULL WHERE id = $1 AND deleted_at IS NOT NULL`
 	result, err := r.pool.Exec(ctx, query, id)
 	if err != nil {
-		return fmt.Errorf("erreur lors de la suppression: %w", err)
+		return fmt.Errorf("erreur lors de la restauration: %w", err)
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("paiement introuvable ou deja traite")
+		return fmt.Errorf("paiement introuvable")
 	}
 
 	return nil
 }
 
-// SettleExpenseSplits marks expense splits as settled when a payment is confirmed
-func (r *PaymentRepository) SettleExpenseSplits(ctx context.Context, colocationID, fromUserID, toUserID string, amount float64) error {
-	// Get unsettled splits where fromUser owes toUser
+// PurgeDeleted hard-deletes the payments cancelled before a date and returns
+// their number
+func (r *PaymentRepository) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.pool.Exec(ctx, "DELETE FROM payments WHERE deleted_at < $1", before)
+	if err != nil {
+		return 0, fmt.Errorf("erreur lors de la purge des paiements: %w", err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+// MarkStaleForReminder flags the pending payments left unconfirmed for the
+// reminder delay of their colocation and returns them. Each payment is flagged
+// once, and not when it is due to expire anyway.
+func (r *PaymentRepository) MarkStaleForReminder(ctx context.Context) ([]string, error) {
+	query := `
+		UPDATE payments p SET reminded_at = NOW()
+		FROM colocations c
+		WHERE p.colocation_id = c.id
+		  AND p.status = 'pending' AND p.deleted_at IS NULL AND p.reminded_at IS NULL
+		  AND c.payment_reminder_days IS NOT NULL
+		  AND p.created_at <= NOW() - make_interval(days => c.payment_reminder_days)
+		  AND (c.payment_expiry_days IS NULL OR p.created_at > NOW() - make_interval(days => c.payment_expiry_days))
+		RETURNING p.id
+	`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la relance des paiements: %w", err)
+	}
+
+	return scanPaymentIDs(rows)
+}
+
+// ExpireStale expires the pending payments left unconfirmed for the expiry
+// delay of their colocation, records the transition and returns them
+func (r *PaymentRepository) ExpireStale(ctx context.Context) ([]string, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors du demarrage de la transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE payments p SET status = 'expired'
+		FROM colocations c
+		WHERE p.colocation_id = c.id
+		  AND p.status = 'pending' AND p.deleted_at IS NULL
+		  AND c.payment_expiry_days IS NOT NULL
+		  AND p.created_at <= NOW() - make_interval(days => c.payment_expiry_days)
+		RETURNING p.id
+	`
+
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de l'expiration des paiements: %w", err)
+	}
+	ids, err := scanPaymentIDs(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		event := &domain.PaymentEvent{PaymentID: id, Type: domain.PaymentEventExpired}
+		if err := insertPaymentEvent(ctx, tx, event); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func scanPaymentIDs(rows pgx.Rows) ([]string, error) {
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("erreur lors du scan du paiement: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// splitSettledAmount is the SQL sum of the confirmed payments allocated to the
+// split aliased es, in the base currency
+const splitSettledAmount = `COALESCE((
+	SELECT SUM(pa.amount)
+	FROM payment_allocations pa
+	INNER JOIN payments p ON pa.payment_id = p.id
+	WHERE pa.expense_id = es.expense_id AND p.from_user_id = es.user_id
+	  AND p.status = 'confirmed' AND p.deleted_at IS NULL
+), 0)`
+
+// Confirm confirms a pending or disputed payment, records the transition and
+// allocates the payment to the shares of its sender in the expenses paid by
+// its recipient
+func (r *PaymentRepository) Confirm(ctx context.Context, id string, event *domain.PaymentEvent) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("erreur lors du demarrage de la transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var p domain.Payment
+	err = tx.QueryRow(ctx, `
+		UPDATE payments SET status = 'confirmed', confirmed_at = NOW()
+		WHERE id = $1 AND status IN ('pending', 'disputed', 'escalated') AND deleted_at IS NULL
+		RETURNING id, colocation_id, from_user_id, to_user_id, base_amount, allocate_to
+	`, id).Scan(&p.ID, &p.ColocationID, &p.FromUserID, &p.ToUserID, &p.BaseAmount, &p.AllocateTo)
+	if err == pgx.ErrNoRows {
+		return fmt.Errorf("paiement introuvable ou deja traite")
+	}
+	if err != nil {
+		return fmt.Errorf("erreur lors de la confirmation: %w", err)
+	}
+
+	if err := insertPaymentEvent(ctx, tx, event); err != nil {
+		return err
+	}
+
+	if err := allocatePayment(ctx, tx, &p); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// allocatePayment applies a confirmed payment to the unsettled shares of its
+// sender owed to its recipient: the expenses of AllocateTo first in their
+// order, then the oldest ones. The shares fully reimbursed are marked settled,
+// what exceeds the debts stays unallocated.
+func allocatePayment(ctx context.Context, tx pgx.Tx, payment *domain.Payment) error {
+	// Payments between the same two members are allocated one at a time:
+	// locking the splits is not enough, as a transaction waiting for them
+	// would still sum the allocations committed before it started waiting
+	_, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))",
+		"payment_allocation:"+payment.FromUserID+":"+payment.ToUserID)
+	if err != nil {
+		return fmt.Errorf("erreur lors du verrouillage des dettes: %w", err)
+	}
+
+	// The recipient is owed the part of the share matching their contribution
+	query := `
+		SELECT e.id,
+		       ` + owedShare + ` - COALESCE((
+				SELECT SUM(pa.amount)
+				FROM payment_allocations pa
+				INNER JOIN payments p ON pa.payment_id = p.id
+				WHERE pa.expense_id = e.id AND p.from_user_id = $2 AND p.to_user_id = $3
+				  AND p.status = 'confirmed' AND p.deleted_at IS NULL
+		       ), 0)
+		FROM expense_splits es
+		INNER JOIN expenses e ON es.expense_id = e.id
+		INNER JOIN expense_payers ep ON ep.expense_id = e.id AND ep.user_id = $3
+		WHERE e.colocation_id = $1 AND e.deleted_at IS NULL AND e.base_amount > 0 AND ` + unfundedExpense + `
+		  AND es.user_id = $2 AND es.is_settled = false
+		ORDER BY array_position($4::uuid[], e.id) NULLS LAST, e.expense_date, e.created_at
+		FOR UPDATE OF es
+	`
+
+	rows, err := tx.Query(ctx, query, payment.ColocationID, payment.FromUserID, payment.ToUserID, payment.AllocateTo)
+	if err != nil {
+		return fmt.Errorf("erreur lors de la recuperation des dettes: %w", err)
+	}
+
+	left := payment.BaseAmount
+	var allocations []domain.PaymentAllocation
+	for rows.Next() {
+		var expenseID string
+		var remaining domain.Money
+		if err := rows.Scan(&expenseID, &remaining); err != nil {
+			rows.Close()
+			return fmt.Errorf("erreur lors du scan de la dette: %w", err)
+		}
+		if remaining <= 0 || left <= 0 {
+			continue
+		}
+		amount := min(remaining, left)
+		left -= amount
+		allocations = append(allocations, domain.PaymentAllocation{ExpenseID: expenseID, Amount: amount})
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(allocations) == 0 {
+		return nil
+	}
+
+	expenseIDs := make([]string, len(allocations))
+	for i, a := range allocations {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO payment_allocations (payment_id, expense_id, amount)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (payment_id, expense_id) DO UPDATE SET amount = payment_allocations.amount + EXCLUDED.amount
+		`, payment.ID, a.ExpenseID, a.Amount)
+		if err != nil {
+			return fmt.Errorf("erreur lors de l'allocation du paiement: %w", err)
+		}
+		expenseIDs[i] = a.ExpenseID
+	}
+
+	return refreshSettledSplits(ctx, tx, expenseIDs)
+}
+
+// refreshSettledSplits marks the splits of expenses as settled once the
+// confirmed payments allocated to them cover the parts owed to the other
+// payers. The shares of the payers themselves are settled right away.
+func refreshSettledSplits(ctx context.Context, tx pgx.Tx, expenseIDs []string) error {
 	query := `
 		UPDATE expense_splits es
-		SET is_settled = true
+		SET is_settled = (
+			SELECT COALESCE(SUM(` + owedShare + `), 0)
+			FROM expense_payers ep
+			WHERE ep.expense_id = e.id AND ep.user_id != es.user_id
+		) <= ` + splitSettledAmount + `
 		FROM expenses e
-		WHERE es.expense_id = e.id
-		  AND e.colocation_id = $1
-		  AND es.user_id = $2
-		  AND e.paid_by = $3
-		  AND es.is_settled = false
-		  AND es.id IN (
-			SELECT es2.id
-			FROM expense_splits es2
-			INNER JOIN expenses e2 ON es2.expense_id = e2.id
-			WHERE e2.colocation_id = $1 AND es2.user_id = $2 AND e2.paid_by = $3 AND es2.is_settled = false
-			ORDER BY e2.expense_date ASC
-			LIMIT (
-				SELECT COUNT(*) FROM expense_splits es3
-				INNER JOIN expenses e3 ON es3.expense_id = e3.id
-				WHERE e3.colocation_id = $1 AND es3.user_id = $2 AND e3.paid_by = $3 AND es3.is_settled = false
-				AND (SELECT COALESCE(SUM(es4.amount), 0) FROM expense_splits es4
-					INNER JOIN expenses e4 ON es4.expense_id = e4.id
-					WHERE e4.colocation_id = $1 AND es4.user_id = $2 AND e4.paid_by = $3 AND es4.is_settled = false
-					AND e4.expense_date <= e3.expense_date) <= $4
-			)
-		  )
+		WHERE e.id = es.expense_id AND es.expense_id = ANY($1)
 	`
 
-	_, err := r.pool.Exec(ctx, query, colocationID, fromUserID, toUserID, amount)
-	return err
+	if _, err := tx.Exec(ctx, query, expenseIDs); err != nil {
+		return fmt.Errorf("erreur lors de la mise a jour des splits regles: %w", err)
+	}
+	return nil
+}
+
+// GetAllocations retrieves the allocations of a payment, oldest expense first
+func (r *PaymentRepository) GetAllocations(ctx context.Context, paymentID string) ([]domain.PaymentAllocation, error) {
+	query := `
+		SELECT pa.payment_id, pa.expense_id, pa.amount, pa.created_at, e.title, e.expense_date
+		FROM payment_allocations pa
+		INNER JOIN expenses e ON pa.expense_id = e.id
+		WHERE pa.payment_id = $1
+		ORDER BY e.expense_date, e.created_at
+	`
+
+	rows, err := r.pool.Query(ctx, query, paymentID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des allocations: %w", err)
+	}
+	defer rows.Close()
+
+	var allocations []domain.PaymentAllocation
+	for rows.Next() {
+		var a domain.PaymentAllocation
+		if err := rows.Scan(&a.PaymentID, &a.ExpenseID, &a.Amount, &a.CreatedAt, &a.ExpenseTitle, &a.ExpenseDate); err != nil {
+			return nil, fmt.Errorf("erreur lors du scan de l'allocation: %w", err)
+		}
+		allocations = append(allocations, a)
+	}
+
+	return allocations, rows.Err()
+}
+
+// CountAllocatableExpenses counts the expenses among expenseIDs a payment from
+// fromUserID to toUserID can be allocated to: active expenses of the
+// colocation paid by toUserID, not by a fund, and shared with fromUserID
+func (r *PaymentRepository) CountAllocatableExpenses(ctx context.Context, colocationID, fromUserID, toUserID string, expenseIDs []string) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM expenses e
+		WHERE e.id = ANY($4) AND e.colocation_id = $1 AND e.deleted_at IS NULL AND ` + unfundedExpense + `
+		  AND EXISTS (SELECT 1 FROM expense_splits es WHERE es.expense_id = e.id AND es.user_id = $2)
+		  AND EXISTS (SELECT 1 FROM expense_payers ep WHERE ep.expense_id = e.id AND ep.user_id = $3)
+	`
+
+	var count int
+	err := r.pool.QueryRow(ctx, query, colocationID, fromUserID, toUserID, expenseIDs).Scan(&count)
+	return count, err
+}
+
+// insertPaymentEvent records a transition of a payment within a transaction
+func insertPaymentEvent(ctx context.Context, tx pgx.Tx, event *domain.PaymentEvent) error {
+	query := `
+		INSERT INTO payment_events (payment_id, actor_id, type, comment, proof_file_name, proof_content_type, proof_size_bytes, proof_storage_key)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at
+	`
+
+	err := tx.QueryRow(ctx, query,
+		event.PaymentID,
+		event.ActorID,
+		event.Type,
+		event.Comment,
+		event.ProofFileName,
+		event.ProofContentType,
+		event.ProofSizeBytes,
+		event.ProofStorageKey,
+	).Scan(&event.ID, &event.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("erreur lors de l'enregistrement de l'evenement du paiement: %w", err)
+	}
+	return nil
+}
+
+// AddEvent records an event of a payment which does not change its status
+func (r *PaymentRepository) AddEvent(ctx context.Context, event *domain.PaymentEvent) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("erreur lors du demarrage de la transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := insertPaymentEvent(ctx, tx, event); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+const paymentEventSelect = `
+	SELECT pe.id, pe.payment_id, pe.actor_id, pe.type, pe.comment,
+	       pe.proof_file_name, pe.proof_content_type, pe.proof_size_bytes, pe.proof_storage_key, pe.created_at,
+	       COALESCE(u.nom, ''), COALESCE(u.prenom, '')
+	FROM payment_events pe
+	LEFT JOIN users u ON pe.actor_id = u.id
+`
+
+func scanPaymentEvent(row pgx.Row) (*domain.PaymentEvent, error) {
+	var e domain.PaymentEvent
+	err := row.Scan(
+		&e.ID, &e.PaymentID, &e.ActorID, &e.Type, &e.Comment,
+		&e.ProofFileName, &e.ProofContentType, &e.ProofSizeBytes, &e.ProofStorageKey, &e.CreatedAt,
+		&e.ActorNom, &e.ActorPrenom,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// GetEvents retrieves the events of payments, oldest first
+func (r *PaymentRepository) GetEvents(ctx context.Context, paymentIDs []string) ([]domain.PaymentEvent, error) {
+	query := paymentEventSelect + " WHERE pe.payment_id = ANY($1) ORDER BY pe.created_at, pe.id"
+
+	rows, err := r.pool.Query(ctx, query, paymentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des evenements: %w", err)
+	}
+	defer rows.Close()
+
+	var events []domain.PaymentEvent
+	for rows.Next() {
+		e, err := scanPaymentEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("erreur lors du scan de l'evenement: %w", err)
+		}
+		events = append(events, *e)
+	}
+
+	return events, rows.Err()
+}
+
+// GetEvent retrieves an event of a payment
+func (r *PaymentRepository) GetEvent(ctx context.Context, paymentID, eventID string) (*domain.PaymentEvent, error) {
+	query := paymentEventSelect + " WHERE pe.id = $1 AND pe.payment_id = $2"
+
+	event, err := scanPaymentEvent(r.pool.QueryRow(ctx, query, eventID, paymentID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation de l'evenement: %w", err)
+	}
+
+	return event, nil
+}
+
+// attachEvents loads the events of payments in a single query
+func (r *PaymentRepository) attachEvents(ctx context.Context, payments []domain.Payment) error {
+	if len(payments) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(payments))
+	index := make(map[string]int, len(payments))
+	for i, p := range payments {
+		ids[i] = p.ID
+		index[p.ID] = i
+	}
+
+	events, err := r.GetEvents(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, e := range events {
+		p := &payments[index[e.PaymentID]]
+		p.Events = append(p.Events, e)
+	}
+
+	return nil
 }
 
 // SaveBalance upserts a balance record
-func (r *PaymentRepository) SaveBalance(ctx context.Context, colocationID, fromUserID, toUserID string, amount float64) error {
+func (r *PaymentRepository) SaveBalance(ctx context.Context, colocationID, fromUserID, toUserID string, amount domain.Money) error {
 	query := `
 		INSERT INTO balances (colocation_id, from_user_id, to_user_id, amount)
 		VALUES ($1, $2, $3, $4)
@@ -219,7 +712,7 @@ func (r *PaymentRepository) SaveBalance(ctx context.Context, colocationID, fromU
 
 // PaymentExistsAndPending checks if a payment exists and is pending
 func (r *PaymentRepository) PaymentExistsAndPending(ctx context.Context, id string) (bool, error) {
-	query := `SELECT EXISTS(SELECT 1 FROM payments WHERE id = $1 AND status = 'pending')`
+	query := `SELECT EXISTS(SELECT 1 FROM payments WHERE id = $1 AND status = 'pending' AND deleted_at IS NULL)`
 	var exists bool
 	err := r.pool.QueryRow(ctx, query, id).Scan(&exists)
 	return exists, err
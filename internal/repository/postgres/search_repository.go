@@ -0,0 +1,178 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vblanchet22/back_coloc/internal/domain"
+)
+
+// SearchRepository handles full-text search database operations
+type SearchRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewSearchRepository creates a new SearchRepository
+func NewSearchRepository(pool *pgxpool.Pool) *SearchRepository {
+	return &SearchRepository{pool: pool}
+}
+
+// searchSource describes how an entity type is searched. Every table has a
+// search_vector column, see migration 000025.
+type searchSource struct {
+	table  string // Table aliased as x
+	filter string // Extra condition on x
+	title  string
+	text   string // Text the snippet is taken from
+	amount string // Empty when the entity has no amount
+	date   string
+}
+
+var searchSources = map[domain.SearchEntityType]searchSource{
+	domain.SearchEntityExpense: {
+		table:  "expenses",
+		filter: "x.deleted_at IS NULL",
+		title:  "x.title",
+		text:   "x.title || ' ' || COALESCE(x.description, '')",
+		amount: "x.base_amount",
+		date:   "x.expense_date",
+	},
+	domain.SearchEntityDecision: {
+		table: "decisions",
+		title: "x.title",
+		text: "x.title || ' ' || COALESCE((SELECT string_agg(o, ' / ') FROM jsonb_array_elements_text(x.options) o), '')" +
+			" || ' ' || COALESCE(x.description, '')",
+		date: "x.created_at",
+	},
+	domain.SearchEntityFund: {
+		table:  "common_funds",
+		title:  "x.name",
+		text:   "x.name || ' ' || COALESCE(x.description, '')",
+		amount: "x.current_amount",
+		date:   "x.created_at",
+	},
+	domain.SearchEntityEvent: {
+		table:  "events",
+		title:  "x.title",
+		text:   "x.title || ' ' || COALESCE(x.description, '')",
+		amount: "x.budget",
+		date:   "x.event_date",
+	},
+}
+
+// headlineOptions wraps the matching words of snippets in <mark> tags
+const headlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxWords=30, MinWords=10, MaxFragments=2"
+
+// escapeHTML returns a SQL expression escaping the HTML special characters of
+// a text expression, so that the <mark> tags are the only markup of snippets
+func escapeHTML(expr string) string {
+	return "replace(replace(replace(replace(replace(" + expr +
+		`, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;')`
+}
+
+// headline returns the SQL expression of the snippet of a text expression.
+// Texts are indexed in both French and English, so the snippet is built with
+// the configuration the text matches in, French first.
+func headline(text string) string {
+	escaped := escapeHTML(text)
+	return fmt.Sprintf(`CASE WHEN to_tsvector('french', %s) @@ q.french
+			       THEN ts_headline('french', %s, q.french, '%s')
+			       ELSE ts_headline('english', %s, q.english, '%s') END`,
+		text, escaped, headlineOptions, escaped, headlineOptions)
+}
+
+// Search returns up to limit entities of a type matching a web search query
+// in French or English, best match first, starting after the cursor
+func (r *SearchRepository) Search(ctx context.Context, colocationID string, entityType domain.SearchEntityType, query string, filters domain.SearchFilters, after *domain.SearchCursor, limit int) ([]domain.SearchHit, error) {
+	source, ok := searchSources[entityType]
+	if !ok {
+		return nil, fmt.Errorf("type de recherche invalide")
+	}
+
+	hasAmountFilter := filters.MinAmount != nil || filters.MaxAmount != nil
+	if source.amount == "" && hasAmountFilter {
+		return nil, nil
+	}
+
+	amount := source.amount
+	if amount == "" {
+		amount = "NULL::numeric"
+	}
+
+	conditions := "x.colocation_id = $1 AND x.search_vector @@ q.query"
+	if source.filter != "" {
+		conditions += " AND " + source.filter
+	}
+
+	args := []interface{}{colocationID, query}
+	argIndex := 3
+
+	if filters.MinAmount != nil {
+		conditions += fmt.Sprintf(" AND %s >= $%d", source.amount, argIndex)
+		args = append(args, *filters.MinAmount)
+		argIndex++
+	}
+	if filters.MaxAmount != nil {
+		conditions += fmt.Sprintf(" AND %s <= $%d", source.amount, argIndex)
+		args = append(args, *filters.MaxAmount)
+		argIndex++
+	}
+	if filters.StartDate != nil {
+		conditions += fmt.Sprintf(" AND %s >= $%d", source.date, argIndex)
+		args = append(args, *filters.StartDate)
+		argIndex++
+	}
+	if filters.EndDate != nil {
+		conditions += fmt.Sprintf(" AND %s <= $%d", source.date, argIndex)
+		args = append(args, *filters.EndDate)
+		argIndex++
+	}
+
+	// Keyset pagination on (rank DESC, id ASC)
+	page := ""
+	if after != nil {
+		page = fmt.Sprintf("WHERE h.rank < $%d OR (h.rank = $%d AND h.id > $%d::uuid)", argIndex, argIndex, argIndex+1)
+		args = append(args, after.Rank, after.ID)
+		argIndex += 2
+	}
+
+	sql := fmt.Sprintf(`
+		WITH q AS (
+			SELECT fr AS french, en AS english, fr || en AS query
+			FROM websearch_to_tsquery('french', $2) fr, websearch_to_tsquery('english', $2) en
+		)
+		SELECT h.id, h.title, h.snippet, h.amount, h.currency, h.date, h.rank
+		FROM (
+			SELECT x.id, %s AS title,
+			       %s AS snippet,
+			       %s AS amount, c.base_currency AS currency, %s::timestamptz AS date,
+			       ts_rank(x.search_vector, q.query) AS rank
+			FROM %s x
+			CROSS JOIN q
+			INNER JOIN colocations c ON x.colocation_id = c.id
+			WHERE %s
+		) h
+		%s
+		ORDER BY h.rank DESC, h.id ASC
+		LIMIT $%d
+	`, source.title, headline(source.text), amount, source.date, source.table, conditions, page, argIndex)
+	args = append(args, limit)
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recherche: %w", err)
+	}
+	defer rows.Close()
+
+	var hits []domain.SearchHit
+	for rows.Next() {
+		h := domain.SearchHit{EntityType: entityType}
+		if err := rows.Scan(&h.ID, &h.Title, &h.Snippet, &h.Amount, &h.Currency, &h.Date, &h.Rank); err != nil {
+			return nil, fmt.Errorf("erreur lors du scan du resultat: %w", err)
+		}
+		hits = append(hits, h)
+	}
+
+	return hits, rows.Err()
+}
@@ -0,0 +1,205 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vblanchet22/back_coloc/internal/domain"
+)
+
+// SettlementRepository handles settlement plan database operations
+type SettlementRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewSettlementRepository creates a new SettlementRepository
+func NewSettlementRepository(pool *pgxpool.Pool) *SettlementRepository {
+	return &SettlementRepository{pool: pool}
+}
+
+// Create creates a settlement plan and its pending payments in one transaction
+func (r *SettlementRepository) Create(ctx context.Context, plan *domain.SettlementPlan, payments []domain.Payment) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("erreur lors du demarrage de la transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Only one active plan per colocation
+	var exists bool
+	err = tx.QueryRow(ctx,
+		"SELECT EXISTS(SELECT 1 FROM settlement_plans WHERE colocation_id = $1 AND status = 'active')",
+		plan.ColocationID,
+	).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("erreur lors de la verification des plans: %w", err)
+	}
+	if exists {
+		return fmt.Errorf("un plan de remboursement est deja en cours")
+	}
+
+	query := `
+		INSERT INTO settlement_plans (colocation_id, created_by, balance_fingerprint, total_amount)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, status, created_at
+	`
+
+	err = tx.QueryRow(ctx, query,
+		plan.ColocationID,
+		plan.CreatedBy,
+		plan.BalanceFingerprint,
+		plan.TotalAmount,
+	).Scan(&plan.ID, &plan.Status, &plan.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("erreur lors de la creation du plan: %w", err)
+	}
+
+	for i := range payments {
+		p := &payments[i]
+		p.SettlementPlanID = &plan.ID
+		err = tx.QueryRow(ctx, `
+			INSERT INTO payments (colocation_id, from_user_id, to_user_id, amount, currency, exchange_rate, base_amount, note, settlement_plan_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			RETURNING id, status, method, created_at
+		`, p.ColocationID, p.FromUserID, p.ToUserID, p.Amount, p.Currency, p.ExchangeRate, p.BaseAmount, p.Note, plan.ID).Scan(&p.ID, &p.Status, &p.Method, &p.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("erreur lors de la creation du paiement: %w", err)
+		}
+
+		event := &domain.PaymentEvent{PaymentID: p.ID, ActorID: &plan.CreatedBy, Type: domain.PaymentEventCreated}
+		if err := insertPaymentEvent(ctx, tx, event); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+const settlementPlanSelect = `
+	SELECT sp.id, sp.colocation_id, sp.created_by, sp.status, sp.balance_fingerprint, sp.total_amount,
+	       sp.completed_at, sp.invalidated_at, sp.created_at,
+	       u.nom, u.prenom, c.base_currency
+	FROM settlement_plans sp
+	INNER JOIN users u ON sp.created_by = u.id
+	INNER JOIN colocations c ON sp.colocation_id = c.id
+`
+
+// GetByID retrieves a settlement plan by ID with its payments
+func (r *SettlementRepository) GetByID(ctx context.Context, id string) (*domain.SettlementPlan, error) {
+	return r.getPlan(ctx, settlementPlanSelect+" WHERE sp.id = $1", id)
+}
+
+// GetActive retrieves the active settlement plan of a colocation, if any
+func (r *SettlementRepository) GetActive(ctx context.Context, colocationID string) (*domain.SettlementPlan, error) {
+	return r.getPlan(ctx, settlementPlanSelect+" WHERE sp.colocation_id = $1 AND sp.status = 'active'", colocationID)
+}
+
+func (r *SettlementRepository) getPlan(ctx context.Context, query string, arg string) (*domain.SettlementPlan, error) {
+	var plan domain.SettlementPlan
+	err := r.pool.QueryRow(ctx, query, arg).Scan(
+		&plan.ID, &plan.ColocationID, &plan.CreatedBy, &plan.Status, &plan.BalanceFingerprint, &plan.TotalAmount,
+		&plan.CompletedAt, &plan.InvalidatedAt, &plan.CreatedAt,
+		&plan.CreatedByNom, &plan.CreatedByPrenom, &plan.Currency,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation du plan: %w", err)
+	}
+
+	payments, err := r.listPayments(ctx, plan.ID)
+	if err != nil {
+		return nil, err
+	}
+	plan.Payments = payments
+
+	return &plan, nil
+}
+
+// listPayments lists the payments linked to a settlement plan
+func (r *SettlementRepository) listPayments(ctx context.Context, planID string) ([]domain.Payment, error) {
+	query := `
+		SELECT p.id, p.colocation_id, p.from_user_id, p.to_user_id, p.amount, p.currency, p.exchange_rate, p.base_amount, p.status, p.method, p.note, p.settlement_plan_id, p.confirmed_at, p.created_at,
+		       fu.nom, fu.prenom, fu.avatar_url,
+		       tu.nom, tu.prenom, tu.avatar_url, c.base_currency
+		FROM payments p
+		INNER JOIN users fu ON p.from_user_id = fu.id
+		INNER JOIN users tu ON p.to_user_id = tu.id
+		INNER JOIN colocations c ON p.colocation_id = c.id
+		WHERE p.settlement_plan_id = $1 AND p.deleted_at IS NULL
+		ORDER BY p.amount DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, planID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des paiements du plan: %w", err)
+	}
+	defer rows.Close()
+
+	var payments []domain.Payment
+	for rows.Next() {
+		var p domain.Payment
+		if err := rows.Scan(
+			&p.ID, &p.ColocationID, &p.FromUserID, &p.ToUserID, &p.Amount, &p.Currency, &p.ExchangeRate, &p.BaseAmount, &p.Status, &p.Method, &p.Note, &p.SettlementPlanID, &p.ConfirmedAt, &p.CreatedAt,
+			&p.FromUserNom, &p.FromUserPrenom, &p.FromAvatarURL,
+			&p.ToUserNom, &p.ToUserPrenom, &p.ToAvatarURL, &p.BaseCurrency,
+		); err != nil {
+			return nil, fmt.Errorf("erreur lors du scan du paiement: %w", err)
+		}
+		payments = append(payments, p)
+	}
+
+	return payments, rows.Err()
+}
+
+// MarkCompleted marks an active settlement plan as completed
+func (r *SettlementRepository) MarkCompleted(ctx context.Context, id string) error {
+	query := `
+		UPDATE settlement_plans
+		SET status = 'completed', completed_at = NOW()
+		WHERE id = $1 AND status = 'active'
+	`
+
+	if _, err := r.pool.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("erreur lors de la cloture du plan: %w", err)
+	}
+
+	return nil
+}
+
+// Invalidate marks an active settlement plan as invalidated and removes its
+// payments that are still pending. Confirmed payments are kept since the
+// money has actually been transferred.
+func (r *SettlementRepository) Invalidate(ctx context.Context, id string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("erreur lors du demarrage de la transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		UPDATE settlement_plans
+		SET status = 'invalidated', invalidated_at = NOW()
+		WHERE id = $1 AND status = 'active'
+	`, id)
+	if err != nil {
+		return fmt.Errorf("erreur lors de l'invalidation du plan: %w", err)
+	}
+
+	// Already completed or invalidated by someone else
+	if result.RowsAffected() == 0 {
+		return nil
+	}
+
+	if _, err := tx.Exec(ctx,
+		"DELETE FROM payments WHERE settlement_plan_id = $1 AND status = 'pending'",
+		id,
+	); err != nil {
+		return fmt.Errorf("erreur lors de la suppression des paiements en attente: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
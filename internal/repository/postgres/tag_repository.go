@@ -0,0 +1,230 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vblanchet22/back_coloc/internal/domain"
+)
+
+// TagRepository handles expense tag database operations
+type TagRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewTagRepository creates a new TagRepository
+func NewTagRepository(pool *pgxpool.Pool) *TagRepository {
+	return &TagRepository{pool: pool}
+}
+
+// Create creates a new tag
+func (r *TagRepository) Create(ctx context.Context, tag *domain.Tag) error {
+	query := `
+		INSERT INTO tags (colocation_id, name, color, created_by)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`
+
+	return r.pool.QueryRow(ctx, query,
+		tag.ColocationID,
+		tag.Name,
+		tag.Color,
+		tag.CreatedBy,
+	).Scan(&tag.ID, &tag.CreatedAt)
+}
+
+// GetByID retrieves a tag by ID
+func (r *TagRepository) GetByID(ctx context.Context, id string) (*domain.Tag, error) {
+	query := `
+		SELECT id, colocation_id, name, color, created_by, created_at
+		FROM tags
+		WHERE id = $1
+	`
+
+	var t domain.Tag
+	err := r.pool.QueryRow(ctx, query, id).Scan(&t.ID, &t.ColocationID, &t.Name, &t.Color, &t.CreatedBy, &t.CreatedAt)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation du tag: %w", err)
+	}
+
+	return &t, nil
+}
+
+// GetByName retrieves a tag of a colocation by name, case-insensitively
+func (r *TagRepository) GetByName(ctx context.Context, colocationID, name string) (*domain.Tag, error) {
+	query := `
+		SELECT id, colocation_id, name, color, created_by, created_at
+		FROM tags
+		WHERE colocation_id = $1 AND LOWER(name) = LOWER($2)
+	`
+
+	var t domain.Tag
+	err := r.pool.QueryRow(ctx, query, colocationID, name).Scan(&t.ID, &t.ColocationID, &t.Name, &t.Color, &t.CreatedBy, &t.CreatedAt)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation du tag: %w", err)
+	}
+
+	return &t, nil
+}
+
+// ListByColocation lists the tags of a colocation by name, with the number of
+// expenses using them
+func (r *TagRepository) ListByColocation(ctx context.Context, colocationID string) ([]domain.Tag, error) {
+	query := `
+		SELECT t.id, t.colocation_id, t.name, t.color, t.created_by, t.created_at,
+		       COUNT(e.id) as expense_count
+		FROM tags t
+		LEFT JOIN expense_tags et ON et.tag_id = t.id
+		LEFT JOIN expenses e ON et.expense_id = e.id AND e.deleted_at IS NULL
+		WHERE t.colocation_id = $1
+		GROUP BY t.id
+		ORDER BY LOWER(t.name)
+	`
+
+	rows, err := r.pool.Query(ctx, query, colocationID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des tags: %w", err)
+	}
+	defer rows.Close()
+
+	var tags []domain.Tag
+	for rows.Next() {
+		var t domain.Tag
+		if err := rows.Scan(&t.ID, &t.ColocationID, &t.Name, &t.Color, &t.CreatedBy, &t.CreatedAt, &t.ExpenseCount); err != nil {
+			return nil, fmt.Errorf("erreur lors du scan du tag: %w", err)
+		}
+		tags = append(tags, t)
+	}
+
+	return tags, rows.Err()
+}
+
+// CountInColocation returns how many of the given tags belong to a colocation
+func (r *TagRepository) CountInColocation(ctx context.Context, colocationID string, ids []string) (int, error) {
+	var count int
+	err := r.pool.QueryRow(ctx,
+		"SELECT COUNT(*) FROM tags WHERE colocation_id = $1 AND id = ANY($2)",
+		colocationID, ids,
+	).Scan(&count)
+	return count, err
+}
+
+// Update updates the name and color of a tag
+func (r *TagRepository) Update(ctx context.Context, tag *domain.Tag) error {
+	query := `UPDATE tags SET name = $1, color = $2 WHERE id = $3`
+	if _, err := r.pool.Exec(ctx, query, tag.Name, tag.Color, tag.ID); err != nil {
+		return fmt.Errorf("erreur lors de la mise a jour du tag: %w", err)
+	}
+	return nil
+}
+
+// Merge moves the expenses of the source tags to the target tag and deletes
+// the source tags
+func (r *TagRepository) Merge(ctx context.Context, targetID string, sourceIDs []string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("erreur lors du demarrage de la transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO expense_tags (expense_id, tag_id)
+		SELECT DISTINCT expense_id, $1::uuid FROM expense_tags WHERE tag_id = ANY($2)
+		ON CONFLICT DO NOTHING
+	`, targetID, sourceIDs)
+	if err != nil {
+		return fmt.Errorf("erreur lors du transfert des depenses: %w", err)
+	}
+
+	// Links to the source tags are deleted by CASCADE
+	if _, err := tx.Exec(ctx, "DELETE FROM tags WHERE id = ANY($1)", sourceIDs); err != nil {
+		return fmt.Errorf("erreur lors de la suppression des tags: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Delete deletes a tag, its expenses are kept
+func (r *TagRepository) Delete(ctx context.Context, id string) error {
+	if _, err := r.pool.Exec(ctx, "DELETE FROM tags WHERE id = $1", id); err != nil {
+		return fmt.Errorf("erreur lors de la suppression du tag: %w", err)
+	}
+	return nil
+}
+
+// GetStats returns tag statistics for a colocation within a date range, in its
+// base currency, along with the total spending over that range
+func (r *TagRepository) GetStats(ctx context.Context, colocationID string, startDate, endDate *time.Time) ([]domain.TagStat, domain.Money, error) {
+	query := `
+		SELECT
+			t.id,
+			t.name,
+			t.color,
+			COALESCE(SUM(e.base_amount), 0) as total_amount,
+			COUNT(e.id) as expense_count
+		FROM tags t
+		LEFT JOIN expense_tags et ON et.tag_id = t.id
+		LEFT JOIN expenses e ON et.expense_id = e.id
+			AND e.deleted_at IS NULL
+			AND ($2::timestamp IS NULL OR e.expense_date >= $2)
+			AND ($3::timestamp IS NULL OR e.expense_date <= $3)
+		WHERE t.colocation_id = $1
+		GROUP BY t.id, t.name, t.color
+		ORDER BY total_amount DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, colocationID, startDate, endDate)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var stats []domain.TagStat
+	for rows.Next() {
+		var s domain.TagStat
+		if err := rows.Scan(
+			&s.TagID,
+			&s.TagName,
+			&s.Color,
+			&s.TotalAmount,
+			&s.ExpenseCount,
+		); err != nil {
+			return nil, 0, err
+		}
+		stats = append(stats, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	// Expenses can have several tags or none, so the percentages are computed
+	// against the whole spending of the range
+	var totalAmount domain.Money
+	err = r.pool.QueryRow(ctx, `
+		SELECT COALESCE(SUM(base_amount), 0)
+		FROM expenses
+		WHERE colocation_id = $1 AND deleted_at IS NULL
+			AND ($2::timestamp IS NULL OR expense_date >= $2)
+			AND ($3::timestamp IS NULL OR expense_date <= $3)
+	`, colocationID, startDate, endDate).Scan(&totalAmount)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for i := range stats {
+		if totalAmount > 0 {
+			stats[i].Percentage = (stats[i].TotalAmount.Float64() / totalAmount.Float64()) * 100
+		}
+	}
+
+	return stats, totalAmount, nil
+}
@@ -0,0 +1,104 @@
+// Package scheduler runs periodic background jobs inside the server process.
+// Each run of a job is guarded by a Postgres advisory lock so that only one
+// server instance executes it at a time.
+package scheduler
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vblanchet22/back_coloc/internal/repository/postgres"
+)
+
+// JobFunc is the work executed by a job
+type JobFunc func(ctx context.Context) error
+
+// Job is a named task executed at a fixed interval
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      JobFunc
+}
+
+// Scheduler runs registered jobs in the background
+type Scheduler struct {
+	pool *pgxpool.Pool
+	jobs []Job
+	wg   sync.WaitGroup
+}
+
+// New creates a new Scheduler
+func New(pool *pgxpool.Pool) *Scheduler {
+	return &Scheduler{pool: pool}
+}
+
+// Register adds a job to the scheduler. It must be called before Start.
+func (s *Scheduler) Register(name string, interval time.Duration, run JobFunc) {
+	s.jobs = append(s.jobs, Job{Name: name, Interval: interval, Run: run})
+}
+
+// Start launches every registered job. Jobs run once immediately, then at
+// their interval, until ctx is cancelled.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		if job.Interval <= 0 {
+			log.Printf("[scheduler] job %s desactive (intervalle %s)", job.Name, job.Interval)
+			continue
+		}
+
+		s.wg.Add(1)
+		go func(job Job) {
+			defer s.wg.Done()
+			s.loop(ctx, job)
+		}(job)
+
+		log.Printf("[scheduler] job %s planifie toutes les %s", job.Name, job.Interval)
+	}
+}
+
+// Wait blocks until every job loop has stopped
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	s.runOnce(ctx, job)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.runOnce(ctx, job)
+		}
+	}
+}
+
+// runOnce executes a job if no other instance is currently running it
+func (s *Scheduler) runOnce(ctx context.Context, job Job) {
+	acquired, unlock, err := postgres.TryAdvisoryLock(ctx, s.pool, "scheduler:"+job.Name)
+	if err != nil {
+		log.Printf("[scheduler] job %s: %v", job.Name, err)
+		return
+	}
+	defer unlock()
+
+	if !acquired {
+		log.Printf("[scheduler] job %s deja en cours sur une autre instance, execution ignoree", job.Name)
+		return
+	}
+
+	start := time.Now()
+	if err := job.Run(ctx); err != nil {
+		log.Printf("[scheduler] job %s termine avec des erreurs:\n%v", job.Name, err)
+		return
+	}
+
+	log.Printf("[scheduler] job %s termine en %s", job.Name, time.Since(start).Round(time.Millisecond))
+}
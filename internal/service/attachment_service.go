@@ -0,0 +1,300 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/vblanchet22/back_coloc/internal/auth"
+	"github.com/vblanchet22/back_coloc/internal/constants"
+	"github.com/vblanchet22/back_coloc/internal/domain"
+	"github.com/vblanchet22/back_coloc/internal/repository/postgres"
+	"github.com/vblanchet22/back_coloc/internal/storage"
+	"github.com/vblanchet22/back_coloc/internal/utils"
+)
+
+// allowedAttachmentTypes lists the content types accepted for attachments
+var allowedAttachmentTypes = map[string]bool{
+	domain.ContentTypeJPEG: true,
+	domain.ContentTypePNG:  true,
+	domain.ContentTypeGIF:  true,
+	domain.ContentTypePDF:  true,
+}
+
+// AttachmentService handles the files attached to expenses
+type AttachmentService struct {
+	repo           *postgres.AttachmentRepository
+	expenseRepo    *postgres.ExpenseRepository
+	colocationRepo *postgres.ColocationRepository
+	store          storage.BlobStore
+	maxSize        int64
+}
+
+// NewAttachmentService creates a new AttachmentService
+func NewAttachmentService(repo *postgres.AttachmentRepository, expenseRepo *postgres.ExpenseRepository, colocationRepo *postgres.ColocationRepository, store storage.BlobStore, maxSize int64) *AttachmentService {
+	if maxSize <= 0 {
+		maxSize = constants.DefaultMaxAttachmentSize
+	}
+	return &AttachmentService{
+		repo:           repo,
+		expenseRepo:    expenseRepo,
+		colocationRepo: colocationRepo,
+		store:          store,
+		maxSize:        maxSize,
+	}
+}
+
+// UploadAttachmentInput represents input for uploading an attachment
+type UploadAttachmentInput struct {
+	ColocationID string
+	ExpenseID    string
+	FileName     string
+	Content      io.Reader
+}
+
+// ensureMembership verifies user is a member and returns the userID
+func (s *AttachmentService) ensureMembership(ctx context.Context, colocationID string) (string, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	isMember, err := s.colocationRepo.IsMember(ctx, colocationID, userID)
+	if err != nil {
+		return "", fmt.Errorf("erreur lors de la verification: %w", err)
+	}
+	if !isMember {
+		return "", fmt.Errorf("vous n'etes pas membre de cette colocation")
+	}
+
+	return userID, nil
+}
+
+// ensureExpense verifies the expense belongs to the colocation
+func (s *AttachmentService) ensureExpense(ctx context.Context, colocationID, expenseID string) error {
+	belongs, err := s.expenseRepo.BelongsToColocation(ctx, expenseID, colocationID)
+	if err != nil {
+		return fmt.Errorf("erreur lors de la verification: %w", err)
+	}
+	if !belongs {
+		return fmt.Errorf("depense introuvable")
+	}
+	return nil
+}
+
+// getAttachment retrieves an attachment and checks it belongs to the expense
+func (s *AttachmentService) getAttachment(ctx context.Context, expenseID, attachmentID string) (*domain.ExpenseAttachment, error) {
+	attachment, err := s.repo.GetByID(ctx, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+	if attachment == nil || attachment.ExpenseID != expenseID {
+		return nil, fmt.Errorf("piece jointe introuvable")
+	}
+	return attachment, nil
+}
+
+// Upload validates and stores a file attached to an expense. Images also get
+// a JPEG thumbnail.
+func (s *AttachmentService) Upload(ctx context.Context, input UploadAttachmentInput) (*domain.ExpenseAttachment, error) {
+	userID, err := s.ensureMembership(ctx, input.ColocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureExpense(ctx, input.ColocationID, input.ExpenseID); err != nil {
+		return nil, err
+	}
+
+	fileName := sanitizeFileName(input.FileName)
+	if fileName == "" {
+		return nil, fmt.Errorf("le nom du fichier est requis")
+	}
+
+	count, err := s.repo.CountByExpense(ctx, input.ExpenseID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors du comptage des pieces jointes: %w", err)
+	}
+	if count >= constants.MaxAttachmentsPerExpense {
+		return nil, fmt.Errorf("une depense ne peut pas avoir plus de %d pieces jointes", constants.MaxAttachmentsPerExpense)
+	}
+
+	// Read one byte past the limit to detect files that are too large
+	data, err := io.ReadAll(io.LimitReader(input.Content, s.maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la lecture du fichier: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("le fichier est vide")
+	}
+	if int64(len(data)) > s.maxSize {
+		return nil, fmt.Errorf("le fichier depasse la taille maximale de %d Mo", s.maxSize>>20)
+	}
+
+	// The content type is detected from the content, never trusted from the client
+	contentType := http.DetectContentType(data)
+	if !allowedAttachmentTypes[contentType] {
+		return nil, fmt.Errorf("type de fichier non supporte (%s): seuls les images JPEG, PNG, GIF et les PDF sont acceptes", contentType)
+	}
+
+	attachment := &domain.ExpenseAttachment{
+		ExpenseID:   input.ExpenseID,
+		UploadedBy:  userID,
+		FileName:    fileName,
+		ContentType: contentType,
+		SizeBytes:   int64(len(data)),
+		StorageKey:  path.Join(input.ColocationID, input.ExpenseID, randomKey()),
+	}
+
+	if attachment.IsImage() {
+		thumbnail, err := utils.GenerateThumbnail(data, constants.ThumbnailMaxDimension)
+		if err != nil {
+			return nil, err
+		}
+
+		thumbnailKey := attachment.StorageKey + "_thumb"
+		if err := s.store.Put(ctx, thumbnailKey, bytes.NewReader(thumbnail)); err != nil {
+			return nil, fmt.Errorf("erreur lors de l'enregistrement de la miniature: %w", err)
+		}
+		attachment.ThumbnailKey = &thumbnailKey
+	}
+
+	if err := s.store.Put(ctx, attachment.StorageKey, bytes.NewReader(data)); err != nil {
+		s.deleteBlobs(ctx, attachment)
+		return nil, fmt.Errorf("erreur lors de l'enregistrement du fichier: %w", err)
+	}
+
+	if err := s.repo.Create(ctx, attachment); err != nil {
+		s.deleteBlobs(ctx, attachment)
+		return nil, fmt.Errorf("erreur lors de la creation de la piece jointe: %w", err)
+	}
+
+	return s.repo.GetByID(ctx, attachment.ID)
+}
+
+// List lists the attachments of an expense
+func (s *AttachmentService) List(ctx context.Context, colocationID, expenseID string) ([]domain.ExpenseAttachment, error) {
+	if _, err := s.ensureMembership(ctx, colocationID); err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureExpense(ctx, colocationID, expenseID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListByExpense(ctx, expenseID)
+}
+
+// Open returns an attachment and a reader over its content, or over its
+// thumbnail if requested. The caller must close the reader.
+func (s *AttachmentService) Open(ctx context.Context, colocationID, expenseID, attachmentID string, thumbnail bool) (*domain.ExpenseAttachment, io.ReadCloser, error) {
+	if _, err := s.ensureMembership(ctx, colocationID); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.ensureExpense(ctx, colocationID, expenseID); err != nil {
+		return nil, nil, err
+	}
+
+	attachment, err := s.getAttachment(ctx, expenseID, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := attachment.StorageKey
+	if thumbnail {
+		if !attachment.HasThumbnail() {
+			return nil, nil, fmt.Errorf("cette piece jointe n'a pas de miniature")
+		}
+		key = *attachment.ThumbnailKey
+	}
+
+	content, err := s.store.Get(ctx, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("erreur lors de la lecture du fichier: %w", err)
+	}
+
+	return attachment, content, nil
+}
+
+// Delete deletes an attachment (uploader or expense payer only)
+func (s *AttachmentService) Delete(ctx context.Context, colocationID, expenseID, attachmentID string) error {
+	userID, err := s.ensureMembership(ctx, colocationID)
+	if err != nil {
+		return err
+	}
+
+	expense, err := s.expenseRepo.GetByID(ctx, expenseID)
+	if err != nil {
+		return err
+	}
+	if expense == nil || expense.ColocationID != colocationID {
+		return fmt.Errorf("depense introuvable")
+	}
+
+	attachment, err := s.getAttachment(ctx, expenseID, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	if attachment.UploadedBy != userID && expense.PaidBy != userID {
+		return fmt.Errorf("seul l'auteur de la piece jointe ou le payeur peut la supprimer")
+	}
+
+	if err := s.repo.Delete(ctx, attachmentID); err != nil {
+		return err
+	}
+
+	s.deleteBlobs(ctx, attachment)
+	return nil
+}
+
+// DeleteFiles removes the stored files of attachments whose records were
+// already deleted, such as those of purged expenses
+func (s *AttachmentService) DeleteFiles(ctx context.Context, attachments []domain.ExpenseAttachment) {
+	for i := range attachments {
+		s.deleteBlobs(ctx, &attachments[i])
+	}
+}
+
+// deleteBlobs removes the stored files of an attachment. Failures only leave
+// orphan files behind, so they are logged and not returned.
+func (s *AttachmentService) deleteBlobs(ctx context.Context, attachment *domain.ExpenseAttachment) {
+	keys := []string{attachment.StorageKey}
+	if attachment.ThumbnailKey != nil {
+		keys = append(keys, *attachment.ThumbnailKey)
+	}
+
+	for _, key := range keys {
+		if err := s.store.Delete(ctx, key); err != nil {
+			log.Printf("Impossible de supprimer le fichier %s: %v", key, err)
+		}
+	}
+}
+
+// sanitizeFileName keeps the base name of a client-provided file name
+func sanitizeFileName(name string) string {
+	name = strings.ReplaceAll(name, "\\", "/")
+	name = strings.TrimSpace(path.Base(name))
+	if name == "." || name == "/" {
+		return ""
+	}
+	if len(name) > 255 {
+		name = name[len(name)-255:]
+	}
+	return name
+}
+
+// randomKey returns a random hexadecimal identifier for a stored file
+func randomKey() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -44,43 +44,53 @@ func (s *BalanceService) GetBalances(ctx context.Context, colocationID string) (
 	return balances, debts, nil
 }
 
-// GetSimplifiedDebts returns simplified debts using the min-cash-flow algorithm
-func (s *BalanceService) GetSimplifiedDebts(ctx context.Context, colocationID string) ([]domain.SimplifiedDebt, error) {
+// GetSimplifiedDebts returns simplified debts and the strategy used to compute them
+func (s *BalanceService) GetSimplifiedDebts(ctx context.Context, colocationID string) ([]domain.SimplifiedDebt, algorithm.Strategy, error) {
 	if _, err := s.ensureColocationMember(ctx, colocationID); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	// Get member info and balances
 	members, err := s.repo.GetMembersInfo(ctx, colocationID)
 	if err != nil {
-		return nil, fmt.Errorf("erreur lors de la recuperation des membres: %w", err)
+		return nil, "", fmt.Errorf("erreur lors de la recuperation des membres: %w", err)
 	}
 
 	if len(members) == 0 {
-		return nil, nil
+		return nil, algorithm.StrategyExact, nil
 	}
 
 	balances, err := s.repo.GetUserBalances(ctx, colocationID)
 	if err != nil {
-		return nil, fmt.Errorf("erreur lors du calcul des soldes: %w", err)
+		return nil, "", fmt.Errorf("erreur lors du calcul des soldes: %w", err)
 	}
 
+	debts, strategy := simplifyDebts(members, balances)
+	return debts, strategy, nil
+}
+
+// simplifyDebts computes the transfers settling the net balances of the
+// members, with the exact minimum-transaction solver for small groups and the
+// greedy min-cash-flow above algorithm.ExactMaxParticipants
+func simplifyDebts(members []postgres.MemberInfo, balances []domain.UserBalance) ([]domain.SimplifiedDebt, algorithm.Strategy) {
 	// Build user index map
 	userIndexMap := make(map[string]int)
 	for i, m := range members {
 		userIndexMap[m.UserID] = i
 	}
 
-	// Build net balances array
-	netBalances := make([]float64, len(members))
+	// Build net balances array (all balances share the base currency)
+	var currency string
+	netBalances := make([]int64, len(members))
 	for _, b := range balances {
+		currency = b.Currency
 		if idx, ok := userIndexMap[b.UserID]; ok {
-			netBalances[idx] = b.NetBalance
+			netBalances[idx] = b.NetBalance.Cents()
 		}
 	}
 
-	// Run min-cash-flow algorithm
-	edges := algorithm.MinCashFlow(netBalances)
+	// Run the simplification algorithm
+	edges, strategy := algorithm.SimplifyDebts(netBalances)
 
 	// Convert edges to SimplifiedDebt with user info
 	var simplifiedDebts []domain.SimplifiedDebt
@@ -97,11 +107,12 @@ func (s *BalanceService) GetSimplifiedDebts(ctx context.Context, colocationID st
 			ToUserNom:      to.Nom,
 			ToUserPrenom:   to.Prenom,
 			ToAvatarURL:    to.AvatarURL,
-			Amount:         edge.Amount,
+			Amount:         domain.Money(edge.Amount),
+			Currency:       currency,
 		})
 	}
 
-	return simplifiedDebts, nil
+	return simplifiedDebts, strategy
 }
 
 // GetBalanceHistory returns balance history for the current user
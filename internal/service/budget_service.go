@@ -0,0 +1,362 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/vblanchet22/back_coloc/internal/auth"
+	"github.com/vblanchet22/back_coloc/internal/constants"
+	"github.com/vblanchet22/back_coloc/internal/domain"
+	"github.com/vblanchet22/back_coloc/internal/repository/postgres"
+)
+
+// budgetHistoryMonths is the history used to project one-off spending, same
+// as the expense forecast
+const budgetHistoryMonths = 3
+
+// BudgetService handles budget business logic
+type BudgetService struct {
+	repo           *postgres.BudgetRepository
+	expenseRepo    *postgres.ExpenseRepository
+	categoryRepo   *postgres.CategoryRepository
+	colocationRepo *postgres.ColocationRepository
+	notifier       *NotificationService
+}
+
+// NewBudgetService creates a new BudgetService
+func NewBudgetService(repo *postgres.BudgetRepository, expenseRepo *postgres.ExpenseRepository, categoryRepo *postgres.CategoryRepository, colocationRepo *postgres.ColocationRepository, notifier *NotificationService) *BudgetService {
+	return &BudgetService{
+		repo:           repo,
+		expenseRepo:    expenseRepo,
+		categoryRepo:   categoryRepo,
+		colocationRepo: colocationRepo,
+		notifier:       notifier,
+	}
+}
+
+// ensureMembership verifies user is a member and returns the userID
+func (s *BudgetService) ensureMembership(ctx context.Context, colocationID string) (string, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	isMember, err := s.colocationRepo.IsMember(ctx, colocationID, userID)
+	if err != nil {
+		return "", fmt.Errorf("erreur lors de la verification: %w", err)
+	}
+	if !isMember {
+		return "", fmt.Errorf("vous n'etes pas membre de cette colocation")
+	}
+
+	return userID, nil
+}
+
+// ensureAdmin verifies user is an admin of the colocation and returns the userID
+func (s *BudgetService) ensureAdmin(ctx context.Context, colocationID string) (string, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	member, err := s.colocationRepo.GetMember(ctx, colocationID, userID)
+	if err != nil {
+		return "", err
+	}
+	if member == nil || member.Role != domain.RoleAdmin {
+		return "", fmt.Errorf("seuls les administrateurs peuvent gerer les budgets")
+	}
+
+	return userID, nil
+}
+
+// getBudget retrieves a budget and verifies it belongs to the colocation
+func (s *BudgetService) getBudget(ctx context.Context, colocationID, budgetID string) (*domain.Budget, error) {
+	budget, err := s.repo.GetByID(ctx, budgetID)
+	if err != nil {
+		return nil, err
+	}
+	if budget == nil || budget.ColocationID != colocationID {
+		return nil, fmt.Errorf("budget introuvable")
+	}
+	return budget, nil
+}
+
+func validateBudget(amount domain.Money, period domain.BudgetPeriod) error {
+	if amount <= 0 {
+		return fmt.Errorf("le montant du budget doit etre positif")
+	}
+	if period != domain.BudgetPeriodWeekly && period != domain.BudgetPeriodMonthly {
+		return fmt.Errorf("periode de budget invalide")
+	}
+	return nil
+}
+
+// List returns the budgets of a colocation
+func (s *BudgetService) List(ctx context.Context, colocationID string) ([]domain.Budget, error) {
+	if _, err := s.ensureMembership(ctx, colocationID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListByColocation(ctx, colocationID)
+}
+
+// Create creates a budget for a category, or the overall budget when
+// categoryID is nil. Amounts are in the base currency of the colocation; an
+// empty currency means the base currency.
+func (s *BudgetService) Create(ctx context.Context, colocationID string, categoryID *string, amount domain.Money, currency string, period domain.BudgetPeriod) (*domain.Budget, error) {
+	userID, err := s.ensureAdmin(ctx, colocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateBudget(amount, period); err != nil {
+		return nil, err
+	}
+	if err := ensureBaseCurrency(ctx, s.colocationRepo, colocationID, currency); err != nil {
+		return nil, err
+	}
+
+	if categoryID != nil {
+		belongs, err := s.categoryRepo.BelongsToColocation(ctx, *categoryID, colocationID)
+		if err != nil {
+			return nil, fmt.Errorf("erreur lors de la verification de la categorie: %w", err)
+		}
+		if !belongs {
+			return nil, fmt.Errorf("categorie invalide pour cette colocation")
+		}
+	}
+
+	existing, err := s.repo.GetByCategory(ctx, colocationID, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		if categoryID == nil {
+			return nil, fmt.Errorf("la colocation a deja un budget global")
+		}
+		return nil, fmt.Errorf("cette categorie a deja un budget")
+	}
+
+	budget := &domain.Budget{
+		ColocationID: colocationID,
+		CategoryID:   categoryID,
+		Amount:       amount,
+		Period:       period,
+		CreatedBy:    &userID,
+	}
+
+	if err := s.repo.Create(ctx, budget); err != nil {
+		return nil, fmt.Errorf("erreur lors de la creation du budget: %w", err)
+	}
+
+	created, err := s.repo.GetByID(ctx, budget.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The new limit may already be reached
+	s.Check(ctx, colocationID)
+
+	return created, nil
+}
+
+// Update changes the limit or the period of a budget
+func (s *BudgetService) Update(ctx context.Context, colocationID, budgetID string, amount *domain.Money, currency string, period *domain.BudgetPeriod) (*domain.Budget, error) {
+	if _, err := s.ensureAdmin(ctx, colocationID); err != nil {
+		return nil, err
+	}
+
+	budget, err := s.getBudget(ctx, colocationID, budgetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if amount != nil {
+		if err := ensureBaseCurrency(ctx, s.colocationRepo, colocationID, currency); err != nil {
+			return nil, err
+		}
+		budget.Amount = *amount
+	}
+	if period != nil {
+		budget.Period = *period
+	}
+	if err := validateBudget(budget.Amount, budget.Period); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, budget); err != nil {
+		return nil, err
+	}
+
+	s.Check(ctx, colocationID)
+
+	return budget, nil
+}
+
+// Delete deletes a budget
+func (s *BudgetService) Delete(ctx context.Context, colocationID, budgetID string) error {
+	if _, err := s.ensureAdmin(ctx, colocationID); err != nil {
+		return err
+	}
+
+	if _, err := s.getBudget(ctx, colocationID, budgetID); err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, budgetID)
+}
+
+// GetStatus returns the spending of the current period of every budget of a
+// colocation
+func (s *BudgetService) GetStatus(ctx context.Context, colocationID string) ([]domain.BudgetStatus, error) {
+	if _, err := s.ensureMembership(ctx, colocationID); err != nil {
+		return nil, err
+	}
+
+	return s.statuses(ctx, colocationID, time.Now())
+}
+
+// Check notifies the members of a colocation when the spending of a budget
+// reaches the warning or the exceeded threshold, once per threshold and
+// period. Failures are logged and never fail the caller.
+func (s *BudgetService) Check(ctx context.Context, colocationID string) {
+	if s == nil {
+		return
+	}
+
+	if err := s.check(ctx, colocationID); err != nil {
+		log.Printf("Budgets de la colocation %s non verifies: %v", colocationID, err)
+	}
+}
+
+func (s *BudgetService) check(ctx context.Context, colocationID string) error {
+	statuses, err := s.statuses(ctx, colocationID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, st := range statuses {
+		// Reaching both thresholds at once only sends the exceeded alert
+		notify := false
+		var reached int
+		for _, threshold := range []int{constants.BudgetExceededThreshold, constants.BudgetWarningThreshold} {
+			if st.Percentage < float64(threshold) {
+				continue
+			}
+			recorded, err := s.repo.RecordAlert(ctx, st.Budget.ID, st.PeriodStart, threshold)
+			if err != nil {
+				return err
+			}
+			if reached == 0 {
+				reached = threshold
+				notify = recorded
+			}
+		}
+		if notify {
+			s.notifyThreshold(ctx, &st, reached)
+		}
+	}
+
+	return nil
+}
+
+// notifyThreshold notifies every member that a budget threshold was reached
+func (s *BudgetService) notifyThreshold(ctx context.Context, st *domain.BudgetStatus, threshold int) {
+	b := st.Budget
+	label := "global"
+	if b.CategoryID != nil {
+		label = fmt.Sprintf("\"%s\"", b.CategoryName)
+	}
+
+	notifType, title := domain.NotifBudgetWarning, "Budget bientot atteint"
+	if threshold >= constants.BudgetExceededThreshold {
+		notifType, title = domain.NotifBudgetExceeded, "Budget depasse"
+	}
+
+	s.notifier.Publish(ctx, b.ColocationID, "", notifType, title,
+		fmt.Sprintf("Le budget %s est utilise a %.0f%% (%s / %s %s)", label, st.Percentage, st.Spent, b.Amount, b.Currency),
+		map[string]string{"budget_id": b.ID, "period_start": st.PeriodStart.Format("2006-01-02")},
+	)
+}
+
+// statuses computes the current period of every budget of a colocation
+func (s *BudgetService) statuses(ctx context.Context, colocationID string, now time.Time) ([]domain.BudgetStatus, error) {
+	budgets, err := s.repo.ListByColocation(ctx, colocationID)
+	if err != nil {
+		return nil, err
+	}
+	if len(budgets) == 0 {
+		return nil, nil
+	}
+
+	// Same recurring templates as the expense forecast
+	recurrings, err := s.expenseRepo.ListRecurringByColocation(ctx, colocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]domain.BudgetStatus, 0, len(budgets))
+	for _, b := range budgets {
+		st, err := s.status(ctx, b, recurrings, now)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, *st)
+	}
+
+	return statuses, nil
+}
+
+// status computes the spending of the period containing now. The projection
+// adds the recurring occurrences still due in the period and the one-off
+// spending of the remaining days at the pace of the last months.
+func (s *BudgetService) status(ctx context.Context, b domain.Budget, recurrings []domain.RecurringExpense, now time.Time) (*domain.BudgetStatus, error) {
+	start, end := b.Period.Bounds(now)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	spent, err := s.repo.GetSpent(ctx, b.ColocationID, b.CategoryID, start, end, false)
+	if err != nil {
+		return nil, err
+	}
+
+	projected := spent
+	for _, re := range recurrings {
+		if !re.IsActive || (b.CategoryID != nil && re.CategoryID != *b.CategoryID) {
+			continue
+		}
+		// Occurrences before today are generated by the scheduler with their
+		// due date, they are not counted in spent yet
+		for _, occ := range re.OccurrencesUntil(end, 0) {
+			if occ.Status == domain.OccurrenceScheduled && !occ.Date.Before(start) {
+				projected += occ.Amount
+			}
+		}
+	}
+
+	remainingDays := int(end.Sub(today).Hours() / 24)
+	if remainingDays > 0 {
+		historyStart := start.AddDate(0, -budgetHistoryMonths, 0)
+		history, err := s.repo.GetSpent(ctx, b.ColocationID, b.CategoryID, historyStart, start.AddDate(0, 0, -1), true)
+		if err != nil {
+			return nil, err
+		}
+		historyDays := start.Sub(historyStart).Hours() / 24
+		projected += domain.Money(math.Round(float64(history) / historyDays * float64(remainingDays)))
+	}
+
+	st := &domain.BudgetStatus{
+		Budget:      b,
+		PeriodStart: start,
+		PeriodEnd:   end,
+		Spent:       spent,
+		Projected:   projected,
+		Remaining:   b.Amount - spent,
+	}
+	st.Percentage = spent.Float64() / b.Amount.Float64() * 100
+
+	return st, nil
+}
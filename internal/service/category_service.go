@@ -148,21 +148,31 @@ func (s *CategoryService) Delete(ctx context.Context, colocationID, categoryID s
 	return s.repo.Delete(ctx, categoryID)
 }
 
-// GetStats returns category statistics for a colocation
-func (s *CategoryService) GetStats(ctx context.Context, colocationID string, startDate, endDate *time.Time) ([]domain.CategoryStat, float64, error) {
+// GetStats returns category statistics for a colocation along with its base currency
+func (s *CategoryService) GetStats(ctx context.Context, colocationID string, startDate, endDate *time.Time) ([]domain.CategoryStat, domain.Money, string, error) {
 	userID, err := auth.GetUserIDFromContext(ctx)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 
 	// Check if user is member of the colocation
 	isMember, err := s.colocationRepo.IsMember(ctx, colocationID, userID)
 	if err != nil {
-		return nil, 0, fmt.Errorf("erreur lors de la verification: %w", err)
+		return nil, 0, "", fmt.Errorf("erreur lors de la verification: %w", err)
 	}
 	if !isMember {
-		return nil, 0, fmt.Errorf("vous n'etes pas membre de cette colocation")
+		return nil, 0, "", fmt.Errorf("vous n'etes pas membre de cette colocation")
 	}
 
-	return s.repo.GetStats(ctx, colocationID, startDate, endDate)
+	currency, err := s.colocationRepo.GetBaseCurrency(ctx, colocationID)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	stats, total, err := s.repo.GetStats(ctx, colocationID, startDate, endDate)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	return stats, total, currency, nil
 }
@@ -5,18 +5,21 @@ import (
 	"fmt"
 
 	"github.com/vblanchet22/back_coloc/internal/auth"
+	"github.com/vblanchet22/back_coloc/internal/constants"
 	"github.com/vblanchet22/back_coloc/internal/domain"
 	"github.com/vblanchet22/back_coloc/internal/repository/postgres"
 )
 
 // ColocationService handles colocation business logic
 type ColocationService struct {
-	repo *postgres.ColocationRepository
+	repo        *postgres.ColocationRepository
+	attachments *AttachmentService
+	notifier    *NotificationService
 }
 
 // NewColocationService creates a new ColocationService
-func NewColocationService(repo *postgres.ColocationRepository) *ColocationService {
-	return &ColocationService{repo: repo}
+func NewColocationService(repo *postgres.ColocationRepository, attachments *AttachmentService, notifier *NotificationService) *ColocationService {
+	return &ColocationService{repo: repo, attachments: attachments, notifier: notifier}
 }
 
 // ColocationWithRole contains colocation data with the current user's role
@@ -26,18 +29,28 @@ type ColocationWithRole struct {
 	MemberCount     int
 }
 
-// Create creates a new colocation and adds the creator as admin
-func (s *ColocationService) Create(ctx context.Context, name string, description, address *string) (*ColocationWithRole, error) {
+// Create creates a new colocation and adds the creator as admin.
+// The base currency defaults to constants.DefaultCurrency.
+func (s *ColocationService) Create(ctx context.Context, name string, description, address, baseCurrency *string) (*ColocationWithRole, error) {
 	userID, err := auth.GetUserIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	currency := constants.DefaultCurrency
+	if baseCurrency != nil && *baseCurrency != "" {
+		currency, err = domain.NormalizeCurrency(*baseCurrency)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	coloc := &domain.Colocation{
-		Name:        name,
-		Description: description,
-		Address:     address,
-		CreatedBy:   userID,
+		Name:         name,
+		Description:  description,
+		Address:      address,
+		BaseCurrency: currency,
+		CreatedBy:    userID,
 	}
 
 	if err := s.repo.Create(ctx, coloc); err != nil {
@@ -127,8 +140,10 @@ func (s *ColocationService) List(ctx context.Context) ([]ColocationWithRole, err
 	return result, nil
 }
 
-// Update updates a colocation (admin only)
-func (s *ColocationService) Update(ctx context.Context, id string, name, description, address *string) (*ColocationWithRole, error) {
+// Update updates a colocation (admin only). The base currency can only change
+// while no amount has been recorded in the colocation. A payment reminder or
+// expiry of 0 days disables it.
+func (s *ColocationService) Update(ctx context.Context, id string, name, description, address, baseCurrency *string, paymentReminderDays, paymentExpiryDays *int) (*ColocationWithRole, error) {
 	userID, err := auth.GetUserIDFromContext(ctx)
 	if err != nil {
 		return nil, err
@@ -160,6 +175,31 @@ func (s *ColocationService) Update(ctx context.Context, id string, name, descrip
 	if address != nil {
 		coloc.Address = address
 	}
+	if baseCurrency != nil {
+		currency, err := domain.NormalizeCurrency(*baseCurrency)
+		if err != nil {
+			return nil, err
+		}
+		if currency != coloc.BaseCurrency {
+			hasAmounts, err := s.repo.HasAmounts(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if hasAmounts {
+				return nil, fmt.Errorf("la devise de base ne peut plus etre modifiee une fois des montants enregistres")
+			}
+			coloc.BaseCurrency = currency
+		}
+	}
+	if paymentReminderDays != nil {
+		coloc.PaymentReminderDays = policyDays(*paymentReminderDays)
+	}
+	if paymentExpiryDays != nil {
+		coloc.PaymentExpiryDays = policyDays(*paymentExpiryDays)
+	}
+	if err := validatePaymentPolicy(coloc); err != nil {
+		return nil, err
+	}
 
 	if err := s.repo.Update(ctx, coloc); err != nil {
 		return nil, err
@@ -177,6 +217,28 @@ func (s *ColocationService) Update(ctx context.Context, id string, name, descrip
 	}, nil
 }
 
+// policyDays returns nil for a disabled delay
+func policyDays(days int) *int {
+	if days == 0 {
+		return nil
+	}
+	return &days
+}
+
+// validatePaymentPolicy checks the delays of the pending payment policy, the
+// reminder must come before the expiry
+func validatePaymentPolicy(coloc *domain.Colocation) error {
+	for _, days := range []*int{coloc.PaymentReminderDays, coloc.PaymentExpiryDays} {
+		if days != nil && (*days < 0 || *days > constants.MaxPaymentPolicyDays) {
+			return fmt.Errorf("les delais de relance et d'expiration doivent etre compris entre 0 et %d jours", constants.MaxPaymentPolicyDays)
+		}
+	}
+	if coloc.PaymentReminderDays != nil && coloc.PaymentExpiryDays != nil && *coloc.PaymentReminderDays >= *coloc.PaymentExpiryDays {
+		return fmt.Errorf("la relance doit avoir lieu avant l'expiration des paiements")
+	}
+	return nil
+}
+
 // Delete deletes a colocation (admin only)
 func (s *ColocationService) Delete(ctx context.Context, id string) error {
 	userID, err := auth.GetUserIDFromContext(ctx)
@@ -193,7 +255,13 @@ func (s *ColocationService) Delete(ctx context.Context, id string) error {
 		return fmt.Errorf("seuls les administrateurs peuvent supprimer la colocation")
 	}
 
-	return s.repo.Delete(ctx, id)
+	attachments, err := s.repo.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	s.attachments.DeleteFiles(ctx, attachments)
+	return nil
 }
 
 // Join joins a colocation using an invite code
@@ -225,6 +293,14 @@ func (s *ColocationService) Join(ctx context.Context, inviteCode string) (*Coloc
 		return nil, err
 	}
 
+	if joined, err := s.repo.GetMember(ctx, coloc.ID, userID); err == nil && joined != nil {
+		s.notifier.Publish(ctx, coloc.ID, userID, domain.NotifMemberJoined,
+			"Nouveau colocataire",
+			fmt.Sprintf("%s %s a rejoint %s", joined.Prenom, joined.Nom, coloc.Name),
+			map[string]string{"user_id": userID},
+		)
+	}
+
 	memberCount, err := s.repo.CountMembers(ctx, coloc.ID)
 	if err != nil {
 		return nil, err
@@ -271,7 +347,17 @@ func (s *ColocationService) Leave(ctx context.Context, id string) error {
 		}
 	}
 
-	return s.repo.RemoveMember(ctx, id, userID)
+	if err := s.repo.RemoveMember(ctx, id, userID); err != nil {
+		return err
+	}
+
+	s.notifier.Publish(ctx, id, userID, domain.NotifMemberLeft,
+		"Depart d'un colocataire",
+		fmt.Sprintf("%s %s a quitte la colocation", member.Prenom, member.Nom),
+		map[string]string{"user_id": userID},
+	)
+
+	return nil
 }
 
 // GetMembers retrieves all members of a colocation
@@ -314,7 +400,17 @@ func (s *ColocationService) RemoveMember(ctx context.Context, colocationID, targ
 		return fmt.Errorf("utilisez la fonction quitter pour vous retirer")
 	}
 
-	return s.repo.RemoveMember(ctx, colocationID, targetUserID)
+	if err := s.repo.RemoveMember(ctx, colocationID, targetUserID); err != nil {
+		return err
+	}
+
+	s.notifier.PublishToUser(ctx, targetUserID, userID, colocationID, domain.NotifMemberRemoved,
+		"Retrait de la colocation",
+		fmt.Sprintf("%s vous a retire de la colocation", member.Prenom),
+		map[string]string{"user_id": targetUserID},
+	)
+
+	return nil
 }
 
 // UpdateMemberRole updates a member's role (admin only)
@@ -342,6 +438,12 @@ func (s *ColocationService) UpdateMemberRole(ctx context.Context, colocationID,
 		return nil, err
 	}
 
+	s.notifier.PublishToUser(ctx, targetUserID, userID, colocationID, domain.NotifRoleChanged,
+		"Role modifie",
+		fmt.Sprintf("%s vous a attribue le role %s", member.Prenom, role),
+		map[string]string{"user_id": targetUserID, "role": role},
+	)
+
 	return s.repo.GetMember(ctx, colocationID, targetUserID)
 }
 
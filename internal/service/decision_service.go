@@ -19,13 +19,15 @@ const (
 type DecisionService struct {
 	repo           *postgres.DecisionRepository
 	colocationRepo *postgres.ColocationRepository
+	notifier       *NotificationService
 }
 
 // NewDecisionService creates a new DecisionService
-func NewDecisionService(repo *postgres.DecisionRepository, colocationRepo *postgres.ColocationRepository) *DecisionService {
+func NewDecisionService(repo *postgres.DecisionRepository, colocationRepo *postgres.ColocationRepository, notifier *NotificationService) *DecisionService {
 	return &DecisionService{
 		repo:           repo,
 		colocationRepo: colocationRepo,
+		notifier:       notifier,
 	}
 }
 
@@ -73,7 +75,18 @@ func (s *DecisionService) Create(ctx context.Context, colocationID, title string
 		return nil, fmt.Errorf("erreur lors de la creation: %w", err)
 	}
 
-	return s.repo.GetByID(ctx, decision.ID, userID)
+	created, err := s.repo.GetByID(ctx, decision.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifier.Publish(ctx, colocationID, userID, domain.NotifDecisionCreated,
+		"Nouvelle decision",
+		fmt.Sprintf("%s propose un vote: \"%s\"", created.CreatedByPrenom, created.Title),
+		map[string]string{"decision_id": created.ID},
+	)
+
+	return created, nil
 }
 
 // GetByID retrieves a decision by ID
@@ -269,6 +282,12 @@ func (s *DecisionService) Close(ctx context.Context, colocationID, decisionID st
 		return nil, fmt.Errorf("erreur lors de la fermeture: %w", err)
 	}
 
+	s.notifier.Publish(ctx, colocationID, userID, domain.NotifDecisionClosed,
+		"Decision cloturee",
+		fmt.Sprintf("Le vote \"%s\" est termine, consultez les resultats", decision.Title),
+		map[string]string{"decision_id": decision.ID},
+	)
+
 	return s.repo.GetByID(ctx, decisionID, userID)
 }
 
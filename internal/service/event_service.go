@@ -0,0 +1,336 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vblanchet22/back_coloc/internal/auth"
+	"github.com/vblanchet22/back_coloc/internal/domain"
+	"github.com/vblanchet22/back_coloc/internal/repository/postgres"
+)
+
+// EventService handles event business logic
+type EventService struct {
+	repo           *postgres.EventRepository
+	fundRepo       *postgres.FundRepository
+	colocationRepo *postgres.ColocationRepository
+	notifier       *NotificationService
+}
+
+// NewEventService creates a new EventService
+func NewEventService(repo *postgres.EventRepository, fundRepo *postgres.FundRepository, colocationRepo *postgres.ColocationRepository, notifier *NotificationService) *EventService {
+	return &EventService{
+		repo:           repo,
+		fundRepo:       fundRepo,
+		colocationRepo: colocationRepo,
+		notifier:       notifier,
+	}
+}
+
+// ensureMembership verifies user is a member and returns the userID
+func (s *EventService) ensureMembership(ctx context.Context, colocationID string) (string, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	isMember, err := s.colocationRepo.IsMember(ctx, colocationID, userID)
+	if err != nil {
+		return "", fmt.Errorf("erreur lors de la verification: %w", err)
+	}
+	if !isMember {
+		return "", fmt.Errorf("vous n'etes pas membre de cette colocation")
+	}
+
+	return userID, nil
+}
+
+// validateFund checks that a linked fund exists and belongs to the colocation
+func (s *EventService) validateFund(ctx context.Context, fundID *string, colocationID string) error {
+	if fundID == nil {
+		return nil
+	}
+
+	fund, err := s.fundRepo.GetByID(ctx, *fundID)
+	if err != nil {
+		return fmt.Errorf("erreur lors de la verification du fonds: %w", err)
+	}
+	if fund == nil || fund.ColocationID != colocationID {
+		return fmt.Errorf("fonds invalide pour cette colocation")
+	}
+
+	return nil
+}
+
+// CreateEventInput contains input for creating an event
+type CreateEventInput struct {
+	ColocationID string
+	Title        string
+	Description  *string
+	EventDate    time.Time
+	Location     *string
+	Budget       *domain.Money
+	Currency     string // Currency of the budget, empty for the base currency
+	FundID       *string
+}
+
+// Create creates a new event
+func (s *EventService) Create(ctx context.Context, input CreateEventInput) (*domain.Event, error) {
+	userID, err := s.ensureMembership(ctx, input.ColocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Budget != nil && *input.Budget < 0 {
+		return nil, fmt.Errorf("le budget ne peut pas etre negatif")
+	}
+
+	if err := ensureBaseCurrency(ctx, s.colocationRepo, input.ColocationID, input.Currency); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateFund(ctx, input.FundID, input.ColocationID); err != nil {
+		return nil, err
+	}
+
+	event := &domain.Event{
+		ColocationID: input.ColocationID,
+		FundID:       input.FundID,
+		CreatedBy:    userID,
+		Title:        input.Title,
+		Description:  input.Description,
+		Budget:       input.Budget,
+		EventDate:    input.EventDate,
+		Location:     input.Location,
+	}
+
+	if err := s.repo.Create(ctx, event); err != nil {
+		return nil, fmt.Errorf("erreur lors de la creation: %w", err)
+	}
+
+	created, err := s.repo.GetByID(ctx, event.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifier.Publish(ctx, created.ColocationID, userID, domain.NotifEventCreated,
+		"Nouvel evenement",
+		fmt.Sprintf("%s organise \"%s\" le %s", created.CreatedByPrenom, created.Title, created.EventDate.Format("02/01/2006 15:04")),
+		map[string]string{"event_id": created.ID},
+	)
+
+	return created, nil
+}
+
+// GetByID retrieves an event by ID
+func (s *EventService) GetByID(ctx context.Context, colocationID, eventID string) (*domain.Event, error) {
+	userID, err := s.ensureMembership(ctx, colocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getEvent(ctx, colocationID, eventID, userID)
+}
+
+// getEvent retrieves an event and validates it belongs to the colocation
+func (s *EventService) getEvent(ctx context.Context, colocationID, eventID, userID string) (*domain.Event, error) {
+	event, err := s.repo.GetByID(ctx, eventID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation: %w", err)
+	}
+	if event == nil || event.ColocationID != colocationID {
+		return nil, fmt.Errorf("evenement introuvable")
+	}
+
+	return event, nil
+}
+
+// ListEventsInput contains filters for listing events
+type ListEventsInput struct {
+	ColocationID string
+	Status       *domain.EventStatus
+	StartDate    *time.Time
+	EndDate      *time.Time
+	Page         int
+	PageSize     int
+}
+
+// List lists events for a colocation
+func (s *EventService) List(ctx context.Context, input ListEventsInput) ([]domain.Event, int, error) {
+	userID, err := s.ensureMembership(ctx, input.ColocationID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	input.Page, input.PageSize = normalizePagination(input.Page, input.PageSize)
+
+	return s.repo.ListByColocation(ctx, input.ColocationID, userID, input.Status, input.StartDate, input.EndDate, input.Page, input.PageSize)
+}
+
+// UpdateEventInput contains input for updating an event
+type UpdateEventInput struct {
+	ColocationID string
+	EventID      string
+	Title        *string
+	Description  *string
+	EventDate    *time.Time
+	Location     *string
+	Budget       *domain.Money
+	Currency     string // Currency of the budget, empty for the base currency
+	FundID       *string
+	Status       *domain.EventStatus
+}
+
+// Update updates an event (creator only)
+func (s *EventService) Update(ctx context.Context, input UpdateEventInput) (*domain.Event, error) {
+	userID, err := s.ensureMembership(ctx, input.ColocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := s.getEvent(ctx, input.ColocationID, input.EventID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.CreatedBy != userID {
+		return nil, fmt.Errorf("seul le createur peut modifier cet evenement")
+	}
+
+	if input.Currency != "" && input.Currency != event.Currency {
+		return nil, fmt.Errorf("les montants doivent etre en %s, la devise de base de la colocation", event.Currency)
+	}
+
+	if event.Status.IsFinal() {
+		return nil, fmt.Errorf("un evenement termine ou annule ne peut plus etre modifie")
+	}
+
+	if input.Status != nil && !event.Status.CanTransitionTo(*input.Status) {
+		return nil, fmt.Errorf("transition de statut invalide: %s vers %s", event.Status, *input.Status)
+	}
+
+	if input.Budget != nil && *input.Budget < 0 {
+		return nil, fmt.Errorf("le budget ne peut pas etre negatif")
+	}
+
+	if input.FundID != nil {
+		if err := s.validateFund(ctx, input.FundID, input.ColocationID); err != nil {
+			return nil, err
+		}
+	}
+
+	s.applyEventUpdates(event, input)
+
+	if err := s.repo.Update(ctx, event); err != nil {
+		return nil, fmt.Errorf("erreur lors de la mise a jour: %w", err)
+	}
+
+	updated, err := s.repo.GetByID(ctx, event.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if updated.Status == domain.EventStatusCancelled {
+		s.notifier.Publish(ctx, updated.ColocationID, userID, domain.NotifEventCancelled,
+			"Evenement annule",
+			fmt.Sprintf("\"%s\" a ete annule", updated.Title),
+			map[string]string{"event_id": updated.ID},
+		)
+	} else {
+		s.notifier.Publish(ctx, updated.ColocationID, userID, domain.NotifEventUpdated,
+			"Evenement modifie",
+			fmt.Sprintf("%s a modifie \"%s\"", updated.CreatedByPrenom, updated.Title),
+			map[string]string{"event_id": updated.ID},
+		)
+	}
+
+	return updated, nil
+}
+
+// applyEventUpdates applies non-nil update fields to an event
+func (s *EventService) applyEventUpdates(event *domain.Event, input UpdateEventInput) {
+	if input.Title != nil {
+		event.Title = *input.Title
+	}
+	if input.Description != nil {
+		event.Description = input.Description
+	}
+	if input.EventDate != nil {
+		event.EventDate = *input.EventDate
+	}
+	if input.Location != nil {
+		event.Location = input.Location
+	}
+	if input.Budget != nil {
+		event.Budget = input.Budget
+	}
+	if input.FundID != nil {
+		event.FundID = input.FundID
+	}
+	if input.Status != nil {
+		event.Status = *input.Status
+	}
+}
+
+// Delete deletes an event (creator only)
+func (s *EventService) Delete(ctx context.Context, colocationID, eventID string) error {
+	userID, err := s.ensureMembership(ctx, colocationID)
+	if err != nil {
+		return err
+	}
+
+	event, err := s.getEvent(ctx, colocationID, eventID, userID)
+	if err != nil {
+		return err
+	}
+
+	if event.CreatedBy != userID {
+		return fmt.Errorf("seul le createur peut supprimer cet evenement")
+	}
+
+	return s.repo.Delete(ctx, eventID)
+}
+
+// RSVP records the current user's answer to an event
+func (s *EventService) RSVP(ctx context.Context, colocationID, eventID string, rsvp domain.RSVPStatus) error {
+	userID, err := s.ensureMembership(ctx, colocationID)
+	if err != nil {
+		return err
+	}
+
+	event, err := s.getEvent(ctx, colocationID, eventID, userID)
+	if err != nil {
+		return err
+	}
+
+	if event.Status.IsFinal() {
+		return fmt.Errorf("impossible de repondre a un evenement termine ou annule")
+	}
+
+	switch rsvp {
+	case domain.RSVPGoing, domain.RSVPMaybe, domain.RSVPNotGoing:
+	default:
+		return fmt.Errorf("reponse invalide")
+	}
+
+	if err := s.repo.UpsertRSVP(ctx, eventID, userID, rsvp); err != nil {
+		return fmt.Errorf("erreur lors de l'enregistrement de la reponse: %w", err)
+	}
+
+	return nil
+}
+
+// GetParticipants returns the participants of an event
+func (s *EventService) GetParticipants(ctx context.Context, colocationID, eventID string) ([]domain.EventParticipant, error) {
+	userID, err := s.ensureMembership(ctx, colocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getEvent(ctx, colocationID, eventID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListParticipants(ctx, eventID)
+}
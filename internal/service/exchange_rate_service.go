@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vblanchet22/back_coloc/internal/auth"
+	"github.com/vblanchet22/back_coloc/internal/domain"
+	"github.com/vblanchet22/back_coloc/internal/repository/postgres"
+)
+
+// ExchangeRateService handles exchange rate business logic
+type ExchangeRateService struct {
+	repo           *postgres.ExchangeRateRepository
+	colocationRepo *postgres.ColocationRepository
+}
+
+// NewExchangeRateService creates a new ExchangeRateService
+func NewExchangeRateService(repo *postgres.ExchangeRateRepository, colocationRepo *postgres.ColocationRepository) *ExchangeRateService {
+	return &ExchangeRateService{
+		repo:           repo,
+		colocationRepo: colocationRepo,
+	}
+}
+
+// ImportRateInput is one imported rate: 1 unit of Currency is worth Rate units
+// of the colocation base currency on RateDate
+type ImportRateInput struct {
+	Currency string
+	Rate     domain.Rate
+	RateDate time.Time
+}
+
+// Import imports exchange rates towards the base currency (admin only).
+// Imported rates replace the rates already stored for the same day.
+func (s *ExchangeRateService) Import(ctx context.Context, colocationID string, inputs []ImportRateInput) ([]domain.ExchangeRate, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := s.colocationRepo.GetMember(ctx, colocationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil || member.Role != domain.RoleAdmin {
+		return nil, fmt.Errorf("seuls les administrateurs peuvent importer des taux de change")
+	}
+
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("aucun taux a importer")
+	}
+
+	baseCurrency, err := s.colocationRepo.GetBaseCurrency(ctx, colocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := make([]domain.ExchangeRate, 0, len(inputs))
+	for _, input := range inputs {
+		currency, err := domain.NormalizeCurrency(input.Currency)
+		if err != nil {
+			return nil, err
+		}
+		if currency == baseCurrency {
+			return nil, fmt.Errorf("%s est la devise de base de la colocation", currency)
+		}
+		if input.Rate <= 0 {
+			return nil, fmt.Errorf("le taux de change doit etre positif")
+		}
+
+		rates = append(rates, domain.ExchangeRate{
+			ColocationID: colocationID,
+			BaseCurrency: baseCurrency,
+			Currency:     currency,
+			Rate:         input.Rate,
+			RateDate:     input.RateDate,
+			Source:       domain.RateSourceImport,
+			CreatedBy:    &userID,
+		})
+	}
+
+	if err := s.repo.Import(ctx, rates); err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+// List lists the exchange rates of a colocation, optionally for one currency
+func (s *ExchangeRateService) List(ctx context.Context, colocationID string, currency *string) ([]domain.ExchangeRate, string, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	isMember, err := s.colocationRepo.IsMember(ctx, colocationID, userID)
+	if err != nil {
+		return nil, "", fmt.Errorf("erreur lors de la verification: %w", err)
+	}
+	if !isMember {
+		return nil, "", fmt.Errorf("vous n'etes pas membre de cette colocation")
+	}
+
+	if currency != nil {
+		normalized, err := domain.NormalizeCurrency(*currency)
+		if err != nil {
+			return nil, "", err
+		}
+		currency = &normalized
+	}
+
+	baseCurrency, err := s.colocationRepo.GetBaseCurrency(ctx, colocationID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	rates, err := s.repo.List(ctx, colocationID, baseCurrency, currency)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return rates, baseCurrency, nil
+}
+
+// convertToBase resolves the rate of an amount entered in currency on date and
+// returns its value in the base currency. An empty currency means the base
+// currency. A manual rate is used as is and recorded; otherwise the latest rate
+// on or before date is used.
+func (s *ExchangeRateService) convertToBase(ctx context.Context, colocationID, userID, currency string, amount domain.Money, date time.Time, manual *domain.Rate) (string, domain.Rate, domain.Money, error) {
+	baseCurrency, err := s.colocationRepo.GetBaseCurrency(ctx, colocationID)
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	if currency == "" || currency == baseCurrency {
+		return baseCurrency, domain.RateOne, amount, nil
+	}
+
+	rate, err := s.resolveRate(ctx, colocationID, userID, baseCurrency, currency, date, manual)
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	return currency, rate, rate.Convert(amount), nil
+}
+
+// resolveRate returns the manual rate when given, recording it, or the latest
+// stored rate on or before date
+func (s *ExchangeRateService) resolveRate(ctx context.Context, colocationID, userID, baseCurrency, currency string, date time.Time, manual *domain.Rate) (domain.Rate, error) {
+	if manual != nil {
+		if *manual <= 0 {
+			return 0, fmt.Errorf("le taux de change doit etre positif")
+		}
+		if err := s.repo.SaveManual(ctx, &domain.ExchangeRate{
+			ColocationID: colocationID,
+			BaseCurrency: baseCurrency,
+			Currency:     currency,
+			Rate:         *manual,
+			RateDate:     date,
+			Source:       domain.RateSourceManual,
+			CreatedBy:    &userID,
+		}); err != nil {
+			return 0, err
+		}
+		return *manual, nil
+	}
+
+	stored, err := s.repo.FindLatest(ctx, colocationID, baseCurrency, currency, date)
+	if err != nil {
+		return 0, err
+	}
+	if stored == nil {
+		return 0, fmt.Errorf("aucun taux de change %s/%s disponible au %s, veuillez saisir un taux", currency, baseCurrency, date.Format("02/01/2006"))
+	}
+	return stored.Rate, nil
+}
+
+// ensureBaseCurrency rejects amounts that are not in the base currency of the
+// colocation, for features that only track the base currency. An empty
+// currency means the base currency.
+func ensureBaseCurrency(ctx context.Context, colocationRepo *postgres.ColocationRepository, colocationID, currency string) error {
+	if currency == "" {
+		return nil
+	}
+
+	baseCurrency, err := colocationRepo.GetBaseCurrency(ctx, colocationID)
+	if err != nil {
+		return err
+	}
+	if currency != baseCurrency {
+		return fmt.Errorf("les montants doivent etre en %s, la devise de base de la colocation", baseCurrency)
+	}
+	return nil
+}
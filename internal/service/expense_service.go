@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/vblanchet22/back_coloc/internal/auth"
@@ -16,26 +18,47 @@ type ExpenseService struct {
 	repo           *postgres.ExpenseRepository
 	colocationRepo *postgres.ColocationRepository
 	categoryRepo   *postgres.CategoryRepository
+	tagRepo        *postgres.TagRepository
+	rates          *ExchangeRateService
+	settlements    *SettlementService
+	budgets        *BudgetService
+	notifier       *NotificationService
+	softDelete     SoftDeletePolicy
 }
 
 // NewExpenseService creates a new ExpenseService
-func NewExpenseService(repo *postgres.ExpenseRepository, colocationRepo *postgres.ColocationRepository, categoryRepo *postgres.CategoryRepository) *ExpenseService {
+func NewExpenseService(repo *postgres.ExpenseRepository, colocationRepo *postgres.ColocationRepository, categoryRepo *postgres.CategoryRepository, tagRepo *postgres.TagRepository, rates *ExchangeRateService, settlements *SettlementService, budgets *BudgetService, notifier *NotificationService, softDelete SoftDeletePolicy) *ExpenseService {
 	return &ExpenseService{
 		repo:           repo,
 		colocationRepo: colocationRepo,
 		categoryRepo:   categoryRepo,
+		tagRepo:        tagRepo,
+		rates:          rates,
+		settlements:    settlements,
+		budgets:        budgets,
+		notifier:       notifier,
+		softDelete:     softDelete,
 	}
 }
 
-// CreateExpenseInput contains input for creating an expense
+// CreateExpenseInput contains input for creating an expense.
+// Currency defaults to the base currency of the colocation; ExchangeRate
+// overrides the stored rates for foreign currencies. PaidBy defaults to the
+// current user, or to the largest contribution when Payers is given.
 type CreateExpenseInput struct {
 	ColocationID string
+	PaidBy       string
+	Payers       []domain.ExpensePayerInput // When several members paid, must sum to Amount
 	Title        string
 	Description  *string
-	Amount       float64
+	Amount       domain.Money
+	Currency     string
+	ExchangeRate *domain.Rate
 	CategoryID   string
 	SplitType    domain.SplitType
 	Splits       []domain.ExpenseSplitInput
+	Items        []domain.ExpenseItemInput // Itemized expenses only
+	TagIDs       []string
 	ExpenseDate  time.Time
 }
 
@@ -50,27 +73,71 @@ func (s *ExpenseService) Create(ctx context.Context, input CreateExpenseInput) (
 		return nil, err
 	}
 
-	splits, err := s.calculateSplits(ctx, input.ColocationID, input.Amount, input.SplitType, input.Splits, false)
+	tags, err := s.resolveTags(ctx, input.ColocationID, input.TagIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	splits, err := s.calculateSplits(ctx, input.ColocationID, input.Amount, input.SplitType, input.Splits, input.Items, false)
+	if err != nil {
+		return nil, err
+	}
+
+	paidBy := input.PaidBy
+	if paidBy == "" && len(input.Payers) == 0 {
+		paidBy = userID
+	}
+	paidBy, payers, err := s.resolvePayers(ctx, input.ColocationID, input.Amount, paidBy, input.Payers)
+	if err != nil {
+		return nil, err
+	}
+
+	currency, rate, baseAmount, err := s.rates.convertToBase(ctx, input.ColocationID, userID, input.Currency, input.Amount, input.ExpenseDate, input.ExchangeRate)
 	if err != nil {
 		return nil, err
 	}
 
 	expense := &domain.Expense{
 		ColocationID: input.ColocationID,
-		PaidBy:       userID,
+		PaidBy:       paidBy,
+		CreatedBy:    userID,
 		CategoryID:   input.CategoryID,
 		Title:        input.Title,
 		Description:  input.Description,
 		Amount:       input.Amount,
+		Currency:     currency,
+		Payers:       payers,
+		ExchangeRate: rate,
+		BaseAmount:   baseAmount,
 		SplitType:    input.SplitType,
 		ExpenseDate:  input.ExpenseDate,
+		Tags:         tags,
+	}
+	if input.SplitType == domain.SplitTypeItemized {
+		expense.Items = expenseItemsFromInput(input.Items)
 	}
+	setSplitBaseAmounts(splits, baseAmount)
+	setPayerBaseAmounts(payers, baseAmount)
 
 	if err := s.repo.Create(ctx, expense, splits); err != nil {
 		return nil, fmt.Errorf("erreur lors de la creation de la depense: %w", err)
 	}
 
-	return s.repo.GetByID(ctx, expense.ID)
+	created, err := s.repo.GetByID(ctx, expense.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.settlements.Refresh(ctx, created.ColocationID)
+	s.budgets.Check(ctx, created.ColocationID)
+
+	s.notifier.Publish(ctx, created.ColocationID, userID, domain.NotifExpenseCreated,
+		"Nouvelle depense",
+		fmt.Sprintf("%s a ajoute la depense \"%s\" (%s %s)", created.PaidByPrenom, created.Title, created.Amount, created.Currency),
+		map[string]string{"expense_id": created.ID},
+	)
+
+	return created, nil
 }
 
 // ensureMembership verifies user is a member and returns the userID
@@ -103,9 +170,40 @@ func (s *ExpenseService) validateCategory(ctx context.Context, categoryID, coloc
 	return nil
 }
 
+// resolveTags verifies the tags belong to the colocation, duplicates are ignored
+func (s *ExpenseService) resolveTags(ctx context.Context, colocationID string, tagIDs []string) ([]domain.Tag, error) {
+	seen := make(map[string]bool, len(tagIDs))
+	tags := []domain.Tag{}
+	for _, id := range tagIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		tags = append(tags, domain.Tag{ID: id})
+	}
+	if len(tags) == 0 {
+		return tags, nil
+	}
+
+	ids := make([]string, 0, len(tags))
+	for _, t := range tags {
+		ids = append(ids, t.ID)
+	}
+	count, err := s.tagRepo.CountInColocation(ctx, colocationID, ids)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la verification des tags: %w", err)
+	}
+	if count != len(ids) {
+		return nil, fmt.Errorf("tag invalide pour cette colocation")
+	}
+
+	return tags, nil
+}
+
 // calculateSplits calculates expense splits based on the split type
 // If percentageOnly is true, only percentages are calculated (for recurring expenses)
-func (s *ExpenseService) calculateSplits(ctx context.Context, colocationID string, amount float64, splitType domain.SplitType, inputSplits []domain.ExpenseSplitInput, percentageOnly bool) ([]domain.ExpenseSplitInput, error) {
+// Itemized splits are derived from items, other split types ignore them
+func (s *ExpenseService) calculateSplits(ctx context.Context, colocationID string, amount domain.Money, splitType domain.SplitType, inputSplits []domain.ExpenseSplitInput, items []domain.ExpenseItemInput, percentageOnly bool) ([]domain.ExpenseSplitInput, error) {
 	members, err := s.colocationRepo.ListMembers(ctx, colocationID)
 	if err != nil {
 		return nil, fmt.Errorf("erreur lors de la recuperation des membres: %w", err)
@@ -133,6 +231,21 @@ func (s *ExpenseService) calculateSplits(ctx context.Context, colocationID strin
 			return nil, err
 		}
 
+	case domain.SplitTypeEqualSelected, domain.SplitTypeShares:
+		splits, err = s.calculateSharesSplits(members, inputSplits, amount, splitType == domain.SplitTypeEqualSelected, percentageOnly)
+		if err != nil {
+			return nil, err
+		}
+
+	case domain.SplitTypeItemized:
+		if percentageOnly {
+			return nil, fmt.Errorf("le mode detaille n'est pas disponible pour les depenses recurrentes")
+		}
+		splits, err = s.calculateItemizedSplits(members, items, amount)
+		if err != nil {
+			return nil, err
+		}
+
 	default:
 		return nil, fmt.Errorf("type de partage invalide")
 	}
@@ -140,19 +253,21 @@ func (s *ExpenseService) calculateSplits(ctx context.Context, colocationID strin
 	return splits, nil
 }
 
-// calculateEqualSplits divides amount equally among all members
-func (s *ExpenseService) calculateEqualSplits(members []domain.ColocationMember, amount float64, percentageOnly bool) []domain.ExpenseSplitInput {
-	memberCount := float64(len(members))
-	percentage := constants.PercentageBase / memberCount
+// calculateEqualSplits divides amount equally among all members.
+// Remainder cents go to the first members in list order, so the splits
+// always sum exactly to the amount.
+func (s *ExpenseService) calculateEqualSplits(members []domain.ColocationMember, amount domain.Money, percentageOnly bool) []domain.ExpenseSplitInput {
+	percentage := constants.PercentageBase / float64(len(members))
+	amounts := amount.Split(len(members))
 
 	var splits []domain.ExpenseSplitInput
-	for _, m := range members {
+	for i, m := range members {
 		split := domain.ExpenseSplitInput{
 			UserID:     m.UserID,
 			Percentage: percentage,
 		}
 		if !percentageOnly {
-			split.Amount = amount / memberCount
+			split.Amount = amounts[i]
 		}
 		splits = append(splits, split)
 	}
@@ -160,7 +275,7 @@ func (s *ExpenseService) calculateEqualSplits(members []domain.ColocationMember,
 }
 
 // calculatePercentageSplits validates and calculates splits from percentages
-func (s *ExpenseService) calculatePercentageSplits(inputSplits []domain.ExpenseSplitInput, amount float64, percentageOnly bool) ([]domain.ExpenseSplitInput, error) {
+func (s *ExpenseService) calculatePercentageSplits(inputSplits []domain.ExpenseSplitInput, amount domain.Money, percentageOnly bool) ([]domain.ExpenseSplitInput, error) {
 	if len(inputSplits) == 0 {
 		return nil, fmt.Errorf("splits requis pour le mode pourcentage")
 	}
@@ -173,11 +288,17 @@ func (s *ExpenseService) calculatePercentageSplits(inputSplits []domain.ExpenseS
 		return inputSplits, nil
 	}
 
+	percentages := make([]float64, len(inputSplits))
+	for i, split := range inputSplits {
+		percentages[i] = split.Percentage
+	}
+	amounts := amount.Allocate(percentages)
+
 	var splits []domain.ExpenseSplitInput
-	for _, split := range inputSplits {
+	for i, split := range inputSplits {
 		splits = append(splits, domain.ExpenseSplitInput{
 			UserID:     split.UserID,
-			Amount:     amount * split.Percentage / constants.PercentageBase,
+			Amount:     amounts[i],
 			Percentage: split.Percentage,
 		})
 	}
@@ -185,7 +306,7 @@ func (s *ExpenseService) calculatePercentageSplits(inputSplits []domain.ExpenseS
 }
 
 // calculateCustomSplits validates and calculates splits from custom amounts
-func (s *ExpenseService) calculateCustomSplits(inputSplits []domain.ExpenseSplitInput, amount float64, percentageOnly bool) ([]domain.ExpenseSplitInput, error) {
+func (s *ExpenseService) calculateCustomSplits(inputSplits []domain.ExpenseSplitInput, amount domain.Money, percentageOnly bool) ([]domain.ExpenseSplitInput, error) {
 	if len(inputSplits) == 0 {
 		return nil, fmt.Errorf("splits requis pour le mode personnalise")
 	}
@@ -198,7 +319,7 @@ func (s *ExpenseService) calculateCustomSplits(inputSplits []domain.ExpenseSplit
 	for _, split := range inputSplits {
 		s := domain.ExpenseSplitInput{
 			UserID:     split.UserID,
-			Percentage: (split.Amount / amount) * constants.PercentageBase,
+			Percentage: (split.Amount.Float64() / amount.Float64()) * constants.PercentageBase,
 		}
 		if !percentageOnly {
 			s.Amount = split.Amount
@@ -208,6 +329,228 @@ func (s *ExpenseService) calculateCustomSplits(inputSplits []domain.ExpenseSplit
 	return splits, nil
 }
 
+// calculateSharesSplits divides amount among the given participants
+// proportionally to their shares. With equal, every participant counts for
+// one share and the input shares are ignored.
+func (s *ExpenseService) calculateSharesSplits(members []domain.ColocationMember, inputSplits []domain.ExpenseSplitInput, amount domain.Money, equal, percentageOnly bool) ([]domain.ExpenseSplitInput, error) {
+	if err := validateParticipants(members, inputSplits); err != nil {
+		return nil, err
+	}
+
+	weights := make([]float64, len(inputSplits))
+	var totalShares int
+	for i, split := range inputSplits {
+		shares := split.Shares
+		if equal {
+			shares = 1
+		} else if shares < 1 {
+			return nil, fmt.Errorf("le nombre de parts doit etre un entier positif")
+		}
+		weights[i] = float64(shares)
+		totalShares += shares
+	}
+	amounts := amount.Allocate(weights)
+
+	var splits []domain.ExpenseSplitInput
+	for i, split := range inputSplits {
+		s := domain.ExpenseSplitInput{
+			UserID:     split.UserID,
+			Percentage: weights[i] / float64(totalShares) * constants.PercentageBase,
+		}
+		if !equal {
+			s.Shares = split.Shares
+		}
+		if !percentageOnly {
+			s.Amount = amounts[i]
+		}
+		splits = append(splits, s)
+	}
+	return splits, nil
+}
+
+// validateParticipants checks that the participants of a split are distinct
+// members of the colocation
+func validateParticipants(members []domain.ColocationMember, inputSplits []domain.ExpenseSplitInput) error {
+	if len(inputSplits) == 0 {
+		return fmt.Errorf("participants requis pour ce mode de partage")
+	}
+
+	isMember := make(map[string]bool, len(members))
+	for _, m := range members {
+		isMember[m.UserID] = true
+	}
+
+	seen := make(map[string]bool, len(inputSplits))
+	for _, split := range inputSplits {
+		if !isMember[split.UserID] {
+			return fmt.Errorf("un participant n'est pas membre de la colocation")
+		}
+		if seen[split.UserID] {
+			return fmt.Errorf("participant en double")
+		}
+		seen[split.UserID] = true
+	}
+	return nil
+}
+
+// calculateItemizedSplits derives the splits of an itemized expense from its
+// line items. Each item is allocated among its participants by weight, then
+// the shared charges (tax, tip) are allocated proportionally to the subtotal
+// of each participant.
+func (s *ExpenseService) calculateItemizedSplits(members []domain.ColocationMember, items []domain.ExpenseItemInput, amount domain.Money) ([]domain.ExpenseSplitInput, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf("lignes requises pour le mode detaille")
+	}
+
+	isMember := make(map[string]bool, len(members))
+	for _, m := range members {
+		isMember[m.UserID] = true
+	}
+
+	var total domain.Money
+	var charges []domain.Money
+	var userIDs []string
+	subtotals := make(map[string]domain.Money)
+
+	for i, item := range items {
+		line := i + 1
+		if strings.TrimSpace(item.Label) == "" {
+			return nil, fmt.Errorf("libelle requis pour la ligne %d", line)
+		}
+		if item.Amount <= 0 {
+			return nil, fmt.Errorf("le montant de la ligne %d doit etre positif", line)
+		}
+		total += item.Amount
+
+		if item.IsSharedCharge {
+			if len(item.Participants) > 0 {
+				return nil, fmt.Errorf("la ligne %d est une charge partagee et ne peut pas avoir de participants", line)
+			}
+			charges = append(charges, item.Amount)
+			continue
+		}
+
+		if len(item.Participants) == 0 {
+			return nil, fmt.Errorf("participants requis pour la ligne %d", line)
+		}
+
+		weights := make([]float64, len(item.Participants))
+		seen := make(map[string]bool, len(item.Participants))
+		for j, p := range item.Participants {
+			if !isMember[p.UserID] {
+				return nil, fmt.Errorf("un participant de la ligne %d n'est pas membre de la colocation", line)
+			}
+			if seen[p.UserID] {
+				return nil, fmt.Errorf("participant en double sur la ligne %d", line)
+			}
+			if p.Weight < 0 {
+				return nil, fmt.Errorf("poids invalide sur la ligne %d", line)
+			}
+			seen[p.UserID] = true
+			weights[j] = itemWeight(p.Weight)
+		}
+
+		for j, part := range item.Amount.Allocate(weights) {
+			userID := item.Participants[j].UserID
+			if _, ok := subtotals[userID]; !ok {
+				userIDs = append(userIDs, userID)
+			}
+			subtotals[userID] += part
+		}
+	}
+
+	if len(userIDs) == 0 {
+		return nil, fmt.Errorf("au moins une ligne doit avoir des participants")
+	}
+	if total != amount {
+		return nil, fmt.Errorf("les lignes doivent totaliser %s (actuellement: %s)", amount, total)
+	}
+
+	shares := make([]domain.Money, len(userIDs))
+	weights := make([]float64, len(userIDs))
+	for i, userID := range userIDs {
+		shares[i] = subtotals[userID]
+		weights[i] = subtotals[userID].Float64()
+	}
+	for _, charge := range charges {
+		for i, part := range charge.Allocate(weights) {
+			shares[i] += part
+		}
+	}
+
+	splits := make([]domain.ExpenseSplitInput, len(userIDs))
+	for i, userID := range userIDs {
+		splits[i] = domain.ExpenseSplitInput{
+			UserID:     userID,
+			Amount:     shares[i],
+			Percentage: (shares[i].Float64() / amount.Float64()) * constants.PercentageBase,
+		}
+	}
+	return splits, nil
+}
+
+// itemWeight returns the share weight of an item participant, 1 when unset
+func itemWeight(weight float64) float64 {
+	if weight == 0 {
+		return 1
+	}
+	return weight
+}
+
+// expenseItemsFromInput builds the line items stored with an itemized expense
+func expenseItemsFromInput(inputs []domain.ExpenseItemInput) []domain.ExpenseItem {
+	items := make([]domain.ExpenseItem, len(inputs))
+	for i, in := range inputs {
+		items[i] = domain.ExpenseItem{
+			Label:          strings.TrimSpace(in.Label),
+			Amount:         in.Amount,
+			IsSharedCharge: in.IsSharedCharge,
+		}
+		for _, p := range in.Participants {
+			items[i].Participants = append(items[i].Participants, domain.ExpenseItemParticipant{
+				UserID: p.UserID,
+				Weight: itemWeight(p.Weight),
+			})
+		}
+	}
+	return items
+}
+
+// expenseSplitInputs turns stored splits back into inputs, to recompute the
+// splits of an expense whose participants are not given again
+func expenseSplitInputs(splits []domain.ExpenseSplit) []domain.ExpenseSplitInput {
+	inputs := make([]domain.ExpenseSplitInput, len(splits))
+	for i, split := range splits {
+		inputs[i] = domain.ExpenseSplitInput{
+			UserID:     split.UserID,
+			Amount:     split.Amount,
+			Percentage: split.Percentage,
+			Shares:     split.Shares,
+		}
+	}
+	return inputs
+}
+
+// expenseItemInputs turns stored line items back into inputs, to recompute
+// the splits of an itemized expense
+func expenseItemInputs(items []domain.ExpenseItem) []domain.ExpenseItemInput {
+	inputs := make([]domain.ExpenseItemInput, len(items))
+	for i, item := range items {
+		inputs[i] = domain.ExpenseItemInput{
+			Label:          item.Label,
+			Amount:         item.Amount,
+			IsSharedCharge: item.IsSharedCharge,
+		}
+		for _, p := range item.Participants {
+			inputs[i].Participants = append(inputs[i].Participants, domain.ExpenseItemParticipantInput{
+				UserID: p.UserID,
+				Weight: p.Weight,
+			})
+		}
+	}
+	return inputs
+}
+
 // validatePercentageTotal checks that percentages sum to 100%
 func validatePercentageTotal(splits []domain.ExpenseSplitInput) error {
 	var total float64
@@ -220,18 +563,111 @@ func validatePercentageTotal(splits []domain.ExpenseSplitInput) error {
 	return nil
 }
 
-// validateAmountTotal checks that amounts sum to the expected total
-func validateAmountTotal(splits []domain.ExpenseSplitInput, expectedTotal float64) error {
-	var total float64
+// validateAmountTotal checks that amounts sum exactly to the expected total
+func validateAmountTotal(splits []domain.ExpenseSplitInput, expectedTotal domain.Money) error {
+	var total domain.Money
 	for _, split := range splits {
 		total += split.Amount
 	}
-	if total < expectedTotal-constants.AmountTolerance || total > expectedTotal+constants.AmountTolerance {
-		return fmt.Errorf("les montants doivent totaliser %.2f EUR (actuellement: %.2f EUR)", expectedTotal, total)
+	if total != expectedTotal {
+		return fmt.Errorf("les montants doivent totaliser %s (actuellement: %s)", expectedTotal, total)
 	}
 	return nil
 }
 
+// resolvePayers validates the payers of an expense and returns its main payer
+// with the contributions. Without contributions, paidBy pays the whole amount.
+// With contributions, paidBy must be one of the payers and defaults to the
+// largest contribution.
+func (s *ExpenseService) resolvePayers(ctx context.Context, colocationID string, amount domain.Money, paidBy string, inputs []domain.ExpensePayerInput) (string, []domain.ExpensePayer, error) {
+	members, err := s.colocationRepo.ListMembers(ctx, colocationID)
+	if err != nil {
+		return "", nil, fmt.Errorf("erreur lors de la recuperation des membres: %w", err)
+	}
+
+	isMember := make(map[string]bool, len(members))
+	for _, m := range members {
+		isMember[m.UserID] = true
+	}
+
+	if len(inputs) == 0 {
+		if !isMember[paidBy] {
+			return "", nil, fmt.Errorf("le payeur n'est pas membre de la colocation")
+		}
+		return paidBy, []domain.ExpensePayer{{UserID: paidBy, Amount: amount}}, nil
+	}
+
+	var total domain.Money
+	main := -1
+	payers := make([]domain.ExpensePayer, len(inputs))
+	seen := make(map[string]bool, len(inputs))
+	for i, in := range inputs {
+		if !isMember[in.UserID] {
+			return "", nil, fmt.Errorf("un payeur n'est pas membre de la colocation")
+		}
+		if seen[in.UserID] {
+			return "", nil, fmt.Errorf("payeur en double")
+		}
+		if in.Amount <= 0 {
+			return "", nil, fmt.Errorf("la contribution de chaque payeur doit etre positive")
+		}
+		seen[in.UserID] = true
+		total += in.Amount
+		payers[i] = domain.ExpensePayer{UserID: in.UserID, Amount: in.Amount}
+
+		switch {
+		case paidBy != "":
+			if in.UserID == paidBy {
+				main = i
+			}
+		case main == -1 || in.Amount > payers[main].Amount:
+			main = i
+		}
+	}
+
+	if total != amount {
+		return "", nil, fmt.Errorf("les contributions des payeurs doivent totaliser %s (actuellement: %s)", amount, total)
+	}
+	if main == -1 {
+		return "", nil, fmt.Errorf("le payeur principal doit faire partie des payeurs")
+	}
+
+	return payers[main].UserID, payers, nil
+}
+
+// expensePayerInputs turns stored payer contributions back into inputs
+func expensePayerInputs(payers []domain.ExpensePayer) []domain.ExpensePayerInput {
+	inputs := make([]domain.ExpensePayerInput, len(payers))
+	for i, p := range payers {
+		inputs[i] = domain.ExpensePayerInput{UserID: p.UserID, Amount: p.Amount}
+	}
+	return inputs
+}
+
+// setPayerBaseAmounts distributes the base amount of an expense over its
+// payers proportionally to their contributions
+func setPayerBaseAmounts(payers []domain.ExpensePayer, baseAmount domain.Money) {
+	weights := make([]float64, len(payers))
+	for i, p := range payers {
+		weights[i] = p.Amount.Float64()
+	}
+	for i, part := range baseAmount.Allocate(weights) {
+		payers[i].BaseAmount = part
+	}
+}
+
+// setSplitBaseAmounts distributes the base amount of an expense over its splits
+// proportionally to their amounts, so the split base amounts sum exactly to it
+func setSplitBaseAmounts(splits []domain.ExpenseSplitInput, baseAmount domain.Money) {
+	weights := make([]float64, len(splits))
+	for i, split := range splits {
+		weights[i] = split.Amount.Float64()
+	}
+	for i, part := range baseAmount.Allocate(weights) {
+		splits[i].BaseAmount = part
+	}
+}
+
 // normalizePagination ensures pagination values are within valid bounds
 func normalizePagination(page, pageSize int) (int, int) {
 	if page < 1 {
@@ -267,6 +703,8 @@ type ListExpensesInput struct {
 	PaidBy       *string
 	StartDate    *time.Time
 	EndDate      *time.Time
+	TagIDs       []string
+	MatchAllTags bool // Expenses with all the tags instead of any of them
 	Page         int
 	PageSize     int
 }
@@ -279,19 +717,28 @@ func (s *ExpenseService) List(ctx context.Context, input ListExpensesInput) ([]d
 
 	input.Page, input.PageSize = normalizePagination(input.Page, input.PageSize)
 
-	return s.repo.ListByColocation(ctx, input.ColocationID, input.CategoryID, input.PaidBy, input.StartDate, input.EndDate, input.Page, input.PageSize)
+	return s.repo.ListByColocation(ctx, input.ColocationID, input.CategoryID, input.PaidBy, input.StartDate, input.EndDate, input.TagIDs, input.MatchAllTags, input.Page, input.PageSize)
 }
 
-// UpdateExpenseInput contains input for updating an expense
+// UpdateExpenseInput contains input for updating an expense.
+// The exchange rate of the expense is kept unless Currency changes or a new
+// ExchangeRate is given.
 type UpdateExpenseInput struct {
 	ColocationID string
 	ExpenseID    string
+	PaidBy       *string
+	Payers       []domain.ExpensePayerInput // Replaces the contributions, they are kept when empty
 	Title        *string
 	Description  *string
-	Amount       *float64
+	Amount       *domain.Money
+	Currency     string
+	ExchangeRate *domain.Rate
 	CategoryID   *string
 	SplitType    *domain.SplitType
-	Splits       []domain.ExpenseSplitInput
+	Splits       []domain.ExpenseSplitInput // The current splits are kept when empty and the split type is unchanged
+	Items        []domain.ExpenseItemInput  // Itemized expenses, the current items are kept when empty
+	TagIDs       []string                   // Replaces the tags, they are kept when empty
+	ClearTags    bool                       // Removes all the tags
 	ExpenseDate  *time.Time
 }
 
@@ -310,10 +757,11 @@ func (s *ExpenseService) Update(ctx context.Context, input UpdateExpenseInput) (
 		return nil, fmt.Errorf("depense introuvable")
 	}
 
-	if expense.PaidBy != userID {
-		return nil, fmt.Errorf("seul le payeur peut modifier cette depense")
+	if expense.PaidBy != userID && expense.CreatedBy != userID {
+		return nil, fmt.Errorf("seul le payeur ou l'auteur peut modifier cette depense")
 	}
 
+	splitType := expense.SplitType
 	s.applyExpenseUpdates(expense, input)
 
 	if input.CategoryID != nil {
@@ -323,16 +771,86 @@ func (s *ExpenseService) Update(ctx context.Context, input UpdateExpenseInput) (
 		expense.CategoryID = *input.CategoryID
 	}
 
-	splits, err := s.calculateSplits(ctx, input.ColocationID, expense.Amount, expense.SplitType, input.Splits, false)
+	// Tags are only rewritten when they change
+	expense.Tags = nil
+	if input.ClearTags {
+		expense.Tags = []domain.Tag{}
+	} else if len(input.TagIDs) > 0 {
+		if expense.Tags, err = s.resolveTags(ctx, input.ColocationID, input.TagIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	items := input.Items
+	if len(items) == 0 && expense.SplitType == domain.SplitTypeItemized {
+		items = expenseItemInputs(expense.Items)
+	}
+
+	inputSplits := input.Splits
+	if len(inputSplits) == 0 && expense.SplitType == splitType {
+		inputSplits = expenseSplitInputs(expense.Splits)
+	}
+
+	splits, err := s.calculateSplits(ctx, input.ColocationID, expense.Amount, expense.SplitType, inputSplits, items, false)
+	if err != nil {
+		return nil, err
+	}
+
+	expense.Items = nil
+	if expense.SplitType == domain.SplitTypeItemized {
+		expense.Items = expenseItemsFromInput(items)
+	}
+
+	// Several payers keep their contributions unless new ones are given
+	paidBy := ""
+	payerInputs := input.Payers
+	if input.PaidBy != nil {
+		paidBy = *input.PaidBy
+	} else if len(payerInputs) == 0 {
+		paidBy = expense.PaidBy
+		if len(expense.Payers) > 1 {
+			payerInputs = expensePayerInputs(expense.Payers)
+		}
+	}
+	expense.PaidBy, expense.Payers, err = s.resolvePayers(ctx, input.ColocationID, expense.Amount, paidBy, payerInputs)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := s.repo.Update(ctx, expense, splits); err != nil {
+	if (input.Currency != "" && input.Currency != expense.Currency) || input.ExchangeRate != nil {
+		currency := input.Currency
+		if currency == "" {
+			currency = expense.Currency
+		}
+		expense.Currency, expense.ExchangeRate, expense.BaseAmount, err = s.rates.convertToBase(ctx, input.ColocationID, userID, currency, expense.Amount, expense.ExpenseDate, input.ExchangeRate)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		expense.BaseAmount = expense.ExchangeRate.Convert(expense.Amount)
+	}
+	setSplitBaseAmounts(splits, expense.BaseAmount)
+	setPayerBaseAmounts(expense.Payers, expense.BaseAmount)
+
+	if err := s.repo.Update(ctx, expense, splits, userID); err != nil {
 		return nil, fmt.Errorf("erreur lors de la mise a jour: %w", err)
 	}
 
-	return s.repo.GetByID(ctx, expense.ID)
+	updated, err := s.repo.GetByID(ctx, expense.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.settlements.Refresh(ctx, updated.ColocationID)
+	s.budgets.Check(ctx, updated.ColocationID)
+
+	s.notifier.Publish(ctx, updated.ColocationID, userID, domain.NotifExpenseUpdated,
+		"Depense modifiee",
+		fmt.Sprintf("%s a modifie la depense \"%s\" (%s %s)", updated.PaidByPrenom, updated.Title, updated.Amount, updated.Currency),
+		map[string]string{"expense_id": updated.ID},
+	)
+
+	return updated, nil
 }
 
 // applyExpenseUpdates applies non-nil update fields to an expense
@@ -354,34 +872,369 @@ func (s *ExpenseService) applyExpenseUpdates(expense *domain.Expense, input Upda
 	}
 }
 
-// Delete deletes an expense
-func (s *ExpenseService) Delete(ctx context.Context, colocationID, expenseID string) error {
+// Delete soft-deletes an expense and returns the date until which it can be
+// restored
+func (s *ExpenseService) Delete(ctx context.Context, colocationID, expenseID string) (time.Time, error) {
 	userID, err := s.ensureMembership(ctx, colocationID)
 	if err != nil {
-		return err
+		return time.Time{}, err
 	}
 
 	expense, err := s.repo.GetByID(ctx, expenseID)
 	if err != nil {
-		return fmt.Errorf("erreur lors de la recuperation: %w", err)
+		return time.Time{}, fmt.Errorf("erreur lors de la recuperation: %w", err)
 	}
 	if expense == nil || expense.ColocationID != colocationID {
-		return fmt.Errorf("depense introuvable")
+		return time.Time{}, fmt.Errorf("depense introuvable")
 	}
 
-	if expense.PaidBy != userID {
-		return fmt.Errorf("seul le payeur peut supprimer cette depense")
+	if expense.PaidBy != userID && expense.CreatedBy != userID {
+		return time.Time{}, fmt.Errorf("seul le payeur ou l'auteur peut supprimer cette depense")
+	}
+
+	if err := s.repo.Delete(ctx, expense, userID); err != nil {
+		return time.Time{}, err
+	}
+
+	s.settlements.Refresh(ctx, colocationID)
+
+	s.notifier.Publish(ctx, colocationID, userID, domain.NotifExpenseDeleted,
+		"Depense supprimee",
+		fmt.Sprintf("%s a supprime la depense \"%s\" (%s %s)", expense.PaidByPrenom, expense.Title, expense.Amount, expense.Currency),
+		map[string]string{"expense_id": expense.ID},
+	)
+
+	return s.softDelete.RestorableUntil(*expense.DeletedAt), nil
+}
+
+// Restore brings back a soft-deleted expense within the undo window (payer,
+// author or member who deleted it)
+func (s *ExpenseService) Restore(ctx context.Context, colocationID, expenseID string) (*domain.Expense, error) {
+	userID, err := s.ensureMembership(ctx, colocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	expense, err := s.repo.GetDeletedByID(ctx, expenseID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation: %w", err)
+	}
+	if expense == nil || expense.ColocationID != colocationID {
+		return nil, fmt.Errorf("depense introuvable")
+	}
+
+	deletedBy := expense.DeletedBy != nil && *expense.DeletedBy == userID
+	if expense.PaidBy != userID && expense.CreatedBy != userID && !deletedBy {
+		return nil, fmt.Errorf("seul le payeur ou l'auteur peut restaurer cette depense")
+	}
+
+	if err := s.softDelete.ensureRestorable(expense.DeletedAt); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Undelete(ctx, expense, userID); err != nil {
+		return nil, err
+	}
+
+	restored, err := s.repo.GetByID(ctx, expenseID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.settlements.Refresh(ctx, colocationID)
+	s.budgets.Check(ctx, colocationID)
+
+	s.notifier.Publish(ctx, colocationID, userID, domain.NotifExpenseUpdated,
+		"Depense restauree",
+		fmt.Sprintf("La depense \"%s\" a ete restauree (%s %s)", restored.Title, restored.Amount, restored.Currency),
+		map[string]string{"expense_id": restored.ID},
+	)
+
+	return restored, nil
+}
+
+// ListDeleted lists the deleted expenses of a colocation that can still be
+// restored, most recently deleted first
+func (s *ExpenseService) ListDeleted(ctx context.Context, colocationID string) ([]domain.Expense, error) {
+	if _, err := s.ensureMembership(ctx, colocationID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListDeleted(ctx, colocationID, time.Now().Add(-s.softDelete.UndoWindow))
+}
+
+// RestorableUntil returns the date until which a deleted expense can be restored
+func (s *ExpenseService) RestorableUntil(deletedAt time.Time) time.Time {
+	return s.softDelete.RestorableUntil(deletedAt)
+}
+
+// PurgeDeleted hard-deletes the expenses deleted for longer than the
+// retention. It returns the number of purged expenses and their attachments,
+// whose files are left to the caller.
+func (s *ExpenseService) PurgeDeleted(ctx context.Context) (int, []domain.ExpenseAttachment, error) {
+	return s.repo.PurgeDeleted(ctx, s.softDelete.purgeBefore(time.Now()))
+}
+
+// ListHistory lists the revisions of an expense, newest first, with the fields
+// changed by each of them. The history of a deleted expense stays available.
+func (s *ExpenseService) ListHistory(ctx context.Context, colocationID, expenseID string) ([]domain.ExpenseRevision, error) {
+	if _, err := s.ensureMembership(ctx, colocationID); err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.repo.ListRevisions(ctx, expenseID)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) == 0 || revisions[0].ColocationID != colocationID {
+		return nil, fmt.Errorf("historique introuvable")
+	}
+
+	labels, err := s.loadHistoryLabels(ctx, colocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 1; i < len(revisions); i++ {
+		revisions[i].Changes = labels.diff(&revisions[i-1].Snapshot, &revisions[i].Snapshot)
+	}
+
+	history := make([]domain.ExpenseRevision, len(revisions))
+	for i, rev := range revisions {
+		history[len(revisions)-1-i] = rev
+	}
+	return history, nil
+}
+
+// RestoreRevision brings an expense back to the state recorded by one of its
+// revisions. A deleted expense is recreated with its ID.
+func (s *ExpenseService) RestoreRevision(ctx context.Context, colocationID, expenseID string, revision int) (*domain.Expense, error) {
+	userID, err := s.ensureMembership(ctx, colocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	rev, err := s.repo.GetRevision(ctx, expenseID, revision)
+	if err != nil {
+		return nil, err
+	}
+	if rev == nil || rev.ColocationID != colocationID {
+		return nil, fmt.Errorf("version introuvable")
+	}
+	snapshot := rev.Snapshot
+
+	current, err := s.repo.GetByID(ctx, expenseID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation: %w", err)
+	}
+
+	// The payer or the author of the current state, or of the restored one
+	// when the expense was deleted
+	paidBy, createdBy := snapshot.PaidBy, snapshot.CreatedBy
+	if current != nil {
+		paidBy, createdBy = current.PaidBy, current.CreatedBy
+	}
+	if paidBy != userID && createdBy != userID {
+		return nil, fmt.Errorf("seul le payeur ou l'auteur peut restaurer cette depense")
+	}
+
+	if err := s.validateCategory(ctx, snapshot.CategoryID, colocationID); err != nil {
+		return nil, err
+	}
+	if err := s.validateSnapshotMembers(ctx, colocationID, &snapshot); err != nil {
+		return nil, err
+	}
+
+	expense := &domain.Expense{
+		ID:           expenseID,
+		ColocationID: colocationID,
+		PaidBy:       snapshot.PaidBy,
+		CreatedBy:    snapshot.CreatedBy,
+		CategoryID:   snapshot.CategoryID,
+		Title:        snapshot.Title,
+		Description:  snapshot.Description,
+		Amount:       snapshot.Amount,
+		Currency:     snapshot.Currency,
+		ExchangeRate: snapshot.ExchangeRate,
+		BaseAmount:   snapshot.BaseAmount,
+		SplitType:    snapshot.SplitType,
+		ExpenseDate:  snapshot.ExpenseDate,
+		RecurringID:  snapshot.RecurringID,
+		ImportRef:    snapshot.ImportRef,
+		Payers:       snapshot.Payers,
+	}
+	if snapshot.SplitType == domain.SplitTypeItemized {
+		expense.Items = expenseItemsFromInput(snapshot.Items)
+	}
+
+	if err := s.repo.Restore(ctx, expense, snapshot.Splits, userID); err != nil {
+		return nil, fmt.Errorf("erreur lors de la restauration: %w", err)
+	}
+
+	restored, err := s.repo.GetByID(ctx, expenseID)
+	if err != nil {
+		return nil, err
 	}
 
-	return s.repo.Delete(ctx, expenseID)
+	s.settlements.Refresh(ctx, colocationID)
+	s.budgets.Check(ctx, colocationID)
+
+	s.notifier.Publish(ctx, colocationID, userID, domain.NotifExpenseUpdated,
+		"Depense restauree",
+		fmt.Sprintf("La depense \"%s\" a ete restauree a sa version %d (%s %s)", restored.Title, revision, restored.Amount, restored.Currency),
+		map[string]string{"expense_id": restored.ID},
+	)
+
+	return restored, nil
 }
 
-// CreateRecurringInput contains input for creating a recurring expense
+// validateSnapshotMembers checks that the payers and participants of a
+// revision are still members of the colocation
+func (s *ExpenseService) validateSnapshotMembers(ctx context.Context, colocationID string, snapshot *domain.ExpenseSnapshot) error {
+	members, err := s.colocationRepo.ListMembers(ctx, colocationID)
+	if err != nil {
+		return fmt.Errorf("erreur lors de la recuperation des membres: %w", err)
+	}
+
+	isMember := make(map[string]bool, len(members))
+	for _, m := range members {
+		isMember[m.UserID] = true
+	}
+
+	userIDs := []string{snapshot.PaidBy}
+	for _, p := range snapshot.Payers {
+		userIDs = append(userIDs, p.UserID)
+	}
+	for _, split := range snapshot.Splits {
+		userIDs = append(userIDs, split.UserID)
+	}
+	for _, item := range snapshot.Items {
+		for _, p := range item.Participants {
+			userIDs = append(userIDs, p.UserID)
+		}
+	}
+
+	for _, id := range userIDs {
+		if !isMember[id] {
+			return fmt.Errorf("un membre de cette version a quitte la colocation")
+		}
+	}
+	return nil
+}
+
+// historyLabels resolves the member and category names shown in the changes
+// of an expense history
+type historyLabels struct {
+	users      map[string]string
+	categories map[string]string
+}
+
+// loadHistoryLabels loads the names of the members and categories of a colocation
+func (s *ExpenseService) loadHistoryLabels(ctx context.Context, colocationID string) (*historyLabels, error) {
+	members, err := s.colocationRepo.ListMembers(ctx, colocationID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des membres: %w", err)
+	}
+	categories, err := s.categoryRepo.ListByColocation(ctx, colocationID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des categories: %w", err)
+	}
+
+	labels := &historyLabels{
+		users:      make(map[string]string, len(members)),
+		categories: make(map[string]string, len(categories)),
+	}
+	for _, m := range members {
+		labels.users[m.UserID] = strings.TrimSpace(m.Prenom + " " + m.Nom)
+	}
+	for _, c := range categories {
+		labels.categories[c.ID] = c.Name
+	}
+	return labels, nil
+}
+
+// user returns the name of a member, or its ID when it left the colocation
+func (l *historyLabels) user(id string) string {
+	if name, ok := l.users[id]; ok {
+		return name
+	}
+	return id
+}
+
+// category returns the name of a category, or its ID when it was deleted
+func (l *historyLabels) category(id string) string {
+	if name, ok := l.categories[id]; ok {
+		return name
+	}
+	return id
+}
+
+// diff lists the fields that differ between two states of an expense
+func (l *historyLabels) diff(old, cur *domain.ExpenseSnapshot) []domain.ExpenseFieldChange {
+	var changes []domain.ExpenseFieldChange
+	add := func(field, oldValue, newValue string) {
+		if oldValue != newValue {
+			changes = append(changes, domain.ExpenseFieldChange{Field: field, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+
+	add("title", old.Title, cur.Title)
+	add("description", optionalString(old.Description), optionalString(cur.Description))
+	add("amount", old.Amount.String()+" "+old.Currency, cur.Amount.String()+" "+cur.Currency)
+	add("exchange_rate", old.ExchangeRate.String(), cur.ExchangeRate.String())
+	add("category", l.category(old.CategoryID), l.category(cur.CategoryID))
+	add("expense_date", old.ExpenseDate.Format("2006-01-02"), cur.ExpenseDate.Format("2006-01-02"))
+	add("split_type", string(old.SplitType), string(cur.SplitType))
+	add("payers", l.formatPayers(old), l.formatPayers(cur))
+	add("splits", l.formatSplits(old), l.formatSplits(cur))
+	add("items", formatItems(old), formatItems(cur))
+
+	return changes
+}
+
+// formatPayers formats the contributions of the payers of an expense
+func (l *historyLabels) formatPayers(snapshot *domain.ExpenseSnapshot) string {
+	parts := make([]string, len(snapshot.Payers))
+	for i, p := range snapshot.Payers {
+		parts[i] = fmt.Sprintf("%s: %s", l.user(p.UserID), p.Amount)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatSplits formats the amount owed by each participant of an expense
+func (l *historyLabels) formatSplits(snapshot *domain.ExpenseSnapshot) string {
+	parts := make([]string, len(snapshot.Splits))
+	for i, split := range snapshot.Splits {
+		parts[i] = fmt.Sprintf("%s: %s", l.user(split.UserID), split.Amount)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatItems formats the line items of an itemized expense
+func formatItems(snapshot *domain.ExpenseSnapshot) string {
+	parts := make([]string, len(snapshot.Items))
+	for i, item := range snapshot.Items {
+		parts[i] = fmt.Sprintf("%s: %s", item.Label, item.Amount)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// optionalString returns the value of an optional string, empty when nil
+func optionalString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// CreateRecurringInput contains input for creating a recurring expense.
+// Recurring expenses are always in the base currency of the colocation.
 type CreateRecurringInput struct {
 	ColocationID string
 	Title        string
 	Description  *string
-	Amount       float64
+	Amount       domain.Money
+	Currency     string
 	CategoryID   string
 	SplitType    domain.SplitType
 	Splits       []domain.ExpenseSplitInput
@@ -401,7 +1254,11 @@ func (s *ExpenseService) CreateRecurring(ctx context.Context, input CreateRecurr
 		return nil, err
 	}
 
-	splits, err := s.calculateSplits(ctx, input.ColocationID, input.Amount, input.SplitType, input.Splits, true)
+	if err := ensureBaseCurrency(ctx, s.colocationRepo, input.ColocationID, input.Currency); err != nil {
+		return nil, err
+	}
+
+	splits, err := s.calculateSplits(ctx, input.ColocationID, input.Amount, input.SplitType, input.Splits, nil, true)
 	if err != nil {
 		return nil, err
 	}
@@ -442,7 +1299,8 @@ type UpdateRecurringInput struct {
 	RecurringID  string
 	Title        *string
 	Description  *string
-	Amount       *float64
+	Amount       *domain.Money
+	Currency     string
 	CategoryID   *string
 	SplitType    *domain.SplitType
 	Splits       []domain.ExpenseSplitInput
@@ -470,6 +1328,10 @@ func (s *ExpenseService) UpdateRecurring(ctx context.Context, input UpdateRecurr
 		return nil, fmt.Errorf("seul le payeur peut modifier cette depense")
 	}
 
+	if err := ensureBaseCurrency(ctx, s.colocationRepo, input.ColocationID, input.Currency); err != nil {
+		return nil, err
+	}
+
 	s.applyRecurringUpdates(recurring, input)
 
 	if input.CategoryID != nil {
@@ -481,7 +1343,7 @@ func (s *ExpenseService) UpdateRecurring(ctx context.Context, input UpdateRecurr
 
 	var splits []domain.ExpenseSplitInput
 	if len(input.Splits) > 0 || input.SplitType != nil {
-		splits, err = s.calculateSplits(ctx, input.ColocationID, recurring.Amount, recurring.SplitType, input.Splits, true)
+		splits, err = s.calculateSplits(ctx, input.ColocationID, recurring.Amount, recurring.SplitType, input.Splits, nil, true)
 		if err != nil {
 			return nil, err
 		}
@@ -541,13 +1403,183 @@ func (s *ExpenseService) DeleteRecurring(ctx context.Context, colocationID, recu
 	return s.repo.DeleteRecurring(ctx, recurringID)
 }
 
+// getOwnRecurring retrieves a recurring expense of the colocation that the
+// current user pays
+func (s *ExpenseService) getOwnRecurring(ctx context.Context, colocationID, recurringID string) (*domain.RecurringExpense, error) {
+	userID, err := s.ensureMembership(ctx, colocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	recurring, err := s.repo.GetRecurringByID(ctx, recurringID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation: %w", err)
+	}
+	if recurring == nil || recurring.ColocationID != colocationID {
+		return nil, fmt.Errorf("depense recurrente introuvable")
+	}
+
+	if recurring.PaidBy != userID {
+		return nil, fmt.Errorf("seul le payeur peut modifier cette depense")
+	}
+
+	return recurring, nil
+}
+
+// OccurrenceOverrideInput contains the changes to one occurrence of a
+// recurring expense. Amounts are in the base currency of the colocation.
+type OccurrenceOverrideInput struct {
+	ColocationID   string
+	RecurringID    string
+	OccurrenceDate time.Time
+	Skip           bool
+	Amount         *domain.Money
+	Currency       string
+	PaidBy         *string
+}
+
+// SetOccurrenceOverride skips an occurrence of a recurring expense or changes
+// its amount or payer, replacing any previous change to that occurrence
+func (s *ExpenseService) SetOccurrenceOverride(ctx context.Context, input OccurrenceOverrideInput) (*domain.RecurringOccurrence, error) {
+	recurring, err := s.getOwnRecurring(ctx, input.ColocationID, input.RecurringID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !input.Skip && input.Amount == nil && input.PaidBy == nil {
+		return nil, fmt.Errorf("aucune modification de l'occurrence")
+	}
+	if !recurring.IsOccurrence(input.OccurrenceDate) {
+		return nil, fmt.Errorf("cette date n'est pas une occurrence a venir de la depense recurrente")
+	}
+
+	if input.Amount != nil {
+		if *input.Amount <= 0 {
+			return nil, fmt.Errorf("le montant doit etre positif")
+		}
+		if err := ensureBaseCurrency(ctx, s.colocationRepo, input.ColocationID, input.Currency); err != nil {
+			return nil, err
+		}
+	}
+	if input.PaidBy != nil {
+		isMember, err := s.colocationRepo.IsMember(ctx, input.ColocationID, *input.PaidBy)
+		if err != nil {
+			return nil, fmt.Errorf("erreur lors de la verification: %w", err)
+		}
+		if !isMember {
+			return nil, fmt.Errorf("le payeur n'est pas membre de la colocation")
+		}
+	}
+
+	// Only the payer can change the occurrences
+	userID := recurring.PaidBy
+	override := &domain.RecurringOccurrenceOverride{
+		RecurringID:    recurring.ID,
+		OccurrenceDate: input.OccurrenceDate,
+		Skip:           input.Skip,
+		Amount:         input.Amount,
+		PaidBy:         input.PaidBy,
+		CreatedBy:      &userID,
+	}
+	if err := s.repo.SetRecurringOverride(ctx, override); err != nil {
+		return nil, err
+	}
+
+	return s.getOccurrence(ctx, recurring.ID, input.OccurrenceDate)
+}
+
+// ClearOccurrenceOverride restores an occurrence of a recurring expense to
+// the template
+func (s *ExpenseService) ClearOccurrenceOverride(ctx context.Context, colocationID, recurringID string, occurrenceDate time.Time) (*domain.RecurringOccurrence, error) {
+	recurring, err := s.getOwnRecurring(ctx, colocationID, recurringID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !recurring.IsOccurrence(occurrenceDate) {
+		return nil, fmt.Errorf("cette date n'est pas une occurrence a venir de la depense recurrente")
+	}
+
+	if err := s.repo.DeleteRecurringOverride(ctx, recurring.ID, occurrenceDate); err != nil {
+		return nil, err
+	}
+
+	return s.getOccurrence(ctx, recurring.ID, occurrenceDate)
+}
+
+// getOccurrence reloads a recurring expense and returns one of its occurrences
+func (s *ExpenseService) getOccurrence(ctx context.Context, recurringID string, date time.Time) (*domain.RecurringOccurrence, error) {
+	recurring, err := s.repo.GetRecurringByID(ctx, recurringID)
+	if err != nil {
+		return nil, err
+	}
+	if recurring == nil {
+		return nil, fmt.Errorf("depense recurrente introuvable")
+	}
+
+	occurrence := recurring.Occurrence(date)
+	return &occurrence, nil
+}
+
+// PauseRecurring stops generating the occurrences of a recurring expense
+// until a date, or resumes it right away when until is nil
+func (s *ExpenseService) PauseRecurring(ctx context.Context, colocationID, recurringID string, until *time.Time) (*domain.RecurringExpense, error) {
+	recurring, err := s.getOwnRecurring(ctx, colocationID, recurringID)
+	if err != nil {
+		return nil, err
+	}
+
+	if until != nil && !until.After(time.Now()) {
+		return nil, fmt.Errorf("la date de reprise doit etre dans le futur")
+	}
+
+	if err := s.repo.SetRecurringPause(ctx, recurring.ID, until); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetRecurringByID(ctx, recurring.ID)
+}
+
+// ListUpcomingOccurrences returns the next occurrences of a recurring expense
+// with their overrides applied, skipped and paused ones included. An inactive
+// recurring expense has none.
+func (s *ExpenseService) ListUpcomingOccurrences(ctx context.Context, colocationID, recurringID string, count int) ([]domain.RecurringOccurrence, error) {
+	if _, err := s.ensureMembership(ctx, colocationID); err != nil {
+		return nil, err
+	}
+
+	recurring, err := s.repo.GetRecurringByID(ctx, recurringID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation: %w", err)
+	}
+	if recurring == nil || recurring.ColocationID != colocationID {
+		return nil, fmt.Errorf("depense recurrente introuvable")
+	}
+
+	if count < 1 {
+		count = constants.DefaultUpcomingOccurrences
+	}
+	if count > constants.MaxUpcomingOccurrences {
+		count = constants.MaxUpcomingOccurrences
+	}
+
+	if !recurring.IsActive {
+		return nil, nil
+	}
+
+	// Far enough for count yearly occurrences, the count bounds the list
+	horizon := recurring.NextDueDate.AddDate(count, 0, 0)
+	return recurring.OccurrencesUntil(horizon, count), nil
+}
+
 // Forecast limits
 const (
 	maxForecastMonths = 12
 )
 
-// GetForecast returns expense forecast for a colocation
-func (s *ExpenseService) GetForecast(ctx context.Context, colocationID string, monthsAhead int) ([]domain.MonthlyForecast, error) {
+// GetForecast returns expense forecast for a colocation. The recurring mode is
+// used when mode is empty.
+func (s *ExpenseService) GetForecast(ctx context.Context, colocationID string, monthsAhead int, mode domain.ForecastMode) ([]domain.MonthlyForecast, error) {
 	if _, err := s.ensureMembership(ctx, colocationID); err != nil {
 		return nil, err
 	}
@@ -556,44 +1588,116 @@ func (s *ExpenseService) GetForecast(ctx context.Context, colocationID string, m
 		monthsAhead = constants.DefaultForecastMonths
 	}
 
-	return s.repo.GetForecastData(ctx, colocationID, monthsAhead)
+	switch mode {
+	case "", domain.ForecastModeRecurring:
+		return s.repo.GetForecastData(ctx, colocationID, monthsAhead)
+	case domain.ForecastModeHistory:
+		return s.getHistoryForecast(ctx, colocationID, monthsAhead)
+	default:
+		return nil, fmt.Errorf("mode de prevision invalide")
+	}
 }
 
-// ProcessDueRecurringExpenses processes all recurring expenses that are due
-func (s *ExpenseService) ProcessDueRecurringExpenses(ctx context.Context) error {
+// getHistoryForecast blends the recurring occurrences with the one-off
+// spending estimated by a seasonal model fitted per category
+func (s *ExpenseService) getHistoryForecast(ctx context.Context, colocationID string, monthsAhead int) ([]domain.MonthlyForecast, error) {
+	forecasts, err := s.repo.GetRecurringForecast(ctx, colocationID, monthsAhead)
+	if err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	totals, err := s.repo.ListMonthlyCategoryTotals(ctx, colocationID, currentMonth.AddDate(0, -constants.ForecastHistoryMonths, 0))
+	if err != nil {
+		return nil, err
+	}
+	models := fitSeasonalModels(totals, currentMonth)
 
-	recurrings, err := s.repo.GetActiveRecurringDue(ctx, now)
+	for i := range forecasts {
+		month, err := time.Parse("2006-01", forecasts[i].Month)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range models {
+			estimate, low, high := m.predict(month.Month())
+			if high == 0 {
+				continue
+			}
+			forecasts[i].Add(domain.CategoryForecast{
+				CategoryID:      m.categoryID,
+				CategoryName:    m.categoryName,
+				Amount:          estimate,
+				EstimatedAmount: estimate,
+				LowAmount:       low,
+				HighAmount:      high,
+			})
+		}
+	}
+
+	return forecasts, nil
+}
+
+// ProcessDueRecurringExpenses processes all recurring expenses that are due.
+// Missed occurrences are caught up, so a monthly template due three months ago
+// generates three expenses. It returns the number of expenses created and the
+// errors of every template that failed, without stopping the others.
+func (s *ExpenseService) ProcessDueRecurringExpenses(ctx context.Context) (int, error) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	recurrings, err := s.repo.GetActiveRecurringDue(ctx, today)
 	if err != nil {
-		return fmt.Errorf("erreur lors de la recuperation des recurrences: %w", err)
+		return 0, fmt.Errorf("erreur lors de la recuperation des recurrences: %w", err)
 	}
 
+	created := 0
+	var errs []error
 	for _, re := range recurrings {
-		if _, err := s.repo.CreateFromRecurring(ctx, &re); err != nil {
-			continue
+		n, err := s.processRecurringOccurrences(ctx, &re, today)
+		created += n
+		if err != nil {
+			errs = append(errs, fmt.Errorf("recurrence %s (%s): %w", re.ID, re.Title, err))
 		}
+	}
 
-		nextDue := calculateNextDueDate(re.NextDueDate, re.Recurrence)
-		if err := s.repo.UpdateNextDueDate(ctx, re.ID, nextDue); err != nil {
-			continue
+	return created, errors.Join(errs...)
+}
+
+// processRecurringOccurrences generates every occurrence of a template due by today
+func (s *ExpenseService) processRecurringOccurrences(ctx context.Context, re *domain.RecurringExpense, today time.Time) (int, error) {
+	created := 0
+	occurrence := re.NextDueDate
+
+	for !occurrence.After(today) {
+		if re.EndDate != nil && occurrence.After(*re.EndDate) {
+			break
+		}
+
+		nextDue := calculateNextDueDate(occurrence, re.Recurrence)
+		// Skipped and paused occurrences only advance the due date
+		expense, err := s.repo.CreateFromRecurring(ctx, re, re.Occurrence(occurrence), nextDue)
+		if err != nil {
+			return created, fmt.Errorf("occurrence du %s: %w", occurrence.Format("2006-01-02"), err)
 		}
+		if expense != nil {
+			created++
+			s.settlements.Refresh(ctx, re.ColocationID)
+			s.budgets.Check(ctx, re.ColocationID)
+			s.notifier.Publish(ctx, re.ColocationID, "", domain.NotifRecurringDue,
+				"Depense recurrente",
+				fmt.Sprintf("La depense recurrente \"%s\" du %s a ete ajoutee (%s %s)", re.Title, occurrence.Format("02/01/2006"), expense.Amount, expense.Currency),
+				map[string]string{"expense_id": expense.ID, "recurring_id": re.ID},
+			)
+		}
+
+		occurrence = nextDue
 	}
 
-	return nil
+	return created, nil
 }
 
 // calculateNextDueDate calculates the next due date based on recurrence
 func calculateNextDueDate(current time.Time, recurrence domain.Recurrence) time.Time {
-	switch recurrence {
-	case domain.RecurrenceDaily:
-		return current.AddDate(0, 0, 1)
-	case domain.RecurrenceWeekly:
-		return current.AddDate(0, 0, 7)
-	case domain.RecurrenceMonthly:
-		return current.AddDate(0, 1, 0)
-	case domain.RecurrenceYearly:
-		return current.AddDate(1, 0, 0)
-	default:
-		return current.AddDate(0, 1, 0)
-	}
+	return recurrence.Next(current)
 }
@@ -0,0 +1,308 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vblanchet22/back_coloc/internal/auth"
+	"github.com/vblanchet22/back_coloc/internal/constants"
+	"github.com/vblanchet22/back_coloc/internal/domain"
+	"github.com/vblanchet22/back_coloc/internal/export"
+	"github.com/vblanchet22/back_coloc/internal/repository/postgres"
+)
+
+// ExportFormat is a kind of colocation export
+type ExportFormat string
+
+const (
+	ExportExpensesCSV  ExportFormat = "expenses_csv"
+	ExportPaymentsCSV  ExportFormat = "payments_csv"
+	ExportJSON         ExportFormat = "json"
+	ExportStatementPDF ExportFormat = "statement_pdf"
+)
+
+// Content types of the exported files
+const (
+	contentTypeCSV  = "text/csv; charset=utf-8"
+	contentTypeJSON = "application/json"
+)
+
+// ExportService exports the data of a colocation
+type ExportService struct {
+	colocationRepo   *postgres.ColocationRepository
+	categoryRepo     *postgres.CategoryRepository
+	expenseRepo      *postgres.ExpenseRepository
+	paymentRepo      *postgres.PaymentRepository
+	balanceRepo      *postgres.BalanceRepository
+	fundRepo         *postgres.FundRepository
+	eventRepo        *postgres.EventRepository
+	decisionRepo     *postgres.DecisionRepository
+	exchangeRateRepo *postgres.ExchangeRateRepository
+}
+
+// NewExportService creates a new ExportService
+func NewExportService(
+	colocationRepo *postgres.ColocationRepository,
+	categoryRepo *postgres.CategoryRepository,
+	expenseRepo *postgres.ExpenseRepository,
+	paymentRepo *postgres.PaymentRepository,
+	balanceRepo *postgres.BalanceRepository,
+	fundRepo *postgres.FundRepository,
+	eventRepo *postgres.EventRepository,
+	decisionRepo *postgres.DecisionRepository,
+	exchangeRateRepo *postgres.ExchangeRateRepository,
+) *ExportService {
+	return &ExportService{
+		colocationRepo:   colocationRepo,
+		categoryRepo:     categoryRepo,
+		expenseRepo:      expenseRepo,
+		paymentRepo:      paymentRepo,
+		balanceRepo:      balanceRepo,
+		fundRepo:         fundRepo,
+		eventRepo:        eventRepo,
+		decisionRepo:     decisionRepo,
+		exchangeRateRepo: exchangeRateRepo,
+	}
+}
+
+// ExportInput represents input for exporting a colocation.
+// StartDate and EndDate filter the CSV exports. Month (any day of it, the
+// current month when zero) and UserID (the current user when empty) select
+// the PDF statement.
+type ExportInput struct {
+	ColocationID string
+	Format       ExportFormat
+	StartDate    *time.Time
+	EndDate      *time.Time
+	Month        time.Time
+	UserID       string
+}
+
+// ExportFile is an exported file
+type ExportFile struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+}
+
+// Export exports the colocation in the requested format
+func (s *ExportService) Export(ctx context.Context, input ExportInput) (*ExportFile, error) {
+	userID, err := s.ensureMembership(ctx, input.ColocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	coloc, err := s.colocationRepo.GetByID(ctx, input.ColocationID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation de la colocation: %w", err)
+	}
+	if coloc == nil {
+		return nil, fmt.Errorf("colocation introuvable")
+	}
+
+	now := time.Now()
+	prefix := exportFilePrefix(coloc.Name)
+	var buf bytes.Buffer
+	file := &ExportFile{}
+
+	switch input.Format {
+	case ExportExpensesCSV:
+		expenses, err := s.expenseRepo.ListForExport(ctx, input.ColocationID, input.StartDate, input.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		if err := export.WriteExpensesCSV(&buf, expenses); err != nil {
+			return nil, fmt.Errorf("erreur lors de l'export des depenses: %w", err)
+		}
+		file.FileName = fmt.Sprintf("%s-depenses-%s.csv", prefix, now.Format("2006-01-02"))
+		file.ContentType = contentTypeCSV
+
+	case ExportPaymentsCSV:
+		payments, err := s.paymentRepo.ListForExport(ctx, input.ColocationID, input.StartDate, input.EndDate)
+		if err != nil {
+			return nil, fmt.Errorf("erreur lors de la recuperation des paiements: %w", err)
+		}
+		if err := export.WritePaymentsCSV(&buf, payments); err != nil {
+			return nil, fmt.Errorf("erreur lors de l'export des paiements: %w", err)
+		}
+		file.FileName = fmt.Sprintf("%s-paiements-%s.csv", prefix, now.Format("2006-01-02"))
+		file.ContentType = contentTypeCSV
+
+	case ExportJSON:
+		archive, err := s.buildArchive(ctx, coloc, userID, now)
+		if err != nil {
+			return nil, err
+		}
+		if err := export.WriteArchive(&buf, archive); err != nil {
+			return nil, fmt.Errorf("erreur lors de l'export de l'archive: %w", err)
+		}
+		file.FileName = fmt.Sprintf("%s-archive-%s.json", prefix, now.Format("2006-01-02"))
+		file.ContentType = contentTypeJSON
+
+	case ExportStatementPDF:
+		memberID := input.UserID
+		if memberID == "" {
+			memberID = userID
+		}
+		month := input.Month
+		if month.IsZero() {
+			month = now
+		}
+		statement, err := s.buildStatement(ctx, coloc, memberID, month, now)
+		if err != nil {
+			return nil, err
+		}
+		if err := export.WriteStatementPDF(&buf, statement); err != nil {
+			return nil, fmt.Errorf("erreur lors de la generation du releve: %w", err)
+		}
+		file.FileName = fmt.Sprintf("%s-releve-%s-%s.pdf", prefix, statement.PeriodStart.Format("2006-01"), exportFilePrefix(statement.MemberName))
+		file.ContentType = domain.ContentTypePDF
+
+	default:
+		return nil, fmt.Errorf("format d'export invalide")
+	}
+
+	if buf.Len() > constants.MaxExportSize {
+		return nil, fmt.Errorf("export trop volumineux, reduisez la periode exportee")
+	}
+
+	file.Content = buf.Bytes()
+	return file, nil
+}
+
+// buildArchive gathers all the data of the colocation
+func (s *ExportService) buildArchive(ctx context.Context, coloc *domain.Colocation, userID string, now time.Time) (*export.Archive, error) {
+	archive := &export.Archive{
+		Version:    export.ArchiveVersion,
+		ExportedAt: now,
+		ExportedBy: userID,
+		Colocation: coloc,
+	}
+
+	var err error
+	if archive.Members, err = s.colocationRepo.ListMembers(ctx, coloc.ID); err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des membres: %w", err)
+	}
+	if archive.Categories, err = s.categoryRepo.ListByColocation(ctx, coloc.ID); err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des categories: %w", err)
+	}
+	if archive.Balances, err = s.balanceRepo.GetUserBalances(ctx, coloc.ID); err != nil {
+		return nil, fmt.Errorf("erreur lors du calcul des soldes: %w", err)
+	}
+	if archive.Expenses, err = s.expenseRepo.ListForExport(ctx, coloc.ID, nil, nil); err != nil {
+		return nil, err
+	}
+	if archive.RecurringExpenses, err = s.expenseRepo.ListRecurringByColocation(ctx, coloc.ID); err != nil {
+		return nil, err
+	}
+	if archive.Payments, err = s.paymentRepo.ListForExport(ctx, coloc.ID, nil, nil); err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des paiements: %w", err)
+	}
+	if archive.ExchangeRates, err = s.exchangeRateRepo.List(ctx, coloc.ID, coloc.BaseCurrency, nil); err != nil {
+		return nil, err
+	}
+
+	funds, err := s.fundRepo.ListByColocation(ctx, coloc.ID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des cagnottes: %w", err)
+	}
+	for _, f := range funds {
+		contributions, err := s.fundRepo.ListContributions(ctx, f.ID)
+		if err != nil {
+			return nil, fmt.Errorf("erreur lors de la recuperation des contributions: %w", err)
+		}
+		archive.Funds = append(archive.Funds, export.ArchivedFund{CommonFund: f, Contributions: contributions})
+	}
+
+	// Events and decisions are only listed by page
+	for page := 1; ; page++ {
+		events, total, err := s.eventRepo.ListByColocation(ctx, coloc.ID, userID, nil, nil, nil, page, constants.MaxPageSize)
+		if err != nil {
+			return nil, fmt.Errorf("erreur lors de la recuperation des evenements: %w", err)
+		}
+		archive.Events = append(archive.Events, events...)
+		if len(events) == 0 || len(archive.Events) >= total {
+			break
+		}
+	}
+	for page := 1; ; page++ {
+		decisions, total, err := s.decisionRepo.ListByColocation(ctx, coloc.ID, userID, nil, page, constants.MaxPageSize)
+		if err != nil {
+			return nil, fmt.Errorf("erreur lors de la recuperation des decisions: %w", err)
+		}
+		archive.Decisions = append(archive.Decisions, decisions...)
+		if len(decisions) == 0 || len(archive.Decisions) >= total {
+			break
+		}
+	}
+
+	return archive, nil
+}
+
+// buildStatement gathers the monthly statement of a member: the balance
+// computed by GetUserBalances and the operations of the month
+func (s *ExportService) buildStatement(ctx context.Context, coloc *domain.Colocation, memberID string, month, now time.Time) (*export.MemberStatement, error) {
+	balances, err := s.balanceRepo.GetUserBalances(ctx, coloc.ID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors du calcul des soldes: %w", err)
+	}
+
+	var balance *domain.UserBalance
+	for i := range balances {
+		if balances[i].UserID == memberID {
+			balance = &balances[i]
+			break
+		}
+	}
+	if balance == nil {
+		return nil, fmt.Errorf("ce membre ne fait pas partie de la colocation")
+	}
+
+	start := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0).Add(-time.Microsecond)
+	entries, err := s.balanceRepo.GetBalanceHistory(ctx, coloc.ID, memberID, &start, &end)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation de l'historique: %w", err)
+	}
+
+	return &export.MemberStatement{
+		ColocationName: coloc.Name,
+		MemberName:     strings.TrimSpace(balance.UserPrenom + " " + balance.UserNom),
+		Currency:       coloc.BaseCurrency,
+		PeriodStart:    start,
+		PeriodEnd:      start.AddDate(0, 1, -1),
+		GeneratedAt:    now,
+		Balance:        *balance,
+		Entries:        entries,
+	}, nil
+}
+
+// ensureMembership verifies user is a member and returns the userID
+func (s *ExportService) ensureMembership(ctx context.Context, colocationID string) (string, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	isMember, err := s.colocationRepo.IsMember(ctx, colocationID, userID)
+	if err != nil {
+		return "", fmt.Errorf("erreur lors de la verification: %w", err)
+	}
+	if !isMember {
+		return "", fmt.Errorf("vous n'etes pas membre de cette colocation")
+	}
+
+	return userID, nil
+}
+
+// exportFilePrefix turns a name into a file name prefix, e.g. "coloc-des-lilas"
+func exportFilePrefix(name string) string {
+	prefix := strings.ReplaceAll(normalizeLabel(name), " ", "-")
+	if prefix == "" {
+		return "colocation"
+	}
+	return prefix
+}
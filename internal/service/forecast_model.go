@@ -0,0 +1,128 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/vblanchet22/back_coloc/internal/constants"
+	"github.com/vblanchet22/back_coloc/internal/domain"
+)
+
+// seasonalModel estimates the monthly one-off spending of a category as a
+// moving average of the recent months plus an additive effect per calendar
+// month, fitted once a full year of history is available
+type seasonalModel struct {
+	categoryID   string
+	categoryName string
+	level        float64     // Moving average of the deseasonalized recent months
+	seasonal     [12]float64 // Effect of each calendar month, January first
+	stddev       float64     // Spread of the deseasonalized months
+}
+
+// fitSeasonalModels fits one model per category on the monthly totals of the
+// months before currentMonth. A category is followed from its first month of
+// spending, the months without spending count as zero.
+func fitSeasonalModels(totals []domain.CategoryMonthTotal, currentMonth time.Time) []seasonalModel {
+	type history struct {
+		name   string
+		first  time.Time
+		months map[time.Time]float64
+	}
+
+	byCategory := make(map[string]*history)
+	for _, t := range totals {
+		if !t.Month.Before(currentMonth) {
+			continue // The current month is not complete yet
+		}
+		h, ok := byCategory[t.CategoryID]
+		if !ok {
+			h = &history{name: t.CategoryName, first: t.Month, months: make(map[time.Time]float64)}
+			byCategory[t.CategoryID] = h
+		}
+		if t.Month.Before(h.first) {
+			h.first = t.Month
+		}
+		h.months[t.Month] += float64(t.Amount)
+	}
+
+	models := make([]seasonalModel, 0, len(byCategory))
+	for categoryID, h := range byCategory {
+		var series []float64
+		var calendar []time.Month
+		for m := h.first; m.Before(currentMonth); m = m.AddDate(0, 1, 0) {
+			series = append(series, h.months[m])
+			calendar = append(calendar, m.Month())
+		}
+
+		model := fitSeasonalModel(series, calendar)
+		model.categoryID = categoryID
+		model.categoryName = h.name
+		models = append(models, model)
+	}
+
+	sort.Slice(models, func(i, j int) bool { return models[i].categoryName < models[j].categoryName })
+	return models
+}
+
+// fitSeasonalModel fits a model on a monthly series, calendar giving the month
+// of each value
+func fitSeasonalModel(series []float64, calendar []time.Month) seasonalModel {
+	var model seasonalModel
+	n := len(series)
+	mean := average(series)
+
+	// Seasonality needs every calendar month at least once
+	if n >= 12 {
+		var sums [12]float64
+		var counts [12]int
+		for i, v := range series {
+			sums[calendar[i]-1] += v
+			counts[calendar[i]-1]++
+		}
+		for m := range sums {
+			model.seasonal[m] = sums[m]/float64(counts[m]) - mean
+		}
+	}
+
+	deseasonalized := make([]float64, n)
+	for i, v := range series {
+		deseasonalized[i] = v - model.seasonal[calendar[i]-1]
+	}
+
+	recent := deseasonalized
+	if n > constants.ForecastMovingAverageMonths {
+		recent = deseasonalized[n-constants.ForecastMovingAverageMonths:]
+	}
+	model.level = average(recent)
+
+	if n > 1 {
+		var sq float64
+		dmean := average(deseasonalized)
+		for _, d := range deseasonalized {
+			sq += (d - dmean) * (d - dmean)
+		}
+		model.stddev = math.Sqrt(sq / float64(n-1))
+	}
+
+	return model
+}
+
+// predict returns the estimated spending of a month with its confidence range,
+// never below zero
+func (m seasonalModel) predict(month time.Month) (estimate, low, high domain.Money) {
+	e := math.Max(0, m.level+m.seasonal[month-1])
+	spread := constants.ForecastIntervalZ * m.stddev
+	return domain.Money(math.Round(e)), domain.Money(math.Round(math.Max(0, e-spread))), domain.Money(math.Round(e + spread))
+}
+
+func average(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/vblanchet22/back_coloc/internal/auth"
 	"github.com/vblanchet22/back_coloc/internal/domain"
@@ -12,19 +13,28 @@ import (
 // FundService handles fund business logic
 type FundService struct {
 	repo           *postgres.FundRepository
+	expenseRepo    *postgres.ExpenseRepository
 	colocationRepo *postgres.ColocationRepository
+	settlements    *SettlementService
+	notifier       *NotificationService
+	softDelete     SoftDeletePolicy
 }
 
 // NewFundService creates a new FundService
-func NewFundService(repo *postgres.FundRepository, colocationRepo *postgres.ColocationRepository) *FundService {
+func NewFundService(repo *postgres.FundRepository, expenseRepo *postgres.ExpenseRepository, colocationRepo *postgres.ColocationRepository, settlements *SettlementService, notifier *NotificationService, softDelete SoftDeletePolicy) *FundService {
 	return &FundService{
 		repo:           repo,
+		expenseRepo:    expenseRepo,
 		colocationRepo: colocationRepo,
+		settlements:    settlements,
+		notifier:       notifier,
+		softDelete:     softDelete,
 	}
 }
 
-// Create creates a new fund
-func (s *FundService) Create(ctx context.Context, colocationID, name string, description *string, targetAmount *float64) (*domain.CommonFund, error) {
+// Create creates a new fund. Funds are kept in the base currency of the
+// colocation; an empty currency means the base currency.
+func (s *FundService) Create(ctx context.Context, colocationID, name string, description *string, targetAmount *domain.Money, currency string) (*domain.CommonFund, error) {
 	userID, err := auth.GetUserIDFromContext(ctx)
 	if err != nil {
 		return nil, err
@@ -38,6 +48,10 @@ func (s *FundService) Create(ctx context.Context, colocationID, name string, des
 		return nil, fmt.Errorf("vous n'etes pas membre de cette colocation")
 	}
 
+	if err := ensureBaseCurrency(ctx, s.colocationRepo, colocationID, currency); err != nil {
+		return nil, err
+	}
+
 	fund := &domain.CommonFund{
 		ColocationID: colocationID,
 		Name:         name,
@@ -50,7 +64,18 @@ func (s *FundService) Create(ctx context.Context, colocationID, name string, des
 		return nil, fmt.Errorf("erreur lors de la creation: %w", err)
 	}
 
-	return s.repo.GetByID(ctx, fund.ID)
+	created, err := s.repo.GetByID(ctx, fund.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifier.Publish(ctx, colocationID, userID, domain.NotifFundCreated,
+		"Nouveau fonds commun",
+		fmt.Sprintf("%s a cree le fonds \"%s\"", created.CreatedByPrenom, created.Name),
+		map[string]string{"fund_id": created.ID},
+	)
+
+	return created, nil
 }
 
 // GetByID retrieves a fund by ID
@@ -98,7 +123,7 @@ func (s *FundService) List(ctx context.Context, colocationID string, isActive *b
 }
 
 // Update updates a fund
-func (s *FundService) Update(ctx context.Context, colocationID, fundID string, name *string, description *string, targetAmount *float64, isActive *bool) (*domain.CommonFund, error) {
+func (s *FundService) Update(ctx context.Context, colocationID, fundID string, name *string, description *string, targetAmount *domain.Money, currency string, isActive *bool) (*domain.CommonFund, error) {
 	userID, err := auth.GetUserIDFromContext(ctx)
 	if err != nil {
 		return nil, err
@@ -116,6 +141,10 @@ func (s *FundService) Update(ctx context.Context, colocationID, fundID string, n
 		return nil, fmt.Errorf("seul le createur peut modifier ce fonds")
 	}
 
+	if err := ensureBaseCurrency(ctx, s.colocationRepo, colocationID, currency); err != nil {
+		return nil, err
+	}
+
 	if name != nil {
 		fund.Name = *name
 	}
@@ -159,7 +188,7 @@ func (s *FundService) Delete(ctx context.Context, colocationID, fundID string) e
 }
 
 // AddContribution adds a contribution to a fund
-func (s *FundService) AddContribution(ctx context.Context, colocationID, fundID string, amount float64, note *string) (*domain.FundContribution, error) {
+func (s *FundService) AddContribution(ctx context.Context, colocationID, fundID string, amount domain.Money, currency string, note *string) (*domain.FundContribution, error) {
 	userID, err := auth.GetUserIDFromContext(ctx)
 	if err != nil {
 		return nil, err
@@ -189,6 +218,10 @@ func (s *FundService) AddContribution(ctx context.Context, colocationID, fundID
 		return nil, fmt.Errorf("le montant doit etre positif")
 	}
 
+	if currency != "" && currency != fund.Currency {
+		return nil, fmt.Errorf("les montants doivent etre en %s, la devise de base de la colocation", fund.Currency)
+	}
+
 	contribution := &domain.FundContribution{
 		FundID: fundID,
 		UserID: userID,
@@ -200,7 +233,42 @@ func (s *FundService) AddContribution(ctx context.Context, colocationID, fundID
 		return nil, fmt.Errorf("erreur lors de l'ajout de la contribution: %w", err)
 	}
 
-	return s.repo.GetContribution(ctx, contribution.ID)
+	created, err := s.repo.GetContribution(ctx, contribution.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyContribution(ctx, fund, created)
+
+	return created, nil
+}
+
+// notifyContribution notifies members of a new contribution and, when it makes
+// the fund cross its target, that the goal is reached
+func (s *FundService) notifyContribution(ctx context.Context, fund *domain.CommonFund, contribution *domain.FundContribution) {
+	data := map[string]string{
+		"fund_id":         fund.ID,
+		"contribution_id": contribution.ID,
+	}
+
+	s.notifier.Publish(ctx, fund.ColocationID, contribution.UserID, domain.NotifFundContribution,
+		"Nouvelle contribution",
+		fmt.Sprintf("%s a verse %s %s dans le fonds \"%s\"", contribution.UserPrenom, contribution.Amount, fund.Currency, fund.Name),
+		data,
+	)
+
+	if fund.TargetAmount == nil {
+		return
+	}
+
+	target := *fund.TargetAmount
+	if fund.CurrentAmount < target && fund.CurrentAmount+contribution.Amount >= target {
+		s.notifier.Publish(ctx, fund.ColocationID, contribution.UserID, domain.NotifFundGoalReached,
+			"Objectif atteint",
+			fmt.Sprintf("Le fonds \"%s\" a atteint son objectif de %s %s", fund.Name, target, fund.Currency),
+			data,
+		)
+	}
 }
 
 // ListContributions lists contributions for a fund
@@ -221,24 +289,287 @@ func (s *FundService) ListContributions(ctx context.Context, colocationID, fundI
 	return s.repo.ListContributions(ctx, fundID)
 }
 
-// DeleteContribution deletes a contribution
-func (s *FundService) DeleteContribution(ctx context.Context, colocationID, fundID, contributionID string) error {
+// DeleteContribution soft-deletes a contribution and returns the date until
+// which it can be restored
+func (s *FundService) DeleteContribution(ctx context.Context, colocationID, fundID, contributionID string) (time.Time, error) {
 	userID, err := auth.GetUserIDFromContext(ctx)
 	if err != nil {
-		return err
+		return time.Time{}, err
 	}
 
 	contribution, err := s.repo.GetContribution(ctx, contributionID)
 	if err != nil {
-		return err
+		return time.Time{}, err
+	}
+	if contribution == nil || contribution.FundID != fundID {
+		return time.Time{}, fmt.Errorf("contribution introuvable")
+	}
+
+	if contribution.UserID != userID {
+		return time.Time{}, fmt.Errorf("seul le contributeur peut supprimer sa contribution")
+	}
+
+	deletedAt, err := s.repo.DeleteContribution(ctx, contributionID, fundID, contribution.Amount, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return s.softDelete.RestorableUntil(deletedAt), nil
+}
+
+// RestoreContribution brings back a deleted contribution within the undo
+// window (only by the contributor)
+func (s *FundService) RestoreContribution(ctx context.Context, colocationID, fundID, contributionID string) (*domain.FundContribution, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fund, err := s.repo.GetByID(ctx, fundID)
+	if err != nil {
+		return nil, err
+	}
+	if fund == nil || fund.ColocationID != colocationID {
+		return nil, fmt.Errorf("fonds introuvable")
+	}
+
+	contribution, err := s.repo.GetDeletedContribution(ctx, contributionID)
+	if err != nil {
+		return nil, err
 	}
 	if contribution == nil || contribution.FundID != fundID {
-		return fmt.Errorf("contribution introuvable")
+		return nil, fmt.Errorf("contribution introuvable")
 	}
 
 	if contribution.UserID != userID {
-		return fmt.Errorf("seul le contributeur peut supprimer sa contribution")
+		return nil, fmt.Errorf("seul le contributeur peut restaurer sa contribution")
+	}
+
+	if err := s.softDelete.ensureRestorable(contribution.DeletedAt); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.RestoreContribution(ctx, contributionID, fundID, contribution.Amount); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetContribution(ctx, contributionID)
+}
+
+// Withdraw records money spent from a fund by the current user. Inactive funds
+// can still be spent.
+//
+// A withdrawal linked to an expense reimburses its payer from the fund: the
+// current user must be the only payer and the amount, which defaults to the
+// expense amount, must cover the whole expense. The expense is then left out
+// of the balances, so that the payer is not repaid a second time by the
+// members. Deleting the withdrawal brings the expense back into the balances.
+func (s *FundService) Withdraw(ctx context.Context, colocationID, fundID string, amount *domain.Money, currency string, note, expenseID *string) (*domain.FundWithdrawal, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isMember, err := s.colocationRepo.IsMember(ctx, colocationID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la verification: %w", err)
+	}
+	if !isMember {
+		return nil, fmt.Errorf("vous n'etes pas membre de cette colocation")
+	}
+
+	fund, err := s.repo.GetByID(ctx, fundID)
+	if err != nil {
+		return nil, err
+	}
+	if fund == nil || fund.ColocationID != colocationID {
+		return nil, fmt.Errorf("fonds introuvable")
+	}
+
+	if currency != "" && currency != fund.Currency {
+		return nil, fmt.Errorf("les montants doivent etre en %s, la devise de base de la colocation", fund.Currency)
 	}
 
-	return s.repo.DeleteContribution(ctx, contributionID, fundID, contribution.Amount)
+	if expenseID != nil {
+		expense, err := s.expenseRepo.GetByID(ctx, *expenseID)
+		if err != nil {
+			return nil, err
+		}
+		if expense == nil || expense.ColocationID != colocationID {
+			return nil, fmt.Errorf("depense introuvable")
+		}
+
+		funded, err := s.repo.IsExpenseFunded(ctx, *expenseID)
+		if err != nil {
+			return nil, fmt.Errorf("erreur lors de la verification de la depense: %w", err)
+		}
+		if funded {
+			return nil, fmt.Errorf("cette depense est deja payee par un fonds")
+		}
+
+		if len(expense.Payers) != 1 || expense.Payers[0].UserID != userID {
+			return nil, fmt.Errorf("seul le payeur unique de la depense peut la faire rembourser par un fonds")
+		}
+
+		if amount == nil {
+			amount = &expense.BaseAmount
+		}
+		if *amount != expense.BaseAmount {
+			return nil, fmt.Errorf("le retrait doit couvrir le montant de la depense (%s %s)", expense.BaseAmount, fund.Currency)
+		}
+	}
+
+	if amount == nil || *amount <= 0 {
+		return nil, fmt.Errorf("le montant doit etre positif")
+	}
+
+	withdrawal := &domain.FundWithdrawal{
+		FundID:    fundID,
+		UserID:    userID,
+		Amount:    *amount,
+		Note:      note,
+		ExpenseID: expenseID,
+	}
+
+	if err := s.repo.AddWithdrawal(ctx, withdrawal); err != nil {
+		return nil, fmt.Errorf("erreur lors du retrait: %w", err)
+	}
+
+	created, err := s.repo.GetWithdrawal(ctx, withdrawal.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifier.Publish(ctx, colocationID, userID, domain.NotifFundWithdrawal,
+		"Depense sur un fonds commun",
+		fmt.Sprintf("%s a depense %s %s du fonds \"%s\"", created.UserPrenom, created.Amount, fund.Currency, fund.Name),
+		map[string]string{"fund_id": fund.ID, "withdrawal_id": created.ID},
+	)
+
+	if created.ExpenseID != nil {
+		s.settlements.Refresh(ctx, colocationID)
+	}
+
+	return created, nil
+}
+
+// DeleteWithdrawal soft-deletes a withdrawal, putting its amount back into
+// the fund, and returns the date until which it can be restored
+func (s *FundService) DeleteWithdrawal(ctx context.Context, colocationID, fundID, withdrawalID string) (time.Time, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	fund, err := s.repo.GetByID(ctx, fundID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if fund == nil || fund.ColocationID != colocationID {
+		return time.Time{}, fmt.Errorf("fonds introuvable")
+	}
+
+	withdrawal, err := s.repo.GetWithdrawal(ctx, withdrawalID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if withdrawal == nil || withdrawal.FundID != fundID {
+		return time.Time{}, fmt.Errorf("retrait introuvable")
+	}
+
+	if withdrawal.UserID != userID {
+		return time.Time{}, fmt.Errorf("seul l'auteur du retrait peut le supprimer")
+	}
+
+	deletedAt, err := s.repo.DeleteWithdrawal(ctx, withdrawalID, fundID, withdrawal.Amount, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if withdrawal.ExpenseID != nil {
+		s.settlements.Refresh(ctx, colocationID)
+	}
+
+	return s.softDelete.RestorableUntil(deletedAt), nil
+}
+
+// RestoreWithdrawal brings back a deleted withdrawal within the undo window
+// (only by its author), as long as the fund still holds its amount and its
+// expense was not paid by another withdrawal in the meantime
+func (s *FundService) RestoreWithdrawal(ctx context.Context, colocationID, fundID, withdrawalID string) (*domain.FundWithdrawal, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fund, err := s.repo.GetByID(ctx, fundID)
+	if err != nil {
+		return nil, err
+	}
+	if fund == nil || fund.ColocationID != colocationID {
+		return nil, fmt.Errorf("fonds introuvable")
+	}
+
+	withdrawal, err := s.repo.GetDeletedWithdrawal(ctx, withdrawalID)
+	if err != nil {
+		return nil, err
+	}
+	if withdrawal == nil || withdrawal.FundID != fundID {
+		return nil, fmt.Errorf("retrait introuvable")
+	}
+
+	if withdrawal.UserID != userID {
+		return nil, fmt.Errorf("seul l'auteur du retrait peut le restaurer")
+	}
+
+	if err := s.softDelete.ensureRestorable(withdrawal.DeletedAt); err != nil {
+		return nil, err
+	}
+
+	if withdrawal.ExpenseID != nil {
+		funded, err := s.repo.IsExpenseFunded(ctx, *withdrawal.ExpenseID)
+		if err != nil {
+			return nil, fmt.Errorf("erreur lors de la verification de la depense: %w", err)
+		}
+		if funded {
+			return nil, fmt.Errorf("cette depense est deja payee par un fonds")
+		}
+	}
+
+	if err := s.repo.RestoreWithdrawal(ctx, withdrawalID, fundID, withdrawal.Amount); err != nil {
+		return nil, err
+	}
+
+	if withdrawal.ExpenseID != nil {
+		s.settlements.Refresh(ctx, colocationID)
+	}
+
+	return s.repo.GetWithdrawal(ctx, withdrawalID)
+}
+
+// ListTransactions returns the ledger of a fund with its running balance
+func (s *FundService) ListTransactions(ctx context.Context, colocationID, fundID string) ([]domain.FundTransaction, string, error) {
+	fund, err := s.GetByID(ctx, colocationID, fundID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	transactions, err := s.repo.ListTransactions(ctx, fundID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return transactions, fund.Currency, nil
+}
+
+// PurgeDeletedContributions hard-deletes the contributions deleted for longer
+// than the retention and returns their number
+func (s *FundService) PurgeDeletedContributions(ctx context.Context) (int, error) {
+	return s.repo.PurgeDeletedContributions(ctx, s.softDelete.purgeBefore(time.Now()))
+}
+
+// PurgeDeletedWithdrawals hard-deletes the withdrawals deleted for longer than
+// the retention and returns their number
+func (s *FundService) PurgeDeletedWithdrawals(ctx context.Context) (int, error) {
+	return s.repo.PurgeDeletedWithdrawals(ctx, s.softDelete.purgeBefore(time.Now()))
 }
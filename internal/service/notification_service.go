@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"log"
 	"sync"
 
 	"github.com/vblanchet22/back_coloc/internal/auth"
@@ -138,5 +139,48 @@ func (s *NotificationService) broadcastToUser(userID string, notif *domain.Notif
 
 // NotifyColocationMembers sends a notification to all members of a colocation
 func (s *NotificationService) NotifyColocationMembers(ctx context.Context, colocationID, excludeUserID string, notifType domain.NotificationType, title, body string, data map[string]string) error {
-	return s.repo.CreateForColocationMembers(ctx, colocationID, excludeUserID, notifType, title, body, data)
+	notifications, err := s.repo.CreateForColocationMembers(ctx, colocationID, excludeUserID, notifType, title, body, data)
+	if err != nil {
+		return fmt.Errorf("erreur lors de la creation des notifications: %w", err)
+	}
+
+	for i := range notifications {
+		s.broadcastToUser(notifications[i].UserID, &notifications[i])
+	}
+
+	return nil
+}
+
+// Publish notifies every member of a colocation except the actor.
+// It must be called once the triggering write is committed; failures are
+// logged and never fail the caller's mutation.
+func (s *NotificationService) Publish(ctx context.Context, colocationID, actorID string, notifType domain.NotificationType, title, body string, data map[string]string) {
+	if s == nil {
+		return
+	}
+
+	if err := s.NotifyColocationMembers(ctx, colocationID, actorID, notifType, title, body, data); err != nil {
+		log.Printf("Notification %s non envoyee: %v", notifType, err)
+	}
+}
+
+// PublishToUser notifies a single user of an action in a colocation, unless
+// that user is the actor. Failures are logged and never fail the caller.
+func (s *NotificationService) PublishToUser(ctx context.Context, userID, actorID, colocationID string, notifType domain.NotificationType, title, body string, data map[string]string) {
+	if s == nil || userID == actorID {
+		return
+	}
+
+	notif := &domain.Notification{
+		UserID:       userID,
+		ColocationID: &colocationID,
+		Type:         notifType,
+		Title:        title,
+		Body:         body,
+		Data:         data,
+	}
+
+	if err := s.Notify(ctx, notif); err != nil {
+		log.Printf("Notification %s non envoyee: %v", notifType, err)
+	}
 }
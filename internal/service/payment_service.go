@@ -1,25 +1,47 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"path"
+	"strings"
+	"time"
 
 	"github.com/vblanchet22/back_coloc/internal/auth"
+	"github.com/vblanchet22/back_coloc/internal/constants"
 	"github.com/vblanchet22/back_coloc/internal/domain"
 	"github.com/vblanchet22/back_coloc/internal/repository/postgres"
+	"github.com/vblanchet22/back_coloc/internal/storage"
+	"github.com/vblanchet22/back_coloc/internal/utils"
 )
 
 // PaymentService handles payment business logic
 type PaymentService struct {
 	repo           *postgres.PaymentRepository
 	colocationRepo *postgres.ColocationRepository
+	userRepo       *postgres.AuthRepository
+	rates          *ExchangeRateService
+	settlements    *SettlementService
+	notifier       *NotificationService
+	store          storage.BlobStore
+	softDelete     SoftDeletePolicy
 }
 
 // NewPaymentService creates a new PaymentService
-func NewPaymentService(repo *postgres.PaymentRepository, colocationRepo *postgres.ColocationRepository) *PaymentService {
+func NewPaymentService(repo *postgres.PaymentRepository, colocationRepo *postgres.ColocationRepository, userRepo *postgres.AuthRepository, rates *ExchangeRateService, settlements *SettlementService, notifier *NotificationService, store storage.BlobStore, softDelete SoftDeletePolicy) *PaymentService {
 	return &PaymentService{
 		repo:           repo,
 		colocationRepo: colocationRepo,
+		userRepo:       userRepo,
+		rates:          rates,
+		settlements:    settlements,
+		notifier:       notifier,
+		store:          store,
+		softDelete:     softDelete,
 	}
 }
 
@@ -41,38 +63,86 @@ func (s *PaymentService) ensureMembership(ctx context.Context, colocationID stri
 	return userID, nil
 }
 
-// Create creates a new payment (declare reimbursement)
-func (s *PaymentService) Create(ctx context.Context, colocationID, toUserID string, amount float64, note *string) (*domain.Payment, error) {
-	userID, err := s.ensureMembership(ctx, colocationID)
+// CreatePaymentInput contains the data to declare a reimbursement
+type CreatePaymentInput struct {
+	ColocationID string
+	ToUserID     string
+	Amount       domain.Money
+	Currency     string       // Empty for the base currency
+	ExchangeRate *domain.Rate // Overrides the stored rates for foreign currencies
+	Method       domain.PaymentMethod
+	Note         *string
+	AllocateTo   []string // Expenses settled first once confirmed, in order
+}
+
+// Create creates a new payment (declare reimbursement). Once confirmed, the
+// payment settles the expenses of AllocateTo first, then the oldest debts to
+// the recipient.
+func (s *PaymentService) Create(ctx context.Context, input CreatePaymentInput) (*domain.Payment, error) {
+	userID, err := s.ensureMembership(ctx, input.ColocationID)
 	if err != nil {
 		return nil, err
 	}
 
-	if userID == toUserID {
+	if userID == input.ToUserID {
 		return nil, fmt.Errorf("vous ne pouvez pas vous payer vous-meme")
 	}
 
-	if err := s.verifyRecipientMembership(ctx, colocationID, toUserID); err != nil {
+	if err := s.verifyRecipientMembership(ctx, input.ColocationID, input.ToUserID); err != nil {
 		return nil, err
 	}
 
-	if amount <= 0 {
+	if input.Amount <= 0 {
 		return nil, fmt.Errorf("le montant doit etre positif")
 	}
 
+	method := input.Method
+	if method == "" {
+		method = domain.PaymentMethodOther
+	}
+	if !method.IsValid() {
+		return nil, fmt.Errorf("moyen de paiement invalide")
+	}
+
+	allocateTo, err := s.verifyAllocation(ctx, input.ColocationID, userID, input.ToUserID, input.AllocateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	currency, rate, baseAmount, err := s.rates.convertToBase(ctx, input.ColocationID, userID, input.Currency, input.Amount, time.Now(), input.ExchangeRate)
+	if err != nil {
+		return nil, err
+	}
+
 	payment := &domain.Payment{
-		ColocationID: colocationID,
+		ColocationID: input.ColocationID,
 		FromUserID:   userID,
-		ToUserID:     toUserID,
-		Amount:       amount,
-		Note:         note,
+		ToUserID:     input.ToUserID,
+		Amount:       input.Amount,
+		Currency:     currency,
+		ExchangeRate: rate,
+		BaseAmount:   baseAmount,
+		Method:       method,
+		Note:         input.Note,
+		AllocateTo:   allocateTo,
 	}
 
 	if err := s.repo.Create(ctx, payment); err != nil {
 		return nil, fmt.Errorf("erreur lors de la creation du paiement: %w", err)
 	}
 
-	return s.repo.GetByID(ctx, payment.ID)
+	created, err := s.repo.GetByID(ctx, payment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifier.PublishToUser(ctx, created.ToUserID, userID, input.ColocationID, domain.NotifPaymentReceived,
+		"Paiement recu",
+		fmt.Sprintf("%s vous a envoye %s %s, a confirmer", created.FromUserPrenom, created.Amount, created.Currency),
+		map[string]string{"payment_id": created.ID},
+	)
+
+	return created, nil
 }
 
 // verifyRecipientMembership checks if the recipient is a member of the colocation
@@ -87,6 +157,32 @@ func (s *PaymentService) verifyRecipientMembership(ctx context.Context, colocati
 	return nil
 }
 
+// verifyAllocation removes the duplicates of the expenses a payment should be
+// allocated to and checks that the sender owes the recipient a share of each
+func (s *PaymentService) verifyAllocation(ctx context.Context, colocationID, fromUserID, toUserID string, expenseIDs []string) ([]string, error) {
+	seen := map[string]bool{}
+	var ids []string
+	for _, id := range expenseIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	count, err := s.repo.CountAllocatableExpenses(ctx, colocationID, fromUserID, toUserID, ids)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la verification des depenses: %w", err)
+	}
+	if count != len(ids) {
+		return nil, fmt.Errorf("le paiement ne peut etre affecte qu'a des depenses payees par le destinataire et partagees avec vous")
+	}
+
+	return ids, nil
+}
+
 // GetByID retrieves a payment by ID
 func (s *PaymentService) GetByID(ctx context.Context, colocationID, paymentID string) (*domain.Payment, error) {
 	if _, err := s.ensureMembership(ctx, colocationID); err != nil {
@@ -125,7 +221,9 @@ func (s *PaymentService) List(ctx context.Context, input ListPaymentsInput) ([]d
 	return s.repo.ListByColocation(ctx, input.ColocationID, input.Status, input.FromUserID, input.ToUserID, input.Page, input.PageSize)
 }
 
-// Confirm confirms a payment (only by recipient)
+// Confirm confirms a payment (only by recipient) and allocates it to the
+// expense splits it settles. A disputed payment can still be confirmed by its
+// recipient, which closes the dispute.
 func (s *PaymentService) Confirm(ctx context.Context, colocationID, paymentID string) (*domain.Payment, error) {
 	userID, err := auth.GetUserIDFromContext(ctx)
 	if err != nil {
@@ -141,24 +239,39 @@ func (s *PaymentService) Confirm(ctx context.Context, colocationID, paymentID st
 		return nil, fmt.Errorf("seul le destinataire peut confirmer ce paiement")
 	}
 
-	if payment.Status != domain.PaymentStatusPending {
+	if !payment.Status.IsOpen() {
 		return nil, fmt.Errorf("ce paiement n'est pas en attente")
 	}
 
-	if err := s.repo.UpdateStatus(ctx, paymentID, string(domain.PaymentStatusConfirmed)); err != nil {
-		return nil, fmt.Errorf("erreur lors de la confirmation: %w", err)
+	event := &domain.PaymentEvent{PaymentID: paymentID, ActorID: &userID, Type: domain.PaymentEventConfirmed}
+	if err := s.repo.Confirm(ctx, paymentID, event); err != nil {
+		return nil, err
 	}
 
+	s.settlements.Refresh(ctx, colocationID)
+
+	s.notifier.PublishToUser(ctx, payment.FromUserID, userID, colocationID, domain.NotifPaymentConfirmed,
+		"Paiement confirme",
+		fmt.Sprintf("%s a confirme votre paiement de %s %s", payment.ToUserPrenom, payment.Amount, payment.Currency),
+		map[string]string{"payment_id": payment.ID},
+	)
+
 	return s.repo.GetByID(ctx, paymentID)
 }
 
-// Reject rejects a payment (only by recipient)
-func (s *PaymentService) Reject(ctx context.Context, colocationID, paymentID string) (*domain.Payment, error) {
+// Reject disputes a pending payment (only by recipient), the reason is
+// mandatory. The sender can then respond or escalate the dispute.
+func (s *PaymentService) Reject(ctx context.Context, colocationID, paymentID, reason string) (*domain.Payment, error) {
 	userID, err := auth.GetUserIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, fmt.Errorf("le motif du rejet est obligatoire")
+	}
+
 	payment, err := s.getPaymentForAction(ctx, colocationID, paymentID)
 	if err != nil {
 		return nil, err
@@ -172,34 +285,500 @@ func (s *PaymentService) Reject(ctx context.Context, colocationID, paymentID str
 		return nil, fmt.Errorf("ce paiement n'est pas en attente")
 	}
 
-	if err := s.repo.UpdateStatus(ctx, paymentID, string(domain.PaymentStatusRejected)); err != nil {
+	event := &domain.PaymentEvent{PaymentID: paymentID, ActorID: &userID, Type: domain.PaymentEventDisputed, Comment: &reason}
+	if err := s.repo.UpdateStatus(ctx, paymentID, []domain.PaymentStatus{domain.PaymentStatusPending}, domain.PaymentStatusDisputed, event); err != nil {
 		return nil, fmt.Errorf("erreur lors du rejet: %w", err)
 	}
 
+	s.notifier.PublishToUser(ctx, payment.FromUserID, userID, colocationID, domain.NotifPaymentRejected,
+		"Paiement conteste",
+		fmt.Sprintf("%s conteste votre paiement de %s %s: %s", payment.ToUserPrenom, payment.Amount, payment.Currency, reason),
+		map[string]string{"payment_id": payment.ID},
+	)
+
 	return s.repo.GetByID(ctx, paymentID)
 }
 
-// Cancel cancels a pending payment (only by sender)
-func (s *PaymentService) Cancel(ctx context.Context, colocationID, paymentID string) error {
+// RespondToDisputeInput contains the answer of the sender of a disputed
+// payment, a comment and/or a proof of the payment
+type RespondToDisputeInput struct {
+	ColocationID  string
+	PaymentID     string
+	Comment       string
+	ProofFileName string
+	Proof         io.Reader // Nil without proof
+}
+
+// RespondToDispute records the answer of the sender to the dispute of their
+// payment (only by sender)
+func (s *PaymentService) RespondToDispute(ctx context.Context, input RespondToDisputeInput) (*domain.Payment, error) {
 	userID, err := auth.GetUserIDFromContext(ctx)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	payment, err := s.getPaymentForAction(ctx, input.ColocationID, input.PaymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if payment.FromUserID != userID {
+		return nil, fmt.Errorf("seul l'emetteur peut repondre a la contestation")
+	}
+
+	if payment.Status != domain.PaymentStatusDisputed && payment.Status != domain.PaymentStatusEscalated {
+		return nil, fmt.Errorf("ce paiement n'est pas conteste")
+	}
+
+	event := &domain.PaymentEvent{PaymentID: payment.ID, ActorID: &userID, Type: domain.PaymentEventResponded}
+	if comment := strings.TrimSpace(input.Comment); comment != "" {
+		event.Comment = &comment
+	}
+	if input.Proof != nil {
+		if err := s.storeProof(ctx, payment, input.ProofFileName, input.Proof, event); err != nil {
+			return nil, err
+		}
+	}
+	if event.Comment == nil && event.ProofStorageKey == nil {
+		return nil, fmt.Errorf("un commentaire ou une preuve est obligatoire")
+	}
+
+	if err := s.repo.AddEvent(ctx, event); err != nil {
+		if event.ProofStorageKey != nil {
+			s.deleteProof(ctx, *event.ProofStorageKey)
+		}
+		return nil, err
+	}
+
+	s.notifier.PublishToUser(ctx, payment.ToUserID, userID, input.ColocationID, domain.NotifPaymentDisputeResponse,
+		"Reponse a la contestation",
+		fmt.Sprintf("%s a repondu a la contestation du paiement de %s %s", payment.FromUserPrenom, payment.Amount, payment.Currency),
+		map[string]string{"payment_id": payment.ID},
+	)
+
+	return s.repo.GetByID(ctx, payment.ID)
+}
+
+// storeProof validates and stores the proof attached to a dispute response
+func (s *PaymentService) storeProof(ctx context.Context, payment *domain.Payment, fileName string, content io.Reader, event *domain.PaymentEvent) error {
+	fileName = sanitizeFileName(fileName)
+	if fileName == "" {
+		return fmt.Errorf("le nom du fichier est requis")
+	}
+
+	// Read one byte past the limit to detect files that are too large
+	data, err := io.ReadAll(io.LimitReader(content, constants.MaxPaymentProofSize+1))
+	if err != nil {
+		return fmt.Errorf("erreur lors de la lecture du fichier: %w", err)
+	}
+	if len(data) == 0 {
+		return fmt.Errorf("le fichier est vide")
+	}
+	if len(data) > constants.MaxPaymentProofSize {
+		return fmt.Errorf("le fichier depasse la taille maximale de %d Mo", constants.MaxPaymentProofSize>>20)
+	}
+
+	// The content type is detected from the content, never trusted from the client
+	contentType := http.DetectContentType(data)
+	if !allowedAttachmentTypes[contentType] {
+		return fmt.Errorf("type de fichier non supporte (%s): seuls les images JPEG, PNG, GIF et les PDF sont acceptes", contentType)
+	}
+
+	key := path.Join(payment.ColocationID, "payments", payment.ID, randomKey())
+	if err := s.store.Put(ctx, key, bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("erreur lors de l'enregistrement du fichier: %w", err)
+	}
+
+	size := int64(len(data))
+	event.ProofFileName = &fileName
+	event.ProofContentType = &contentType
+	event.ProofSizeBytes = &size
+	event.ProofStorageKey = &key
+	return nil
+}
+
+// deleteProof removes a stored proof, failures are only logged
+func (s *PaymentService) deleteProof(ctx context.Context, key string) {
+	if err := s.store.Delete(ctx, key); err != nil {
+		log.Printf("Preuve de paiement %s non supprimee: %v", key, err)
+	}
+}
+
+// Escalate hands a disputed payment over to the admins of the colocation
+// (sender or recipient only)
+func (s *PaymentService) Escalate(ctx context.Context, colocationID, paymentID string, comment *string) (*domain.Payment, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err := s.getPaymentForAction(ctx, colocationID, paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if payment.FromUserID != userID && payment.ToUserID != userID {
+		return nil, fmt.Errorf("seuls l'emetteur et le destinataire peuvent escalader la contestation")
+	}
+
+	if payment.Status != domain.PaymentStatusDisputed {
+		return nil, fmt.Errorf("ce paiement n'est pas conteste")
+	}
+
+	event := &domain.PaymentEvent{PaymentID: paymentID, ActorID: &userID, Type: domain.PaymentEventEscalated, Comment: trimComment(comment)}
+	if err := s.repo.UpdateStatus(ctx, paymentID, []domain.PaymentStatus{domain.PaymentStatusDisputed}, domain.PaymentStatusEscalated, event); err != nil {
+		return nil, fmt.Errorf("erreur lors de l'escalade: %w", err)
+	}
+
+	members, err := s.colocationRepo.ListMembers(ctx, colocationID)
+	if err != nil {
+		log.Printf("Administrateurs de la colocation %s non notifies: %v", colocationID, err)
+	}
+	notified := map[string]bool{}
+	for _, m := range members {
+		if m.Role == domain.RoleAdmin {
+			notified[m.UserID] = true
+		}
+	}
+	// The other party is told even when they are not an admin
+	notified[payment.FromUserID] = true
+	notified[payment.ToUserID] = true
+
+	body := fmt.Sprintf("Le paiement de %s %s de %s a %s est soumis aux administrateurs",
+		payment.Amount, payment.Currency, payment.FromUserPrenom, payment.ToUserPrenom)
+	for recipient := range notified {
+		s.notifier.PublishToUser(ctx, recipient, userID, colocationID, domain.NotifPaymentEscalated,
+			"Contestation escaladee", body,
+			map[string]string{"payment_id": payment.ID},
+		)
+	}
+
+	return s.repo.GetByID(ctx, paymentID)
+}
+
+// PaymentResolution is the outcome of an escalated dispute
+type PaymentResolution string
+
+const (
+	PaymentResolutionConfirmed PaymentResolution = "confirmed"
+	PaymentResolutionVoid      PaymentResolution = "void"
+)
+
+// Resolve closes an escalated dispute (admins only): the payment is either
+// confirmed as if by its recipient, or voided
+func (s *PaymentService) Resolve(ctx context.Context, colocationID, paymentID string, resolution PaymentResolution, comment *string) (*domain.Payment, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := s.colocationRepo.GetMember(ctx, colocationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil || member.Role != domain.RoleAdmin {
+		return nil, fmt.Errorf("seuls les administrateurs peuvent trancher une contestation")
 	}
 
 	payment, err := s.getPaymentForAction(ctx, colocationID, paymentID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if payment.Status != domain.PaymentStatusEscalated {
+		return nil, fmt.Errorf("ce paiement n'est pas soumis aux administrateurs")
+	}
+
+	var outcome string
+	switch resolution {
+	case PaymentResolutionConfirmed:
+		event := &domain.PaymentEvent{PaymentID: paymentID, ActorID: &userID, Type: domain.PaymentEventConfirmed, Comment: trimComment(comment)}
+		if err := s.repo.Confirm(ctx, paymentID, event); err != nil {
+			return nil, err
+		}
+		outcome = "confirme"
+	case PaymentResolutionVoid:
+		event := &domain.PaymentEvent{PaymentID: paymentID, ActorID: &userID, Type: domain.PaymentEventVoided, Comment: trimComment(comment)}
+		if err := s.repo.UpdateStatus(ctx, paymentID, []domain.PaymentStatus{domain.PaymentStatusEscalated}, domain.PaymentStatusVoid, event); err != nil {
+			return nil, fmt.Errorf("erreur lors de l'annulation: %w", err)
+		}
+		outcome = "annule"
+	default:
+		return nil, fmt.Errorf("decision invalide")
+	}
+
+	s.settlements.Refresh(ctx, colocationID)
+
+	body := fmt.Sprintf("Les administrateurs ont %s le paiement de %s %s de %s a %s",
+		outcome, payment.Amount, payment.Currency, payment.FromUserPrenom, payment.ToUserPrenom)
+	for _, recipient := range []string{payment.FromUserID, payment.ToUserID} {
+		s.notifier.PublishToUser(ctx, recipient, userID, colocationID, domain.NotifPaymentResolved,
+			"Contestation tranchee", body,
+			map[string]string{"payment_id": payment.ID},
+		)
+	}
+
+	return s.repo.GetByID(ctx, paymentID)
+}
+
+// OpenProof returns an event of a payment and a reader over its proof (sender,
+// recipient and admins only). The caller must close the reader.
+func (s *PaymentService) OpenProof(ctx context.Context, colocationID, paymentID, eventID string) (*domain.PaymentEvent, io.ReadCloser, error) {
+	userID, err := s.ensureMembership(ctx, colocationID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	payment, err := s.getPaymentForAction(ctx, colocationID, paymentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if payment.FromUserID != userID && payment.ToUserID != userID {
+		member, err := s.colocationRepo.GetMember(ctx, colocationID, userID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if member == nil || member.Role != domain.RoleAdmin {
+			return nil, nil, fmt.Errorf("seuls les parties et les administrateurs peuvent consulter cette preuve")
+		}
+	}
+
+	event, err := s.repo.GetEvent(ctx, paymentID, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if event == nil || event.ProofStorageKey == nil {
+		return nil, nil, fmt.Errorf("preuve introuvable")
+	}
+
+	content, err := s.store.Get(ctx, *event.ProofStorageKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("erreur lors de la lecture du fichier: %w", err)
+	}
+
+	return event, content, nil
+}
+
+// trimComment returns nil for missing or blank comments
+func trimComment(comment *string) *string {
+	if comment == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*comment)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+// recordEvent records an event which does not change the status of a
+// payment, failures are only logged
+func (s *PaymentService) recordEvent(ctx context.Context, paymentID, userID string, eventType domain.PaymentEventType) {
+	event := &domain.PaymentEvent{PaymentID: paymentID, ActorID: &userID, Type: eventType}
+	if err := s.repo.AddEvent(ctx, event); err != nil {
+		log.Printf("Evenement %s du paiement %s non enregistre: %v", eventType, paymentID, err)
+	}
+}
+
+// Cancel cancels a pending payment (only by sender) and returns the date until
+// which it can be restored
+func (s *PaymentService) Cancel(ctx context.Context, colocationID, paymentID string) (time.Time, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	payment, err := s.getPaymentForAction(ctx, colocationID, paymentID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if payment.FromUserID != userID {
+		return time.Time{}, fmt.Errorf("seul l'emetteur peut annuler ce paiement")
+	}
+
+	if payment.Status != domain.PaymentStatusPending {
+		return time.Time{}, fmt.Errorf("ce paiement n'est pas en attente")
+	}
+
+	deletedAt, err := s.repo.Delete(ctx, paymentID, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	s.recordEvent(ctx, paymentID, userID, domain.PaymentEventCancelled)
+
+	if payment.SettlementPlanID != nil {
+		s.settlements.Refresh(ctx, colocationID)
+	}
+
+	return s.softDelete.RestorableUntil(deletedAt), nil
+}
+
+// Restore brings back a cancelled payment within the undo window (only by
+// sender). Payments of a settlement plan are replaced by the next plan and
+// cannot be restored.
+func (s *PaymentService) Restore(ctx context.Context, colocationID, paymentID string) (*domain.Payment, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err := s.repo.GetDeletedByID(ctx, paymentID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation: %w", err)
+	}
+	if payment == nil || payment.ColocationID != colocationID {
+		return nil, fmt.Errorf("paiement introuvable")
 	}
 
 	if payment.FromUserID != userID {
-		return fmt.Errorf("seul l'emetteur peut annuler ce paiement")
+		return nil, fmt.Errorf("seul l'emetteur peut restaurer ce paiement")
+	}
+
+	if payment.SettlementPlanID != nil {
+		return nil, fmt.Errorf("un paiement de plan de remboursement ne peut pas etre restaure")
+	}
+
+	if err := s.softDelete.ensureRestorable(payment.DeletedAt); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Restore(ctx, paymentID); err != nil {
+		return nil, err
+	}
+
+	s.recordEvent(ctx, paymentID, userID, domain.PaymentEventRestored)
+
+	return s.repo.GetByID(ctx, paymentID)
+}
+
+// GetInstructions returns the bank details of the recipient of a pending
+// payment with the EPC QR code of the SEPA transfer, for its sender to scan in
+// their banking app. Only payments in euros can be paid by SEPA transfer.
+func (s *PaymentService) GetInstructions(ctx context.Context, colocationID, paymentID string) (*domain.PaymentInstructions, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
+	payment, err := s.getPaymentForAction(ctx, colocationID, paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if payment.FromUserID != userID {
+		return nil, fmt.Errorf("seul l'emetteur peut obtenir les instructions de ce paiement")
+	}
 	if payment.Status != domain.PaymentStatusPending {
-		return fmt.Errorf("ce paiement n'est pas en attente")
+		return nil, fmt.Errorf("ce paiement n'est pas en attente")
 	}
+	if payment.Currency != "EUR" {
+		return nil, fmt.Errorf("le virement SEPA n'est possible que pour les paiements en euros")
+	}
+
+	recipient, err := s.userRepo.GetUserByID(ctx, payment.ToUserID)
+	if err != nil {
+		return nil, err
+	}
+	if recipient == nil || recipient.IBAN == nil {
+		return nil, fmt.Errorf("%s n'a pas renseigne son IBAN", payment.ToUserPrenom)
+	}
+
+	coloc, err := s.colocationRepo.GetByID(ctx, colocationID)
+	if err != nil {
+		return nil, err
+	}
+	remittance := fmt.Sprintf("Remboursement %s", coloc.Name)
+	if payment.Note != nil && *payment.Note != "" {
+		remittance = *payment.Note
+	}
+
+	transfer := domain.SEPATransfer{
+		BeneficiaryName: strings.TrimSpace(recipient.Prenom + " " + recipient.Nom),
+		IBAN:            *recipient.IBAN,
+		Amount:          payment.Amount,
+		Remittance:      remittance,
+	}
+	if recipient.BIC != nil {
+		transfer.BIC = *recipient.BIC
+	}
+
+	payload, err := transfer.EPCPayload()
+	if err != nil {
+		return nil, err
+	}
+	qrCode, err := utils.GenerateQRCode(payload, constants.PaymentQRCodeSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.PaymentInstructions{
+		Payment:         payment,
+		BeneficiaryName: transfer.BeneficiaryName,
+		IBAN:            transfer.IBAN,
+		BIC:             recipient.BIC,
+		Remittance:      remittance,
+		EPCPayload:      payload,
+		QRCode:          qrCode,
+	}, nil
+}
+
+// ProcessStalePayments applies the pending payment policy of every
+// colocation: the recipients of payments left pending for the reminder delay
+// are reminded, and payments left pending for the expiry delay expire. It
+// returns the number of reminded and expired payments.
+func (s *PaymentService) ProcessStalePayments(ctx context.Context) (int, int, error) {
+	reminded, err := s.repo.MarkStaleForReminder(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, id := range reminded {
+		payment, err := s.repo.GetByID(ctx, id)
+		if err != nil || payment == nil {
+			continue
+		}
+		s.notifier.PublishToUser(ctx, payment.ToUserID, "", payment.ColocationID, domain.NotifPaymentReminder,
+			"Paiement a confirmer",
+			fmt.Sprintf("Le paiement de %s %s de %s attend toujours votre confirmation", payment.Amount, payment.Currency, payment.FromUserPrenom),
+			map[string]string{"payment_id": payment.ID},
+		)
+	}
+
+	expired, err := s.repo.ExpireStale(ctx)
+	if err != nil {
+		return len(reminded), 0, err
+	}
+	refreshed := map[string]bool{}
+	for _, id := range expired {
+		payment, err := s.repo.GetByID(ctx, id)
+		if err != nil || payment == nil {
+			continue
+		}
+		// Settlement plans with an expired payment must be recomputed
+		if payment.SettlementPlanID != nil && !refreshed[payment.ColocationID] {
+			refreshed[payment.ColocationID] = true
+			s.settlements.Refresh(ctx, payment.ColocationID)
+		}
+
+		body := fmt.Sprintf("Le paiement de %s %s de %s a %s a expire sans confirmation",
+			payment.Amount, payment.Currency, payment.FromUserPrenom, payment.ToUserPrenom)
+		for _, recipient := range []string{payment.FromUserID, payment.ToUserID} {
+			s.notifier.PublishToUser(ctx, recipient, "", payment.ColocationID, domain.NotifPaymentExpired,
+				"Paiement expire", body,
+				map[string]string{"payment_id": payment.ID},
+			)
+		}
+	}
+
+	return len(reminded), len(expired), nil
+}
 
-	return s.repo.Delete(ctx, paymentID)
+// PurgeDeleted hard-deletes the payments cancelled for longer than the
+// retention and returns their number
+func (s *PaymentService) PurgeDeleted(ctx context.Context) (int, error) {
+	return s.repo.PurgeDeleted(ctx, s.softDelete.purgeBefore(time.Now()))
 }
 
 // getPaymentForAction retrieves a payment and validates it belongs to the colocation
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/vblanchet22/back_coloc/internal/auth"
+	"github.com/vblanchet22/back_coloc/internal/constants"
+	"github.com/vblanchet22/back_coloc/internal/domain"
+	"github.com/vblanchet22/back_coloc/internal/repository/postgres"
+)
+
+// SearchService handles full-text search across the entities of a colocation
+type SearchService struct {
+	repo           *postgres.SearchRepository
+	colocationRepo *postgres.ColocationRepository
+}
+
+// NewSearchService creates a new SearchService
+func NewSearchService(repo *postgres.SearchRepository, colocationRepo *postgres.ColocationRepository) *SearchService {
+	return &SearchService{
+		repo:           repo,
+		colocationRepo: colocationRepo,
+	}
+}
+
+// SearchInput contains the parameters of a search. A cursor returned by a
+// previous search continues the group it belongs to, Types is then ignored.
+type SearchInput struct {
+	ColocationID string
+	Query        string
+	Types        []domain.SearchEntityType // All types when empty
+	Filters      domain.SearchFilters
+	PageSize     int // Hits per entity type
+	Cursor       string
+}
+
+// Search searches the expenses, decisions, funds and events of a colocation
+// and returns one group per entity type
+func (s *SearchService) Search(ctx context.Context, input SearchInput) ([]domain.SearchGroup, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isMember, err := s.colocationRepo.IsMember(ctx, input.ColocationID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la verification: %w", err)
+	}
+	if !isMember {
+		return nil, fmt.Errorf("vous n'etes pas membre de cette colocation")
+	}
+
+	query := strings.TrimSpace(input.Query)
+	if query == "" {
+		return nil, fmt.Errorf("la recherche est vide")
+	}
+	if len(query) > constants.MaxSearchQueryLength {
+		return nil, fmt.Errorf("la recherche ne peut pas depasser %d caracteres", constants.MaxSearchQueryLength)
+	}
+
+	f := input.Filters
+	if f.MinAmount != nil && f.MaxAmount != nil && *f.MinAmount > *f.MaxAmount {
+		return nil, fmt.Errorf("le montant minimum doit etre inferieur au montant maximum")
+	}
+	if f.StartDate != nil && f.EndDate != nil && f.StartDate.After(*f.EndDate) {
+		return nil, fmt.Errorf("la date de debut doit preceder la date de fin")
+	}
+
+	pageSize := input.PageSize
+	if pageSize < 1 {
+		pageSize = constants.DefaultSearchPageSize
+	}
+	if pageSize > constants.MaxSearchPageSize {
+		pageSize = constants.MaxSearchPageSize
+	}
+
+	types := input.Types
+	if len(types) == 0 {
+		types = domain.SearchEntityTypes
+	}
+
+	var after *domain.SearchCursor
+	if input.Cursor != "" {
+		after, err = decodeSearchCursor(input.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		types = []domain.SearchEntityType{after.EntityType}
+	}
+
+	groups := make([]domain.SearchGroup, 0, len(types))
+	for _, entityType := range types {
+		// One extra hit tells whether there is a next page
+		hits, err := s.repo.Search(ctx, input.ColocationID, entityType, query, f, after, pageSize+1)
+		if err != nil {
+			return nil, err
+		}
+
+		group := domain.SearchGroup{EntityType: entityType, Hits: hits}
+		if len(hits) > pageSize {
+			group.Hits = hits[:pageSize]
+			last := group.Hits[pageSize-1]
+			group.NextCursor = encodeSearchCursor(domain.SearchCursor{EntityType: entityType, Rank: last.Rank, ID: last.ID})
+		}
+		groups = append(groups, group)
+	}
+
+	return groups, nil
+}
+
+// encodeSearchCursor encodes a cursor as an opaque URL-safe string
+func encodeSearchCursor(cursor domain.SearchCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeSearchCursor decodes a cursor returned by encodeSearchCursor
+func decodeSearchCursor(s string) (*domain.SearchCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("curseur invalide")
+	}
+
+	var cursor domain.SearchCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == "" {
+		return nil, fmt.Errorf("curseur invalide")
+	}
+
+	for _, t := range domain.SearchEntityTypes {
+		if cursor.EntityType == t {
+			return &cursor, nil
+		}
+	}
+	return nil, fmt.Errorf("curseur invalide")
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/vblanchet22/back_coloc/internal/auth"
+	"github.com/vblanchet22/back_coloc/internal/domain"
+	"github.com/vblanchet22/back_coloc/internal/repository/postgres"
+)
+
+// SettlementService handles settlement plans: the simplified debts of a
+// colocation turned into linked pending payments
+type SettlementService struct {
+	repo           *postgres.SettlementRepository
+	balanceRepo    *postgres.BalanceRepository
+	colocationRepo *postgres.ColocationRepository
+	notifier       *NotificationService
+}
+
+// NewSettlementService creates a new SettlementService
+func NewSettlementService(repo *postgres.SettlementRepository, balanceRepo *postgres.BalanceRepository, colocationRepo *postgres.ColocationRepository, notifier *NotificationService) *SettlementService {
+	return &SettlementService{
+		repo:           repo,
+		balanceRepo:    balanceRepo,
+		colocationRepo: colocationRepo,
+		notifier:       notifier,
+	}
+}
+
+// ensureMembership verifies user is a member and returns the userID
+func (s *SettlementService) ensureMembership(ctx context.Context, colocationID string) (string, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	isMember, err := s.colocationRepo.IsMember(ctx, colocationID, userID)
+	if err != nil {
+		return "", fmt.Errorf("erreur lors de la verification: %w", err)
+	}
+	if !isMember {
+		return "", fmt.Errorf("vous n'etes pas membre de cette colocation")
+	}
+
+	return userID, nil
+}
+
+// SettleUp snapshots the current simplified debts into a settlement plan made
+// of pending payments. If a plan is still active and valid, it is returned
+// instead of creating a new one.
+func (s *SettlementService) SettleUp(ctx context.Context, colocationID string) (*domain.SettlementPlan, error) {
+	userID, err := s.ensureMembership(ctx, colocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	active, err := s.refresh(ctx, colocationID)
+	if err != nil {
+		return nil, err
+	}
+	if active != nil {
+		return active, nil
+	}
+
+	members, err := s.balanceRepo.GetMembersInfo(ctx, colocationID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la recuperation des membres: %w", err)
+	}
+
+	balances, err := s.balanceRepo.GetUserBalances(ctx, colocationID)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors du calcul des soldes: %w", err)
+	}
+
+	debts, _ := simplifyDebts(members, balances)
+	if len(debts) == 0 {
+		return nil, fmt.Errorf("aucune dette a regler")
+	}
+
+	note := "Plan de remboursement"
+	plan := &domain.SettlementPlan{
+		ColocationID:       colocationID,
+		CreatedBy:          userID,
+		BalanceFingerprint: balanceFingerprint(balances, nil),
+	}
+
+	var payments []domain.Payment
+	for _, d := range debts {
+		plan.TotalAmount += d.Amount
+		payments = append(payments, domain.Payment{
+			ColocationID: colocationID,
+			FromUserID:   d.FromUserID,
+			ToUserID:     d.ToUserID,
+			Amount:       d.Amount,
+			Currency:     d.Currency,
+			ExchangeRate: domain.RateOne,
+			BaseAmount:   d.Amount,
+			Note:         &note,
+		})
+	}
+
+	if err := s.repo.Create(ctx, plan, payments); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.GetByID(ctx, plan.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifier.Publish(ctx, colocationID, userID, domain.NotifSettlementCreated,
+		"Plan de remboursement",
+		fmt.Sprintf("%s a lance un plan de remboursement de %d paiement(s) pour %s %s", created.CreatedByPrenom, len(created.Payments), created.TotalAmount, created.Currency),
+		map[string]string{"settlement_plan_id": created.ID},
+	)
+
+	return created, nil
+}
+
+// GetPlan retrieves a settlement plan with its payments and progress
+func (s *SettlementService) GetPlan(ctx context.Context, colocationID, planID string) (*domain.SettlementPlan, error) {
+	if _, err := s.ensureMembership(ctx, colocationID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.refresh(ctx, colocationID); err != nil {
+		return nil, err
+	}
+
+	plan, err := s.repo.GetByID(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	if plan == nil || plan.ColocationID != colocationID {
+		return nil, fmt.Errorf("plan de remboursement introuvable")
+	}
+
+	return plan, nil
+}
+
+// Refresh re-evaluates the active settlement plan of a colocation after its
+// balances or payments changed. Failures are logged and never fail the caller.
+func (s *SettlementService) Refresh(ctx context.Context, colocationID string) {
+	if s == nil {
+		return
+	}
+
+	if _, err := s.refresh(ctx, colocationID); err != nil {
+		log.Printf("Plan de remboursement de la colocation %s non mis a jour: %v", colocationID, err)
+	}
+}
+
+// refresh completes the active plan once all its payments are confirmed, or
+// invalidates it when the balances no longer match the snapshot it was built
+// from. It returns the plan if it is still active.
+func (s *SettlementService) refresh(ctx context.Context, colocationID string) (*domain.SettlementPlan, error) {
+	plan, err := s.repo.GetActive(ctx, colocationID)
+	if err != nil {
+		return nil, err
+	}
+	if plan == nil {
+		return nil, nil
+	}
+
+	valid, err := s.isPlanValid(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	if !valid {
+		if err := s.repo.Invalidate(ctx, plan.ID); err != nil {
+			return nil, err
+		}
+		s.notifier.Publish(ctx, colocationID, "", domain.NotifSettlementInvalidated,
+			"Plan de remboursement invalide",
+			"Les soldes ont change, les paiements en attente du plan ont ete annules. Relancez un plan pour repartir des soldes actuels.",
+			map[string]string{"settlement_plan_id": plan.ID},
+		)
+		return nil, nil
+	}
+
+	if plan.ConfirmedCount() == len(plan.Payments) {
+		if err := s.repo.MarkCompleted(ctx, plan.ID); err != nil {
+			return nil, err
+		}
+		s.notifier.Publish(ctx, colocationID, "", domain.NotifSettlementCompleted,
+			"Plan de remboursement termine",
+			fmt.Sprintf("Tous les paiements du plan ont ete confirmes (%s %s)", plan.TotalAmount, plan.Currency),
+			map[string]string{"settlement_plan_id": plan.ID},
+		)
+		return nil, nil
+	}
+
+	return plan, nil
+}
+
+// isPlanValid checks that none of the plan payments was voided, expired or cancelled
+// and that the balances, once the confirmed plan payments are taken out, are
+// still the ones the plan was computed from
+func (s *SettlementService) isPlanValid(ctx context.Context, plan *domain.SettlementPlan) (bool, error) {
+	var total domain.Money
+	for _, p := range plan.Payments {
+		if p.Status == domain.PaymentStatusVoid || p.Status == domain.PaymentStatusExpired {
+			return false, nil
+		}
+		total += p.BaseAmount
+	}
+
+	// A cancelled payment is deleted, so the plan no longer adds up
+	if total != plan.TotalAmount {
+		return false, nil
+	}
+
+	balances, err := s.balanceRepo.GetUserBalances(ctx, plan.ColocationID)
+	if err != nil {
+		return false, fmt.Errorf("erreur lors du calcul des soldes: %w", err)
+	}
+
+	return balanceFingerprint(balances, plan.Payments) == plan.BalanceFingerprint, nil
+}
+
+// balanceFingerprint hashes the net balances of a colocation. The effect of
+// the confirmed payments given is removed first, so that a plan being
+// settled keeps the fingerprint it had when it was created.
+func balanceFingerprint(balances []domain.UserBalance, payments []domain.Payment) string {
+	net := make(map[string]domain.Money, len(balances))
+	for _, b := range balances {
+		net[b.UserID] = b.NetBalance
+	}
+
+	for _, p := range payments {
+		if p.Status != domain.PaymentStatusConfirmed {
+			continue
+		}
+		net[p.FromUserID] -= p.BaseAmount
+		net[p.ToUserID] += p.BaseAmount
+	}
+
+	userIDs := make([]string, 0, len(net))
+	for userID, amount := range net {
+		if amount != 0 {
+			userIDs = append(userIDs, userID)
+		}
+	}
+	sort.Strings(userIDs)
+
+	h := sha256.New()
+	for _, userID := range userIDs {
+		fmt.Fprintf(h, "%s:%d;", userID, net[userID].Cents())
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
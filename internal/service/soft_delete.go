@@ -0,0 +1,40 @@
+package service
+
+import (
+	"fmt"
+	"time"
+)
+
+// SoftDeletePolicy controls how long soft-deleted expenses, payments and
+// contributions can be restored and when they are purged
+type SoftDeletePolicy struct {
+	UndoWindow time.Duration // Restore allowed while deleted for less than this
+	Retention  time.Duration // Hard-deleted once deleted for longer than this
+}
+
+// RestorableUntil returns the date until which a row deleted at deletedAt can
+// be restored
+func (p SoftDeletePolicy) RestorableUntil(deletedAt time.Time) time.Time {
+	return deletedAt.Add(p.UndoWindow)
+}
+
+// ensureRestorable checks that a deleted row is still within the undo window
+func (p SoftDeletePolicy) ensureRestorable(deletedAt *time.Time) error {
+	if deletedAt == nil {
+		return fmt.Errorf("cet element n'est pas supprime")
+	}
+	if time.Now().After(p.RestorableUntil(*deletedAt)) {
+		return fmt.Errorf("le delai de restauration est depasse")
+	}
+	return nil
+}
+
+// purgeBefore returns the date before which deleted rows are purged. Rows are
+// never purged while they can still be restored.
+func (p SoftDeletePolicy) purgeBefore(now time.Time) time.Time {
+	retention := p.Retention
+	if retention < p.UndoWindow {
+		retention = p.UndoWindow
+	}
+	return now.Add(-retention)
+}
@@ -0,0 +1,468 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/vblanchet22/back_coloc/internal/constants"
+	"github.com/vblanchet22/back_coloc/internal/domain"
+	"github.com/vblanchet22/back_coloc/internal/repository/postgres"
+	"github.com/vblanchet22/back_coloc/internal/statement"
+)
+
+// Keywords of bank labels mapped to the default categories, used when the
+// expense history of the colocation gives no hint
+var categoryKeywords = map[string][]string{
+	"Courses":        {"carrefour", "leclerc", "auchan", "intermarche", "monoprix", "franprix", "lidl", "aldi", "casino", "super u", "hyper u", "picard", "biocoop", "naturalia", "supermarche", "epicerie", "boulangerie"},
+	"Loyer":          {"loyer", "bailleur", "agence immobiliere", "foncia", "nexity", "citya"},
+	"Electricite":    {"edf", "engie", "total energies", "totalenergies", "electricite", "ekwateur", "enercoop"},
+	"Eau":            {"eau de paris", "veolia", "suez", "saur", "eau"},
+	"Internet":       {"orange", "free", "sfr", "bouygues", "sosh", "red by sfr", "internet", "box"},
+	"Gaz":            {"grdf", "gaz"},
+	"Assurance":      {"assurance", "maif", "macif", "matmut", "axa", "allianz", "groupama", "lemonade", "luko"},
+	"Entretien":      {"leroy merlin", "castorama", "brico", "plombier", "serrurier"},
+	"Menage":         {"menage", "pressing", "laverie"},
+	"Mobilier":       {"ikea", "conforama", "but", "maisons du monde", "alinea"},
+	"Electromenager": {"darty", "boulanger", "fnac"},
+	"Loisirs":        {"netflix", "spotify", "deezer", "disney", "canal", "cinema", "ugc", "pathe", "steam", "playstation"},
+	"Restaurant":     {"restaurant", "resto", "mcdonald", "burger king", "kfc", "uber eats", "deliveroo", "just eat", "pizza", "sushi", "cafe", "bar", "brasserie"},
+	"Transport":      {"sncf", "ratp", "navigo", "uber", "bolt", "blablacar", "total", "esso", "shell", "bp", "station", "peage", "parking"},
+	"Sante":          {"pharmacie", "docteur", "medecin", "dentiste", "hopital", "laboratoire", "mutuelle"},
+}
+
+// Prefixes added by banks to card payments, transfers and direct debits
+var bankLabelPrefixes = map[string]bool{
+	"cb": true, "carte": true, "paiement": true, "achat": true, "prlv": true, "prelevement": true,
+	"sepa": true, "vir": true, "virement": true, "facture": true, "retrait": true, "dab": true, "inst": true,
+}
+
+var (
+	labelDatePattern = regexp.MustCompile(`^\d{2}[/.-]\d{2}([/.-]\d{2,4})?$`)
+	labelCardPattern = regexp.MustCompile(`^[xX*]+\d{2,4}$`)
+)
+
+// StatementService imports bank statements as expenses
+type StatementService struct {
+	expenses     *ExpenseService
+	repo         *postgres.ExpenseRepository
+	categoryRepo *postgres.CategoryRepository
+}
+
+// NewStatementService creates a new StatementService
+func NewStatementService(expenses *ExpenseService, repo *postgres.ExpenseRepository, categoryRepo *postgres.CategoryRepository) *StatementService {
+	return &StatementService{
+		expenses:     expenses,
+		repo:         repo,
+		categoryRepo: categoryRepo,
+	}
+}
+
+// ImportStatementInput represents input for parsing a bank statement.
+// An empty format is detected from the content.
+type ImportStatementInput struct {
+	ColocationID string
+	Content      []byte
+	Format       statement.Format
+	CSVMapping   *statement.CSVMapping
+}
+
+// Import parses a bank statement and returns its debits as expense drafts,
+// with a suggested category and the possible duplicates. Nothing is created:
+// the drafts are confirmed with Confirm. The number of credits, which are not
+// expenses, is returned as well.
+func (s *StatementService) Import(ctx context.Context, input ImportStatementInput) ([]domain.ExpenseDraft, int, error) {
+	if _, err := s.expenses.ensureMembership(ctx, input.ColocationID); err != nil {
+		return nil, 0, err
+	}
+
+	if len(input.Content) == 0 {
+		return nil, 0, fmt.Errorf("releve vide")
+	}
+	if len(input.Content) > constants.MaxStatementSize {
+		return nil, 0, fmt.Errorf("releve trop volumineux (maximum %d Mo)", constants.MaxStatementSize>>20)
+	}
+
+	transactions, err := statement.Parse(input.Content, input.Format, input.CSVMapping)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(transactions) > constants.MaxStatementTransactions {
+		return nil, 0, fmt.Errorf("releve trop long (maximum %d transactions)", constants.MaxStatementTransactions)
+	}
+
+	baseCurrency, err := s.expenses.colocationRepo.GetBaseCurrency(ctx, input.ColocationID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var (
+		debits  []statement.Transaction
+		credits int
+	)
+	for _, t := range transactions {
+		if !t.IsDebit() {
+			credits++
+			continue
+		}
+		debits = append(debits, t)
+	}
+	if len(debits) == 0 {
+		return []domain.ExpenseDraft{}, credits, nil
+	}
+
+	// History used for duplicates (around the statement dates) and category hints
+	since := time.Now().AddDate(-1, 0, 0)
+	for _, t := range debits {
+		if d := t.Date.AddDate(0, 0, -constants.StatementDuplicateDays); d.Before(since) {
+			since = d
+		}
+	}
+	history, err := s.repo.ListSince(ctx, input.ColocationID, since)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	refs := make([]string, len(debits))
+	for i, t := range debits {
+		refs[i] = t.Reference
+	}
+	imported, err := s.repo.GetByImportRefs(ctx, input.ColocationID, refs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	categories, err := s.categoryRepo.ListByColocation(ctx, input.ColocationID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("erreur lors de la recuperation des categories: %w", err)
+	}
+	suggester := newCategorySuggester(categories, history)
+
+	matched := make(map[string]bool)
+	drafts := make([]domain.ExpenseDraft, 0, len(debits))
+	for _, t := range debits {
+		currency := t.Currency
+		if currency == "" {
+			currency = baseCurrency
+		}
+
+		draft := domain.ExpenseDraft{
+			Reference:   t.Reference,
+			Title:       cleanStatementLabel(t.Description),
+			Label:       t.Description,
+			Amount:      t.Amount.Abs(),
+			Currency:    currency,
+			ExpenseDate: t.Date,
+		}
+		if category := suggester.suggest(draft.Title); category != nil {
+			draft.SuggestedCategoryID = category.ID
+			draft.SuggestedCategoryName = category.Name
+		}
+
+		if id, ok := imported[t.Reference]; ok {
+			draft.AlreadyImported = true
+			draft.DuplicateExpenseID = &id
+			matched[id] = true
+		} else if e := findDuplicateExpense(history, matched, draft); e != nil {
+			draft.DuplicateExpenseID = &e.ID
+			matched[e.ID] = true
+		}
+
+		drafts = append(drafts, draft)
+	}
+
+	return drafts, credits, nil
+}
+
+// ConfirmDraftInput is a reviewed draft to create as an expense
+type ConfirmDraftInput struct {
+	Reference    string
+	Title        string
+	Description  *string
+	Amount       domain.Money
+	Currency     string
+	ExchangeRate *domain.Rate
+	CategoryID   string
+	SplitType    domain.SplitType
+	Splits       []domain.ExpenseSplitInput
+	ExpenseDate  time.Time
+}
+
+// Confirm creates the selected drafts as expenses paid by the current user,
+// in a single transaction. A statement line can only be imported once.
+func (s *StatementService) Confirm(ctx context.Context, colocationID string, drafts []ConfirmDraftInput) ([]domain.Expense, error) {
+	userID, err := s.expenses.ensureMembership(ctx, colocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(drafts) == 0 {
+		return nil, fmt.Errorf("aucune depense a importer")
+	}
+	if len(drafts) > constants.MaxStatementTransactions {
+		return nil, fmt.Errorf("trop de depenses a importer (maximum %d)", constants.MaxStatementTransactions)
+	}
+
+	refs := make([]string, 0, len(drafts))
+	seen := make(map[string]bool)
+	for _, d := range drafts {
+		if d.Reference == "" {
+			return nil, fmt.Errorf("reference de transaction manquante")
+		}
+		if seen[d.Reference] {
+			return nil, fmt.Errorf("la transaction %s est en double dans l'import", d.Reference)
+		}
+		seen[d.Reference] = true
+		refs = append(refs, d.Reference)
+	}
+
+	imported, err := s.repo.GetByImportRefs(ctx, colocationID, refs)
+	if err != nil {
+		return nil, err
+	}
+	if len(imported) > 0 {
+		for _, ref := range refs {
+			if _, ok := imported[ref]; ok {
+				return nil, fmt.Errorf("la transaction %s a deja ete importee", ref)
+			}
+		}
+	}
+
+	expenses := make([]*domain.Expense, 0, len(drafts))
+	allSplits := make([][]domain.ExpenseSplitInput, 0, len(drafts))
+	for _, d := range drafts {
+		if err := s.expenses.validateCategory(ctx, d.CategoryID, colocationID); err != nil {
+			return nil, fmt.Errorf("transaction %s: %w", d.Reference, err)
+		}
+
+		splitType := d.SplitType
+		if splitType == "" {
+			splitType = domain.SplitTypeEqual
+		}
+		splits, err := s.expenses.calculateSplits(ctx, colocationID, d.Amount, splitType, d.Splits, nil, false)
+		if err != nil {
+			return nil, fmt.Errorf("transaction %s: %w", d.Reference, err)
+		}
+
+		currency, rate, baseAmount, err := s.expenses.rates.convertToBase(ctx, colocationID, userID, d.Currency, d.Amount, d.ExpenseDate, d.ExchangeRate)
+		if err != nil {
+			return nil, fmt.Errorf("transaction %s: %w", d.Reference, err)
+		}
+		setSplitBaseAmounts(splits, baseAmount)
+
+		ref := d.Reference
+		expenses = append(expenses, &domain.Expense{
+			ColocationID: colocationID,
+			PaidBy:       userID,
+			CategoryID:   d.CategoryID,
+			Title:        d.Title,
+			Description:  d.Description,
+			Amount:       d.Amount,
+			Currency:     currency,
+			ExchangeRate: rate,
+			BaseAmount:   baseAmount,
+			SplitType:    splitType,
+			ExpenseDate:  d.ExpenseDate,
+			ImportRef:    &ref,
+		})
+		allSplits = append(allSplits, splits)
+	}
+
+	if err := s.repo.CreateMany(ctx, expenses, allSplits); err != nil {
+		return nil, fmt.Errorf("erreur lors de l'import des depenses: %w", err)
+	}
+
+	created := make([]domain.Expense, 0, len(expenses))
+	for _, e := range expenses {
+		expense, err := s.repo.GetByID(ctx, e.ID)
+		if err != nil {
+			return nil, err
+		}
+		created = append(created, *expense)
+	}
+
+	s.expenses.settlements.Refresh(ctx, colocationID)
+	s.expenses.budgets.Check(ctx, colocationID)
+
+	s.expenses.notifier.Publish(ctx, colocationID, userID, domain.NotifExpenseCreated,
+		"Depenses importees",
+		fmt.Sprintf("%s a importe %d depenses depuis un releve bancaire", created[0].PaidByPrenom, len(created)),
+		map[string]string{"expense_count": fmt.Sprintf("%d", len(created))},
+	)
+
+	return created, nil
+}
+
+// findDuplicateExpense returns an expense of the history with the same amount
+// and currency within a few days of the draft, not matched by another draft
+func findDuplicateExpense(history []domain.Expense, matched map[string]bool, draft domain.ExpenseDraft) *domain.Expense {
+	window := time.Duration(constants.StatementDuplicateDays) * 24 * time.Hour
+	for i := range history {
+		e := &history[i]
+		if matched[e.ID] || e.Amount != draft.Amount || e.Currency != draft.Currency {
+			continue
+		}
+		diff := e.ExpenseDate.Sub(draft.ExpenseDate)
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff <= window {
+			return e
+		}
+	}
+	return nil
+}
+
+// categorySuggester suggests a category from the words of a label, first from
+// the categories of past expenses sharing these words, then from keywords
+type categorySuggester struct {
+	categories map[string]*domain.ExpenseCategory // By ID
+	byName     map[string]*domain.ExpenseCategory // By normalized name
+	wordCounts map[string]map[string]int          // Word -> category ID -> count
+}
+
+func newCategorySuggester(categories []domain.ExpenseCategory, history []domain.Expense) *categorySuggester {
+	cs := &categorySuggester{
+		categories: make(map[string]*domain.ExpenseCategory),
+		byName:     make(map[string]*domain.ExpenseCategory),
+		wordCounts: make(map[string]map[string]int),
+	}
+	for i := range categories {
+		c := &categories[i]
+		cs.categories[c.ID] = c
+		// Custom categories of the colocation take precedence over global ones
+		if _, exists := cs.byName[normalizeLabel(c.Name)]; !exists || c.ColocationID != nil {
+			cs.byName[normalizeLabel(c.Name)] = c
+		}
+	}
+	for _, e := range history {
+		for _, word := range labelWords(e.Title) {
+			if cs.wordCounts[word] == nil {
+				cs.wordCounts[word] = make(map[string]int)
+			}
+			cs.wordCounts[word][e.CategoryID]++
+		}
+	}
+	return cs
+}
+
+// suggest returns the suggested category, "Autre" when nothing matches
+func (cs *categorySuggester) suggest(label string) *domain.ExpenseCategory {
+	scores := make(map[string]int)
+	for _, word := range labelWords(label) {
+		for categoryID, count := range cs.wordCounts[word] {
+			scores[categoryID] += count
+		}
+	}
+	var best string
+	for categoryID, score := range scores {
+		if _, ok := cs.categories[categoryID]; !ok {
+			continue
+		}
+		if best == "" || score > scores[best] || (score == scores[best] && categoryID < best) {
+			best = categoryID
+		}
+	}
+	if best != "" {
+		return cs.categories[best]
+	}
+
+	// The longest matching keyword wins ("total energies" over "total")
+	normalized := " " + normalizeLabel(label) + " "
+	var (
+		match       *domain.ExpenseCategory
+		matchLength int
+	)
+	for name, keywords := range categoryKeywords {
+		category := cs.byName[normalizeLabel(name)]
+		if category == nil {
+			continue
+		}
+		for _, keyword := range keywords {
+			if len(keyword) > matchLength && strings.Contains(normalized, " "+keyword+" ") {
+				match, matchLength = category, len(keyword)
+			}
+		}
+	}
+	if match != nil {
+		return match
+	}
+
+	return cs.byName["autre"]
+}
+
+// cleanStatementLabel turns a bank label such as "CB CARREFOUR 12/03 X1234"
+// into an expense title ("Carrefour")
+func cleanStatementLabel(label string) string {
+	words := strings.Fields(label)
+	var kept []string
+	for _, w := range words {
+		lower := strings.ToLower(w)
+		if len(kept) == 0 && bankLabelPrefixes[lower] {
+			continue
+		}
+		if labelDatePattern.MatchString(w) || labelCardPattern.MatchString(w) {
+			continue
+		}
+		kept = append(kept, w)
+	}
+	if len(kept) == 0 {
+		kept = words
+	}
+
+	title := strings.Join(kept, " ")
+	if strings.ToUpper(title) == title {
+		title = capitalizeWords(strings.ToLower(title))
+	}
+	if title == "" {
+		title = "Depense importee"
+	}
+
+	if runes := []rune(title); len(runes) > constants.MaxExpenseTitleLength {
+		title = string(runes[:constants.MaxExpenseTitleLength])
+	}
+	return title
+}
+
+// capitalizeWords upper-cases the first letter of each word
+func capitalizeWords(s string) string {
+	runes := []rune(s)
+	for i, r := range runes {
+		if i == 0 || unicode.IsSpace(runes[i-1]) || runes[i-1] == '-' {
+			runes[i] = unicode.ToUpper(r)
+		}
+	}
+	return string(runes)
+}
+
+// labelWords returns the significant words of a label
+func labelWords(label string) []string {
+	var words []string
+	for _, w := range strings.Fields(normalizeLabel(label)) {
+		if len(w) < 3 || bankLabelPrefixes[w] || strings.IndexFunc(w, unicode.IsLetter) < 0 {
+			continue
+		}
+		words = append(words, w)
+	}
+	return words
+}
+
+// normalizeLabel lower-cases a label, strips accents and replaces punctuation by spaces
+func normalizeLabel(s string) string {
+	s = strings.NewReplacer(
+		"é", "e", "è", "e", "ê", "e", "ë", "e",
+		"à", "a", "â", "a", "ä", "a",
+		"î", "i", "ï", "i", "ô", "o", "ö", "o",
+		"ù", "u", "û", "u", "ü", "u", "ç", "c",
+	).Replace(strings.ToLower(s))
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return ' '
+	}, s)
+	return strings.Join(strings.Fields(s), " ")
+}
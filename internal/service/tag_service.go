@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vblanchet22/back_coloc/internal/auth"
+	"github.com/vblanchet22/back_coloc/internal/constants"
+	"github.com/vblanchet22/back_coloc/internal/domain"
+	"github.com/vblanchet22/back_coloc/internal/repository/postgres"
+)
+
+// TagService handles expense tag business logic
+type TagService struct {
+	repo           *postgres.TagRepository
+	colocationRepo *postgres.ColocationRepository
+}
+
+// NewTagService creates a new TagService
+func NewTagService(repo *postgres.TagRepository, colocationRepo *postgres.ColocationRepository) *TagService {
+	return &TagService{
+		repo:           repo,
+		colocationRepo: colocationRepo,
+	}
+}
+
+// ensureMembership verifies user is a member and returns the userID
+func (s *TagService) ensureMembership(ctx context.Context, colocationID string) (string, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	isMember, err := s.colocationRepo.IsMember(ctx, colocationID, userID)
+	if err != nil {
+		return "", fmt.Errorf("erreur lors de la verification: %w", err)
+	}
+	if !isMember {
+		return "", fmt.Errorf("vous n'etes pas membre de cette colocation")
+	}
+
+	return userID, nil
+}
+
+// getTag retrieves a tag and verifies it belongs to the colocation
+func (s *TagService) getTag(ctx context.Context, colocationID, tagID string) (*domain.Tag, error) {
+	tag, err := s.repo.GetByID(ctx, tagID)
+	if err != nil {
+		return nil, err
+	}
+	if tag == nil || tag.ColocationID != colocationID {
+		return nil, fmt.Errorf("tag introuvable")
+	}
+	return tag, nil
+}
+
+// normalizeTagName trims a tag name and checks its length
+func normalizeTagName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("le nom du tag est obligatoire")
+	}
+	if len([]rune(name)) > constants.MaxTagNameLength {
+		return "", fmt.Errorf("le nom du tag ne peut pas depasser %d caracteres", constants.MaxTagNameLength)
+	}
+	return name, nil
+}
+
+// List returns the tags of a colocation with their expense count
+func (s *TagService) List(ctx context.Context, colocationID string) ([]domain.Tag, error) {
+	if _, err := s.ensureMembership(ctx, colocationID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListByColocation(ctx, colocationID)
+}
+
+// Create creates a new tag, names are unique per colocation regardless of case
+func (s *TagService) Create(ctx context.Context, colocationID, name string, color *string) (*domain.Tag, error) {
+	userID, err := s.ensureMembership(ctx, colocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err = normalizeTagName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.GetByName(ctx, colocationID, name)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("un tag \"%s\" existe deja", existing.Name)
+	}
+
+	tag := &domain.Tag{
+		ColocationID: colocationID,
+		Name:         name,
+		Color:        color,
+		CreatedBy:    &userID,
+	}
+
+	if err := s.repo.Create(ctx, tag); err != nil {
+		return nil, fmt.Errorf("erreur lors de la creation du tag: %w", err)
+	}
+
+	return tag, nil
+}
+
+// Update renames a tag or changes its color
+func (s *TagService) Update(ctx context.Context, colocationID, tagID string, name, color *string) (*domain.Tag, error) {
+	if _, err := s.ensureMembership(ctx, colocationID); err != nil {
+		return nil, err
+	}
+
+	tag, err := s.getTag(ctx, colocationID, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	if name != nil {
+		newName, err := normalizeTagName(*name)
+		if err != nil {
+			return nil, err
+		}
+
+		existing, err := s.repo.GetByName(ctx, colocationID, newName)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil && existing.ID != tag.ID {
+			return nil, fmt.Errorf("un tag \"%s\" existe deja, fusionnez les tags a la place", existing.Name)
+		}
+		tag.Name = newName
+	}
+	if color != nil {
+		tag.Color = color
+	}
+
+	if err := s.repo.Update(ctx, tag); err != nil {
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+// Merge moves the expenses of the source tags to the target tag and deletes
+// the source tags
+func (s *TagService) Merge(ctx context.Context, colocationID, targetID string, sourceIDs []string) (*domain.Tag, error) {
+	if _, err := s.ensureMembership(ctx, colocationID); err != nil {
+		return nil, err
+	}
+
+	target, err := s.getTag(ctx, colocationID, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	var ids []string
+	for _, id := range sourceIDs {
+		if id == targetID {
+			return nil, fmt.Errorf("un tag ne peut pas etre fusionne avec lui-meme")
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("au moins un tag a fusionner est obligatoire")
+	}
+
+	count, err := s.repo.CountInColocation(ctx, colocationID, ids)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la verification des tags: %w", err)
+	}
+	if count != len(ids) {
+		return nil, fmt.Errorf("tag invalide pour cette colocation")
+	}
+
+	if err := s.repo.Merge(ctx, target.ID, ids); err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
+
+// Delete deletes a tag, the tagged expenses are kept
+func (s *TagService) Delete(ctx context.Context, colocationID, tagID string) error {
+	if _, err := s.ensureMembership(ctx, colocationID); err != nil {
+		return err
+	}
+
+	if _, err := s.getTag(ctx, colocationID, tagID); err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, tagID)
+}
+
+// GetStats returns tag statistics for a colocation along with its base currency
+func (s *TagService) GetStats(ctx context.Context, colocationID string, startDate, endDate *time.Time) ([]domain.TagStat, domain.Money, string, error) {
+	if _, err := s.ensureMembership(ctx, colocationID); err != nil {
+		return nil, 0, "", err
+	}
+
+	currency, err := s.colocationRepo.GetBaseCurrency(ctx, colocationID)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	stats, total, err := s.repo.GetStats(ctx, colocationID, startDate, endDate)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	return stats, total, currency, nil
+}
@@ -37,7 +37,8 @@ func (s *UserService) GetCurrentUser(ctx context.Context) (*domain.User, error)
 	return user, nil
 }
 
-// GetUserByID retrieves a user by ID
+// GetUserByID retrieves a user by ID. Bank details are only returned to the
+// user themselves.
 func (s *UserService) GetUserByID(ctx context.Context, id string) (*domain.User, error) {
 	user, err := s.repo.GetUserByID(ctx, id)
 	if err != nil {
@@ -47,6 +48,10 @@ func (s *UserService) GetUserByID(ctx context.Context, id string) (*domain.User,
 		return nil, fmt.Errorf("utilisateur introuvable")
 	}
 
+	if userID, err := auth.GetUserIDFromContext(ctx); err != nil || userID != user.ID {
+		user.IBAN, user.BIC = nil, nil
+	}
+
 	return user, nil
 }
 
@@ -56,6 +61,8 @@ type UpdateUserInput struct {
 	Prenom          *string
 	Telephone       *string
 	AvatarURL       *string
+	IBAN            *string // Empty to remove the bank details
+	BIC             *string
 	CurrentPassword *string
 	NewPassword     *string
 }
@@ -88,6 +95,9 @@ func (s *UserService) UpdateCurrentUser(ctx context.Context, input UpdateUserInp
 	if input.AvatarURL != nil {
 		user.AvatarURL = input.AvatarURL
 	}
+	if err := applyBankDetails(user, input.IBAN, input.BIC); err != nil {
+		return nil, err
+	}
 
 	// Handle password change
 	if input.NewPassword != nil && *input.NewPassword != "" {
@@ -116,6 +126,39 @@ func (s *UserService) UpdateCurrentUser(ctx context.Context, input UpdateUserInp
 	return user, nil
 }
 
+// applyBankDetails validates and sets the IBAN and BIC of a user. Removing the
+// IBAN removes the BIC, which is useless without it.
+func applyBankDetails(user *domain.User, iban, bic *string) error {
+	if iban != nil {
+		if *iban == "" {
+			user.IBAN, user.BIC = nil, nil
+		} else {
+			normalized, err := domain.NormalizeIBAN(*iban)
+			if err != nil {
+				return err
+			}
+			user.IBAN = &normalized
+		}
+	}
+
+	if bic != nil {
+		if *bic == "" {
+			user.BIC = nil
+		} else {
+			normalized, err := domain.NormalizeBIC(*bic)
+			if err != nil {
+				return err
+			}
+			user.BIC = &normalized
+		}
+	}
+
+	if user.BIC != nil && user.IBAN == nil {
+		return fmt.Errorf("un IBAN est obligatoire avec le BIC")
+	}
+	return nil
+}
+
 // DeleteCurrentUser deactivates the current user's account
 func (s *UserService) DeleteCurrentUser(ctx context.Context) error {
 	userID, err := auth.GetUserIDFromContext(ctx)
@@ -0,0 +1,186 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/vblanchet22/back_coloc/internal/domain"
+)
+
+// camtDocument maps the parts of an ISO 20022 camt.053 document used for the
+// import. Elements are matched by local name, so every camt.053.001.xx
+// version is accepted.
+type camtDocument struct {
+	Statements []struct {
+		Entries []camtEntry `xml:"Ntry"`
+	} `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camtEntry struct {
+	Ref    string `xml:"NtryRef"`
+	Amount struct {
+		Value    string `xml:",chardata"`
+		Currency string `xml:"Ccy,attr"`
+	} `xml:"Amt"`
+	CreditDebit string `xml:"CdtDbtInd"`
+	Status      struct {
+		Value string `xml:",chardata"`
+		Code  string `xml:"Cd"` // camt.053.001.08 and later
+	} `xml:"Sts"`
+	BookingDate    camtDate `xml:"BookgDt"`
+	ValueDate      camtDate `xml:"ValDt"`
+	ServicerRef    string   `xml:"AcctSvcrRef"`
+	AdditionalInfo string   `xml:"AddtlNtryInf"`
+	Details        []struct {
+		EndToEndID   string   `xml:"Refs>EndToEndId"`
+		Creditor     string   `xml:"RltdPties>Cdtr>Nm"`
+		CreditorPty  string   `xml:"RltdPties>Cdtr>Pty>Nm"`
+		Debtor       string   `xml:"RltdPties>Dbtr>Nm"`
+		DebtorPty    string   `xml:"RltdPties>Dbtr>Pty>Nm"`
+		Unstructured []string `xml:"RmtInf>Ustrd"`
+	} `xml:"NtryDtls>TxDtls"`
+}
+
+type camtDate struct {
+	Date     string `xml:"Dt"`
+	DateTime string `xml:"DtTm"`
+}
+
+func parseCAMT053(data []byte) ([]Transaction, error) {
+	var doc camtDocument
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) {
+		// Latin-1 is the only non UTF-8 encoding seen in practice
+		if strings.EqualFold(charset, "iso-8859-1") || strings.EqualFold(charset, "latin1") {
+			return latin1Reader(input), nil
+		}
+		return nil, fmt.Errorf("encodage non supporte: %s", charset)
+	}
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("fichier CAMT.053 invalide: %w", err)
+	}
+	if len(doc.Statements) == 0 {
+		return nil, fmt.Errorf("fichier CAMT.053 invalide: aucun releve")
+	}
+
+	var transactions []Transaction
+	for _, stmt := range doc.Statements {
+		for _, entry := range stmt.Entries {
+			status := strings.TrimSpace(entry.Status.Code)
+			if status == "" {
+				status = strings.TrimSpace(entry.Status.Value)
+			}
+			// Pending and information-only entries are not booked yet
+			if status == "PDNG" || status == "INFO" {
+				continue
+			}
+
+			t, err := camtTransaction(entry)
+			if err != nil {
+				return nil, err
+			}
+			if t.Amount != 0 {
+				transactions = append(transactions, t)
+			}
+		}
+	}
+
+	return transactions, nil
+}
+
+// camtTransaction converts one camt.053 entry
+func camtTransaction(entry camtEntry) (Transaction, error) {
+	var t Transaction
+
+	amount, err := domain.ParseMoney(entry.Amount.Value)
+	if err != nil {
+		return t, fmt.Errorf("ecriture CAMT %s: %w", entry.ServicerRef, err)
+	}
+	switch strings.TrimSpace(entry.CreditDebit) {
+	case "DBIT":
+		t.Amount = -amount.Abs()
+	case "CRDT":
+		t.Amount = amount.Abs()
+	default:
+		return t, fmt.Errorf("ecriture CAMT %s: sens invalide: %q", entry.ServicerRef, entry.CreditDebit)
+	}
+
+	date := entry.BookingDate
+	if date.Date == "" && date.DateTime == "" {
+		date = entry.ValueDate
+	}
+	if t.Date, err = date.parse(); err != nil {
+		return t, fmt.Errorf("ecriture CAMT %s: %w", entry.ServicerRef, err)
+	}
+
+	if entry.Amount.Currency != "" {
+		if t.Currency, err = domain.NormalizeCurrency(entry.Amount.Currency); err != nil {
+			return t, err
+		}
+	}
+
+	t.Reference = strings.TrimSpace(entry.ServicerRef)
+	if t.Reference == "" {
+		t.Reference = strings.TrimSpace(entry.Ref)
+	}
+
+	var parts []string
+	for _, d := range entry.Details {
+		// The counterparty is the creditor of a debit and the debtor of a credit
+		party := firstNonEmpty(d.Creditor, d.CreditorPty)
+		if t.Amount > 0 {
+			party = firstNonEmpty(d.Debtor, d.DebtorPty)
+		}
+		if party != "" {
+			parts = append(parts, party)
+		}
+		parts = append(parts, d.Unstructured...)
+	}
+	if len(parts) == 0 {
+		parts = append(parts, entry.AdditionalInfo)
+	}
+	t.Description = strings.Join(strings.Fields(strings.Join(parts, " ")), " ")
+
+	return t, nil
+}
+
+// parse returns the date of a camt date choice (Dt or DtTm)
+func (d camtDate) parse() (time.Time, error) {
+	if d.Date != "" {
+		return parseDate(strings.TrimSpace(d.Date), []string{"2006-01-02"})
+	}
+	if d.DateTime != "" {
+		for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05"} {
+			if t, err := time.Parse(layout, strings.TrimSpace(d.DateTime)); err == nil {
+				return dayUTC(t), nil
+			}
+		}
+	}
+	return time.Time{}, fmt.Errorf("date invalide: %q", d.Date+d.DateTime)
+}
+
+// latin1Reader decodes ISO-8859-1 content to UTF-8
+func latin1Reader(r io.Reader) io.Reader {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return errReader{err}
+	}
+	return bytes.NewReader(toUTF8(data))
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
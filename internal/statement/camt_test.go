@@ -0,0 +1,63 @@
+package statement
+
+import "testing"
+
+func TestParseCAMT053(t *testing.T) {
+	transactions, err := Parse(readFixture(t, "releve.camt053.xml"), "", nil)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	// The pending entry is skipped; the counterparty is the creditor of a
+	// debit and the debtor of a credit
+	assertTransactions(t, transactions, []Transaction{
+		{Reference: "CAMT0001", Date: day(5), Description: "Boulangerie du Coin Facture 42", Amount: -4520, Currency: "EUR"},
+		{Reference: "CAMT0002", Date: day(6), Description: "Marie Dupont", Amount: 120000, Currency: "EUR"},
+		{Reference: "REF-FRAIS", Date: day(8), Description: "Frais de tenue de compte", Amount: -850, Currency: "EUR"},
+	})
+}
+
+func TestParseCAMT053Latin1(t *testing.T) {
+	data := []byte("<?xml version=\"1.0\" encoding=\"ISO-8859-1\"?>\n" +
+		"<Document><BkToCstmrStmt><Stmt><Ntry><Amt Ccy=\"EUR\">12.00</Amt><CdtDbtInd>DBIT</CdtDbtInd>" +
+		"<BookgDt><Dt>2024-03-05</Dt></BookgDt><AcctSvcrRef>L1</AcctSvcrRef>" +
+		"<AddtlNtryInf>Caf\xe9 cr\xe8me</AddtlNtryInf></Ntry></Stmt></BkToCstmrStmt></Document>")
+
+	transactions, err := Parse(data, FormatCAMT053, nil)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	assertTransactions(t, transactions, []Transaction{
+		{Reference: "L1", Date: day(5), Description: "Café crème", Amount: -1200, Currency: "EUR"},
+	})
+}
+
+func TestParseCAMT053Malformed(t *testing.T) {
+	entry := func(amount, indicator, date string) string {
+		return "<Document><BkToCstmrStmt><Stmt><Ntry><Amt Ccy=\"EUR\">" + amount + "</Amt>" +
+			"<CdtDbtInd>" + indicator + "</CdtDbtInd><BookgDt>" + date + "</BookgDt>" +
+			"<AcctSvcrRef>E1</AcctSvcrRef></Ntry></Stmt></BkToCstmrStmt></Document>"
+	}
+
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"not XML", "Date;Libelle;Montant", "fichier CAMT.053 invalide"},
+		{"truncated", "<Document><BkToCstmrStmt><Stmt><Ntry>", "fichier CAMT.053 invalide"},
+		{"no statement", "<Document><BkToCstmrStmt></BkToCstmrStmt></Document>", "aucun releve"},
+		{"unknown encoding", `<?xml version="1.0" encoding="EBCDIC"?><Document/>`, "encodage non supporte"},
+		{"French decimal comma", entry("12,50", "DBIT", "<Dt>2024-03-05</Dt>"), "ecriture CAMT E1: montant invalide"},
+		{"bad indicator", entry("12.50", "DEBIT", "<Dt>2024-03-05</Dt>"), "ecriture CAMT E1: sens invalide"},
+		{"bad date", entry("12.50", "DBIT", "<Dt>05/03/2024</Dt>"), "ecriture CAMT E1: date invalide"},
+		{"no date", entry("12.50", "CRDT", ""), "ecriture CAMT E1: date invalide"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactions, err := Parse([]byte(tt.data), FormatCAMT053, nil)
+			assertError(t, transactions, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vblanchet22/back_coloc/internal/domain"
+)
+
+// CSVMapping describes the layout of a CSV statement. Columns are given by
+// header name (case and accents ignored) or by 1-based position; empty
+// columns are detected from the header.
+type CSVMapping struct {
+	Delimiter         string // ";", ",", tab... detected when empty
+	NoHeader          bool   // The first row is already a transaction
+	SkipRows          int    // Rows to ignore before the header (bank name, account...)
+	DateColumn        string
+	DescriptionColumn string
+	AmountColumn      string // Signed amount; alternatively use DebitColumn and CreditColumn
+	DebitColumn       string
+	CreditColumn      string
+	CurrencyColumn    string
+	ReferenceColumn   string
+	DateFormat        string // e.g. "DD/MM/YYYY"; common formats are tried when empty
+	DecimalSeparator  string // "," or "."; detected per value when empty
+}
+
+// Header names recognized when a column is not mapped explicitly
+var csvColumnAliases = map[string][]string{
+	"date":        {"date operation", "date de l'operation", "date op", "date", "booking date", "transaction date", "date comptable"},
+	"description": {"libelle", "description", "intitule", "label", "details", "memo", "payee", "beneficiaire"},
+	"amount":      {"montant", "amount", "valeur", "somme"},
+	"debit":       {"debit"},
+	"credit":      {"credit"},
+	"currency":    {"devise", "currency", "monnaie"},
+	"reference":   {"reference", "transaction id", "id"},
+}
+
+// Date layouts tried when no date format is configured, day first as in French exports
+var defaultDateLayouts = []string{
+	"02/01/2006", "2006-01-02", "02-01-2006", "02.01.2006", "02/01/06", "2006/01/02", "20060102",
+}
+
+// csvColumns holds the resolved 0-based column indexes, -1 when absent
+type csvColumns struct {
+	date, description, amount, debit, credit, currency, reference int
+}
+
+func parseCSV(data []byte, mapping *CSVMapping) ([]Transaction, error) {
+	if mapping == nil {
+		mapping = &CSVMapping{}
+	}
+
+	lines := bytes.SplitAfter(data, []byte("\n"))
+	if mapping.SkipRows > 0 {
+		if mapping.SkipRows >= len(lines) {
+			return nil, fmt.Errorf("fichier CSV vide")
+		}
+		data = bytes.Join(lines[mapping.SkipRows:], nil)
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+	reader.Comma = csvDelimiter(mapping.Delimiter, data)
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("fichier CSV invalide: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("fichier CSV vide")
+	}
+
+	var header []string
+	if !mapping.NoHeader {
+		header = records[0]
+		records = records[1:]
+	}
+
+	cols, err := resolveCSVColumns(mapping, header)
+	if err != nil {
+		return nil, err
+	}
+
+	layouts := defaultDateLayouts
+	if mapping.DateFormat != "" {
+		layouts = []string{dateFormatToLayout(mapping.DateFormat)}
+	}
+
+	var transactions []Transaction
+	for i, record := range records {
+		line := i + 1 + mapping.SkipRows
+		if !mapping.NoHeader {
+			line++
+		}
+		// Blank lines and footers such as balances have no date
+		if isBlankRecord(record) || cols.date >= len(record) || strings.TrimSpace(record[cols.date]) == "" {
+			continue
+		}
+
+		t, err := csvTransaction(record, cols, layouts, mapping.DecimalSeparator)
+		if err != nil {
+			return nil, fmt.Errorf("ligne %d: %w", line, err)
+		}
+		if t.Amount == 0 {
+			continue
+		}
+		transactions = append(transactions, t)
+	}
+
+	return transactions, nil
+}
+
+// csvDelimiter returns the configured delimiter or the most frequent
+// candidate of the first line
+func csvDelimiter(configured string, data []byte) rune {
+	if configured != "" {
+		if configured == `\t` {
+			return '\t'
+		}
+		return []rune(configured)[0]
+	}
+
+	first, _, _ := bytes.Cut(data, []byte("\n"))
+	best, bestCount := ';', 0
+	for _, candidate := range []rune{';', ',', '\t', '|'} {
+		if n := bytes.Count(first, []byte(string(candidate))); n > bestCount {
+			best, bestCount = candidate, n
+		}
+	}
+	return best
+}
+
+// resolveCSVColumns maps the configured or detected columns to indexes
+func resolveCSVColumns(mapping *CSVMapping, header []string) (csvColumns, error) {
+	normalized := make([]string, len(header))
+	for i, h := range header {
+		normalized[i] = normalizeHeader(h)
+	}
+
+	resolve := func(name, configured string) (int, error) {
+		if configured != "" {
+			if pos, err := strconv.Atoi(configured); err == nil {
+				if pos < 1 {
+					return -1, fmt.Errorf("colonne %s invalide: %d", name, pos)
+				}
+				return pos - 1, nil
+			}
+			want := normalizeHeader(configured)
+			for i, h := range normalized {
+				if h == want {
+					return i, nil
+				}
+			}
+			return -1, fmt.Errorf("colonne %q introuvable dans l'en-tete", configured)
+		}
+
+		for _, alias := range csvColumnAliases[name] {
+			for i, h := range normalized {
+				if h == alias || strings.HasPrefix(h, alias+" ") || strings.HasPrefix(h, alias+"(") {
+					return i, nil
+				}
+			}
+		}
+		return -1, nil
+	}
+
+	var cols csvColumns
+	var err error
+	for _, c := range []struct {
+		name       string
+		configured string
+		target     *int
+	}{
+		{"date", mapping.DateColumn, &cols.date},
+		{"description", mapping.DescriptionColumn, &cols.description},
+		{"amount", mapping.AmountColumn, &cols.amount},
+		{"debit", mapping.DebitColumn, &cols.debit},
+		{"credit", mapping.CreditColumn, &cols.credit},
+		{"currency", mapping.CurrencyColumn, &cols.currency},
+		{"reference", mapping.ReferenceColumn, &cols.reference},
+	} {
+		if *c.target, err = resolve(c.name, c.configured); err != nil {
+			return cols, err
+		}
+	}
+
+	if cols.date < 0 {
+		return cols, fmt.Errorf("colonne de date introuvable, precisez le mapping des colonnes")
+	}
+	if cols.amount < 0 && cols.debit < 0 && cols.credit < 0 {
+		return cols, fmt.Errorf("colonne de montant introuvable, precisez le mapping des colonnes")
+	}
+	if cols.description < 0 {
+		return cols, fmt.Errorf("colonne de libelle introuvable, precisez le mapping des colonnes")
+	}
+	return cols, nil
+}
+
+// csvTransaction converts one CSV record
+func csvTransaction(record []string, cols csvColumns, layouts []string, decimalSeparator string) (Transaction, error) {
+	field := func(i int) string {
+		if i < 0 || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var t Transaction
+
+	date, err := parseDate(field(cols.date), layouts)
+	if err != nil {
+		return t, err
+	}
+	t.Date = date
+	t.Description = strings.Join(strings.Fields(field(cols.description)), " ")
+	t.Reference = field(cols.reference)
+
+	if cols.currency >= 0 && field(cols.currency) != "" {
+		currency, err := domain.NormalizeCurrency(field(cols.currency))
+		if err != nil {
+			return t, err
+		}
+		t.Currency = currency
+	}
+
+	if cols.amount >= 0 && field(cols.amount) != "" {
+		t.Amount, err = parseAmount(field(cols.amount), decimalSeparator)
+		return t, err
+	}
+
+	// Separate debit and credit columns, both written as positive amounts
+	if v := field(cols.debit); v != "" {
+		debit, err := parseAmount(v, decimalSeparator)
+		if err != nil {
+			return t, err
+		}
+		t.Amount -= debit.Abs()
+	}
+	if v := field(cols.credit); v != "" {
+		credit, err := parseAmount(v, decimalSeparator)
+		if err != nil {
+			return t, err
+		}
+		t.Amount += credit.Abs()
+	}
+	return t, nil
+}
+
+// parseDate tries each layout in turn
+func parseDate(s string, layouts []string) (time.Time, error) {
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return dayUTC(t), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("date invalide: %q", s)
+}
+
+// dateFormatToLayout converts a format such as "DD/MM/YYYY" to a Go layout
+func dateFormatToLayout(format string) string {
+	return strings.NewReplacer("YYYY", "2006", "YY", "06", "MM", "01", "DD", "02").Replace(strings.ToUpper(format))
+}
+
+// normalizeHeader lower-cases a header and strips accents and extra spaces
+func normalizeHeader(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	s = strings.NewReplacer(
+		"é", "e", "è", "e", "ê", "e", "ë", "e",
+		"à", "a", "â", "a", "ä", "a",
+		"î", "i", "ï", "i", "ô", "o", "ö", "o",
+		"ù", "u", "û", "u", "ü", "u", "ç", "c",
+		"’", "'", "_", " ", ".", " ",
+	).Replace(s)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func isBlankRecord(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
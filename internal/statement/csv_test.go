@@ -0,0 +1,92 @@
+package statement
+
+import "testing"
+
+func TestParseCSVSignedAmounts(t *testing.T) {
+	transactions, err := Parse(readFixture(t, "montant_signe.csv"), "", nil)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	// The zero amount and the balance footer are skipped
+	assertTransactions(t, transactions, []Transaction{
+		{Date: day(5), Description: "CB CARREFOUR MARKET", Amount: -123456, Currency: "EUR"},
+		{Date: day(6), Description: "VIR LOYER MARS", Amount: 250000, Currency: "EUR"},
+		{Date: day(7), Description: "PRLV EDF", Amount: -4550, Currency: "EUR"},
+		{Date: day(8), Description: "REMBOURSEMENT", Amount: 1230, Currency: "EUR"},
+	})
+
+	again, err := Parse(readFixture(t, "montant_signe.csv"), "", nil)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	for i := range transactions {
+		if again[i].Reference != transactions[i].Reference {
+			t.Fatalf("transaction %d: reference %q puis %q", i, transactions[i].Reference, again[i].Reference)
+		}
+	}
+}
+
+func TestParseCSVDebitCreditColumns(t *testing.T) {
+	// Latin-1 export with the account number above the header
+	transactions, err := Parse(readFixture(t, "debit_credit_latin1.csv"), FormatCSV, &CSVMapping{SkipRows: 1})
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	// Debits are negative whatever their written sign
+	assertTransactions(t, transactions, []Transaction{
+		{Date: day(5), Description: "CB BOULANGERIE", Amount: -420},
+		{Date: day(6), Description: "VIR DE MME DUPONT", Amount: 120000},
+		{Date: day(7), Description: "PRLV FREE MOBILE", Amount: -1999},
+	})
+}
+
+func TestParseCSVMapping(t *testing.T) {
+	data := []byte("2024-03-05|1.500|Courses|x\n2024-03-06|-20|Cinema|y\n")
+	mapping := &CSVMapping{
+		NoHeader:          true,
+		DateColumn:        "1",
+		AmountColumn:      "2",
+		DescriptionColumn: "3",
+		ReferenceColumn:   "4",
+		DateFormat:        "YYYY-MM-DD",
+		DecimalSeparator:  ",",
+	}
+
+	transactions, err := Parse(data, FormatCSV, mapping)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	assertTransactions(t, transactions, []Transaction{
+		{Reference: "x", Date: day(5), Description: "Courses", Amount: 150000},
+		{Reference: "y", Date: day(6), Description: "Cinema", Amount: -2000},
+	})
+}
+
+func TestParseCSVMalformed(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		mapping *CSVMapping
+		want    string
+	}{
+		{"empty", "", nil, "fichier CSV vide"},
+		{"only skipped rows", "Banque\n", &CSVMapping{SkipRows: 3}, "fichier CSV vide"},
+		{"bad amount", "Date;Libelle;Montant\n05/03/2024;Courses;abc\n", nil, "ligne 2: montant invalide"},
+		{"bad date", "Date;Libelle;Montant\n05/03/2024;Courses;1,00\n32/13/2024;Cinema;2,00\n", nil, "ligne 3: date invalide"},
+		{"bad currency", "Date;Libelle;Montant;Devise\n05/03/2024;Courses;1,00;Euro\n", nil, "devise invalide"},
+		{"no date column", "Libelle;Montant\nCourses;1,00\n", nil, "colonne de date introuvable"},
+		{"no amount column", "Date;Libelle\n05/03/2024;Courses\n", nil, "colonne de montant introuvable"},
+		{"no description column", "Date;Montant\n05/03/2024;1,00\n", nil, "colonne de libelle introuvable"},
+		{"unknown mapped column", "Date;Libelle;Montant\n", &CSVMapping{AmountColumn: "Somme"}, "introuvable dans l'en-tete"},
+		{"zero position", "Date;Libelle;Montant\n", &CSVMapping{AmountColumn: "0"}, "colonne amount invalide"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactions, err := Parse([]byte(tt.data), FormatCSV, tt.mapping)
+			assertError(t, transactions, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package statement
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/vblanchet22/back_coloc/internal/domain"
+)
+
+// parseOFX parses OFX 1.x (SGML, unclosed leaf tags) and OFX 2.x (XML)
+// statements with a single tolerant tag scanner
+func parseOFX(data []byte) ([]Transaction, error) {
+	start := bytes.Index(bytes.ToUpper(data), []byte("<OFX>"))
+	if start < 0 {
+		return nil, fmt.Errorf("fichier OFX invalide: balise <OFX> introuvable")
+	}
+	data = data[start:]
+
+	var (
+		transactions []Transaction
+		current      map[string]string
+		currency     string // CURDEF, the default currency of the statement
+	)
+
+	for len(data) > 0 {
+		open := bytes.IndexByte(data, '<')
+		if open < 0 {
+			break
+		}
+		end := bytes.IndexByte(data[open:], '>')
+		if end < 0 {
+			break
+		}
+		tag := strings.ToUpper(strings.TrimSpace(string(data[open+1 : open+end])))
+		data = data[open+end+1:]
+
+		// Element value: text up to the next tag
+		next := bytes.IndexByte(data, '<')
+		if next < 0 {
+			next = len(data)
+		}
+		value := strings.TrimSpace(unescapeOFX(string(data[:next])))
+
+		switch {
+		case tag == "STMTTRN":
+			current = make(map[string]string)
+		case tag == "/STMTTRN":
+			if current != nil {
+				t, err := ofxTransaction(current, currency)
+				if err != nil {
+					return nil, err
+				}
+				if t.Amount != 0 {
+					transactions = append(transactions, t)
+				}
+			}
+			current = nil
+		case tag == "CURDEF":
+			currency = value
+		case tag == "CURRENCY" || tag == "ORIGCURRENCY":
+			if current != nil {
+				current["_AGGREGATE"] = tag
+			}
+		case tag == "CURSYM" && current != nil && current["_AGGREGATE"] == "ORIGCURRENCY":
+			// The amount is already converted to the statement currency
+		case strings.HasPrefix(tag, "/") || strings.HasPrefix(tag, "?") || strings.HasPrefix(tag, "!"):
+			// Closing tags of OFX 2.x and XML declarations carry no value
+		case current != nil && value != "":
+			current[tag] = value
+		}
+	}
+
+	return transactions, nil
+}
+
+// ofxTransaction converts the fields of a STMTTRN aggregate
+func ofxTransaction(fields map[string]string, statementCurrency string) (Transaction, error) {
+	var t Transaction
+
+	amount, err := parseAmount(fields["TRNAMT"], "")
+	if err != nil {
+		return t, fmt.Errorf("transaction OFX %s: %w", fields["FITID"], err)
+	}
+	t.Amount = amount
+
+	posted := fields["DTPOSTED"]
+	if len(posted) < 8 {
+		return t, fmt.Errorf("transaction OFX %s: date invalide: %q", fields["FITID"], posted)
+	}
+	t.Date, err = parseDate(posted[:8], []string{"20060102"})
+	if err != nil {
+		return t, fmt.Errorf("transaction OFX %s: %w", fields["FITID"], err)
+	}
+
+	t.Reference = fields["FITID"]
+
+	name, memo := fields["NAME"], fields["MEMO"]
+	switch {
+	case name == "":
+		t.Description = memo
+	case memo == "" || strings.Contains(name, memo):
+		t.Description = name
+	default:
+		t.Description = name + " " + memo
+	}
+	t.Description = strings.Join(strings.Fields(t.Description), " ")
+
+	// A CURRENCY aggregate means the amount is not in the statement currency
+	currency := statementCurrency
+	if c := fields["CURSYM"]; c != "" {
+		currency = c
+	}
+	if currency != "" {
+		if t.Currency, err = domain.NormalizeCurrency(currency); err != nil {
+			return t, err
+		}
+	}
+
+	return t, nil
+}
+
+// unescapeOFX decodes the character entities allowed in OFX values
+func unescapeOFX(s string) string {
+	if !strings.Contains(s, "&") {
+		return s
+	}
+	return strings.NewReplacer("&amp;", "&", "&lt;", "<", "&gt;", ">", "&quot;", `"`, "&apos;", "'", "&nbsp;", " ").Replace(s)
+}
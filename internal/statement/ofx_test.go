@@ -0,0 +1,55 @@
+package statement
+
+import "testing"
+
+func TestParseOFX(t *testing.T) {
+	transactions, err := Parse(readFixture(t, "releve.ofx"), "", nil)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	assertTransactions(t, transactions, []Transaction{
+		{Reference: "OFX0001", Date: day(5), Description: "CB MONOPRIX PARIS 11", Amount: -4520, Currency: "EUR"},
+		{Reference: "OFX0002", Date: day(6), Description: "VIR LOYER & CHARGES", Amount: 120000, Currency: "EUR"},
+		{Reference: "OFX0003", Date: day(7), Description: "AMAZON UK", Amount: -3000, Currency: "GBP"},
+	})
+}
+
+func TestParseOFXXML(t *testing.T) {
+	data := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<?OFX OFXHEADER="200" VERSION="220"?>
+<OFX><BANKMSGSRSV1><STMTTRNRS><STMTRS><CURDEF>EUR</CURDEF><BANKTRANLIST>
+<STMTTRN><TRNTYPE>DEBIT</TRNTYPE><DTPOSTED>20240305</DTPOSTED><TRNAMT>-1 234,56</TRNAMT><FITID>X1</FITID><NAME>LOYER</NAME></STMTTRN>
+<STMTTRN><TRNTYPE>CREDIT</TRNTYPE><DTPOSTED>20240306</DTPOSTED><TRNAMT>0.00</TRNAMT><FITID>X2</FITID><NAME>ANNULE</NAME></STMTTRN>
+</BANKTRANLIST></STMTRS></STMTTRNRS></BANKMSGSRSV1></OFX>`)
+
+	transactions, err := Parse(data, FormatOFX, nil)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	assertTransactions(t, transactions, []Transaction{
+		{Reference: "X1", Date: day(5), Description: "LOYER", Amount: -123456, Currency: "EUR"},
+	})
+}
+
+func TestParseOFXMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"no OFX tag", "OFXHEADER:100\n<BANKMSGSRSV1>", "balise <OFX> introuvable"},
+		{"bad amount", "<OFX><STMTTRN><DTPOSTED>20240305<TRNAMT>abc<FITID>A1</STMTTRN>", "transaction OFX A1: montant invalide"},
+		{"missing amount", "<OFX><STMTTRN><DTPOSTED>20240305<FITID>A2</STMTTRN>", "transaction OFX A2: montant invalide"},
+		{"short date", "<OFX><STMTTRN><DTPOSTED>202403<TRNAMT>-1,00<FITID>A3</STMTTRN>", "transaction OFX A3: date invalide"},
+		{"bad date", "<OFX><STMTTRN><DTPOSTED>20241340<TRNAMT>-1,00<FITID>A4</STMTTRN>", "transaction OFX A4: date invalide"},
+		{"bad currency", "<OFX><CURDEF>EURO<STMTTRN><DTPOSTED>20240305<TRNAMT>-1,00</STMTTRN>", "devise invalide"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactions, err := Parse([]byte(tt.data), FormatOFX, nil)
+			assertError(t, transactions, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// Package statement parses bank statements (CSV, OFX and CAMT.053) into a
+// flat list of transactions.
+package statement
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/vblanchet22/back_coloc/internal/domain"
+)
+
+// Format is a bank statement file format
+type Format string
+
+// Supported formats
+const (
+	FormatCSV     Format = "csv"
+	FormatOFX     Format = "ofx"
+	FormatCAMT053 Format = "camt053"
+)
+
+// Transaction is one line of a bank statement
+type Transaction struct {
+	Reference   string       // Bank transaction ID, or a hash of the line when the file has none
+	Date        time.Time    // Booking date, at midnight UTC
+	Description string       // Label of the transaction
+	Amount      domain.Money // Negative for debits, positive for credits
+	Currency    string       // ISO 4217 code, empty when the statement does not say
+}
+
+// IsDebit reports whether money left the account
+func (t Transaction) IsDebit() bool {
+	return t.Amount < 0
+}
+
+// DetectFormat guesses the format of a statement from its content
+func DetectFormat(data []byte) Format {
+	head := data
+	if len(head) > 4096 {
+		head = head[:4096]
+	}
+	upper := bytes.ToUpper(head)
+
+	switch {
+	case bytes.Contains(upper, []byte("OFXHEADER")) || bytes.Contains(upper, []byte("<OFX>")):
+		return FormatOFX
+	case bytes.Contains(head, []byte("camt.053")) || bytes.Contains(head, []byte("<BkToCstmrStmt")):
+		return FormatCAMT053
+	default:
+		return FormatCSV
+	}
+}
+
+// Parse parses a statement. An empty format is detected from the content;
+// mapping only applies to CSV files and may be nil to detect the columns.
+func Parse(data []byte, format Format, mapping *CSVMapping) ([]Transaction, error) {
+	if format == "" {
+		format = DetectFormat(data)
+	}
+
+	var (
+		transactions []Transaction
+		err          error
+	)
+	switch format {
+	case FormatCSV:
+		transactions, err = parseCSV(toUTF8(data), mapping)
+	case FormatOFX:
+		transactions, err = parseOFX(toUTF8(data))
+	case FormatCAMT053:
+		transactions, err = parseCAMT053(data)
+	default:
+		return nil, fmt.Errorf("format de releve non supporte: %s", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fillReferences(transactions)
+	return transactions, nil
+}
+
+// toUTF8 strips the byte order mark and converts Latin-1 content, still
+// common in French bank exports, to UTF-8
+func toUTF8(data []byte) []byte {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if utf8.Valid(data) {
+		return data
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(len(data) + len(data)/8)
+	for _, b := range data {
+		buf.WriteRune(rune(b))
+	}
+	return buf.Bytes()
+}
+
+// fillReferences gives the transactions without a bank ID a reference derived
+// from their content. Identical lines get an occurrence number, so that two
+// identical purchases on the same day stay distinct while importing the same
+// file twice gives the same references.
+func fillReferences(transactions []Transaction) {
+	seen := make(map[string]int)
+	for i := range transactions {
+		if transactions[i].Reference != "" {
+			continue
+		}
+
+		t := transactions[i]
+		key := fmt.Sprintf("%s|%d|%s|%s", t.Date.Format("2006-01-02"), t.Amount.Cents(), t.Currency, strings.ToLower(t.Description))
+		seen[key]++
+
+		sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%d", key, seen[key])))
+		transactions[i].Reference = "h:" + hex.EncodeToString(sum[:12])
+	}
+}
+
+// parseAmount parses an amount written with either a comma or a dot as decimal
+// separator, with optional thousands separators, currency symbols and a
+// trailing or parenthesized sign. decimalSeparator forces the separator when set.
+func parseAmount(s string, decimalSeparator string) (domain.Money, error) {
+	raw := s
+	s = strings.TrimSpace(s)
+
+	negative := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		negative = true
+		s = s[1 : len(s)-1]
+	}
+
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9', r == ',', r == '.':
+			b.WriteRune(r)
+		case r == '-' || r == '−':
+			negative = !negative
+		}
+	}
+	s = b.String()
+	if s == "" {
+		return 0, fmt.Errorf("montant invalide: %q", raw)
+	}
+
+	sep := decimalSeparator
+	if sep == "" {
+		sep = guessDecimalSeparator(s)
+	}
+	if sep == "," {
+		s = strings.ReplaceAll(s, ".", "")
+		s = strings.Replace(s, ",", ".", 1)
+	} else {
+		s = strings.ReplaceAll(s, ",", "")
+	}
+
+	amount, err := domain.ParseMoney(s)
+	if err != nil {
+		return 0, fmt.Errorf("montant invalide: %q", raw)
+	}
+	if negative {
+		amount = -amount
+	}
+	return amount, nil
+}
+
+// guessDecimalSeparator returns the separator followed by at most two digits
+// at the end of the number, "." by default
+func guessDecimalSeparator(s string) string {
+	i := strings.LastIndexAny(s, ",.")
+	if i < 0 {
+		return "."
+	}
+	if s[i] == ',' {
+		// "1,234" is ambiguous; a lone comma followed by 3 digits is a thousands separator
+		if len(s)-i-1 == 3 && !strings.Contains(s, ".") && strings.Count(s, ",") > 1 {
+			return "."
+		}
+		return ","
+	}
+	if len(s)-i-1 == 3 && strings.Count(s, ".") > 1 {
+		return ","
+	}
+	return "."
+}
+
+// dayUTC truncates a date to midnight UTC
+func dayUTC(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,145 @@
+package statement
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vblanchet22/back_coloc/internal/domain"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("lecture de %s: %v", name, err)
+	}
+	return data
+}
+
+func day(d int) time.Time {
+	return time.Date(2024, time.March, d, 0, 0, 0, 0, time.UTC)
+}
+
+// assertTransactions compares parsed transactions with the expected ones. An
+// empty expected reference stands for a reference derived from the content.
+func assertTransactions(t *testing.T, got, want []Transaction) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("attendu %d transactions, obtenu %d: %+v", len(want), len(got), got)
+	}
+	for i := range want {
+		g, w := got[i], want[i]
+		if w.Reference == "" {
+			if !strings.HasPrefix(g.Reference, "h:") {
+				t.Fatalf("transaction %d: reference %q, attendu une empreinte", i, g.Reference)
+			}
+			w.Reference = g.Reference
+		}
+		if !g.Date.Equal(w.Date) || g.Description != w.Description || g.Amount != w.Amount ||
+			g.Currency != w.Currency || g.Reference != w.Reference {
+			t.Fatalf("transaction %d: obtenu %+v, attendu %+v", i, g, w)
+		}
+		if g.IsDebit() != (w.Amount < 0) {
+			t.Fatalf("transaction %d: IsDebit() = %v pour %s", i, g.IsDebit(), g.Amount)
+		}
+	}
+}
+
+// assertError checks that malformed input is rejected with the expected
+// French message rather than a panic
+func assertError(t *testing.T, transactions []Transaction, err error, want string) {
+	t.Helper()
+
+	if err == nil {
+		t.Fatalf("erreur attendue, obtenu %+v", transactions)
+	}
+	if !strings.Contains(err.Error(), want) {
+		t.Fatalf("erreur %q, attendu %q", err, want)
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		in        string
+		separator string
+		want      domain.Money
+		wantErr   bool
+	}{
+		{in: "12,34", want: 1234},
+		{in: "-12,34", want: -1234},
+		{in: "1 234,56", want: 123456},
+		{in: "1 234,56 €", want: 123456},
+		{in: "1.234,56", want: 123456},
+		{in: "1,234.56", want: 123456},
+		{in: "1.234.567", want: 123456700},
+		{in: "1,234,567", want: 123456700},
+		{in: "12.5", want: 1250},
+		{in: "(45,50)", want: -4550},
+		{in: "45,50-", want: -4550},
+		{in: "−3,10", want: -310},
+		{in: "1,234", want: 123}, // A lone comma is a decimal separator
+		{in: "1,234", separator: ".", want: 123400},
+		{in: "1.500", separator: ",", want: 150000},
+		{in: "", wantErr: true},
+		{in: "EUR", wantErr: true},
+		{in: "12..5", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in+"/"+tt.separator, func(t *testing.T) {
+			got, err := parseAmount(tt.in, tt.separator)
+			if tt.wantErr {
+				assertError(t, nil, err, "montant invalide")
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseAmount(%q): %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("parseAmount(%q) = %d, attendu %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectFormat(t *testing.T) {
+	for name, want := range map[string]Format{
+		"montant_signe.csv":       FormatCSV,
+		"debit_credit_latin1.csv": FormatCSV,
+		"releve.ofx":              FormatOFX,
+		"releve.camt053.xml":      FormatCAMT053,
+	} {
+		if got := DetectFormat(readFixture(t, name)); got != want {
+			t.Fatalf("%s: format %q, attendu %q", name, got, want)
+		}
+	}
+}
+
+// TestParseTruncatedFixtures feeds every prefix of the fixtures to the
+// parsers, which may reject them but must never panic
+func TestParseTruncatedFixtures(t *testing.T) {
+	for _, name := range []string{"montant_signe.csv", "debit_credit_latin1.csv", "releve.ofx", "releve.camt053.xml"} {
+		data := readFixture(t, name)
+		format := DetectFormat(data)
+		for n := 0; n < len(data); n++ {
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						t.Fatalf("%s tronque a %d octets: panique %v", name, n, r)
+					}
+				}()
+				_, _ = Parse(data[:n], format, nil)
+			}()
+		}
+	}
+}
+
+func TestParseUnsupportedFormat(t *testing.T) {
+	transactions, err := Parse([]byte("x"), Format("qif"), nil)
+	assertError(t, transactions, err, "format de releve non supporte")
+}
@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+
+	// Décodeurs supportés pour les miniatures
+	_ "image/gif"
+	_ "image/png"
+)
+
+// maxImagePixels limite la taille des images décodées (protection contre les "decompression bombs")
+const maxImagePixels = 40_000_000
+
+// GenerateThumbnail réduit une image (JPEG, PNG ou GIF) pour que son plus grand côté
+// ne dépasse pas maxDimension, et la renvoie encodée en JPEG.
+// Les zones transparentes sont rendues sur fond blanc.
+func GenerateThumbnail(data []byte, maxDimension int) ([]byte, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("image illisible: %w", err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxImagePixels {
+		return nil, fmt.Errorf("dimensions d'image non supportees (%dx%d)", cfg.Width, cfg.Height)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("image illisible: %w", err)
+	}
+
+	width, height := thumbnailSize(cfg.Width, cfg.Height, maxDimension)
+	thumb := resizeArea(src, width, height)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, thumb, &jpeg.Options{Quality: 80}); err != nil {
+		return nil, fmt.Errorf("erreur lors de l'encodage de la miniature: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// thumbnailSize calcule les dimensions de la miniature en conservant le ratio
+func thumbnailSize(width, height, maxDimension int) (int, int) {
+	if width <= maxDimension && height <= maxDimension {
+		return width, height
+	}
+	if width >= height {
+		return maxDimension, max(1, height*maxDimension/width)
+	}
+	return max(1, width*maxDimension/height), maxDimension
+}
+
+// resizeArea redimensionne par moyenne des pixels sources couverts par chaque
+// pixel de destination, ce qui donne un résultat propre en réduction
+func resizeArea(src image.Image, width, height int) *image.RGBA {
+	bounds := src.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*srcH/height
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*srcH/height)
+
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*srcW/width
+			x1 := max(x0+1, bounds.Min.X+(x+1)*srcW/width)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r += uint64(cr)
+					g += uint64(cg)
+					b += uint64(cb)
+					a += uint64(ca)
+					n++
+				}
+			}
+
+			// Couleurs prémultipliées : on complète avec du blanc selon la transparence
+			white := (n*0xffff - a)
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8((r + white) / n >> 8),
+				G: uint8((g + white) / n >> 8),
+				B: uint8((b + white) / n >> 8),
+				A: 0xff,
+			})
+		}
+	}
+
+	return dst
+}
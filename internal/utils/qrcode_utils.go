@@ -0,0 +1,17 @@
+package utils
+
+import (
+	"fmt"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// GenerateQRCode encode content dans un QR code PNG de size pixels de côté.
+// Le niveau de correction M est celui exigé par les QR codes de virement EPC.
+func GenerateQRCode(content string, size int) ([]byte, error) {
+	png, err := qrcode.Encode(content, qrcode.Medium, size)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lors de la generation du QR code: %w", err)
+	}
+	return png, nil
+}
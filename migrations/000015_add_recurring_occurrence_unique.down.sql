@@ -0,0 +1,2 @@
+-- Drop recurring occurrence unique index
+DROP INDEX IF EXISTS idx_expenses_recurring_occurrence;
@@ -0,0 +1,4 @@
+-- Prevent generating the same recurring occurrence twice
+CREATE UNIQUE INDEX idx_expenses_recurring_occurrence
+ON expenses(recurring_id, expense_date)
+WHERE recurring_id IS NOT NULL;
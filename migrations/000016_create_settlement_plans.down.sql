@@ -0,0 +1,4 @@
+-- Drop settlement plans
+DROP INDEX IF EXISTS idx_payments_settlement_plan;
+ALTER TABLE payments DROP COLUMN IF EXISTS settlement_plan_id;
+DROP TABLE IF EXISTS settlement_plans;
@@ -0,0 +1,20 @@
+-- Create settlement plans table (snapshot of simplified debts turned into payments)
+CREATE TABLE settlement_plans (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    colocation_id UUID NOT NULL REFERENCES colocations(id) ON DELETE CASCADE,
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'completed', 'invalidated')),
+    balance_fingerprint VARCHAR(64) NOT NULL,  -- Hash of the net balances the plan was computed from
+    total_amount DECIMAL(10, 2) NOT NULL CHECK (total_amount > 0),
+    completed_at TIMESTAMPTZ,
+    invalidated_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Link payments to the plan that generated them
+ALTER TABLE payments ADD COLUMN settlement_plan_id UUID REFERENCES settlement_plans(id) ON DELETE SET NULL;
+
+-- Indexes
+CREATE INDEX idx_settlement_plans_colocation ON settlement_plans(colocation_id);
+CREATE UNIQUE INDEX idx_settlement_plans_active ON settlement_plans(colocation_id) WHERE status = 'active';
+CREATE INDEX idx_payments_settlement_plan ON payments(settlement_plan_id) WHERE settlement_plan_id IS NOT NULL;
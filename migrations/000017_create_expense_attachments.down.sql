@@ -0,0 +1,2 @@
+-- Drop expense attachments table
+DROP TABLE IF EXISTS expense_attachments;
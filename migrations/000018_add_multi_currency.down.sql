@@ -0,0 +1,14 @@
+-- Drop multi-currency support
+DROP TABLE IF EXISTS exchange_rates;
+
+ALTER TABLE payments DROP COLUMN IF EXISTS base_amount;
+ALTER TABLE payments DROP COLUMN IF EXISTS exchange_rate;
+ALTER TABLE payments DROP COLUMN IF EXISTS currency;
+
+ALTER TABLE expense_splits DROP COLUMN IF EXISTS base_amount;
+
+ALTER TABLE expenses DROP COLUMN IF EXISTS base_amount;
+ALTER TABLE expenses DROP COLUMN IF EXISTS exchange_rate;
+ALTER TABLE expenses DROP COLUMN IF EXISTS currency;
+
+ALTER TABLE colocations DROP COLUMN IF EXISTS base_currency;
@@ -0,0 +1,37 @@
+-- Base currency of each colocation: balances, funds and events are expressed in it
+ALTER TABLE colocations ADD COLUMN base_currency CHAR(3) NOT NULL DEFAULT 'EUR';
+
+-- Expenses keep their original currency and the rate frozen when they were recorded
+ALTER TABLE expenses ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'EUR';
+ALTER TABLE expenses ADD COLUMN exchange_rate NUMERIC(18, 8) NOT NULL DEFAULT 1 CHECK (exchange_rate > 0);
+ALTER TABLE expenses ADD COLUMN base_amount DECIMAL(10, 2);
+UPDATE expenses SET base_amount = amount;
+ALTER TABLE expenses ALTER COLUMN base_amount SET NOT NULL;
+
+ALTER TABLE expense_splits ADD COLUMN base_amount DECIMAL(10, 2);
+UPDATE expense_splits SET base_amount = amount;
+ALTER TABLE expense_splits ALTER COLUMN base_amount SET NOT NULL;
+
+ALTER TABLE payments ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'EUR';
+ALTER TABLE payments ADD COLUMN exchange_rate NUMERIC(18, 8) NOT NULL DEFAULT 1 CHECK (exchange_rate > 0);
+ALTER TABLE payments ADD COLUMN base_amount DECIMAL(10, 2);
+UPDATE payments SET base_amount = amount;
+ALTER TABLE payments ALTER COLUMN base_amount SET NOT NULL;
+
+-- Exchange rates per colocation: 1 unit of currency = rate units of base_currency
+CREATE TABLE exchange_rates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    colocation_id UUID NOT NULL REFERENCES colocations(id) ON DELETE CASCADE,
+    base_currency CHAR(3) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    rate NUMERIC(18, 8) NOT NULL CHECK (rate > 0),
+    rate_date DATE NOT NULL,
+    source VARCHAR(20) NOT NULL CHECK (source IN ('import', 'manual')),
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (colocation_id, base_currency, currency, rate_date),
+    CHECK (currency <> base_currency)
+);
+
+-- Indexes
+CREATE INDEX idx_exchange_rates_lookup ON exchange_rates(colocation_id, base_currency, currency, rate_date DESC);
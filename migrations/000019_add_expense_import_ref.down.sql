@@ -0,0 +1,3 @@
+-- Drop bank statement import references
+DROP INDEX IF EXISTS idx_expenses_import_ref;
+ALTER TABLE expenses DROP COLUMN IF EXISTS import_ref;
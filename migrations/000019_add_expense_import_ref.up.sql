@@ -0,0 +1,5 @@
+-- Reference of the bank statement line an expense was imported from
+ALTER TABLE expenses ADD COLUMN import_ref VARCHAR(255);
+
+-- A statement line can only be imported once per colocation
+CREATE UNIQUE INDEX idx_expenses_import_ref ON expenses(colocation_id, import_ref) WHERE import_ref IS NOT NULL;
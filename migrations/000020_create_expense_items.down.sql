@@ -0,0 +1,7 @@
+-- Drop itemized expenses
+DROP TABLE IF EXISTS expense_item_participants;
+DROP TABLE IF EXISTS expense_items;
+
+UPDATE expenses SET split_type = 'custom' WHERE split_type = 'itemized';
+ALTER TABLE expenses DROP CONSTRAINT expenses_split_type_check;
+ALTER TABLE expenses ADD CONSTRAINT expenses_split_type_check CHECK (split_type IN ('equal', 'percentage', 'custom'));
@@ -0,0 +1,26 @@
+-- Allow itemized expenses
+ALTER TABLE expenses DROP CONSTRAINT expenses_split_type_check;
+ALTER TABLE expenses ADD CONSTRAINT expenses_split_type_check CHECK (split_type IN ('equal', 'percentage', 'custom', 'itemized'));
+
+-- Line items of itemized expenses. Shared charges (tax, tip) have no
+-- participants and are distributed proportionally to the other items.
+CREATE TABLE expense_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    expense_id UUID NOT NULL REFERENCES expenses(id) ON DELETE CASCADE,
+    label VARCHAR(255) NOT NULL,
+    amount DECIMAL(10, 2) NOT NULL CHECK (amount > 0),
+    is_shared_charge BOOLEAN NOT NULL DEFAULT false,
+    position INT NOT NULL,
+    UNIQUE(expense_id, position)
+);
+
+-- Participants of a line item with their share weight
+CREATE TABLE expense_item_participants (
+    item_id UUID NOT NULL REFERENCES expense_items(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    weight DECIMAL(10, 4) NOT NULL DEFAULT 1 CHECK (weight > 0),
+    PRIMARY KEY (item_id, user_id)
+);
+
+-- Indexes
+CREATE INDEX idx_expense_items_expense ON expense_items(expense_id);
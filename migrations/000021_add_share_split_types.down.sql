@@ -0,0 +1,11 @@
+-- Drop the shares and equal among selected split types
+ALTER TABLE recurring_expense_splits DROP COLUMN IF EXISTS shares;
+ALTER TABLE expense_splits DROP COLUMN IF EXISTS shares;
+
+UPDATE recurring_expenses SET split_type = 'percentage' WHERE split_type IN ('equal_selected', 'shares');
+ALTER TABLE recurring_expenses DROP CONSTRAINT recurring_expenses_split_type_check;
+ALTER TABLE recurring_expenses ADD CONSTRAINT recurring_expenses_split_type_check CHECK (split_type IN ('equal', 'percentage', 'custom'));
+
+UPDATE expenses SET split_type = 'custom' WHERE split_type IN ('equal_selected', 'shares');
+ALTER TABLE expenses DROP CONSTRAINT expenses_split_type_check;
+ALTER TABLE expenses ADD CONSTRAINT expenses_split_type_check CHECK (split_type IN ('equal', 'percentage', 'custom', 'itemized'));
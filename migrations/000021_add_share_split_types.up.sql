@@ -0,0 +1,10 @@
+-- Split among selected members, equally or by integer shares
+ALTER TABLE expenses DROP CONSTRAINT expenses_split_type_check;
+ALTER TABLE expenses ADD CONSTRAINT expenses_split_type_check CHECK (split_type IN ('equal', 'percentage', 'custom', 'itemized', 'equal_selected', 'shares'));
+
+ALTER TABLE recurring_expenses DROP CONSTRAINT recurring_expenses_split_type_check;
+ALTER TABLE recurring_expenses ADD CONSTRAINT recurring_expenses_split_type_check CHECK (split_type IN ('equal', 'percentage', 'custom', 'equal_selected', 'shares'));
+
+-- Number of shares of each member for the shares split type
+ALTER TABLE expense_splits ADD COLUMN shares INT CHECK (shares > 0);
+ALTER TABLE recurring_expense_splits ADD COLUMN shares INT CHECK (shares > 0);
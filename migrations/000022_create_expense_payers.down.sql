@@ -0,0 +1,4 @@
+-- Drop multiple payers, expenses keep their main payer
+DROP TABLE IF EXISTS expense_payers;
+
+ALTER TABLE expenses DROP COLUMN IF EXISTS created_by;
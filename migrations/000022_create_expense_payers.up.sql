@@ -0,0 +1,19 @@
+-- Member who recorded the expense, paid_by can be another member
+ALTER TABLE expenses ADD COLUMN created_by UUID REFERENCES users(id) ON DELETE SET NULL;
+UPDATE expenses SET created_by = paid_by;
+
+-- Contributions of the payers of each expense, they sum to the expense amount.
+-- expenses.paid_by is the main payer.
+CREATE TABLE expense_payers (
+    expense_id UUID NOT NULL REFERENCES expenses(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    amount DECIMAL(10, 2) NOT NULL CHECK (amount > 0),
+    base_amount DECIMAL(10, 2) NOT NULL CHECK (base_amount >= 0),
+    PRIMARY KEY (expense_id, user_id)
+);
+
+INSERT INTO expense_payers (expense_id, user_id, amount, base_amount)
+SELECT id, paid_by, amount, base_amount FROM expenses;
+
+-- Indexes
+CREATE INDEX idx_expense_payers_user ON expense_payers(user_id);
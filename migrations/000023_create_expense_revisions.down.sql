@@ -0,0 +1,4 @@
+-- Drop expense history
+DROP TRIGGER IF EXISTS expense_revisions_append_only ON expense_revisions;
+DROP FUNCTION IF EXISTS prevent_expense_revision_update();
+DROP TABLE IF EXISTS expense_revisions;
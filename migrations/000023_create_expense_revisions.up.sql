@@ -0,0 +1,28 @@
+-- Append-only history of the expenses, written in the same transaction as
+-- each change. expense_id has no foreign key so that the history of deleted
+-- expenses is kept.
+CREATE TABLE expense_revisions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    expense_id UUID NOT NULL,
+    colocation_id UUID NOT NULL REFERENCES colocations(id) ON DELETE CASCADE,
+    revision INT NOT NULL CHECK (revision > 0),
+    action VARCHAR(20) NOT NULL CHECK (action IN ('create', 'update', 'delete', 'restore')),
+    changed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    snapshot JSONB NOT NULL,  -- State of the expense after the change (before it for a delete)
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE(expense_id, revision)
+);
+
+-- Revisions are never modified, they are only removed with their colocation
+CREATE FUNCTION prevent_expense_revision_update() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'expense_revisions is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER expense_revisions_append_only
+BEFORE UPDATE ON expense_revisions
+FOR EACH ROW EXECUTE FUNCTION prevent_expense_revision_update();
+
+-- Indexes
+CREATE INDEX idx_expense_revisions_colocation ON expense_revisions(colocation_id, created_at DESC);
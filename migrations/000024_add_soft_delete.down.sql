@@ -0,0 +1,12 @@
+-- Drop soft delete, deleted rows are removed for good
+DELETE FROM expenses WHERE deleted_at IS NOT NULL;
+DELETE FROM payments WHERE deleted_at IS NOT NULL;
+DELETE FROM fund_contributions WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_expenses_deleted_at;
+DROP INDEX IF EXISTS idx_payments_deleted_at;
+DROP INDEX IF EXISTS idx_fund_contributions_deleted_at;
+
+ALTER TABLE expenses DROP COLUMN IF EXISTS deleted_at, DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE payments DROP COLUMN IF EXISTS deleted_at, DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE fund_contributions DROP COLUMN IF EXISTS deleted_at, DROP COLUMN IF EXISTS deleted_by;
@@ -0,0 +1,16 @@
+-- Deleted expenses, payments and fund contributions are kept until the purge
+-- job removes them, so that they can be restored. Rows with a deleted_at are
+-- ignored by balances and listings.
+ALTER TABLE expenses ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE expenses ADD COLUMN deleted_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+ALTER TABLE payments ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE payments ADD COLUMN deleted_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+ALTER TABLE fund_contributions ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE fund_contributions ADD COLUMN deleted_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+-- Indexes used by the purge job and the lists of deleted items
+CREATE INDEX idx_expenses_deleted_at ON expenses(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_payments_deleted_at ON payments(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_fund_contributions_deleted_at ON fund_contributions(deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,5 @@
+-- Drop full-text search columns, their indexes go with them
+ALTER TABLE expenses DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE decisions DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE common_funds DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE events DROP COLUMN IF EXISTS search_vector;
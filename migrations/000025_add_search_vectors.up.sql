@@ -0,0 +1,37 @@
+-- Full-text search over expenses, decisions, funds and events. Each text is
+-- indexed with both the French and the English configurations, titles
+-- weighted above descriptions.
+ALTER TABLE expenses ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('french', title), 'A') ||
+    setweight(to_tsvector('english', title), 'A') ||
+    setweight(to_tsvector('french', COALESCE(description, '')), 'B') ||
+    setweight(to_tsvector('english', COALESCE(description, '')), 'B')
+) STORED;
+
+ALTER TABLE decisions ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('french', title), 'A') ||
+    setweight(to_tsvector('english', title), 'A') ||
+    setweight(jsonb_to_tsvector('french', options, '["string"]'), 'B') ||
+    setweight(jsonb_to_tsvector('english', options, '["string"]'), 'B') ||
+    setweight(to_tsvector('french', COALESCE(description, '')), 'C') ||
+    setweight(to_tsvector('english', COALESCE(description, '')), 'C')
+) STORED;
+
+ALTER TABLE common_funds ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('french', name), 'A') ||
+    setweight(to_tsvector('english', name), 'A') ||
+    setweight(to_tsvector('french', COALESCE(description, '')), 'B') ||
+    setweight(to_tsvector('english', COALESCE(description, '')), 'B')
+) STORED;
+
+ALTER TABLE events ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('french', title), 'A') ||
+    setweight(to_tsvector('english', title), 'A') ||
+    setweight(to_tsvector('french', COALESCE(description, '')), 'B') ||
+    setweight(to_tsvector('english', COALESCE(description, '')), 'B')
+) STORED;
+
+CREATE INDEX idx_expenses_search ON expenses USING GIN (search_vector);
+CREATE INDEX idx_decisions_search ON decisions USING GIN (search_vector);
+CREATE INDEX idx_common_funds_search ON common_funds USING GIN (search_vector);
+CREATE INDEX idx_events_search ON events USING GIN (search_vector);
@@ -0,0 +1,3 @@
+-- Drop expense tags
+DROP TABLE IF EXISTS expense_tags;
+DROP TABLE IF EXISTS tags;
@@ -0,0 +1,20 @@
+-- Free-form tags, an expense can have several of them so that spending can be
+-- grouped across categories (e.g. "Weekend a Lyon")
+CREATE TABLE tags (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    colocation_id UUID NOT NULL REFERENCES colocations(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    color VARCHAR(7),  -- Hex color code
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE expense_tags (
+    expense_id UUID NOT NULL REFERENCES expenses(id) ON DELETE CASCADE,
+    tag_id UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (expense_id, tag_id)
+);
+
+-- Tag names are unique per colocation, case-insensitively
+CREATE UNIQUE INDEX idx_tags_colocation_name ON tags(colocation_id, LOWER(name));
+CREATE INDEX idx_expense_tags_tag ON expense_tags(tag_id);
@@ -0,0 +1,3 @@
+-- Drop budgets
+DROP TABLE IF EXISTS budget_alerts;
+DROP TABLE IF EXISTS budgets;
@@ -0,0 +1,25 @@
+-- Spending limits per category, or for the whole colocation when category_id
+-- is NULL, over a weekly or monthly period
+CREATE TABLE budgets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    colocation_id UUID NOT NULL REFERENCES colocations(id) ON DELETE CASCADE,
+    category_id UUID REFERENCES expense_categories(id) ON DELETE CASCADE,
+    amount DECIMAL(10, 2) NOT NULL CHECK (amount > 0),  -- In the base currency
+    period VARCHAR(10) NOT NULL CHECK (period IN ('weekly', 'monthly')),
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Alerts already sent, so that each threshold fires once per period
+CREATE TABLE budget_alerts (
+    budget_id UUID NOT NULL REFERENCES budgets(id) ON DELETE CASCADE,
+    period_start DATE NOT NULL,
+    threshold INT NOT NULL,  -- Percentage of the limit
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (budget_id, period_start, threshold)
+);
+
+-- One budget per category and one overall budget per colocation
+CREATE UNIQUE INDEX idx_budgets_colocation_category ON budgets(colocation_id, category_id) WHERE category_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_budgets_colocation_overall ON budgets(colocation_id) WHERE category_id IS NULL;
@@ -0,0 +1,3 @@
+-- Drop recurring occurrence overrides
+DROP TABLE IF EXISTS recurring_occurrence_overrides;
+ALTER TABLE recurring_expenses DROP COLUMN IF EXISTS paused_until;
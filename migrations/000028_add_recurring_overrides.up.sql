@@ -0,0 +1,14 @@
+-- Pause a recurring expense: occurrences before this date are not generated
+ALTER TABLE recurring_expenses ADD COLUMN paused_until DATE;
+
+-- Changes to a single occurrence of a recurring expense
+CREATE TABLE recurring_occurrence_overrides (
+    recurring_id UUID NOT NULL REFERENCES recurring_expenses(id) ON DELETE CASCADE,
+    occurrence_date DATE NOT NULL,
+    skip BOOLEAN NOT NULL DEFAULT false,
+    amount DECIMAL(10, 2) CHECK (amount > 0),          -- Replaces the amount of the template
+    paid_by UUID REFERENCES users(id) ON DELETE CASCADE, -- Replaces the payer of the template
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (recurring_id, occurrence_date)
+);
@@ -0,0 +1,4 @@
+-- Drop payment allocations
+ALTER TABLE payments DROP COLUMN IF EXISTS allocate_to;
+DROP TABLE IF EXISTS payment_allocations;
+UPDATE expense_splits SET is_settled = false;
@@ -0,0 +1,21 @@
+-- Part of a confirmed payment applied to the share of its sender in an expense
+-- paid by its recipient. Allocations are kept per expense rather than per split
+-- so that they survive the splits being replaced when the expense is edited.
+CREATE TABLE payment_allocations (
+    payment_id UUID NOT NULL REFERENCES payments(id) ON DELETE CASCADE,
+    expense_id UUID NOT NULL REFERENCES expenses(id) ON DELETE CASCADE,
+    amount DECIMAL(10, 2) NOT NULL CHECK (amount > 0),  -- In the colocation base currency
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (payment_id, expense_id)
+);
+
+CREATE INDEX idx_payment_allocations_expense ON payment_allocations(expense_id);
+
+-- Expenses the sender wants the payment applied to first, in order
+ALTER TABLE payments ADD COLUMN allocate_to UUID[] NOT NULL DEFAULT '{}';
+
+-- Shares of the payers themselves owe nothing to anyone
+UPDATE expense_splits es SET is_settled = true
+FROM expenses e
+WHERE e.id = es.expense_id
+  AND NOT EXISTS (SELECT 1 FROM expense_payers ep WHERE ep.expense_id = e.id AND ep.user_id != es.user_id);
@@ -0,0 +1,4 @@
+-- Drop payment methods and bank details
+ALTER TABLE users DROP COLUMN IF EXISTS bic;
+ALTER TABLE users DROP COLUMN IF EXISTS iban;
+ALTER TABLE payments DROP COLUMN IF EXISTS method;
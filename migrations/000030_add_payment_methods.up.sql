@@ -0,0 +1,8 @@
+-- How a payment was made, payments recorded before are of an unknown method
+ALTER TABLE payments ADD COLUMN method VARCHAR(20) NOT NULL DEFAULT 'other'
+    CHECK (method IN ('bank_transfer', 'cash', 'paypal', 'lydia', 'wero', 'other'));
+
+-- Bank details used to generate the SEPA transfer QR codes of the payments
+-- made to the user
+ALTER TABLE users ADD COLUMN iban VARCHAR(34);
+ALTER TABLE users ADD COLUMN bic VARCHAR(11);
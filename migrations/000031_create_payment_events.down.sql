@@ -0,0 +1,8 @@
+-- Drop payment events, disputes go back to pending and void payments to rejected
+DROP TABLE IF EXISTS payment_events;
+
+ALTER TABLE payments DROP CONSTRAINT payments_status_check;
+UPDATE payments SET status = 'pending' WHERE status IN ('disputed', 'escalated');
+UPDATE payments SET status = 'rejected' WHERE status = 'void';
+ALTER TABLE payments ADD CONSTRAINT payments_status_check
+    CHECK (status IN ('pending', 'confirmed', 'rejected'));
@@ -0,0 +1,36 @@
+-- Disputes replace the bare rejection: the recipient rejects a payment with a
+-- reason, the sender can respond, either of them can escalate to the admins
+-- who confirm or void it. Payments rejected before are void.
+ALTER TABLE payments DROP CONSTRAINT payments_status_check;
+UPDATE payments SET status = 'void' WHERE status = 'rejected';
+ALTER TABLE payments ADD CONSTRAINT payments_status_check
+    CHECK (status IN ('pending', 'confirmed', 'disputed', 'escalated', 'void'));
+
+-- Every transition of a payment, with the comment and proof of its author
+CREATE TABLE payment_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    payment_id UUID NOT NULL REFERENCES payments(id) ON DELETE CASCADE,
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('created', 'confirmed', 'disputed', 'responded', 'escalated', 'voided', 'cancelled', 'restored')),
+    comment TEXT,
+    proof_file_name VARCHAR(255),
+    proof_content_type VARCHAR(100),
+    proof_size_bytes BIGINT,
+    proof_storage_key VARCHAR(500),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_payment_events_payment ON payment_events(payment_id, created_at);
+
+-- History of the payments recorded before
+INSERT INTO payment_events (payment_id, actor_id, type, created_at)
+SELECT id, from_user_id, 'created', created_at FROM payments;
+
+INSERT INTO payment_events (payment_id, actor_id, type, created_at)
+SELECT id, to_user_id, 'confirmed', confirmed_at FROM payments WHERE status = 'confirmed' AND confirmed_at IS NOT NULL;
+
+INSERT INTO payment_events (payment_id, actor_id, type, created_at)
+SELECT id, to_user_id, 'voided', created_at FROM payments WHERE status = 'void';
+
+INSERT INTO payment_events (payment_id, actor_id, type, created_at)
+SELECT id, deleted_by, 'cancelled', deleted_at FROM payments WHERE deleted_at IS NOT NULL;
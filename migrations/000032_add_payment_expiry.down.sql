@@ -0,0 +1,16 @@
+-- Expired payments go back to pending
+DROP INDEX IF EXISTS idx_payments_pending;
+
+DELETE FROM payment_events WHERE type = 'expired';
+ALTER TABLE payment_events DROP CONSTRAINT payment_events_type_check;
+ALTER TABLE payment_events ADD CONSTRAINT payment_events_type_check
+    CHECK (type IN ('created', 'confirmed', 'disputed', 'responded', 'escalated', 'voided', 'cancelled', 'restored'));
+
+ALTER TABLE payments DROP CONSTRAINT payments_status_check;
+UPDATE payments SET status = 'pending' WHERE status = 'expired';
+ALTER TABLE payments ADD CONSTRAINT payments_status_check
+    CHECK (status IN ('pending', 'confirmed', 'disputed', 'escalated', 'void'));
+
+ALTER TABLE payments DROP COLUMN IF EXISTS reminded_at;
+ALTER TABLE colocations DROP COLUMN IF EXISTS payment_expiry_days;
+ALTER TABLE colocations DROP COLUMN IF EXISTS payment_reminder_days;
@@ -0,0 +1,16 @@
+-- Pending payments are expired after a number of days set per colocation, the
+-- recipient being reminded before. NULL disables the reminder or the expiry.
+ALTER TABLE colocations ADD COLUMN payment_reminder_days INTEGER DEFAULT 7 CHECK (payment_reminder_days > 0);
+ALTER TABLE colocations ADD COLUMN payment_expiry_days INTEGER DEFAULT 30 CHECK (payment_expiry_days > 0);
+
+ALTER TABLE payments ADD COLUMN reminded_at TIMESTAMPTZ;
+
+ALTER TABLE payments DROP CONSTRAINT payments_status_check;
+ALTER TABLE payments ADD CONSTRAINT payments_status_check
+    CHECK (status IN ('pending', 'confirmed', 'disputed', 'escalated', 'void', 'expired'));
+
+ALTER TABLE payment_events DROP CONSTRAINT payment_events_type_check;
+ALTER TABLE payment_events ADD CONSTRAINT payment_events_type_check
+    CHECK (type IN ('created', 'confirmed', 'disputed', 'responded', 'escalated', 'voided', 'cancelled', 'restored', 'expired'));
+
+CREATE INDEX idx_payments_pending ON payments(colocation_id, created_at) WHERE status = 'pending' AND deleted_at IS NULL;
@@ -0,0 +1,8 @@
+-- Drop fund withdrawals, the amounts spent go back into the funds
+ALTER TABLE common_funds DROP CONSTRAINT IF EXISTS common_funds_current_amount_check;
+
+UPDATE common_funds f SET current_amount = current_amount + w.total
+FROM (SELECT fund_id, SUM(amount) AS total FROM fund_withdrawals WHERE deleted_at IS NULL GROUP BY fund_id) w
+WHERE f.id = w.fund_id;
+
+DROP TABLE IF EXISTS fund_withdrawals;
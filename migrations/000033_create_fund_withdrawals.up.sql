@@ -0,0 +1,21 @@
+-- Money spent from a common fund, optionally for an expense paid by the fund.
+-- Withdrawals are soft-deleted like contributions, an expense is paid by at
+-- most one active withdrawal.
+CREATE TABLE fund_withdrawals (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    fund_id UUID NOT NULL REFERENCES common_funds(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    amount DECIMAL(10, 2) NOT NULL CHECK (amount > 0),
+    note TEXT,
+    expense_id UUID REFERENCES expenses(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ,
+    deleted_by UUID REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_fund_withdrawals_fund ON fund_withdrawals(fund_id, created_at);
+CREATE UNIQUE INDEX idx_fund_withdrawals_expense ON fund_withdrawals(expense_id) WHERE deleted_at IS NULL;
+CREATE INDEX idx_fund_withdrawals_deleted_at ON fund_withdrawals(deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- A fund can never be overdrawn
+ALTER TABLE common_funds ADD CONSTRAINT common_funds_current_amount_check CHECK (current_amount >= 0);
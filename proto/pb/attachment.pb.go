@@ -0,0 +1,768 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: attachment.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UploadAttachmentRequest_Metadata
+	//	*UploadAttachmentRequest_Chunk
+	Data          isUploadAttachmentRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_attachment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_attachment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UploadAttachmentRequest) GetData() isUploadAttachmentRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetMetadata() *AttachmentMetadata {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAttachmentRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAttachmentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAttachmentRequest_Data interface {
+	isUploadAttachmentRequest_Data()
+}
+
+type UploadAttachmentRequest_Metadata struct {
+	Metadata *AttachmentMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Metadata) isUploadAttachmentRequest_Data() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Data() {}
+
+type AttachmentMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	ExpenseId     string                 `protobuf:"bytes,2,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentMetadata) Reset() {
+	*x = AttachmentMetadata{}
+	mi := &file_attachment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentMetadata) ProtoMessage() {}
+
+func (x *AttachmentMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentMetadata.ProtoReflect.Descriptor instead.
+func (*AttachmentMetadata) Descriptor() ([]byte, []int) {
+	return file_attachment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttachmentMetadata) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *AttachmentMetadata) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+func (x *AttachmentMetadata) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type ListAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	ExpenseId     string                 `protobuf:"bytes,2,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsRequest) Reset() {
+	*x = ListAttachmentsRequest{}
+	mi := &file_attachment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_attachment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListAttachmentsRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *ListAttachmentsRequest) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+type ListAttachmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*Attachment          `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsResponse) Reset() {
+	*x = ListAttachmentsResponse{}
+	mi := &file_attachment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_attachment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListAttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type DownloadAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	ExpenseId     string                 `protobuf:"bytes,2,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Thumbnail     bool                   `protobuf:"varint,4,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"` // Download the JPEG thumbnail instead of the file (images only)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
+	mi := &file_attachment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentRequest) ProtoMessage() {}
+
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_attachment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DownloadAttachmentRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *DownloadAttachmentRequest) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+func (x *DownloadAttachmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DownloadAttachmentRequest) GetThumbnail() bool {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return false
+}
+
+type AttachmentChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*AttachmentChunk_Attachment
+	//	*AttachmentChunk_Chunk
+	Data          isAttachmentChunk_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentChunk) Reset() {
+	*x = AttachmentChunk{}
+	mi := &file_attachment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentChunk) ProtoMessage() {}
+
+func (x *AttachmentChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentChunk.ProtoReflect.Descriptor instead.
+func (*AttachmentChunk) Descriptor() ([]byte, []int) {
+	return file_attachment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AttachmentChunk) GetData() isAttachmentChunk_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AttachmentChunk) GetAttachment() *Attachment {
+	if x != nil {
+		if x, ok := x.Data.(*AttachmentChunk_Attachment); ok {
+			return x.Attachment
+		}
+	}
+	return nil
+}
+
+func (x *AttachmentChunk) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*AttachmentChunk_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isAttachmentChunk_Data interface {
+	isAttachmentChunk_Data()
+}
+
+type AttachmentChunk_Attachment struct {
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3,oneof"`
+}
+
+type AttachmentChunk_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*AttachmentChunk_Attachment) isAttachmentChunk_Data() {}
+
+func (*AttachmentChunk_Chunk) isAttachmentChunk_Data() {}
+
+type DeleteAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	ExpenseId     string                 `protobuf:"bytes,2,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentRequest) Reset() {
+	*x = DeleteAttachmentRequest{}
+	mi := &file_attachment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentRequest) ProtoMessage() {}
+
+func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_attachment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteAttachmentRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *DeleteAttachmentRequest) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+func (x *DeleteAttachmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentResponse) Reset() {
+	*x = DeleteAttachmentResponse{}
+	mi := &file_attachment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentResponse) ProtoMessage() {}
+
+func (x *DeleteAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_attachment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteAttachmentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type Attachment struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExpenseId        string                 `protobuf:"bytes,2,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	UploadedBy       string                 `protobuf:"bytes,3,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"`
+	UploadedByNom    string                 `protobuf:"bytes,4,opt,name=uploaded_by_nom,json=uploadedByNom,proto3" json:"uploaded_by_nom,omitempty"`
+	UploadedByPrenom string                 `protobuf:"bytes,5,opt,name=uploaded_by_prenom,json=uploadedByPrenom,proto3" json:"uploaded_by_prenom,omitempty"`
+	FileName         string                 `protobuf:"bytes,6,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType      string                 `protobuf:"bytes,7,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes        int64                  `protobuf:"varint,8,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	HasThumbnail     bool                   `protobuf:"varint,9,opt,name=has_thumbnail,json=hasThumbnail,proto3" json:"has_thumbnail,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_attachment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_attachment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Attachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attachment) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+func (x *Attachment) GetUploadedBy() string {
+	if x != nil {
+		return x.UploadedBy
+	}
+	return ""
+}
+
+func (x *Attachment) GetUploadedByNom() string {
+	if x != nil {
+		return x.UploadedByNom
+	}
+	return ""
+}
+
+func (x *Attachment) GetUploadedByPrenom() string {
+	if x != nil {
+		return x.UploadedByPrenom
+	}
+	return ""
+}
+
+func (x *Attachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Attachment) GetHasThumbnail() bool {
+	if x != nil {
+		return x.HasThumbnail
+	}
+	return false
+}
+
+func (x *Attachment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+var File_attachment_proto protoreflect.FileDescriptor
+
+const file_attachment_proto_rawDesc = "" +
+	"\n" +
+	"\x10attachment.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\"r\n" +
+	"\x17UploadAttachmentRequest\x127\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x19.coloc.AttachmentMetadataH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"u\n" +
+	"\x12AttachmentMetadata\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x02 \x01(\tR\texpenseId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\"\\\n" +
+	"\x16ListAttachmentsRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x02 \x01(\tR\texpenseId\"N\n" +
+	"\x17ListAttachmentsResponse\x123\n" +
+	"\vattachments\x18\x01 \x03(\v2\x11.coloc.AttachmentR\vattachments\"\x8d\x01\n" +
+	"\x19DownloadAttachmentRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x02 \x01(\tR\texpenseId\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\x12\x1c\n" +
+	"\tthumbnail\x18\x04 \x01(\bR\tthumbnail\"f\n" +
+	"\x0fAttachmentChunk\x123\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x11.coloc.AttachmentH\x00R\n" +
+	"attachment\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"m\n" +
+	"\x17DeleteAttachmentRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x02 \x01(\tR\texpenseId\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\"4\n" +
+	"\x18DeleteAttachmentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd5\x02\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x02 \x01(\tR\texpenseId\x12\x1f\n" +
+	"\vuploaded_by\x18\x03 \x01(\tR\n" +
+	"uploadedBy\x12&\n" +
+	"\x0fuploaded_by_nom\x18\x04 \x01(\tR\ruploadedByNom\x12,\n" +
+	"\x12uploaded_by_prenom\x18\x05 \x01(\tR\x10uploadedByPrenom\x12\x1b\n" +
+	"\tfile_name\x18\x06 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\a \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\b \x01(\x03R\tsizeBytes\x12#\n" +
+	"\rhas_thumbnail\x18\t \x01(\bR\fhasThumbnail\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt2\xf4\x03\n" +
+	"\x11AttachmentService\x12G\n" +
+	"\x10UploadAttachment\x12\x1e.coloc.UploadAttachmentRequest\x1a\x11.coloc.Attachment(\x01\x12\x9c\x01\n" +
+	"\x0fListAttachments\x12\x1d.coloc.ListAttachmentsRequest\x1a\x1e.coloc.ListAttachmentsResponse\"J\x82\xd3\xe4\x93\x02D\x12B/api/colocations/{colocation_id}/expenses/{expense_id}/attachments\x12P\n" +
+	"\x12DownloadAttachment\x12 .coloc.DownloadAttachmentRequest\x1a\x16.coloc.AttachmentChunk0\x01\x12\xa4\x01\n" +
+	"\x10DeleteAttachment\x12\x1e.coloc.DeleteAttachmentRequest\x1a\x1f.coloc.DeleteAttachmentResponse\"O\x82\xd3\xe4\x93\x02I*G/api/colocations/{colocation_id}/expenses/{expense_id}/attachments/{id}B,Z*github.com/vblanchet22/back_coloc/proto/pbb\x06proto3"
+
+var (
+	file_attachment_proto_rawDescOnce sync.Once
+	file_attachment_proto_rawDescData []byte
+)
+
+func file_attachment_proto_rawDescGZIP() []byte {
+	file_attachment_proto_rawDescOnce.Do(func() {
+		file_attachment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_attachment_proto_rawDesc), len(file_attachment_proto_rawDesc)))
+	})
+	return file_attachment_proto_rawDescData
+}
+
+var file_attachment_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_attachment_proto_goTypes = []any{
+	(*UploadAttachmentRequest)(nil),   // 0: coloc.UploadAttachmentRequest
+	(*AttachmentMetadata)(nil),        // 1: coloc.AttachmentMetadata
+	(*ListAttachmentsRequest)(nil),    // 2: coloc.ListAttachmentsRequest
+	(*ListAttachmentsResponse)(nil),   // 3: coloc.ListAttachmentsResponse
+	(*DownloadAttachmentRequest)(nil), // 4: coloc.DownloadAttachmentRequest
+	(*AttachmentChunk)(nil),           // 5: coloc.AttachmentChunk
+	(*DeleteAttachmentRequest)(nil),   // 6: coloc.DeleteAttachmentRequest
+	(*DeleteAttachmentResponse)(nil),  // 7: coloc.DeleteAttachmentResponse
+	(*Attachment)(nil),                // 8: coloc.Attachment
+}
+var file_attachment_proto_depIdxs = []int32{
+	1, // 0: coloc.UploadAttachmentRequest.metadata:type_name -> coloc.AttachmentMetadata
+	8, // 1: coloc.ListAttachmentsResponse.attachments:type_name -> coloc.Attachment
+	8, // 2: coloc.AttachmentChunk.attachment:type_name -> coloc.Attachment
+	0, // 3: coloc.AttachmentService.UploadAttachment:input_type -> coloc.UploadAttachmentRequest
+	2, // 4: coloc.AttachmentService.ListAttachments:input_type -> coloc.ListAttachmentsRequest
+	4, // 5: coloc.AttachmentService.DownloadAttachment:input_type -> coloc.DownloadAttachmentRequest
+	6, // 6: coloc.AttachmentService.DeleteAttachment:input_type -> coloc.DeleteAttachmentRequest
+	8, // 7: coloc.AttachmentService.UploadAttachment:output_type -> coloc.Attachment
+	3, // 8: coloc.AttachmentService.ListAttachments:output_type -> coloc.ListAttachmentsResponse
+	5, // 9: coloc.AttachmentService.DownloadAttachment:output_type -> coloc.AttachmentChunk
+	7, // 10: coloc.AttachmentService.DeleteAttachment:output_type -> coloc.DeleteAttachmentResponse
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_attachment_proto_init() }
+func file_attachment_proto_init() {
+	if File_attachment_proto != nil {
+		return
+	}
+	file_attachment_proto_msgTypes[0].OneofWrappers = []any{
+		(*UploadAttachmentRequest_Metadata)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+	file_attachment_proto_msgTypes[5].OneofWrappers = []any{
+		(*AttachmentChunk_Attachment)(nil),
+		(*AttachmentChunk_Chunk)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_attachment_proto_rawDesc), len(file_attachment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_attachment_proto_goTypes,
+		DependencyIndexes: file_attachment_proto_depIdxs,
+		MessageInfos:      file_attachment_proto_msgTypes,
+	}.Build()
+	File_attachment_proto = out.File
+	file_attachment_proto_goTypes = nil
+	file_attachment_proto_depIdxs = nil
+}
@@ -22,6 +22,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SimplificationStrategy int32
+
+const (
+	SimplificationStrategy_SIMPLIFICATION_STRATEGY_UNSPECIFIED SimplificationStrategy = 0
+	SimplificationStrategy_SIMPLIFICATION_STRATEGY_EXACT       SimplificationStrategy = 1 // Minimum number of transactions (small groups)
+	SimplificationStrategy_SIMPLIFICATION_STRATEGY_GREEDY      SimplificationStrategy = 2 // Largest creditor / largest debtor pairing
+)
+
+// Enum value maps for SimplificationStrategy.
+var (
+	SimplificationStrategy_name = map[int32]string{
+		0: "SIMPLIFICATION_STRATEGY_UNSPECIFIED",
+		1: "SIMPLIFICATION_STRATEGY_EXACT",
+		2: "SIMPLIFICATION_STRATEGY_GREEDY",
+	}
+	SimplificationStrategy_value = map[string]int32{
+		"SIMPLIFICATION_STRATEGY_UNSPECIFIED": 0,
+		"SIMPLIFICATION_STRATEGY_EXACT":       1,
+		"SIMPLIFICATION_STRATEGY_GREEDY":      2,
+	}
+)
+
+func (x SimplificationStrategy) Enum() *SimplificationStrategy {
+	p := new(SimplificationStrategy)
+	*p = x
+	return p
+}
+
+func (x SimplificationStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SimplificationStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_balance_proto_enumTypes[0].Descriptor()
+}
+
+func (SimplificationStrategy) Type() protoreflect.EnumType {
+	return &file_balance_proto_enumTypes[0]
+}
+
+func (x SimplificationStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SimplificationStrategy.Descriptor instead.
+func (SimplificationStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_balance_proto_rawDescGZIP(), []int{0}
+}
+
 type GetBalancesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
@@ -124,9 +173,9 @@ type UserBalance struct {
 	UserNom       string                 `protobuf:"bytes,2,opt,name=user_nom,json=userNom,proto3" json:"user_nom,omitempty"`
 	UserPrenom    string                 `protobuf:"bytes,3,opt,name=user_prenom,json=userPrenom,proto3" json:"user_prenom,omitempty"`
 	AvatarUrl     *string                `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
-	TotalPaid     float64                `protobuf:"fixed64,5,opt,name=total_paid,json=totalPaid,proto3" json:"total_paid,omitempty"`    // Total amount paid by user
-	TotalOwed     float64                `protobuf:"fixed64,6,opt,name=total_owed,json=totalOwed,proto3" json:"total_owed,omitempty"`    // Total amount user owes
-	NetBalance    float64                `protobuf:"fixed64,7,opt,name=net_balance,json=netBalance,proto3" json:"net_balance,omitempty"` // Positive = others owe them, Negative = they owe others
+	TotalPaid     *Money                 `protobuf:"bytes,5,opt,name=total_paid,json=totalPaid,proto3" json:"total_paid,omitempty"`    // Total amount paid by user
+	TotalOwed     *Money                 `protobuf:"bytes,6,opt,name=total_owed,json=totalOwed,proto3" json:"total_owed,omitempty"`    // Total amount user owes
+	NetBalance    *Money                 `protobuf:"bytes,7,opt,name=net_balance,json=netBalance,proto3" json:"net_balance,omitempty"` // Positive = others owe them, Negative = they owe others
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -189,25 +238,25 @@ func (x *UserBalance) GetAvatarUrl() string {
 	return ""
 }
 
-func (x *UserBalance) GetTotalPaid() float64 {
+func (x *UserBalance) GetTotalPaid() *Money {
 	if x != nil {
 		return x.TotalPaid
 	}
-	return 0
+	return nil
 }
 
-func (x *UserBalance) GetTotalOwed() float64 {
+func (x *UserBalance) GetTotalOwed() *Money {
 	if x != nil {
 		return x.TotalOwed
 	}
-	return 0
+	return nil
 }
 
-func (x *UserBalance) GetNetBalance() float64 {
+func (x *UserBalance) GetNetBalance() *Money {
 	if x != nil {
 		return x.NetBalance
 	}
-	return 0
+	return nil
 }
 
 type Debt struct {
@@ -218,7 +267,7 @@ type Debt struct {
 	ToUserId       string                 `protobuf:"bytes,4,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
 	ToUserNom      string                 `protobuf:"bytes,5,opt,name=to_user_nom,json=toUserNom,proto3" json:"to_user_nom,omitempty"`
 	ToUserPrenom   string                 `protobuf:"bytes,6,opt,name=to_user_prenom,json=toUserPrenom,proto3" json:"to_user_prenom,omitempty"`
-	Amount         float64                `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount         *Money                 `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -295,11 +344,11 @@ func (x *Debt) GetToUserPrenom() string {
 	return ""
 }
 
-func (x *Debt) GetAmount() float64 {
+func (x *Debt) GetAmount() *Money {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return nil
 }
 
 type GetSimplifiedDebtsRequest struct {
@@ -349,6 +398,7 @@ func (x *GetSimplifiedDebtsRequest) GetColocationId() string {
 type GetSimplifiedDebtsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Debts         []*SimplifiedDebt      `protobuf:"bytes,1,rep,name=debts,proto3" json:"debts,omitempty"`
+	Strategy      SimplificationStrategy `protobuf:"varint,2,opt,name=strategy,proto3,enum=coloc.SimplificationStrategy" json:"strategy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -390,6 +440,13 @@ func (x *GetSimplifiedDebtsResponse) GetDebts() []*SimplifiedDebt {
 	return nil
 }
 
+func (x *GetSimplifiedDebtsResponse) GetStrategy() SimplificationStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return SimplificationStrategy_SIMPLIFICATION_STRATEGY_UNSPECIFIED
+}
+
 type SimplifiedDebt struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	FromUserId     string                 `protobuf:"bytes,1,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
@@ -400,7 +457,7 @@ type SimplifiedDebt struct {
 	ToUserNom      string                 `protobuf:"bytes,6,opt,name=to_user_nom,json=toUserNom,proto3" json:"to_user_nom,omitempty"`
 	ToUserPrenom   string                 `protobuf:"bytes,7,opt,name=to_user_prenom,json=toUserPrenom,proto3" json:"to_user_prenom,omitempty"`
 	ToAvatarUrl    *string                `protobuf:"bytes,8,opt,name=to_avatar_url,json=toAvatarUrl,proto3,oneof" json:"to_avatar_url,omitempty"`
-	Amount         float64                `protobuf:"fixed64,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount         *Money                 `protobuf:"bytes,9,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -491,11 +548,11 @@ func (x *SimplifiedDebt) GetToAvatarUrl() string {
 	return ""
 }
 
-func (x *SimplifiedDebt) GetAmount() float64 {
+func (x *SimplifiedDebt) GetAmount() *Money {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return nil
 }
 
 type GetBalanceHistoryRequest struct {
@@ -605,11 +662,11 @@ func (x *GetBalanceHistoryResponse) GetEntries() []*BalanceHistoryEntry {
 type BalanceHistoryEntry struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Date              string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	CumulativeBalance float64                `protobuf:"fixed64,2,opt,name=cumulative_balance,json=cumulativeBalance,proto3" json:"cumulative_balance,omitempty"`
+	CumulativeBalance *Money                 `protobuf:"bytes,2,opt,name=cumulative_balance,json=cumulativeBalance,proto3" json:"cumulative_balance,omitempty"`
 	EventType         string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // "expense" or "payment"
 	EventId           string                 `protobuf:"bytes,4,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	Description       string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Amount            float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount            *Money                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -651,11 +708,11 @@ func (x *BalanceHistoryEntry) GetDate() string {
 	return ""
 }
 
-func (x *BalanceHistoryEntry) GetCumulativeBalance() float64 {
+func (x *BalanceHistoryEntry) GetCumulativeBalance() *Money {
 	if x != nil {
 		return x.CumulativeBalance
 	}
-	return 0
+	return nil
 }
 
 func (x *BalanceHistoryEntry) GetEventType() string {
@@ -679,37 +736,37 @@ func (x *BalanceHistoryEntry) GetDescription() string {
 	return ""
 }
 
-func (x *BalanceHistoryEntry) GetAmount() float64 {
+func (x *BalanceHistoryEntry) GetAmount() *Money {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return nil
 }
 
 var File_balance_proto protoreflect.FileDescriptor
 
 const file_balance_proto_rawDesc = "" +
 	"\n" +
-	"\rbalance.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\"9\n" +
+	"\rbalance.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\x1a\fcommon.proto\"9\n" +
 	"\x12GetBalancesRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\"h\n" +
 	"\x13GetBalancesResponse\x12.\n" +
 	"\bbalances\x18\x01 \x03(\v2\x12.coloc.UserBalanceR\bbalances\x12!\n" +
-	"\x05debts\x18\x02 \x03(\v2\v.coloc.DebtR\x05debts\"\xf4\x01\n" +
+	"\x05debts\x18\x02 \x03(\v2\v.coloc.DebtR\x05debts\"\x9e\x02\n" +
 	"\vUserBalance\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
 	"\buser_nom\x18\x02 \x01(\tR\auserNom\x12\x1f\n" +
 	"\vuser_prenom\x18\x03 \x01(\tR\n" +
 	"userPrenom\x12\"\n" +
 	"\n" +
-	"avatar_url\x18\x04 \x01(\tH\x00R\tavatarUrl\x88\x01\x01\x12\x1d\n" +
+	"avatar_url\x18\x04 \x01(\tH\x00R\tavatarUrl\x88\x01\x01\x12+\n" +
 	"\n" +
-	"total_paid\x18\x05 \x01(\x01R\ttotalPaid\x12\x1d\n" +
+	"total_paid\x18\x05 \x01(\v2\f.coloc.MoneyR\ttotalPaid\x12+\n" +
 	"\n" +
-	"total_owed\x18\x06 \x01(\x01R\ttotalOwed\x12\x1f\n" +
-	"\vnet_balance\x18\a \x01(\x01R\n" +
+	"total_owed\x18\x06 \x01(\v2\f.coloc.MoneyR\ttotalOwed\x12-\n" +
+	"\vnet_balance\x18\a \x01(\v2\f.coloc.MoneyR\n" +
 	"netBalanceB\r\n" +
-	"\v_avatar_url\"\xf2\x01\n" +
+	"\v_avatar_url\"\x80\x02\n" +
 	"\x04Debt\x12 \n" +
 	"\ffrom_user_id\x18\x01 \x01(\tR\n" +
 	"fromUserId\x12\"\n" +
@@ -718,12 +775,13 @@ const file_balance_proto_rawDesc = "" +
 	"\n" +
 	"to_user_id\x18\x04 \x01(\tR\btoUserId\x12\x1e\n" +
 	"\vto_user_nom\x18\x05 \x01(\tR\ttoUserNom\x12$\n" +
-	"\x0eto_user_prenom\x18\x06 \x01(\tR\ftoUserPrenom\x12\x16\n" +
-	"\x06amount\x18\a \x01(\x01R\x06amount\"@\n" +
+	"\x0eto_user_prenom\x18\x06 \x01(\tR\ftoUserPrenom\x12$\n" +
+	"\x06amount\x18\a \x01(\v2\f.coloc.MoneyR\x06amount\"@\n" +
 	"\x19GetSimplifiedDebtsRequest\x12#\n" +
-	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\"I\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\"\x84\x01\n" +
 	"\x1aGetSimplifiedDebtsResponse\x12+\n" +
-	"\x05debts\x18\x01 \x03(\v2\x15.coloc.SimplifiedDebtR\x05debts\"\xf8\x02\n" +
+	"\x05debts\x18\x01 \x03(\v2\x15.coloc.SimplifiedDebtR\x05debts\x129\n" +
+	"\bstrategy\x18\x02 \x01(\x0e2\x1d.coloc.SimplificationStrategyR\bstrategy\"\x86\x03\n" +
 	"\x0eSimplifiedDebt\x12 \n" +
 	"\ffrom_user_id\x18\x01 \x01(\tR\n" +
 	"fromUserId\x12\"\n" +
@@ -734,8 +792,8 @@ const file_balance_proto_rawDesc = "" +
 	"to_user_id\x18\x05 \x01(\tR\btoUserId\x12\x1e\n" +
 	"\vto_user_nom\x18\x06 \x01(\tR\ttoUserNom\x12$\n" +
 	"\x0eto_user_prenom\x18\a \x01(\tR\ftoUserPrenom\x12'\n" +
-	"\rto_avatar_url\x18\b \x01(\tH\x01R\vtoAvatarUrl\x88\x01\x01\x12\x16\n" +
-	"\x06amount\x18\t \x01(\x01R\x06amountB\x12\n" +
+	"\rto_avatar_url\x18\b \x01(\tH\x01R\vtoAvatarUrl\x88\x01\x01\x12$\n" +
+	"\x06amount\x18\t \x01(\v2\f.coloc.MoneyR\x06amountB\x12\n" +
 	"\x10_from_avatar_urlB\x10\n" +
 	"\x0e_to_avatar_url\"\x9f\x01\n" +
 	"\x18GetBalanceHistoryRequest\x12#\n" +
@@ -746,15 +804,19 @@ const file_balance_proto_rawDesc = "" +
 	"\v_start_dateB\v\n" +
 	"\t_end_date\"Q\n" +
 	"\x19GetBalanceHistoryResponse\x124\n" +
-	"\aentries\x18\x01 \x03(\v2\x1a.coloc.BalanceHistoryEntryR\aentries\"\xcc\x01\n" +
+	"\aentries\x18\x01 \x03(\v2\x1a.coloc.BalanceHistoryEntryR\aentries\"\xe8\x01\n" +
 	"\x13BalanceHistoryEntry\x12\x12\n" +
-	"\x04date\x18\x01 \x01(\tR\x04date\x12-\n" +
-	"\x12cumulative_balance\x18\x02 \x01(\x01R\x11cumulativeBalance\x12\x1d\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12;\n" +
+	"\x12cumulative_balance\x18\x02 \x01(\v2\f.coloc.MoneyR\x11cumulativeBalance\x12\x1d\n" +
 	"\n" +
 	"event_type\x18\x03 \x01(\tR\teventType\x12\x19\n" +
 	"\bevent_id\x18\x04 \x01(\tR\aeventId\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x16\n" +
-	"\x06amount\x18\x06 \x01(\x01R\x06amount2\xb7\x03\n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12$\n" +
+	"\x06amount\x18\x06 \x01(\v2\f.coloc.MoneyR\x06amount*\x88\x01\n" +
+	"\x16SimplificationStrategy\x12'\n" +
+	"#SIMPLIFICATION_STRATEGY_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dSIMPLIFICATION_STRATEGY_EXACT\x10\x01\x12\"\n" +
+	"\x1eSIMPLIFICATION_STRATEGY_GREEDY\x10\x022\xb7\x03\n" +
 	"\x0eBalanceService\x12w\n" +
 	"\vGetBalances\x12\x19.coloc.GetBalancesRequest\x1a\x1a.coloc.GetBalancesResponse\"1\x82\xd3\xe4\x93\x02+\x12)/api/colocations/{colocation_id}/balances\x12\x97\x01\n" +
 	"\x12GetSimplifiedDebts\x12 .coloc.GetSimplifiedDebtsRequest\x1a!.coloc.GetSimplifiedDebtsResponse\"<\x82\xd3\xe4\x93\x026\x124/api/colocations/{colocation_id}/balances/simplified\x12\x91\x01\n" +
@@ -772,35 +834,46 @@ func file_balance_proto_rawDescGZIP() []byte {
 	return file_balance_proto_rawDescData
 }
 
+var file_balance_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_balance_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_balance_proto_goTypes = []any{
-	(*GetBalancesRequest)(nil),         // 0: coloc.GetBalancesRequest
-	(*GetBalancesResponse)(nil),        // 1: coloc.GetBalancesResponse
-	(*UserBalance)(nil),                // 2: coloc.UserBalance
-	(*Debt)(nil),                       // 3: coloc.Debt
-	(*GetSimplifiedDebtsRequest)(nil),  // 4: coloc.GetSimplifiedDebtsRequest
-	(*GetSimplifiedDebtsResponse)(nil), // 5: coloc.GetSimplifiedDebtsResponse
-	(*SimplifiedDebt)(nil),             // 6: coloc.SimplifiedDebt
-	(*GetBalanceHistoryRequest)(nil),   // 7: coloc.GetBalanceHistoryRequest
-	(*GetBalanceHistoryResponse)(nil),  // 8: coloc.GetBalanceHistoryResponse
-	(*BalanceHistoryEntry)(nil),        // 9: coloc.BalanceHistoryEntry
+	(SimplificationStrategy)(0),        // 0: coloc.SimplificationStrategy
+	(*GetBalancesRequest)(nil),         // 1: coloc.GetBalancesRequest
+	(*GetBalancesResponse)(nil),        // 2: coloc.GetBalancesResponse
+	(*UserBalance)(nil),                // 3: coloc.UserBalance
+	(*Debt)(nil),                       // 4: coloc.Debt
+	(*GetSimplifiedDebtsRequest)(nil),  // 5: coloc.GetSimplifiedDebtsRequest
+	(*GetSimplifiedDebtsResponse)(nil), // 6: coloc.GetSimplifiedDebtsResponse
+	(*SimplifiedDebt)(nil),             // 7: coloc.SimplifiedDebt
+	(*GetBalanceHistoryRequest)(nil),   // 8: coloc.GetBalanceHistoryRequest
+	(*GetBalanceHistoryResponse)(nil),  // 9: coloc.GetBalanceHistoryResponse
+	(*BalanceHistoryEntry)(nil),        // 10: coloc.BalanceHistoryEntry
+	(*Money)(nil),                      // 11: coloc.Money
 }
 var file_balance_proto_depIdxs = []int32{
-	2, // 0: coloc.GetBalancesResponse.balances:type_name -> coloc.UserBalance
-	3, // 1: coloc.GetBalancesResponse.debts:type_name -> coloc.Debt
-	6, // 2: coloc.GetSimplifiedDebtsResponse.debts:type_name -> coloc.SimplifiedDebt
-	9, // 3: coloc.GetBalanceHistoryResponse.entries:type_name -> coloc.BalanceHistoryEntry
-	0, // 4: coloc.BalanceService.GetBalances:input_type -> coloc.GetBalancesRequest
-	4, // 5: coloc.BalanceService.GetSimplifiedDebts:input_type -> coloc.GetSimplifiedDebtsRequest
-	7, // 6: coloc.BalanceService.GetBalanceHistory:input_type -> coloc.GetBalanceHistoryRequest
-	1, // 7: coloc.BalanceService.GetBalances:output_type -> coloc.GetBalancesResponse
-	5, // 8: coloc.BalanceService.GetSimplifiedDebts:output_type -> coloc.GetSimplifiedDebtsResponse
-	8, // 9: coloc.BalanceService.GetBalanceHistory:output_type -> coloc.GetBalanceHistoryResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	3,  // 0: coloc.GetBalancesResponse.balances:type_name -> coloc.UserBalance
+	4,  // 1: coloc.GetBalancesResponse.debts:type_name -> coloc.Debt
+	11, // 2: coloc.UserBalance.total_paid:type_name -> coloc.Money
+	11, // 3: coloc.UserBalance.total_owed:type_name -> coloc.Money
+	11, // 4: coloc.UserBalance.net_balance:type_name -> coloc.Money
+	11, // 5: coloc.Debt.amount:type_name -> coloc.Money
+	7,  // 6: coloc.GetSimplifiedDebtsResponse.debts:type_name -> coloc.SimplifiedDebt
+	0,  // 7: coloc.GetSimplifiedDebtsResponse.strategy:type_name -> coloc.SimplificationStrategy
+	11, // 8: coloc.SimplifiedDebt.amount:type_name -> coloc.Money
+	10, // 9: coloc.GetBalanceHistoryResponse.entries:type_name -> coloc.BalanceHistoryEntry
+	11, // 10: coloc.BalanceHistoryEntry.cumulative_balance:type_name -> coloc.Money
+	11, // 11: coloc.BalanceHistoryEntry.amount:type_name -> coloc.Money
+	1,  // 12: coloc.BalanceService.GetBalances:input_type -> coloc.GetBalancesRequest
+	5,  // 13: coloc.BalanceService.GetSimplifiedDebts:input_type -> coloc.GetSimplifiedDebtsRequest
+	8,  // 14: coloc.BalanceService.GetBalanceHistory:input_type -> coloc.GetBalanceHistoryRequest
+	2,  // 15: coloc.BalanceService.GetBalances:output_type -> coloc.GetBalancesResponse
+	6,  // 16: coloc.BalanceService.GetSimplifiedDebts:output_type -> coloc.GetSimplifiedDebtsResponse
+	9,  // 17: coloc.BalanceService.GetBalanceHistory:output_type -> coloc.GetBalanceHistoryResponse
+	15, // [15:18] is the sub-list for method output_type
+	12, // [12:15] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_balance_proto_init() }
@@ -808,6 +881,7 @@ func file_balance_proto_init() {
 	if File_balance_proto != nil {
 		return
 	}
+	file_common_proto_init()
 	file_balance_proto_msgTypes[2].OneofWrappers = []any{}
 	file_balance_proto_msgTypes[6].OneofWrappers = []any{}
 	file_balance_proto_msgTypes[7].OneofWrappers = []any{}
@@ -816,13 +890,14 @@ func file_balance_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_balance_proto_rawDesc), len(file_balance_proto_rawDesc)),
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_balance_proto_goTypes,
 		DependencyIndexes: file_balance_proto_depIdxs,
+		EnumInfos:         file_balance_proto_enumTypes,
 		MessageInfos:      file_balance_proto_msgTypes,
 	}.Build()
 	File_balance_proto = out.File
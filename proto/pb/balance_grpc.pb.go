@@ -32,7 +32,7 @@ const (
 type BalanceServiceClient interface {
 	// Get all balances for colocation
 	GetBalances(ctx context.Context, in *GetBalancesRequest, opts ...grpc.CallOption) (*GetBalancesResponse, error)
-	// Get simplified debts (min-cash-flow algorithm)
+	// Get simplified debts (minimum number of transactions)
 	GetSimplifiedDebts(ctx context.Context, in *GetSimplifiedDebtsRequest, opts ...grpc.CallOption) (*GetSimplifiedDebtsResponse, error)
 	// Get balance history for a user
 	GetBalanceHistory(ctx context.Context, in *GetBalanceHistoryRequest, opts ...grpc.CallOption) (*GetBalanceHistoryResponse, error)
@@ -84,7 +84,7 @@ func (c *balanceServiceClient) GetBalanceHistory(ctx context.Context, in *GetBal
 type BalanceServiceServer interface {
 	// Get all balances for colocation
 	GetBalances(context.Context, *GetBalancesRequest) (*GetBalancesResponse, error)
-	// Get simplified debts (min-cash-flow algorithm)
+	// Get simplified debts (minimum number of transactions)
 	GetSimplifiedDebts(context.Context, *GetSimplifiedDebtsRequest) (*GetSimplifiedDebtsResponse, error)
 	// Get balance history for a user
 	GetBalanceHistory(context.Context, *GetBalanceHistoryRequest) (*GetBalanceHistoryResponse, error)
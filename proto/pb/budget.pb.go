@@ -0,0 +1,816 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: budget.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BudgetPeriod int32
+
+const (
+	BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED BudgetPeriod = 0
+	BudgetPeriod_BUDGET_PERIOD_WEEKLY      BudgetPeriod = 1 // Monday to Sunday
+	BudgetPeriod_BUDGET_PERIOD_MONTHLY     BudgetPeriod = 2
+)
+
+// Enum value maps for BudgetPeriod.
+var (
+	BudgetPeriod_name = map[int32]string{
+		0: "BUDGET_PERIOD_UNSPECIFIED",
+		1: "BUDGET_PERIOD_WEEKLY",
+		2: "BUDGET_PERIOD_MONTHLY",
+	}
+	BudgetPeriod_value = map[string]int32{
+		"BUDGET_PERIOD_UNSPECIFIED": 0,
+		"BUDGET_PERIOD_WEEKLY":      1,
+		"BUDGET_PERIOD_MONTHLY":     2,
+	}
+)
+
+func (x BudgetPeriod) Enum() *BudgetPeriod {
+	p := new(BudgetPeriod)
+	*p = x
+	return p
+}
+
+func (x BudgetPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BudgetPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_budget_proto_enumTypes[0].Descriptor()
+}
+
+func (BudgetPeriod) Type() protoreflect.EnumType {
+	return &file_budget_proto_enumTypes[0]
+}
+
+func (x BudgetPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BudgetPeriod.Descriptor instead.
+func (BudgetPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_budget_proto_rawDescGZIP(), []int{0}
+}
+
+type ListBudgetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBudgetsRequest) Reset() {
+	*x = ListBudgetsRequest{}
+	mi := &file_budget_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBudgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetsRequest) ProtoMessage() {}
+
+func (x *ListBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_budget_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*ListBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_budget_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListBudgetsRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+type ListBudgetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budgets       []*Budget              `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBudgetsResponse) Reset() {
+	*x = ListBudgetsResponse{}
+	mi := &file_budget_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBudgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetsResponse) ProtoMessage() {}
+
+func (x *ListBudgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_budget_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetsResponse.ProtoReflect.Descriptor instead.
+func (*ListBudgetsResponse) Descriptor() ([]byte, []int) {
+	return file_budget_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListBudgetsResponse) GetBudgets() []*Budget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+type CreateBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	CategoryId    *string                `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"` // Overall budget when not set
+	Amount        *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`                                 // In the base currency
+	Period        BudgetPeriod           `protobuf:"varint,4,opt,name=period,proto3,enum=coloc.BudgetPeriod" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBudgetRequest) Reset() {
+	*x = CreateBudgetRequest{}
+	mi := &file_budget_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBudgetRequest) ProtoMessage() {}
+
+func (x *CreateBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_budget_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBudgetRequest.ProtoReflect.Descriptor instead.
+func (*CreateBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_budget_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateBudgetRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *CreateBudgetRequest) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreateBudgetRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *CreateBudgetRequest) GetPeriod() BudgetPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED
+}
+
+type UpdateBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Period        *BudgetPeriod          `protobuf:"varint,4,opt,name=period,proto3,enum=coloc.BudgetPeriod,oneof" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBudgetRequest) Reset() {
+	*x = UpdateBudgetRequest{}
+	mi := &file_budget_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBudgetRequest) ProtoMessage() {}
+
+func (x *UpdateBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_budget_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBudgetRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_budget_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateBudgetRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *UpdateBudgetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateBudgetRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *UpdateBudgetRequest) GetPeriod() BudgetPeriod {
+	if x != nil && x.Period != nil {
+		return *x.Period
+	}
+	return BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED
+}
+
+type DeleteBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBudgetRequest) Reset() {
+	*x = DeleteBudgetRequest{}
+	mi := &file_budget_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetRequest) ProtoMessage() {}
+
+func (x *DeleteBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_budget_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_budget_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteBudgetRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *DeleteBudgetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBudgetResponse) Reset() {
+	*x = DeleteBudgetResponse{}
+	mi := &file_budget_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetResponse) ProtoMessage() {}
+
+func (x *DeleteBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_budget_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_budget_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteBudgetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetBudgetStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetStatusRequest) Reset() {
+	*x = GetBudgetStatusRequest{}
+	mi := &file_budget_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetStatusRequest) ProtoMessage() {}
+
+func (x *GetBudgetStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_budget_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetBudgetStatusRequest) Descriptor() ([]byte, []int) {
+	return file_budget_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetBudgetStatusRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+type GetBudgetStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []*BudgetStatus        `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetStatusResponse) Reset() {
+	*x = GetBudgetStatusResponse{}
+	mi := &file_budget_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetStatusResponse) ProtoMessage() {}
+
+func (x *GetBudgetStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_budget_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetBudgetStatusResponse) Descriptor() ([]byte, []int) {
+	return file_budget_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetBudgetStatusResponse) GetStatuses() []*BudgetStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type Budget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId    *string                `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,3,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"` // Empty for the overall budget
+	Amount        *Money                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Period        BudgetPeriod           `protobuf:"varint,5,opt,name=period,proto3,enum=coloc.BudgetPeriod" json:"period,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Budget) Reset() {
+	*x = Budget{}
+	mi := &file_budget_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Budget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Budget) ProtoMessage() {}
+
+func (x *Budget) ProtoReflect() protoreflect.Message {
+	mi := &file_budget_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
+func (*Budget) Descriptor() ([]byte, []int) {
+	return file_budget_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Budget) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Budget) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *Budget) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *Budget) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Budget) GetPeriod() BudgetPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED
+}
+
+func (x *Budget) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Budget) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type BudgetStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budget        *Budget                `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	PeriodStart   string                 `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"` // Format: YYYY-MM-DD
+	PeriodEnd     string                 `protobuf:"bytes,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`       // Format: YYYY-MM-DD
+	Spent         *Money                 `protobuf:"bytes,4,opt,name=spent,proto3" json:"spent,omitempty"`
+	Projected     *Money                 `protobuf:"bytes,5,opt,name=projected,proto3" json:"projected,omitempty"`     // Expected at the end of the period, from recurring expenses and recent spending
+	Remaining     *Money                 `protobuf:"bytes,6,opt,name=remaining,proto3" json:"remaining,omitempty"`     // Negative once the limit is exceeded
+	Percentage    float64                `protobuf:"fixed64,7,opt,name=percentage,proto3" json:"percentage,omitempty"` // Spent share of the limit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetStatus) Reset() {
+	*x = BudgetStatus{}
+	mi := &file_budget_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetStatus) ProtoMessage() {}
+
+func (x *BudgetStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_budget_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetStatus.ProtoReflect.Descriptor instead.
+func (*BudgetStatus) Descriptor() ([]byte, []int) {
+	return file_budget_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BudgetStatus) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+func (x *BudgetStatus) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *BudgetStatus) GetPeriodEnd() string {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return ""
+}
+
+func (x *BudgetStatus) GetSpent() *Money {
+	if x != nil {
+		return x.Spent
+	}
+	return nil
+}
+
+func (x *BudgetStatus) GetProjected() *Money {
+	if x != nil {
+		return x.Projected
+	}
+	return nil
+}
+
+func (x *BudgetStatus) GetRemaining() *Money {
+	if x != nil {
+		return x.Remaining
+	}
+	return nil
+}
+
+func (x *BudgetStatus) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+var File_budget_proto protoreflect.FileDescriptor
+
+const file_budget_proto_rawDesc = "" +
+	"\n" +
+	"\fbudget.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\x1a\fcommon.proto\"9\n" +
+	"\x12ListBudgetsRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\">\n" +
+	"\x13ListBudgetsResponse\x12'\n" +
+	"\abudgets\x18\x01 \x03(\v2\r.coloc.BudgetR\abudgets\"\xc3\x01\n" +
+	"\x13CreateBudgetRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12$\n" +
+	"\vcategory_id\x18\x02 \x01(\tH\x00R\n" +
+	"categoryId\x88\x01\x01\x12$\n" +
+	"\x06amount\x18\x03 \x01(\v2\f.coloc.MoneyR\x06amount\x12+\n" +
+	"\x06period\x18\x04 \x01(\x0e2\x13.coloc.BudgetPeriodR\x06periodB\x0e\n" +
+	"\f_category_id\"\xad\x01\n" +
+	"\x13UpdateBudgetRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12$\n" +
+	"\x06amount\x18\x03 \x01(\v2\f.coloc.MoneyR\x06amount\x120\n" +
+	"\x06period\x18\x04 \x01(\x0e2\x13.coloc.BudgetPeriodH\x00R\x06period\x88\x01\x01B\t\n" +
+	"\a_period\"J\n" +
+	"\x13DeleteBudgetRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"0\n" +
+	"\x14DeleteBudgetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"=\n" +
+	"\x16GetBudgetStatusRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\"J\n" +
+	"\x17GetBudgetStatusResponse\x12/\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x13.coloc.BudgetStatusR\bstatuses\"\x84\x02\n" +
+	"\x06Budget\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
+	"\vcategory_id\x18\x02 \x01(\tH\x00R\n" +
+	"categoryId\x88\x01\x01\x12#\n" +
+	"\rcategory_name\x18\x03 \x01(\tR\fcategoryName\x12$\n" +
+	"\x06amount\x18\x04 \x01(\v2\f.coloc.MoneyR\x06amount\x12+\n" +
+	"\x06period\x18\x05 \x01(\x0e2\x13.coloc.BudgetPeriodR\x06period\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAtB\x0e\n" +
+	"\f_category_id\"\x93\x02\n" +
+	"\fBudgetStatus\x12%\n" +
+	"\x06budget\x18\x01 \x01(\v2\r.coloc.BudgetR\x06budget\x12!\n" +
+	"\fperiod_start\x18\x02 \x01(\tR\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x03 \x01(\tR\tperiodEnd\x12\"\n" +
+	"\x05spent\x18\x04 \x01(\v2\f.coloc.MoneyR\x05spent\x12*\n" +
+	"\tprojected\x18\x05 \x01(\v2\f.coloc.MoneyR\tprojected\x12*\n" +
+	"\tremaining\x18\x06 \x01(\v2\f.coloc.MoneyR\tremaining\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\a \x01(\x01R\n" +
+	"percentage*b\n" +
+	"\fBudgetPeriod\x12\x1d\n" +
+	"\x19BUDGET_PERIOD_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14BUDGET_PERIOD_WEEKLY\x10\x01\x12\x19\n" +
+	"\x15BUDGET_PERIOD_MONTHLY\x10\x022\xf8\x04\n" +
+	"\rBudgetService\x12v\n" +
+	"\vListBudgets\x12\x19.coloc.ListBudgetsRequest\x1a\x1a.coloc.ListBudgetsResponse\"0\x82\xd3\xe4\x93\x02*\x12(/api/colocations/{colocation_id}/budgets\x12n\n" +
+	"\fCreateBudget\x12\x1a.coloc.CreateBudgetRequest\x1a\r.coloc.Budget\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/api/colocations/{colocation_id}/budgets\x12s\n" +
+	"\fUpdateBudget\x12\x1a.coloc.UpdateBudgetRequest\x1a\r.coloc.Budget\"8\x82\xd3\xe4\x93\x022:\x01*\x1a-/api/colocations/{colocation_id}/budgets/{id}\x12~\n" +
+	"\fDeleteBudget\x12\x1a.coloc.DeleteBudgetRequest\x1a\x1b.coloc.DeleteBudgetResponse\"5\x82\xd3\xe4\x93\x02/*-/api/colocations/{colocation_id}/budgets/{id}\x12\x89\x01\n" +
+	"\x0fGetBudgetStatus\x12\x1d.coloc.GetBudgetStatusRequest\x1a\x1e.coloc.GetBudgetStatusResponse\"7\x82\xd3\xe4\x93\x021\x12//api/colocations/{colocation_id}/budgets/statusB,Z*github.com/vblanchet22/back_coloc/proto/pbb\x06proto3"
+
+var (
+	file_budget_proto_rawDescOnce sync.Once
+	file_budget_proto_rawDescData []byte
+)
+
+func file_budget_proto_rawDescGZIP() []byte {
+	file_budget_proto_rawDescOnce.Do(func() {
+		file_budget_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_budget_proto_rawDesc), len(file_budget_proto_rawDesc)))
+	})
+	return file_budget_proto_rawDescData
+}
+
+var file_budget_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_budget_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_budget_proto_goTypes = []any{
+	(BudgetPeriod)(0),               // 0: coloc.BudgetPeriod
+	(*ListBudgetsRequest)(nil),      // 1: coloc.ListBudgetsRequest
+	(*ListBudgetsResponse)(nil),     // 2: coloc.ListBudgetsResponse
+	(*CreateBudgetRequest)(nil),     // 3: coloc.CreateBudgetRequest
+	(*UpdateBudgetRequest)(nil),     // 4: coloc.UpdateBudgetRequest
+	(*DeleteBudgetRequest)(nil),     // 5: coloc.DeleteBudgetRequest
+	(*DeleteBudgetResponse)(nil),    // 6: coloc.DeleteBudgetResponse
+	(*GetBudgetStatusRequest)(nil),  // 7: coloc.GetBudgetStatusRequest
+	(*GetBudgetStatusResponse)(nil), // 8: coloc.GetBudgetStatusResponse
+	(*Budget)(nil),                  // 9: coloc.Budget
+	(*BudgetStatus)(nil),            // 10: coloc.BudgetStatus
+	(*Money)(nil),                   // 11: coloc.Money
+}
+var file_budget_proto_depIdxs = []int32{
+	9,  // 0: coloc.ListBudgetsResponse.budgets:type_name -> coloc.Budget
+	11, // 1: coloc.CreateBudgetRequest.amount:type_name -> coloc.Money
+	0,  // 2: coloc.CreateBudgetRequest.period:type_name -> coloc.BudgetPeriod
+	11, // 3: coloc.UpdateBudgetRequest.amount:type_name -> coloc.Money
+	0,  // 4: coloc.UpdateBudgetRequest.period:type_name -> coloc.BudgetPeriod
+	10, // 5: coloc.GetBudgetStatusResponse.statuses:type_name -> coloc.BudgetStatus
+	11, // 6: coloc.Budget.amount:type_name -> coloc.Money
+	0,  // 7: coloc.Budget.period:type_name -> coloc.BudgetPeriod
+	9,  // 8: coloc.BudgetStatus.budget:type_name -> coloc.Budget
+	11, // 9: coloc.BudgetStatus.spent:type_name -> coloc.Money
+	11, // 10: coloc.BudgetStatus.projected:type_name -> coloc.Money
+	11, // 11: coloc.BudgetStatus.remaining:type_name -> coloc.Money
+	1,  // 12: coloc.BudgetService.ListBudgets:input_type -> coloc.ListBudgetsRequest
+	3,  // 13: coloc.BudgetService.CreateBudget:input_type -> coloc.CreateBudgetRequest
+	4,  // 14: coloc.BudgetService.UpdateBudget:input_type -> coloc.UpdateBudgetRequest
+	5,  // 15: coloc.BudgetService.DeleteBudget:input_type -> coloc.DeleteBudgetRequest
+	7,  // 16: coloc.BudgetService.GetBudgetStatus:input_type -> coloc.GetBudgetStatusRequest
+	2,  // 17: coloc.BudgetService.ListBudgets:output_type -> coloc.ListBudgetsResponse
+	9,  // 18: coloc.BudgetService.CreateBudget:output_type -> coloc.Budget
+	9,  // 19: coloc.BudgetService.UpdateBudget:output_type -> coloc.Budget
+	6,  // 20: coloc.BudgetService.DeleteBudget:output_type -> coloc.DeleteBudgetResponse
+	8,  // 21: coloc.BudgetService.GetBudgetStatus:output_type -> coloc.GetBudgetStatusResponse
+	17, // [17:22] is the sub-list for method output_type
+	12, // [12:17] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_budget_proto_init() }
+func file_budget_proto_init() {
+	if File_budget_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_budget_proto_msgTypes[2].OneofWrappers = []any{}
+	file_budget_proto_msgTypes[3].OneofWrappers = []any{}
+	file_budget_proto_msgTypes[8].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_budget_proto_rawDesc), len(file_budget_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_budget_proto_goTypes,
+		DependencyIndexes: file_budget_proto_depIdxs,
+		EnumInfos:         file_budget_proto_enumTypes,
+		MessageInfos:      file_budget_proto_msgTypes,
+	}.Build()
+	File_budget_proto = out.File
+	file_budget_proto_goTypes = nil
+	file_budget_proto_depIdxs = nil
+}
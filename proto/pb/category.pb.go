@@ -413,7 +413,7 @@ func (x *GetCategoryStatsRequest) GetEndDate() string {
 type GetCategoryStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Stats         []*CategoryStat        `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
-	TotalAmount   float64                `protobuf:"fixed64,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	TotalAmount   *Money                 `protobuf:"bytes,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -455,11 +455,11 @@ func (x *GetCategoryStatsResponse) GetStats() []*CategoryStat {
 	return nil
 }
 
-func (x *GetCategoryStatsResponse) GetTotalAmount() float64 {
+func (x *GetCategoryStatsResponse) GetTotalAmount() *Money {
 	if x != nil {
 		return x.TotalAmount
 	}
-	return 0
+	return nil
 }
 
 type Category struct {
@@ -552,7 +552,7 @@ type CategoryStat struct {
 	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
 	Icon          *string                `protobuf:"bytes,3,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
 	Color         *string                `protobuf:"bytes,4,opt,name=color,proto3,oneof" json:"color,omitempty"`
-	TotalAmount   float64                `protobuf:"fixed64,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	TotalAmount   *Money                 `protobuf:"bytes,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
 	ExpenseCount  int32                  `protobuf:"varint,6,opt,name=expense_count,json=expenseCount,proto3" json:"expense_count,omitempty"`
 	Percentage    float64                `protobuf:"fixed64,7,opt,name=percentage,proto3" json:"percentage,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -617,11 +617,11 @@ func (x *CategoryStat) GetColor() string {
 	return ""
 }
 
-func (x *CategoryStat) GetTotalAmount() float64 {
+func (x *CategoryStat) GetTotalAmount() *Money {
 	if x != nil {
 		return x.TotalAmount
 	}
-	return 0
+	return nil
 }
 
 func (x *CategoryStat) GetExpenseCount() int32 {
@@ -642,7 +642,7 @@ var File_category_proto protoreflect.FileDescriptor
 
 const file_category_proto_rawDesc = "" +
 	"\n" +
-	"\x0ecategory.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\"<\n" +
+	"\x0ecategory.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\x1a\fcommon.proto\"<\n" +
 	"\x15ListCategoriesRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\"I\n" +
 	"\x16ListCategoriesResponse\x12/\n" +
@@ -676,10 +676,10 @@ const file_category_proto_rawDesc = "" +
 	"start_date\x18\x02 \x01(\tH\x00R\tstartDate\x88\x01\x01\x12\x1e\n" +
 	"\bend_date\x18\x03 \x01(\tH\x01R\aendDate\x88\x01\x01B\r\n" +
 	"\v_start_dateB\v\n" +
-	"\t_end_date\"h\n" +
+	"\t_end_date\"v\n" +
 	"\x18GetCategoryStatsResponse\x12)\n" +
-	"\x05stats\x18\x01 \x03(\v2\x13.coloc.CategoryStatR\x05stats\x12!\n" +
-	"\ftotal_amount\x18\x02 \x01(\x01R\vtotalAmount\"\xce\x01\n" +
+	"\x05stats\x18\x01 \x03(\v2\x13.coloc.CategoryStatR\x05stats\x12/\n" +
+	"\ftotal_amount\x18\x02 \x01(\v2\f.coloc.MoneyR\vtotalAmount\"\xce\x01\n" +
 	"\bCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
@@ -689,14 +689,14 @@ const file_category_proto_rawDesc = "" +
 	"\rcolocation_id\x18\x06 \x01(\tH\x02R\fcolocationId\x88\x01\x01B\a\n" +
 	"\x05_iconB\b\n" +
 	"\x06_colorB\x10\n" +
-	"\x0e_colocation_id\"\x83\x02\n" +
+	"\x0e_colocation_id\"\x91\x02\n" +
 	"\fCategoryStat\x12\x1f\n" +
 	"\vcategory_id\x18\x01 \x01(\tR\n" +
 	"categoryId\x12#\n" +
 	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12\x17\n" +
 	"\x04icon\x18\x03 \x01(\tH\x00R\x04icon\x88\x01\x01\x12\x19\n" +
-	"\x05color\x18\x04 \x01(\tH\x01R\x05color\x88\x01\x01\x12!\n" +
-	"\ftotal_amount\x18\x05 \x01(\x01R\vtotalAmount\x12#\n" +
+	"\x05color\x18\x04 \x01(\tH\x01R\x05color\x88\x01\x01\x12/\n" +
+	"\ftotal_amount\x18\x05 \x01(\v2\f.coloc.MoneyR\vtotalAmount\x12#\n" +
 	"\rexpense_count\x18\x06 \x01(\x05R\fexpenseCount\x12\x1e\n" +
 	"\n" +
 	"percentage\x18\a \x01(\x01R\n" +
@@ -734,25 +734,28 @@ var file_category_proto_goTypes = []any{
 	(*GetCategoryStatsResponse)(nil), // 7: coloc.GetCategoryStatsResponse
 	(*Category)(nil),                 // 8: coloc.Category
 	(*CategoryStat)(nil),             // 9: coloc.CategoryStat
+	(*Money)(nil),                    // 10: coloc.Money
 }
 var file_category_proto_depIdxs = []int32{
-	8, // 0: coloc.ListCategoriesResponse.categories:type_name -> coloc.Category
-	9, // 1: coloc.GetCategoryStatsResponse.stats:type_name -> coloc.CategoryStat
-	0, // 2: coloc.CategoryService.ListCategories:input_type -> coloc.ListCategoriesRequest
-	2, // 3: coloc.CategoryService.CreateCategory:input_type -> coloc.CreateCategoryRequest
-	3, // 4: coloc.CategoryService.UpdateCategory:input_type -> coloc.UpdateCategoryRequest
-	4, // 5: coloc.CategoryService.DeleteCategory:input_type -> coloc.DeleteCategoryRequest
-	6, // 6: coloc.CategoryService.GetCategoryStats:input_type -> coloc.GetCategoryStatsRequest
-	1, // 7: coloc.CategoryService.ListCategories:output_type -> coloc.ListCategoriesResponse
-	8, // 8: coloc.CategoryService.CreateCategory:output_type -> coloc.Category
-	8, // 9: coloc.CategoryService.UpdateCategory:output_type -> coloc.Category
-	5, // 10: coloc.CategoryService.DeleteCategory:output_type -> coloc.DeleteCategoryResponse
-	7, // 11: coloc.CategoryService.GetCategoryStats:output_type -> coloc.GetCategoryStatsResponse
-	7, // [7:12] is the sub-list for method output_type
-	2, // [2:7] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	8,  // 0: coloc.ListCategoriesResponse.categories:type_name -> coloc.Category
+	9,  // 1: coloc.GetCategoryStatsResponse.stats:type_name -> coloc.CategoryStat
+	10, // 2: coloc.GetCategoryStatsResponse.total_amount:type_name -> coloc.Money
+	10, // 3: coloc.CategoryStat.total_amount:type_name -> coloc.Money
+	0,  // 4: coloc.CategoryService.ListCategories:input_type -> coloc.ListCategoriesRequest
+	2,  // 5: coloc.CategoryService.CreateCategory:input_type -> coloc.CreateCategoryRequest
+	3,  // 6: coloc.CategoryService.UpdateCategory:input_type -> coloc.UpdateCategoryRequest
+	4,  // 7: coloc.CategoryService.DeleteCategory:input_type -> coloc.DeleteCategoryRequest
+	6,  // 8: coloc.CategoryService.GetCategoryStats:input_type -> coloc.GetCategoryStatsRequest
+	1,  // 9: coloc.CategoryService.ListCategories:output_type -> coloc.ListCategoriesResponse
+	8,  // 10: coloc.CategoryService.CreateCategory:output_type -> coloc.Category
+	8,  // 11: coloc.CategoryService.UpdateCategory:output_type -> coloc.Category
+	5,  // 12: coloc.CategoryService.DeleteCategory:output_type -> coloc.DeleteCategoryResponse
+	7,  // 13: coloc.CategoryService.GetCategoryStats:output_type -> coloc.GetCategoryStatsResponse
+	9,  // [9:14] is the sub-list for method output_type
+	4,  // [4:9] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_category_proto_init() }
@@ -760,6 +763,7 @@ func file_category_proto_init() {
 	if File_category_proto != nil {
 		return
 	}
+	file_common_proto_init()
 	file_category_proto_msgTypes[2].OneofWrappers = []any{}
 	file_category_proto_msgTypes[3].OneofWrappers = []any{}
 	file_category_proto_msgTypes[6].OneofWrappers = []any{}
@@ -131,6 +131,7 @@ type CreateColocationRequest struct {
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	Address       *string                `protobuf:"bytes,3,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	BaseCurrency  *string                `protobuf:"bytes,4,opt,name=base_currency,json=baseCurrency,proto3,oneof" json:"base_currency,omitempty"` // ISO 4217 code, EUR by default
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -186,6 +187,13 @@ func (x *CreateColocationRequest) GetAddress() string {
 	return ""
 }
 
+func (x *CreateColocationRequest) GetBaseCurrency() string {
+	if x != nil && x.BaseCurrency != nil {
+		return *x.BaseCurrency
+	}
+	return ""
+}
+
 type GetColocationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -311,13 +319,16 @@ func (x *ListColocationsResponse) GetColocations() []*Colocation {
 }
 
 type UpdateColocationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Address       *string                `protobuf:"bytes,4,opt,name=address,proto3,oneof" json:"address,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description         *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Address             *string                `protobuf:"bytes,4,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	BaseCurrency        *string                `protobuf:"bytes,5,opt,name=base_currency,json=baseCurrency,proto3,oneof" json:"base_currency,omitempty"`                         // Only while no amount has been recorded
+	PaymentReminderDays *int32                 `protobuf:"varint,6,opt,name=payment_reminder_days,json=paymentReminderDays,proto3,oneof" json:"payment_reminder_days,omitempty"` // 0 disables the reminder
+	PaymentExpiryDays   *int32                 `protobuf:"varint,7,opt,name=payment_expiry_days,json=paymentExpiryDays,proto3,oneof" json:"payment_expiry_days,omitempty"`       // 0 disables the expiry
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *UpdateColocationRequest) Reset() {
@@ -378,6 +389,27 @@ func (x *UpdateColocationRequest) GetAddress() string {
 	return ""
 }
 
+func (x *UpdateColocationRequest) GetBaseCurrency() string {
+	if x != nil && x.BaseCurrency != nil {
+		return *x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *UpdateColocationRequest) GetPaymentReminderDays() int32 {
+	if x != nil && x.PaymentReminderDays != nil {
+		return *x.PaymentReminderDays
+	}
+	return 0
+}
+
+func (x *UpdateColocationRequest) GetPaymentExpiryDays() int32 {
+	if x != nil && x.PaymentExpiryDays != nil {
+		return *x.PaymentExpiryDays
+	}
+	return 0
+}
+
 type DeleteColocationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1167,19 +1199,22 @@ func (x *CancelInvitationResponse) GetSuccess() bool {
 }
 
 type Colocation struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description     *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Address         *string                `protobuf:"bytes,4,opt,name=address,proto3,oneof" json:"address,omitempty"`
-	CreatedBy       string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	InviteCode      string                 `protobuf:"bytes,6,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
-	CreatedAt       string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CurrentUserRole MemberRole             `protobuf:"varint,9,opt,name=current_user_role,json=currentUserRole,proto3,enum=coloc.MemberRole" json:"current_user_role,omitempty"`
-	MemberCount     int32                  `protobuf:"varint,10,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description         *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Address             *string                `protobuf:"bytes,4,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	CreatedBy           string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	InviteCode          string                 `protobuf:"bytes,6,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	CreatedAt           string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CurrentUserRole     MemberRole             `protobuf:"varint,9,opt,name=current_user_role,json=currentUserRole,proto3,enum=coloc.MemberRole" json:"current_user_role,omitempty"`
+	MemberCount         int32                  `protobuf:"varint,10,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	BaseCurrency        string                 `protobuf:"bytes,11,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`                               // Currency of balances, funds and budgets
+	PaymentReminderDays *int32                 `protobuf:"varint,12,opt,name=payment_reminder_days,json=paymentReminderDays,proto3,oneof" json:"payment_reminder_days,omitempty"` // Days before the recipient of a pending payment is reminded, unset when disabled
+	PaymentExpiryDays   *int32                 `protobuf:"varint,13,opt,name=payment_expiry_days,json=paymentExpiryDays,proto3,oneof" json:"payment_expiry_days,omitempty"`       // Days before a pending payment expires, unset when disabled
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Colocation) Reset() {
@@ -1282,6 +1317,27 @@ func (x *Colocation) GetMemberCount() int32 {
 	return 0
 }
 
+func (x *Colocation) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *Colocation) GetPaymentReminderDays() int32 {
+	if x != nil && x.PaymentReminderDays != nil {
+		return *x.PaymentReminderDays
+	}
+	return 0
+}
+
+func (x *Colocation) GetPaymentExpiryDays() int32 {
+	if x != nil && x.PaymentExpiryDays != nil {
+		return *x.PaymentExpiryDays
+	}
+	return 0
+}
+
 type ColocationMember struct {
 	state        protoimpl.MessageState `protogen:"open.v1"`
 	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1487,28 +1543,36 @@ var File_colocation_proto protoreflect.FileDescriptor
 
 const file_colocation_proto_rawDesc = "" +
 	"\n" +
-	"\x10colocation.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\"\x8f\x01\n" +
+	"\x10colocation.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\"\xcb\x01\n" +
 	"\x17CreateColocationRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
 	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1d\n" +
-	"\aaddress\x18\x03 \x01(\tH\x01R\aaddress\x88\x01\x01B\x0e\n" +
+	"\aaddress\x18\x03 \x01(\tH\x01R\aaddress\x88\x01\x01\x12(\n" +
+	"\rbase_currency\x18\x04 \x01(\tH\x02R\fbaseCurrency\x88\x01\x01B\x0e\n" +
 	"\f_descriptionB\n" +
 	"\n" +
-	"\b_address\"&\n" +
+	"\b_addressB\x10\n" +
+	"\x0e_base_currency\"&\n" +
 	"\x14GetColocationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
 	"\x16ListColocationsRequest\"N\n" +
 	"\x17ListColocationsResponse\x123\n" +
-	"\vcolocations\x18\x01 \x03(\v2\x11.coloc.ColocationR\vcolocations\"\xad\x01\n" +
+	"\vcolocations\x18\x01 \x03(\v2\x11.coloc.ColocationR\vcolocations\"\x89\x03\n" +
 	"\x17UpdateColocationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1d\n" +
-	"\aaddress\x18\x04 \x01(\tH\x02R\aaddress\x88\x01\x01B\a\n" +
+	"\aaddress\x18\x04 \x01(\tH\x02R\aaddress\x88\x01\x01\x12(\n" +
+	"\rbase_currency\x18\x05 \x01(\tH\x03R\fbaseCurrency\x88\x01\x01\x127\n" +
+	"\x15payment_reminder_days\x18\x06 \x01(\x05H\x04R\x13paymentReminderDays\x88\x01\x01\x123\n" +
+	"\x13payment_expiry_days\x18\a \x01(\x05H\x05R\x11paymentExpiryDays\x88\x01\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\n" +
 	"\n" +
-	"\b_address\")\n" +
+	"\b_addressB\x10\n" +
+	"\x0e_base_currencyB\x18\n" +
+	"\x16_payment_reminder_daysB\x16\n" +
+	"\x14_payment_expiry_days\")\n" +
 	"\x17DeleteColocationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
 	"\x18DeleteColocationResponse\x12\x18\n" +
@@ -1549,7 +1613,7 @@ const file_colocation_proto_rawDesc = "" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12#\n" +
 	"\rinvitation_id\x18\x02 \x01(\tR\finvitationId\"4\n" +
 	"\x18CancelInvitationResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xf2\x02\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb7\x04\n" +
 	"\n" +
 	"Colocation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
@@ -1566,10 +1630,15 @@ const file_colocation_proto_rawDesc = "" +
 	"updated_at\x18\b \x01(\tR\tupdatedAt\x12=\n" +
 	"\x11current_user_role\x18\t \x01(\x0e2\x11.coloc.MemberRoleR\x0fcurrentUserRole\x12!\n" +
 	"\fmember_count\x18\n" +
-	" \x01(\x05R\vmemberCountB\x0e\n" +
+	" \x01(\x05R\vmemberCount\x12#\n" +
+	"\rbase_currency\x18\v \x01(\tR\fbaseCurrency\x127\n" +
+	"\x15payment_reminder_days\x18\f \x01(\x05H\x02R\x13paymentReminderDays\x88\x01\x01\x123\n" +
+	"\x13payment_expiry_days\x18\r \x01(\x05H\x03R\x11paymentExpiryDays\x88\x01\x01B\x0e\n" +
 	"\f_descriptionB\n" +
 	"\n" +
-	"\b_address\"\x97\x02\n" +
+	"\b_addressB\x18\n" +
+	"\x16_payment_reminder_daysB\x16\n" +
+	"\x14_payment_expiry_days\"\x97\x02\n" +
 	"\x10ColocationMember\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12#\n" +
@@ -136,7 +136,7 @@ type CreateEventRequest struct {
 	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	EventDate     string                 `protobuf:"bytes,4,opt,name=event_date,json=eventDate,proto3" json:"event_date,omitempty"` // Format: YYYY-MM-DD HH:MM
 	Location      *string                `protobuf:"bytes,5,opt,name=location,proto3,oneof" json:"location,omitempty"`
-	Budget        *float64               `protobuf:"fixed64,6,opt,name=budget,proto3,oneof" json:"budget,omitempty"`
+	Budget        *Money                 `protobuf:"bytes,6,opt,name=budget,proto3" json:"budget,omitempty"`
 	FundId        *string                `protobuf:"bytes,7,opt,name=fund_id,json=fundId,proto3,oneof" json:"fund_id,omitempty"` // Link to common fund
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -207,11 +207,11 @@ func (x *CreateEventRequest) GetLocation() string {
 	return ""
 }
 
-func (x *CreateEventRequest) GetBudget() float64 {
-	if x != nil && x.Budget != nil {
-		return *x.Budget
+func (x *CreateEventRequest) GetBudget() *Money {
+	if x != nil {
+		return x.Budget
 	}
-	return 0
+	return nil
 }
 
 func (x *CreateEventRequest) GetFundId() string {
@@ -433,7 +433,7 @@ type UpdateEventRequest struct {
 	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	EventDate     *string                `protobuf:"bytes,5,opt,name=event_date,json=eventDate,proto3,oneof" json:"event_date,omitempty"`
 	Location      *string                `protobuf:"bytes,6,opt,name=location,proto3,oneof" json:"location,omitempty"`
-	Budget        *float64               `protobuf:"fixed64,7,opt,name=budget,proto3,oneof" json:"budget,omitempty"`
+	Budget        *Money                 `protobuf:"bytes,7,opt,name=budget,proto3" json:"budget,omitempty"`
 	FundId        *string                `protobuf:"bytes,8,opt,name=fund_id,json=fundId,proto3,oneof" json:"fund_id,omitempty"`
 	Status        *EventStatus           `protobuf:"varint,9,opt,name=status,proto3,enum=coloc.EventStatus,oneof" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -512,11 +512,11 @@ func (x *UpdateEventRequest) GetLocation() string {
 	return ""
 }
 
-func (x *UpdateEventRequest) GetBudget() float64 {
-	if x != nil && x.Budget != nil {
-		return *x.Budget
+func (x *UpdateEventRequest) GetBudget() *Money {
+	if x != nil {
+		return x.Budget
 	}
-	return 0
+	return nil
 }
 
 func (x *UpdateEventRequest) GetFundId() string {
@@ -948,7 +948,7 @@ type Event struct {
 	Description     *string                `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	EventDate       string                 `protobuf:"bytes,8,opt,name=event_date,json=eventDate,proto3" json:"event_date,omitempty"`
 	Location        *string                `protobuf:"bytes,9,opt,name=location,proto3,oneof" json:"location,omitempty"`
-	Budget          *float64               `protobuf:"fixed64,10,opt,name=budget,proto3,oneof" json:"budget,omitempty"`
+	Budget          *Money                 `protobuf:"bytes,10,opt,name=budget,proto3" json:"budget,omitempty"`
 	FundId          *string                `protobuf:"bytes,11,opt,name=fund_id,json=fundId,proto3,oneof" json:"fund_id,omitempty"`
 	FundName        *string                `protobuf:"bytes,12,opt,name=fund_name,json=fundName,proto3,oneof" json:"fund_name,omitempty"`
 	Status          EventStatus            `protobuf:"varint,13,opt,name=status,proto3,enum=coloc.EventStatus" json:"status,omitempty"`
@@ -1056,11 +1056,11 @@ func (x *Event) GetLocation() string {
 	return ""
 }
 
-func (x *Event) GetBudget() float64 {
-	if x != nil && x.Budget != nil {
-		return *x.Budget
+func (x *Event) GetBudget() *Money {
+	if x != nil {
+		return x.Budget
 	}
-	return 0
+	return nil
 }
 
 func (x *Event) GetFundId() string {
@@ -1123,19 +1123,18 @@ var File_event_proto protoreflect.FileDescriptor
 
 const file_event_proto_rawDesc = "" +
 	"\n" +
-	"\vevent.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\"\xa5\x02\n" +
+	"\vevent.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\x1a\fcommon.proto\"\xa3\x02\n" +
 	"\x12CreateEventRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12%\n" +
 	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1d\n" +
 	"\n" +
 	"event_date\x18\x04 \x01(\tR\teventDate\x12\x1f\n" +
-	"\blocation\x18\x05 \x01(\tH\x01R\blocation\x88\x01\x01\x12\x1b\n" +
-	"\x06budget\x18\x06 \x01(\x01H\x02R\x06budget\x88\x01\x01\x12\x1c\n" +
-	"\afund_id\x18\a \x01(\tH\x03R\x06fundId\x88\x01\x01B\x0e\n" +
+	"\blocation\x18\x05 \x01(\tH\x01R\blocation\x88\x01\x01\x12$\n" +
+	"\x06budget\x18\x06 \x01(\v2\f.coloc.MoneyR\x06budget\x12\x1c\n" +
+	"\afund_id\x18\a \x01(\tH\x02R\x06fundId\x88\x01\x01B\x0e\n" +
 	"\f_descriptionB\v\n" +
-	"\t_locationB\t\n" +
-	"\a_budgetB\n" +
+	"\t_locationB\n" +
 	"\n" +
 	"\b_fund_id\"F\n" +
 	"\x0fGetEventRequest\x12#\n" +
@@ -1160,7 +1159,7 @@ const file_event_proto_rawDesc = "" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x94\x03\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x92\x03\n" +
 	"\x12UpdateEventRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x19\n" +
@@ -1168,15 +1167,14 @@ const file_event_proto_rawDesc = "" +
 	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\"\n" +
 	"\n" +
 	"event_date\x18\x05 \x01(\tH\x02R\teventDate\x88\x01\x01\x12\x1f\n" +
-	"\blocation\x18\x06 \x01(\tH\x03R\blocation\x88\x01\x01\x12\x1b\n" +
-	"\x06budget\x18\a \x01(\x01H\x04R\x06budget\x88\x01\x01\x12\x1c\n" +
-	"\afund_id\x18\b \x01(\tH\x05R\x06fundId\x88\x01\x01\x12/\n" +
-	"\x06status\x18\t \x01(\x0e2\x12.coloc.EventStatusH\x06R\x06status\x88\x01\x01B\b\n" +
+	"\blocation\x18\x06 \x01(\tH\x03R\blocation\x88\x01\x01\x12$\n" +
+	"\x06budget\x18\a \x01(\v2\f.coloc.MoneyR\x06budget\x12\x1c\n" +
+	"\afund_id\x18\b \x01(\tH\x04R\x06fundId\x88\x01\x01\x12/\n" +
+	"\x06status\x18\t \x01(\x0e2\x12.coloc.EventStatusH\x05R\x06status\x88\x01\x01B\b\n" +
 	"\x06_titleB\x0e\n" +
 	"\f_descriptionB\r\n" +
 	"\v_event_dateB\v\n" +
-	"\t_locationB\t\n" +
-	"\a_budgetB\n" +
+	"\t_locationB\n" +
 	"\n" +
 	"\b_fund_idB\t\n" +
 	"\a_status\"I\n" +
@@ -1211,7 +1209,7 @@ const file_event_proto_rawDesc = "" +
 	"\vrsvp_status\x18\x05 \x01(\x0e2\x11.coloc.RSVPStatusR\n" +
 	"rsvpStatus\x12!\n" +
 	"\fresponded_at\x18\x06 \x01(\tR\vrespondedAtB\r\n" +
-	"\v_avatar_url\"\xae\x05\n" +
+	"\v_avatar_url\"\xac\x05\n" +
 	"\x05Event\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
 	"\rcolocation_id\x18\x02 \x01(\tR\fcolocationId\x12\x1d\n" +
@@ -1223,11 +1221,11 @@ const file_event_proto_rawDesc = "" +
 	"\vdescription\x18\a \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1d\n" +
 	"\n" +
 	"event_date\x18\b \x01(\tR\teventDate\x12\x1f\n" +
-	"\blocation\x18\t \x01(\tH\x01R\blocation\x88\x01\x01\x12\x1b\n" +
+	"\blocation\x18\t \x01(\tH\x01R\blocation\x88\x01\x01\x12$\n" +
 	"\x06budget\x18\n" +
-	" \x01(\x01H\x02R\x06budget\x88\x01\x01\x12\x1c\n" +
-	"\afund_id\x18\v \x01(\tH\x03R\x06fundId\x88\x01\x01\x12 \n" +
-	"\tfund_name\x18\f \x01(\tH\x04R\bfundName\x88\x01\x01\x12*\n" +
+	" \x01(\v2\f.coloc.MoneyR\x06budget\x12\x1c\n" +
+	"\afund_id\x18\v \x01(\tH\x02R\x06fundId\x88\x01\x01\x12 \n" +
+	"\tfund_name\x18\f \x01(\tH\x03R\bfundName\x88\x01\x01\x12*\n" +
 	"\x06status\x18\r \x01(\x0e2\x12.coloc.EventStatusR\x06status\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x0e \x01(\tR\tcreatedAt\x12.\n" +
@@ -1238,8 +1236,7 @@ const file_event_proto_rawDesc = "" +
 	"maybeCount\x12&\n" +
 	"\x0fnot_going_count\x18\x12 \x01(\x05R\rnotGoingCountB\x0e\n" +
 	"\f_descriptionB\v\n" +
-	"\t_locationB\t\n" +
-	"\a_budgetB\n" +
+	"\t_locationB\n" +
 	"\n" +
 	"\b_fund_idB\f\n" +
 	"\n" +
@@ -1296,35 +1293,39 @@ var file_event_proto_goTypes = []any{
 	(*GetParticipantsResponse)(nil), // 12: coloc.GetParticipantsResponse
 	(*EventParticipant)(nil),        // 13: coloc.EventParticipant
 	(*Event)(nil),                   // 14: coloc.Event
+	(*Money)(nil),                   // 15: coloc.Money
 }
 var file_event_proto_depIdxs = []int32{
-	0,  // 0: coloc.ListEventsRequest.status:type_name -> coloc.EventStatus
-	14, // 1: coloc.ListEventsResponse.events:type_name -> coloc.Event
-	0,  // 2: coloc.UpdateEventRequest.status:type_name -> coloc.EventStatus
-	1,  // 3: coloc.RSVPRequest.status:type_name -> coloc.RSVPStatus
-	13, // 4: coloc.GetParticipantsResponse.participants:type_name -> coloc.EventParticipant
-	1,  // 5: coloc.EventParticipant.rsvp_status:type_name -> coloc.RSVPStatus
-	0,  // 6: coloc.Event.status:type_name -> coloc.EventStatus
-	1,  // 7: coloc.Event.user_rsvp:type_name -> coloc.RSVPStatus
-	2,  // 8: coloc.EventService.CreateEvent:input_type -> coloc.CreateEventRequest
-	3,  // 9: coloc.EventService.GetEvent:input_type -> coloc.GetEventRequest
-	4,  // 10: coloc.EventService.ListEvents:input_type -> coloc.ListEventsRequest
-	6,  // 11: coloc.EventService.UpdateEvent:input_type -> coloc.UpdateEventRequest
-	7,  // 12: coloc.EventService.DeleteEvent:input_type -> coloc.DeleteEventRequest
-	9,  // 13: coloc.EventService.RSVP:input_type -> coloc.RSVPRequest
-	11, // 14: coloc.EventService.GetParticipants:input_type -> coloc.GetParticipantsRequest
-	14, // 15: coloc.EventService.CreateEvent:output_type -> coloc.Event
-	14, // 16: coloc.EventService.GetEvent:output_type -> coloc.Event
-	5,  // 17: coloc.EventService.ListEvents:output_type -> coloc.ListEventsResponse
-	14, // 18: coloc.EventService.UpdateEvent:output_type -> coloc.Event
-	8,  // 19: coloc.EventService.DeleteEvent:output_type -> coloc.DeleteEventResponse
-	10, // 20: coloc.EventService.RSVP:output_type -> coloc.RSVPResponse
-	12, // 21: coloc.EventService.GetParticipants:output_type -> coloc.GetParticipantsResponse
-	15, // [15:22] is the sub-list for method output_type
-	8,  // [8:15] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	15, // 0: coloc.CreateEventRequest.budget:type_name -> coloc.Money
+	0,  // 1: coloc.ListEventsRequest.status:type_name -> coloc.EventStatus
+	14, // 2: coloc.ListEventsResponse.events:type_name -> coloc.Event
+	15, // 3: coloc.UpdateEventRequest.budget:type_name -> coloc.Money
+	0,  // 4: coloc.UpdateEventRequest.status:type_name -> coloc.EventStatus
+	1,  // 5: coloc.RSVPRequest.status:type_name -> coloc.RSVPStatus
+	13, // 6: coloc.GetParticipantsResponse.participants:type_name -> coloc.EventParticipant
+	1,  // 7: coloc.EventParticipant.rsvp_status:type_name -> coloc.RSVPStatus
+	15, // 8: coloc.Event.budget:type_name -> coloc.Money
+	0,  // 9: coloc.Event.status:type_name -> coloc.EventStatus
+	1,  // 10: coloc.Event.user_rsvp:type_name -> coloc.RSVPStatus
+	2,  // 11: coloc.EventService.CreateEvent:input_type -> coloc.CreateEventRequest
+	3,  // 12: coloc.EventService.GetEvent:input_type -> coloc.GetEventRequest
+	4,  // 13: coloc.EventService.ListEvents:input_type -> coloc.ListEventsRequest
+	6,  // 14: coloc.EventService.UpdateEvent:input_type -> coloc.UpdateEventRequest
+	7,  // 15: coloc.EventService.DeleteEvent:input_type -> coloc.DeleteEventRequest
+	9,  // 16: coloc.EventService.RSVP:input_type -> coloc.RSVPRequest
+	11, // 17: coloc.EventService.GetParticipants:input_type -> coloc.GetParticipantsRequest
+	14, // 18: coloc.EventService.CreateEvent:output_type -> coloc.Event
+	14, // 19: coloc.EventService.GetEvent:output_type -> coloc.Event
+	5,  // 20: coloc.EventService.ListEvents:output_type -> coloc.ListEventsResponse
+	14, // 21: coloc.EventService.UpdateEvent:output_type -> coloc.Event
+	8,  // 22: coloc.EventService.DeleteEvent:output_type -> coloc.DeleteEventResponse
+	10, // 23: coloc.EventService.RSVP:output_type -> coloc.RSVPResponse
+	12, // 24: coloc.EventService.GetParticipants:output_type -> coloc.GetParticipantsResponse
+	18, // [18:25] is the sub-list for method output_type
+	11, // [11:18] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_event_proto_init() }
@@ -1332,6 +1333,7 @@ func file_event_proto_init() {
 	if File_event_proto != nil {
 		return
 	}
+	file_common_proto_init()
 	file_event_proto_msgTypes[0].OneofWrappers = []any{}
 	file_event_proto_msgTypes[2].OneofWrappers = []any{}
 	file_event_proto_msgTypes[4].OneofWrappers = []any{}
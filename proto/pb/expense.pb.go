@@ -25,10 +25,13 @@ const (
 type SplitType int32
 
 const (
-	SplitType_SPLIT_TYPE_UNSPECIFIED SplitType = 0
-	SplitType_SPLIT_TYPE_EQUAL       SplitType = 1 // Equal split among all members
-	SplitType_SPLIT_TYPE_PERCENTAGE  SplitType = 2 // Custom percentage per member
-	SplitType_SPLIT_TYPE_CUSTOM      SplitType = 3 // Fixed amount per member
+	SplitType_SPLIT_TYPE_UNSPECIFIED    SplitType = 0
+	SplitType_SPLIT_TYPE_EQUAL          SplitType = 1 // Equal split among all members
+	SplitType_SPLIT_TYPE_PERCENTAGE     SplitType = 2 // Custom percentage per member
+	SplitType_SPLIT_TYPE_CUSTOM         SplitType = 3 // Fixed amount per member
+	SplitType_SPLIT_TYPE_ITEMIZED       SplitType = 4 // Derived from line items, not for recurring expenses
+	SplitType_SPLIT_TYPE_EQUAL_SELECTED SplitType = 5 // Equal split among the given participants
+	SplitType_SPLIT_TYPE_SHARES         SplitType = 6 // Integer shares per participant
 )
 
 // Enum value maps for SplitType.
@@ -38,12 +41,18 @@ var (
 		1: "SPLIT_TYPE_EQUAL",
 		2: "SPLIT_TYPE_PERCENTAGE",
 		3: "SPLIT_TYPE_CUSTOM",
+		4: "SPLIT_TYPE_ITEMIZED",
+		5: "SPLIT_TYPE_EQUAL_SELECTED",
+		6: "SPLIT_TYPE_SHARES",
 	}
 	SplitType_value = map[string]int32{
-		"SPLIT_TYPE_UNSPECIFIED": 0,
-		"SPLIT_TYPE_EQUAL":       1,
-		"SPLIT_TYPE_PERCENTAGE":  2,
-		"SPLIT_TYPE_CUSTOM":      3,
+		"SPLIT_TYPE_UNSPECIFIED":    0,
+		"SPLIT_TYPE_EQUAL":          1,
+		"SPLIT_TYPE_PERCENTAGE":     2,
+		"SPLIT_TYPE_CUSTOM":         3,
+		"SPLIT_TYPE_ITEMIZED":       4,
+		"SPLIT_TYPE_EQUAL_SELECTED": 5,
+		"SPLIT_TYPE_SHARES":         6,
 	}
 )
 
@@ -129,17 +138,229 @@ func (Recurrence) EnumDescriptor() ([]byte, []int) {
 	return file_expense_proto_rawDescGZIP(), []int{1}
 }
 
+type SplitSettlementStatus int32
+
+const (
+	SplitSettlementStatus_SPLIT_SETTLEMENT_STATUS_UNSPECIFIED       SplitSettlementStatus = 0
+	SplitSettlementStatus_SPLIT_SETTLEMENT_STATUS_UNSETTLED         SplitSettlementStatus = 1
+	SplitSettlementStatus_SPLIT_SETTLEMENT_STATUS_PARTIALLY_SETTLED SplitSettlementStatus = 2
+	SplitSettlementStatus_SPLIT_SETTLEMENT_STATUS_SETTLED           SplitSettlementStatus = 3 // Fully reimbursed, or paid by the participant
+)
+
+// Enum value maps for SplitSettlementStatus.
+var (
+	SplitSettlementStatus_name = map[int32]string{
+		0: "SPLIT_SETTLEMENT_STATUS_UNSPECIFIED",
+		1: "SPLIT_SETTLEMENT_STATUS_UNSETTLED",
+		2: "SPLIT_SETTLEMENT_STATUS_PARTIALLY_SETTLED",
+		3: "SPLIT_SETTLEMENT_STATUS_SETTLED",
+	}
+	SplitSettlementStatus_value = map[string]int32{
+		"SPLIT_SETTLEMENT_STATUS_UNSPECIFIED":       0,
+		"SPLIT_SETTLEMENT_STATUS_UNSETTLED":         1,
+		"SPLIT_SETTLEMENT_STATUS_PARTIALLY_SETTLED": 2,
+		"SPLIT_SETTLEMENT_STATUS_SETTLED":           3,
+	}
+)
+
+func (x SplitSettlementStatus) Enum() *SplitSettlementStatus {
+	p := new(SplitSettlementStatus)
+	*p = x
+	return p
+}
+
+func (x SplitSettlementStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SplitSettlementStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_expense_proto_enumTypes[2].Descriptor()
+}
+
+func (SplitSettlementStatus) Type() protoreflect.EnumType {
+	return &file_expense_proto_enumTypes[2]
+}
+
+func (x SplitSettlementStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SplitSettlementStatus.Descriptor instead.
+func (SplitSettlementStatus) EnumDescriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{2}
+}
+
+type RevisionAction int32
+
+const (
+	RevisionAction_REVISION_ACTION_UNSPECIFIED RevisionAction = 0
+	RevisionAction_REVISION_ACTION_CREATE      RevisionAction = 1
+	RevisionAction_REVISION_ACTION_UPDATE      RevisionAction = 2
+	RevisionAction_REVISION_ACTION_DELETE      RevisionAction = 3
+	RevisionAction_REVISION_ACTION_RESTORE     RevisionAction = 4
+)
+
+// Enum value maps for RevisionAction.
+var (
+	RevisionAction_name = map[int32]string{
+		0: "REVISION_ACTION_UNSPECIFIED",
+		1: "REVISION_ACTION_CREATE",
+		2: "REVISION_ACTION_UPDATE",
+		3: "REVISION_ACTION_DELETE",
+		4: "REVISION_ACTION_RESTORE",
+	}
+	RevisionAction_value = map[string]int32{
+		"REVISION_ACTION_UNSPECIFIED": 0,
+		"REVISION_ACTION_CREATE":      1,
+		"REVISION_ACTION_UPDATE":      2,
+		"REVISION_ACTION_DELETE":      3,
+		"REVISION_ACTION_RESTORE":     4,
+	}
+)
+
+func (x RevisionAction) Enum() *RevisionAction {
+	p := new(RevisionAction)
+	*p = x
+	return p
+}
+
+func (x RevisionAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RevisionAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_expense_proto_enumTypes[3].Descriptor()
+}
+
+func (RevisionAction) Type() protoreflect.EnumType {
+	return &file_expense_proto_enumTypes[3]
+}
+
+func (x RevisionAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RevisionAction.Descriptor instead.
+func (RevisionAction) EnumDescriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{3}
+}
+
+type OccurrenceStatus int32
+
+const (
+	OccurrenceStatus_OCCURRENCE_STATUS_UNSPECIFIED OccurrenceStatus = 0
+	OccurrenceStatus_OCCURRENCE_STATUS_SCHEDULED   OccurrenceStatus = 1
+	OccurrenceStatus_OCCURRENCE_STATUS_SKIPPED     OccurrenceStatus = 2
+	OccurrenceStatus_OCCURRENCE_STATUS_PAUSED      OccurrenceStatus = 3
+)
+
+// Enum value maps for OccurrenceStatus.
+var (
+	OccurrenceStatus_name = map[int32]string{
+		0: "OCCURRENCE_STATUS_UNSPECIFIED",
+		1: "OCCURRENCE_STATUS_SCHEDULED",
+		2: "OCCURRENCE_STATUS_SKIPPED",
+		3: "OCCURRENCE_STATUS_PAUSED",
+	}
+	OccurrenceStatus_value = map[string]int32{
+		"OCCURRENCE_STATUS_UNSPECIFIED": 0,
+		"OCCURRENCE_STATUS_SCHEDULED":   1,
+		"OCCURRENCE_STATUS_SKIPPED":     2,
+		"OCCURRENCE_STATUS_PAUSED":      3,
+	}
+)
+
+func (x OccurrenceStatus) Enum() *OccurrenceStatus {
+	p := new(OccurrenceStatus)
+	*p = x
+	return p
+}
+
+func (x OccurrenceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OccurrenceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_expense_proto_enumTypes[4].Descriptor()
+}
+
+func (OccurrenceStatus) Type() protoreflect.EnumType {
+	return &file_expense_proto_enumTypes[4]
+}
+
+func (x OccurrenceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OccurrenceStatus.Descriptor instead.
+func (OccurrenceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{4}
+}
+
+type ForecastMode int32
+
+const (
+	ForecastMode_FORECAST_MODE_UNSPECIFIED ForecastMode = 0 // Same as FORECAST_MODE_RECURRING
+	ForecastMode_FORECAST_MODE_RECURRING   ForecastMode = 1 // Recurring expenses plus the average of recent one-off expenses
+	ForecastMode_FORECAST_MODE_HISTORY     ForecastMode = 2 // Recurring expenses plus a seasonal model fitted on past one-off expenses
+)
+
+// Enum value maps for ForecastMode.
+var (
+	ForecastMode_name = map[int32]string{
+		0: "FORECAST_MODE_UNSPECIFIED",
+		1: "FORECAST_MODE_RECURRING",
+		2: "FORECAST_MODE_HISTORY",
+	}
+	ForecastMode_value = map[string]int32{
+		"FORECAST_MODE_UNSPECIFIED": 0,
+		"FORECAST_MODE_RECURRING":   1,
+		"FORECAST_MODE_HISTORY":     2,
+	}
+)
+
+func (x ForecastMode) Enum() *ForecastMode {
+	p := new(ForecastMode)
+	*p = x
+	return p
+}
+
+func (x ForecastMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ForecastMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_expense_proto_enumTypes[5].Descriptor()
+}
+
+func (ForecastMode) Type() protoreflect.EnumType {
+	return &file_expense_proto_enumTypes[5]
+}
+
+func (x ForecastMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ForecastMode.Descriptor instead.
+func (ForecastMode) EnumDescriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{5}
+}
+
 type ExpenseSplit struct {
 	state      protoimpl.MessageState `protogen:"open.v1"`
 	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount     float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`                       // Amount owed by this user
+	Amount     *Money                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`                         // Amount owed by this user
 	Percentage float64                `protobuf:"fixed64,3,opt,name=percentage,proto3" json:"percentage,omitempty"`               // Percentage (for SPLIT_TYPE_PERCENTAGE)
 	IsSettled  bool                   `protobuf:"varint,4,opt,name=is_settled,json=isSettled,proto3" json:"is_settled,omitempty"` // Whether this split has been settled
 	// User details
-	UserNom       string `protobuf:"bytes,5,opt,name=user_nom,json=userNom,proto3" json:"user_nom,omitempty"`
-	UserPrenom    string `protobuf:"bytes,6,opt,name=user_prenom,json=userPrenom,proto3" json:"user_prenom,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	UserNom          string                `protobuf:"bytes,5,opt,name=user_nom,json=userNom,proto3" json:"user_nom,omitempty"`
+	UserPrenom       string                `protobuf:"bytes,6,opt,name=user_prenom,json=userPrenom,proto3" json:"user_prenom,omitempty"`
+	BaseAmount       *Money                `protobuf:"bytes,7,opt,name=base_amount,json=baseAmount,proto3" json:"base_amount,omitempty"`          // Amount owed in the colocation base currency
+	Shares           int32                 `protobuf:"varint,8,opt,name=shares,proto3" json:"shares,omitempty"`                                   // Shares (for SPLIT_TYPE_SHARES)
+	SettledAmount    *Money                `protobuf:"bytes,9,opt,name=settled_amount,json=settledAmount,proto3" json:"settled_amount,omitempty"` // Confirmed payments allocated to this share, in the base currency
+	SettlementStatus SplitSettlementStatus `protobuf:"varint,10,opt,name=settlement_status,json=settlementStatus,proto3,enum=coloc.SplitSettlementStatus" json:"settlement_status,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ExpenseSplit) Reset() {
@@ -179,11 +400,11 @@ func (x *ExpenseSplit) GetUserId() string {
 	return ""
 }
 
-func (x *ExpenseSplit) GetAmount() float64 {
+func (x *ExpenseSplit) GetAmount() *Money {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return nil
 }
 
 func (x *ExpenseSplit) GetPercentage() float64 {
@@ -214,16 +435,49 @@ func (x *ExpenseSplit) GetUserPrenom() string {
 	return ""
 }
 
+func (x *ExpenseSplit) GetBaseAmount() *Money {
+	if x != nil {
+		return x.BaseAmount
+	}
+	return nil
+}
+
+func (x *ExpenseSplit) GetShares() int32 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *ExpenseSplit) GetSettledAmount() *Money {
+	if x != nil {
+		return x.SettledAmount
+	}
+	return nil
+}
+
+func (x *ExpenseSplit) GetSettlementStatus() SplitSettlementStatus {
+	if x != nil {
+		return x.SettlementStatus
+	}
+	return SplitSettlementStatus_SPLIT_SETTLEMENT_STATUS_UNSPECIFIED
+}
+
 type CreateExpenseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	CategoryId    string                 `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	SplitType     SplitType              `protobuf:"varint,6,opt,name=split_type,json=splitType,proto3,enum=coloc.SplitType" json:"split_type,omitempty"`
-	Splits        []*ExpenseSplitInput   `protobuf:"bytes,7,rep,name=splits,proto3" json:"splits,omitempty"`                              // Required for percentage/custom
-	ExpenseDate   string                 `protobuf:"bytes,8,opt,name=expense_date,json=expenseDate,proto3" json:"expense_date,omitempty"` // Format: YYYY-MM-DD
+	Splits        []*ExpenseSplitInput   `protobuf:"bytes,7,rep,name=splits,proto3" json:"splits,omitempty"`                                       // Required for percentage/custom/equal_selected/shares
+	ExpenseDate   string                 `protobuf:"bytes,8,opt,name=expense_date,json=expenseDate,proto3" json:"expense_date,omitempty"`          // Format: YYYY-MM-DD
+	ExchangeRate  *string                `protobuf:"bytes,9,opt,name=exchange_rate,json=exchangeRate,proto3,oneof" json:"exchange_rate,omitempty"` // Units of base currency per unit of amount.currency, overrides stored rates
+	Items         []*ExpenseItemInput    `protobuf:"bytes,10,rep,name=items,proto3" json:"items,omitempty"`                                        // Required for itemized, must sum to amount
+	PaidBy        *string                `protobuf:"bytes,11,opt,name=paid_by,json=paidBy,proto3,oneof" json:"paid_by,omitempty"`                  // Main payer, defaults to the current user or the largest contribution
+	Payers        []*ExpensePayerInput   `protobuf:"bytes,12,rep,name=payers,proto3" json:"payers,omitempty"`                                      // When several members paid, must sum to amount
+	TagIds        []string               `protobuf:"bytes,13,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -279,11 +533,11 @@ func (x *CreateExpenseRequest) GetDescription() string {
 	return ""
 }
 
-func (x *CreateExpenseRequest) GetAmount() float64 {
+func (x *CreateExpenseRequest) GetAmount() *Money {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return nil
 }
 
 func (x *CreateExpenseRequest) GetCategoryId() string {
@@ -314,11 +568,47 @@ func (x *CreateExpenseRequest) GetExpenseDate() string {
 	return ""
 }
 
+func (x *CreateExpenseRequest) GetExchangeRate() string {
+	if x != nil && x.ExchangeRate != nil {
+		return *x.ExchangeRate
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetItems() []*ExpenseItemInput {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateExpenseRequest) GetPaidBy() string {
+	if x != nil && x.PaidBy != nil {
+		return *x.PaidBy
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetPayers() []*ExpensePayerInput {
+	if x != nil {
+		return x.Payers
+	}
+	return nil
+}
+
+func (x *CreateExpenseRequest) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
 type ExpenseSplitInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`         // For SPLIT_TYPE_CUSTOM
+	Amount        *Money                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`           // For SPLIT_TYPE_CUSTOM
 	Percentage    float64                `protobuf:"fixed64,3,opt,name=percentage,proto3" json:"percentage,omitempty"` // For SPLIT_TYPE_PERCENTAGE
+	Shares        int32                  `protobuf:"varint,4,opt,name=shares,proto3" json:"shares,omitempty"`          // For SPLIT_TYPE_SHARES; SPLIT_TYPE_EQUAL_SELECTED only needs user_id
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -360,11 +650,11 @@ func (x *ExpenseSplitInput) GetUserId() string {
 	return ""
 }
 
-func (x *ExpenseSplitInput) GetAmount() float64 {
+func (x *ExpenseSplitInput) GetAmount() *Money {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return nil
 }
 
 func (x *ExpenseSplitInput) GetPercentage() float64 {
@@ -374,28 +664,35 @@ func (x *ExpenseSplitInput) GetPercentage() float64 {
 	return 0
 }
 
-type GetExpenseRequest struct {
+func (x *ExpenseSplitInput) GetShares() int32 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+type ExpensePayerInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExpenseRequest) Reset() {
-	*x = GetExpenseRequest{}
+func (x *ExpensePayerInput) Reset() {
+	*x = ExpensePayerInput{}
 	mi := &file_expense_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExpenseRequest) String() string {
+func (x *ExpensePayerInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExpenseRequest) ProtoMessage() {}
+func (*ExpensePayerInput) ProtoMessage() {}
 
-func (x *GetExpenseRequest) ProtoReflect() protoreflect.Message {
+func (x *ExpensePayerInput) ProtoReflect() protoreflect.Message {
 	mi := &file_expense_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -407,52 +704,51 @@ func (x *GetExpenseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExpenseRequest.ProtoReflect.Descriptor instead.
-func (*GetExpenseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExpensePayerInput.ProtoReflect.Descriptor instead.
+func (*ExpensePayerInput) Descriptor() ([]byte, []int) {
 	return file_expense_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetExpenseRequest) GetColocationId() string {
+func (x *ExpensePayerInput) GetUserId() string {
 	if x != nil {
-		return x.ColocationId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetExpenseRequest) GetId() string {
+func (x *ExpensePayerInput) GetAmount() *Money {
 	if x != nil {
-		return x.Id
+		return x.Amount
 	}
-	return ""
+	return nil
 }
 
-type ListExpensesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
-	CategoryId    *string                `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
-	PaidBy        *string                `protobuf:"bytes,3,opt,name=paid_by,json=paidBy,proto3,oneof" json:"paid_by,omitempty"`
-	StartDate     *string                `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
-	EndDate       *string                `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
-	Page          *int32                 `protobuf:"varint,6,opt,name=page,proto3,oneof" json:"page,omitempty"`
-	PageSize      *int32                 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+type ExpensePayer struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount     *Money                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`                           // Contribution in the expense currency
+	BaseAmount *Money                 `protobuf:"bytes,3,opt,name=base_amount,json=baseAmount,proto3" json:"base_amount,omitempty"` // Contribution in the colocation base currency
+	// User details
+	UserNom       string `protobuf:"bytes,4,opt,name=user_nom,json=userNom,proto3" json:"user_nom,omitempty"`
+	UserPrenom    string `protobuf:"bytes,5,opt,name=user_prenom,json=userPrenom,proto3" json:"user_prenom,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListExpensesRequest) Reset() {
-	*x = ListExpensesRequest{}
+func (x *ExpensePayer) Reset() {
+	*x = ExpensePayer{}
 	mi := &file_expense_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListExpensesRequest) String() string {
+func (x *ExpensePayer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExpensesRequest) ProtoMessage() {}
+func (*ExpensePayer) ProtoMessage() {}
 
-func (x *ListExpensesRequest) ProtoReflect() protoreflect.Message {
+func (x *ExpensePayer) ProtoReflect() protoreflect.Message {
 	mi := &file_expense_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -464,84 +760,70 @@ func (x *ListExpensesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExpensesRequest.ProtoReflect.Descriptor instead.
-func (*ListExpensesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExpensePayer.ProtoReflect.Descriptor instead.
+func (*ExpensePayer) Descriptor() ([]byte, []int) {
 	return file_expense_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListExpensesRequest) GetColocationId() string {
+func (x *ExpensePayer) GetUserId() string {
 	if x != nil {
-		return x.ColocationId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *ListExpensesRequest) GetCategoryId() string {
-	if x != nil && x.CategoryId != nil {
-		return *x.CategoryId
+func (x *ExpensePayer) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
 	}
-	return ""
+	return nil
 }
 
-func (x *ListExpensesRequest) GetPaidBy() string {
-	if x != nil && x.PaidBy != nil {
-		return *x.PaidBy
+func (x *ExpensePayer) GetBaseAmount() *Money {
+	if x != nil {
+		return x.BaseAmount
 	}
-	return ""
+	return nil
 }
 
-func (x *ListExpensesRequest) GetStartDate() string {
-	if x != nil && x.StartDate != nil {
-		return *x.StartDate
+func (x *ExpensePayer) GetUserNom() string {
+	if x != nil {
+		return x.UserNom
 	}
 	return ""
 }
 
-func (x *ListExpensesRequest) GetEndDate() string {
-	if x != nil && x.EndDate != nil {
-		return *x.EndDate
+func (x *ExpensePayer) GetUserPrenom() string {
+	if x != nil {
+		return x.UserPrenom
 	}
 	return ""
 }
 
-func (x *ListExpensesRequest) GetPage() int32 {
-	if x != nil && x.Page != nil {
-		return *x.Page
-	}
-	return 0
-}
-
-func (x *ListExpensesRequest) GetPageSize() int32 {
-	if x != nil && x.PageSize != nil {
-		return *x.PageSize
-	}
-	return 0
-}
-
-type ListExpensesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Expenses      []*Expense             `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ExpenseItemInput struct {
+	state          protoimpl.MessageState         `protogen:"open.v1"`
+	Label          string                         `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Amount         *Money                         `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	IsSharedCharge bool                           `protobuf:"varint,3,opt,name=is_shared_charge,json=isSharedCharge,proto3" json:"is_shared_charge,omitempty"` // Tax or tip: no participants, distributed proportionally to the other items
+	Participants   []*ExpenseItemParticipantInput `protobuf:"bytes,4,rep,name=participants,proto3" json:"participants,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListExpensesResponse) Reset() {
-	*x = ListExpensesResponse{}
+func (x *ExpenseItemInput) Reset() {
+	*x = ExpenseItemInput{}
 	mi := &file_expense_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListExpensesResponse) String() string {
+func (x *ExpenseItemInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExpensesResponse) ProtoMessage() {}
+func (*ExpenseItemInput) ProtoMessage() {}
 
-func (x *ListExpensesResponse) ProtoReflect() protoreflect.Message {
+func (x *ExpenseItemInput) ProtoReflect() protoreflect.Message {
 	mi := &file_expense_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -553,68 +835,61 @@ func (x *ListExpensesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExpensesResponse.ProtoReflect.Descriptor instead.
-func (*ListExpensesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExpenseItemInput.ProtoReflect.Descriptor instead.
+func (*ExpenseItemInput) Descriptor() ([]byte, []int) {
 	return file_expense_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListExpensesResponse) GetExpenses() []*Expense {
+func (x *ExpenseItemInput) GetLabel() string {
 	if x != nil {
-		return x.Expenses
+		return x.Label
 	}
-	return nil
+	return ""
 }
 
-func (x *ListExpensesResponse) GetTotalCount() int32 {
+func (x *ExpenseItemInput) GetAmount() *Money {
 	if x != nil {
-		return x.TotalCount
+		return x.Amount
 	}
-	return 0
+	return nil
 }
 
-func (x *ListExpensesResponse) GetPage() int32 {
+func (x *ExpenseItemInput) GetIsSharedCharge() bool {
 	if x != nil {
-		return x.Page
+		return x.IsSharedCharge
 	}
-	return 0
+	return false
 }
 
-func (x *ListExpensesResponse) GetPageSize() int32 {
+func (x *ExpenseItemInput) GetParticipants() []*ExpenseItemParticipantInput {
 	if x != nil {
-		return x.PageSize
+		return x.Participants
 	}
-	return 0
+	return nil
 }
 
-type UpdateExpenseRequest struct {
+type ExpenseItemParticipantInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Title         *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Amount        *float64               `protobuf:"fixed64,5,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
-	CategoryId    *string                `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
-	SplitType     *SplitType             `protobuf:"varint,7,opt,name=split_type,json=splitType,proto3,enum=coloc.SplitType,oneof" json:"split_type,omitempty"`
-	Splits        []*ExpenseSplitInput   `protobuf:"bytes,8,rep,name=splits,proto3" json:"splits,omitempty"`
-	ExpenseDate   *string                `protobuf:"bytes,9,opt,name=expense_date,json=expenseDate,proto3,oneof" json:"expense_date,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Weight        float64                `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"` // Share weight, 1 when unset
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateExpenseRequest) Reset() {
-	*x = UpdateExpenseRequest{}
+func (x *ExpenseItemParticipantInput) Reset() {
+	*x = ExpenseItemParticipantInput{}
 	mi := &file_expense_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateExpenseRequest) String() string {
+func (x *ExpenseItemParticipantInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateExpenseRequest) ProtoMessage() {}
+func (*ExpenseItemParticipantInput) ProtoMessage() {}
 
-func (x *UpdateExpenseRequest) ProtoReflect() protoreflect.Message {
+func (x *ExpenseItemParticipantInput) ProtoReflect() protoreflect.Message {
 	mi := &file_expense_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -626,97 +901,127 @@ func (x *UpdateExpenseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateExpenseRequest.ProtoReflect.Descriptor instead.
-func (*UpdateExpenseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExpenseItemParticipantInput.ProtoReflect.Descriptor instead.
+func (*ExpenseItemParticipantInput) Descriptor() ([]byte, []int) {
 	return file_expense_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UpdateExpenseRequest) GetColocationId() string {
+func (x *ExpenseItemParticipantInput) GetUserId() string {
 	if x != nil {
-		return x.ColocationId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateExpenseRequest) GetId() string {
+func (x *ExpenseItemParticipantInput) GetWeight() float64 {
 	if x != nil {
-		return x.Id
+		return x.Weight
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateExpenseRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
-	}
-	return ""
+type ExpenseItem struct {
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	Id             string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label          string                    `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Amount         *Money                    `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	IsSharedCharge bool                      `protobuf:"varint,4,opt,name=is_shared_charge,json=isSharedCharge,proto3" json:"is_shared_charge,omitempty"`
+	Participants   []*ExpenseItemParticipant `protobuf:"bytes,5,rep,name=participants,proto3" json:"participants,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateExpenseRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
+func (x *ExpenseItem) Reset() {
+	*x = ExpenseItem{}
+	mi := &file_expense_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateExpenseRequest) GetAmount() float64 {
-	if x != nil && x.Amount != nil {
-		return *x.Amount
+func (*ExpenseItem) ProtoMessage() {}
+
+func (x *ExpenseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateExpenseRequest) GetCategoryId() string {
-	if x != nil && x.CategoryId != nil {
-		return *x.CategoryId
+// Deprecated: Use ExpenseItem.ProtoReflect.Descriptor instead.
+func (*ExpenseItem) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExpenseItem) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateExpenseRequest) GetSplitType() SplitType {
-	if x != nil && x.SplitType != nil {
-		return *x.SplitType
+func (x *ExpenseItem) GetLabel() string {
+	if x != nil {
+		return x.Label
 	}
-	return SplitType_SPLIT_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *UpdateExpenseRequest) GetSplits() []*ExpenseSplitInput {
+func (x *ExpenseItem) GetAmount() *Money {
 	if x != nil {
-		return x.Splits
+		return x.Amount
 	}
 	return nil
 }
 
-func (x *UpdateExpenseRequest) GetExpenseDate() string {
-	if x != nil && x.ExpenseDate != nil {
-		return *x.ExpenseDate
+func (x *ExpenseItem) GetIsSharedCharge() bool {
+	if x != nil {
+		return x.IsSharedCharge
 	}
-	return ""
+	return false
 }
 
-type DeleteExpenseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+func (x *ExpenseItem) GetParticipants() []*ExpenseItemParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+type ExpenseItemParticipant struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Weight float64                `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	// User details
+	UserNom       string `protobuf:"bytes,3,opt,name=user_nom,json=userNom,proto3" json:"user_nom,omitempty"`
+	UserPrenom    string `protobuf:"bytes,4,opt,name=user_prenom,json=userPrenom,proto3" json:"user_prenom,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteExpenseRequest) Reset() {
-	*x = DeleteExpenseRequest{}
-	mi := &file_expense_proto_msgTypes[7]
+func (x *ExpenseItemParticipant) Reset() {
+	*x = ExpenseItemParticipant{}
+	mi := &file_expense_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteExpenseRequest) String() string {
+func (x *ExpenseItemParticipant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteExpenseRequest) ProtoMessage() {}
+func (*ExpenseItemParticipant) ProtoMessage() {}
 
-func (x *DeleteExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_proto_msgTypes[7]
+func (x *ExpenseItemParticipant) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -727,47 +1032,62 @@ func (x *DeleteExpenseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteExpenseRequest.ProtoReflect.Descriptor instead.
-func (*DeleteExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_expense_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ExpenseItemParticipant.ProtoReflect.Descriptor instead.
+func (*ExpenseItemParticipant) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DeleteExpenseRequest) GetColocationId() string {
+func (x *ExpenseItemParticipant) GetUserId() string {
 	if x != nil {
-		return x.ColocationId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *DeleteExpenseRequest) GetId() string {
+func (x *ExpenseItemParticipant) GetWeight() float64 {
 	if x != nil {
-		return x.Id
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ExpenseItemParticipant) GetUserNom() string {
+	if x != nil {
+		return x.UserNom
 	}
 	return ""
 }
 
-type DeleteExpenseResponse struct {
+func (x *ExpenseItemParticipant) GetUserPrenom() string {
+	if x != nil {
+		return x.UserPrenom
+	}
+	return ""
+}
+
+type GetExpenseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteExpenseResponse) Reset() {
-	*x = DeleteExpenseResponse{}
-	mi := &file_expense_proto_msgTypes[8]
+func (x *GetExpenseRequest) Reset() {
+	*x = GetExpenseRequest{}
+	mi := &file_expense_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteExpenseResponse) String() string {
+func (x *GetExpenseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteExpenseResponse) ProtoMessage() {}
+func (*GetExpenseRequest) ProtoMessage() {}
 
-func (x *DeleteExpenseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_proto_msgTypes[8]
+func (x *GetExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -778,54 +1098,55 @@ func (x *DeleteExpenseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteExpenseResponse.ProtoReflect.Descriptor instead.
-func (*DeleteExpenseResponse) Descriptor() ([]byte, []int) {
-	return file_expense_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetExpenseRequest.ProtoReflect.Descriptor instead.
+func (*GetExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteExpenseResponse) GetSuccess() bool {
+func (x *GetExpenseRequest) GetColocationId() string {
 	if x != nil {
-		return x.Success
+		return x.ColocationId
 	}
-	return false
+	return ""
 }
 
-type Expense struct {
+func (x *GetExpenseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListExpensesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ColocationId  string                 `protobuf:"bytes,2,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
-	PaidBy        string                 `protobuf:"bytes,3,opt,name=paid_by,json=paidBy,proto3" json:"paid_by,omitempty"`
-	PaidByNom     string                 `protobuf:"bytes,4,opt,name=paid_by_nom,json=paidByNom,proto3" json:"paid_by_nom,omitempty"`
-	PaidByPrenom  string                 `protobuf:"bytes,5,opt,name=paid_by_prenom,json=paidByPrenom,proto3" json:"paid_by_prenom,omitempty"`
-	CategoryId    string                 `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	CategoryName  string                 `protobuf:"bytes,7,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
-	Title         string                 `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,9,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Amount        float64                `protobuf:"fixed64,10,opt,name=amount,proto3" json:"amount,omitempty"`
-	SplitType     SplitType              `protobuf:"varint,11,opt,name=split_type,json=splitType,proto3,enum=coloc.SplitType" json:"split_type,omitempty"`
-	ExpenseDate   string                 `protobuf:"bytes,12,opt,name=expense_date,json=expenseDate,proto3" json:"expense_date,omitempty"`
-	RecurringId   *string                `protobuf:"bytes,13,opt,name=recurring_id,json=recurringId,proto3,oneof" json:"recurring_id,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Splits        []*ExpenseSplit        `protobuf:"bytes,15,rep,name=splits,proto3" json:"splits,omitempty"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	CategoryId    *string                `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	PaidBy        *string                `protobuf:"bytes,3,opt,name=paid_by,json=paidBy,proto3,oneof" json:"paid_by,omitempty"`
+	StartDate     *string                `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	EndDate       *string                `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	Page          *int32                 `protobuf:"varint,6,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	TagIds        []string               `protobuf:"bytes,8,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`                      // Expenses with any of these tags
+	MatchAllTags  bool                   `protobuf:"varint,9,opt,name=match_all_tags,json=matchAllTags,proto3" json:"match_all_tags,omitempty"` // Expenses with all of them instead
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Expense) Reset() {
-	*x = Expense{}
-	mi := &file_expense_proto_msgTypes[9]
+func (x *ListExpensesRequest) Reset() {
+	*x = ListExpensesRequest{}
+	mi := &file_expense_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Expense) String() string {
+func (x *ListExpensesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Expense) ProtoMessage() {}
+func (*ListExpensesRequest) ProtoMessage() {}
 
-func (x *Expense) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_proto_msgTypes[9]
+func (x *ListExpensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -836,147 +1157,178 @@ func (x *Expense) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Expense.ProtoReflect.Descriptor instead.
-func (*Expense) Descriptor() ([]byte, []int) {
-	return file_expense_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *Expense) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use ListExpensesRequest.ProtoReflect.Descriptor instead.
+func (*ListExpensesRequest) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Expense) GetColocationId() string {
+func (x *ListExpensesRequest) GetColocationId() string {
 	if x != nil {
 		return x.ColocationId
 	}
 	return ""
 }
 
-func (x *Expense) GetPaidBy() string {
-	if x != nil {
-		return x.PaidBy
-	}
-	return ""
-}
-
-func (x *Expense) GetPaidByNom() string {
-	if x != nil {
-		return x.PaidByNom
+func (x *ListExpensesRequest) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
 	}
 	return ""
 }
 
-func (x *Expense) GetPaidByPrenom() string {
-	if x != nil {
-		return x.PaidByPrenom
+func (x *ListExpensesRequest) GetPaidBy() string {
+	if x != nil && x.PaidBy != nil {
+		return *x.PaidBy
 	}
 	return ""
 }
 
-func (x *Expense) GetCategoryId() string {
-	if x != nil {
-		return x.CategoryId
+func (x *ListExpensesRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
 	}
 	return ""
 }
 
-func (x *Expense) GetCategoryName() string {
-	if x != nil {
-		return x.CategoryName
+func (x *ListExpensesRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
 	}
 	return ""
 }
 
-func (x *Expense) GetTitle() string {
-	if x != nil {
-		return x.Title
+func (x *ListExpensesRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *Expense) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *ListExpensesRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *Expense) GetAmount() float64 {
+func (x *ListExpensesRequest) GetTagIds() []string {
 	if x != nil {
-		return x.Amount
+		return x.TagIds
 	}
-	return 0
+	return nil
 }
 
-func (x *Expense) GetSplitType() SplitType {
+func (x *ListExpensesRequest) GetMatchAllTags() bool {
 	if x != nil {
-		return x.SplitType
+		return x.MatchAllTags
 	}
-	return SplitType_SPLIT_TYPE_UNSPECIFIED
+	return false
 }
 
-func (x *Expense) GetExpenseDate() string {
+type ListExpensesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expenses      []*Expense             `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpensesResponse) Reset() {
+	*x = ListExpensesResponse{}
+	mi := &file_expense_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpensesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpensesResponse) ProtoMessage() {}
+
+func (x *ListExpensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[11]
 	if x != nil {
-		return x.ExpenseDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Expense) GetRecurringId() string {
-	if x != nil && x.RecurringId != nil {
-		return *x.RecurringId
+// Deprecated: Use ListExpensesResponse.ProtoReflect.Descriptor instead.
+func (*ListExpensesResponse) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListExpensesResponse) GetExpenses() []*Expense {
+	if x != nil {
+		return x.Expenses
 	}
-	return ""
+	return nil
 }
 
-func (x *Expense) GetCreatedAt() string {
+func (x *ListExpensesResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-func (x *Expense) GetSplits() []*ExpenseSplit {
+func (x *ListExpensesResponse) GetPage() int32 {
 	if x != nil {
-		return x.Splits
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-type CreateRecurringExpenseRequest struct {
+func (x *ListExpensesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type UpdateExpenseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	CategoryId    string                 `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	SplitType     SplitType              `protobuf:"varint,6,opt,name=split_type,json=splitType,proto3,enum=coloc.SplitType" json:"split_type,omitempty"`
-	Splits        []*ExpenseSplitInput   `protobuf:"bytes,7,rep,name=splits,proto3" json:"splits,omitempty"`
-	Recurrence    Recurrence             `protobuf:"varint,8,opt,name=recurrence,proto3,enum=coloc.Recurrence" json:"recurrence,omitempty"`
-	StartDate     string                 `protobuf:"bytes,9,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       *string                `protobuf:"bytes,10,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Title         *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	CategoryId    *string                `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	SplitType     *SplitType             `protobuf:"varint,7,opt,name=split_type,json=splitType,proto3,enum=coloc.SplitType,oneof" json:"split_type,omitempty"`
+	Splits        []*ExpenseSplitInput   `protobuf:"bytes,8,rep,name=splits,proto3" json:"splits,omitempty"`
+	ExpenseDate   *string                `protobuf:"bytes,9,opt,name=expense_date,json=expenseDate,proto3,oneof" json:"expense_date,omitempty"`
+	ExchangeRate  *string                `protobuf:"bytes,10,opt,name=exchange_rate,json=exchangeRate,proto3,oneof" json:"exchange_rate,omitempty"` // Replaces the rate frozen on the expense
+	Items         []*ExpenseItemInput    `protobuf:"bytes,11,rep,name=items,proto3" json:"items,omitempty"`                                         // Itemized expenses, the current items are kept when empty
+	PaidBy        *string                `protobuf:"bytes,12,opt,name=paid_by,json=paidBy,proto3,oneof" json:"paid_by,omitempty"`
+	Payers        []*ExpensePayerInput   `protobuf:"bytes,13,rep,name=payers,proto3" json:"payers,omitempty"`                         // Replaces the contributions, they are kept when empty
+	TagIds        []string               `protobuf:"bytes,14,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`           // Replaces the tags, they are kept when empty
+	ClearTags     bool                   `protobuf:"varint,15,opt,name=clear_tags,json=clearTags,proto3" json:"clear_tags,omitempty"` // Removes all the tags
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRecurringExpenseRequest) Reset() {
-	*x = CreateRecurringExpenseRequest{}
-	mi := &file_expense_proto_msgTypes[10]
+func (x *UpdateExpenseRequest) Reset() {
+	*x = UpdateExpenseRequest{}
+	mi := &file_expense_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRecurringExpenseRequest) String() string {
+func (x *UpdateExpenseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRecurringExpenseRequest) ProtoMessage() {}
+func (*UpdateExpenseRequest) ProtoMessage() {}
 
-func (x *CreateRecurringExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_proto_msgTypes[10]
+func (x *UpdateExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -987,103 +1339,139 @@ func (x *CreateRecurringExpenseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRecurringExpenseRequest.ProtoReflect.Descriptor instead.
-func (*CreateRecurringExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_expense_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UpdateExpenseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CreateRecurringExpenseRequest) GetColocationId() string {
+func (x *UpdateExpenseRequest) GetColocationId() string {
 	if x != nil {
 		return x.ColocationId
 	}
 	return ""
 }
 
-func (x *CreateRecurringExpenseRequest) GetTitle() string {
+func (x *UpdateExpenseRequest) GetId() string {
 	if x != nil {
-		return x.Title
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateRecurringExpenseRequest) GetDescription() string {
+func (x *UpdateExpenseRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateExpenseRequest) GetDescription() string {
 	if x != nil && x.Description != nil {
 		return *x.Description
 	}
 	return ""
 }
 
-func (x *CreateRecurringExpenseRequest) GetAmount() float64 {
+func (x *UpdateExpenseRequest) GetAmount() *Money {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateRecurringExpenseRequest) GetCategoryId() string {
-	if x != nil {
-		return x.CategoryId
+func (x *UpdateExpenseRequest) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
 	}
 	return ""
 }
 
-func (x *CreateRecurringExpenseRequest) GetSplitType() SplitType {
-	if x != nil {
-		return x.SplitType
+func (x *UpdateExpenseRequest) GetSplitType() SplitType {
+	if x != nil && x.SplitType != nil {
+		return *x.SplitType
 	}
 	return SplitType_SPLIT_TYPE_UNSPECIFIED
 }
 
-func (x *CreateRecurringExpenseRequest) GetSplits() []*ExpenseSplitInput {
+func (x *UpdateExpenseRequest) GetSplits() []*ExpenseSplitInput {
 	if x != nil {
 		return x.Splits
 	}
 	return nil
 }
 
-func (x *CreateRecurringExpenseRequest) GetRecurrence() Recurrence {
-	if x != nil {
-		return x.Recurrence
+func (x *UpdateExpenseRequest) GetExpenseDate() string {
+	if x != nil && x.ExpenseDate != nil {
+		return *x.ExpenseDate
 	}
-	return Recurrence_RECURRENCE_UNSPECIFIED
+	return ""
 }
 
-func (x *CreateRecurringExpenseRequest) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
+func (x *UpdateExpenseRequest) GetExchangeRate() string {
+	if x != nil && x.ExchangeRate != nil {
+		return *x.ExchangeRate
 	}
 	return ""
 }
 
-func (x *CreateRecurringExpenseRequest) GetEndDate() string {
-	if x != nil && x.EndDate != nil {
-		return *x.EndDate
+func (x *UpdateExpenseRequest) GetItems() []*ExpenseItemInput {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *UpdateExpenseRequest) GetPaidBy() string {
+	if x != nil && x.PaidBy != nil {
+		return *x.PaidBy
 	}
 	return ""
 }
 
-type ListRecurringExpensesRequest struct {
+func (x *UpdateExpenseRequest) GetPayers() []*ExpensePayerInput {
+	if x != nil {
+		return x.Payers
+	}
+	return nil
+}
+
+func (x *UpdateExpenseRequest) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *UpdateExpenseRequest) GetClearTags() bool {
+	if x != nil {
+		return x.ClearTags
+	}
+	return false
+}
+
+type DeleteExpenseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRecurringExpensesRequest) Reset() {
-	*x = ListRecurringExpensesRequest{}
-	mi := &file_expense_proto_msgTypes[11]
+func (x *DeleteExpenseRequest) Reset() {
+	*x = DeleteExpenseRequest{}
+	mi := &file_expense_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRecurringExpensesRequest) String() string {
+func (x *DeleteExpenseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRecurringExpensesRequest) ProtoMessage() {}
+func (*DeleteExpenseRequest) ProtoMessage() {}
 
-func (x *ListRecurringExpensesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_proto_msgTypes[11]
+func (x *DeleteExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1094,40 +1482,48 @@ func (x *ListRecurringExpensesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRecurringExpensesRequest.ProtoReflect.Descriptor instead.
-func (*ListRecurringExpensesRequest) Descriptor() ([]byte, []int) {
-	return file_expense_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use DeleteExpenseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListRecurringExpensesRequest) GetColocationId() string {
+func (x *DeleteExpenseRequest) GetColocationId() string {
 	if x != nil {
 		return x.ColocationId
 	}
 	return ""
 }
 
-type ListRecurringExpensesResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	RecurringExpenses []*RecurringExpense    `protobuf:"bytes,1,rep,name=recurring_expenses,json=recurringExpenses,proto3" json:"recurring_expenses,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *DeleteExpenseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (x *ListRecurringExpensesResponse) Reset() {
-	*x = ListRecurringExpensesResponse{}
-	mi := &file_expense_proto_msgTypes[12]
+type DeleteExpenseResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	RestorableUntil string                 `protobuf:"bytes,2,opt,name=restorable_until,json=restorableUntil,proto3" json:"restorable_until,omitempty"` // The expense can be restored until this date
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteExpenseResponse) Reset() {
+	*x = DeleteExpenseResponse{}
+	mi := &file_expense_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRecurringExpensesResponse) String() string {
+func (x *DeleteExpenseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRecurringExpensesResponse) ProtoMessage() {}
+func (*DeleteExpenseResponse) ProtoMessage() {}
 
-func (x *ListRecurringExpensesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_proto_msgTypes[12]
+func (x *DeleteExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1138,50 +1534,929 @@ func (x *ListRecurringExpensesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRecurringExpensesResponse.ProtoReflect.Descriptor instead.
-func (*ListRecurringExpensesResponse) Descriptor() ([]byte, []int) {
-	return file_expense_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DeleteExpenseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListRecurringExpensesResponse) GetRecurringExpenses() []*RecurringExpense {
+func (x *DeleteExpenseResponse) GetSuccess() bool {
 	if x != nil {
-		return x.RecurringExpenses
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type UpdateRecurringExpenseRequest struct {
+func (x *DeleteExpenseResponse) GetRestorableUntil() string {
+	if x != nil {
+		return x.RestorableUntil
+	}
+	return ""
+}
+
+type RestoreExpenseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
 	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Title         *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Amount        *float64               `protobuf:"fixed64,5,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
-	CategoryId    *string                `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
-	SplitType     *SplitType             `protobuf:"varint,7,opt,name=split_type,json=splitType,proto3,enum=coloc.SplitType,oneof" json:"split_type,omitempty"`
-	Splits        []*ExpenseSplitInput   `protobuf:"bytes,8,rep,name=splits,proto3" json:"splits,omitempty"`
-	Recurrence    *Recurrence            `protobuf:"varint,9,opt,name=recurrence,proto3,enum=coloc.Recurrence,oneof" json:"recurrence,omitempty"`
-	EndDate       *string                `protobuf:"bytes,10,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
-	IsActive      *bool                  `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRecurringExpenseRequest) Reset() {
-	*x = UpdateRecurringExpenseRequest{}
-	mi := &file_expense_proto_msgTypes[13]
+func (x *RestoreExpenseRequest) Reset() {
+	*x = RestoreExpenseRequest{}
+	mi := &file_expense_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRecurringExpenseRequest) String() string {
+func (x *RestoreExpenseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRecurringExpenseRequest) ProtoMessage() {}
+func (*RestoreExpenseRequest) ProtoMessage() {}
 
-func (x *UpdateRecurringExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_proto_msgTypes[13]
+func (x *RestoreExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreExpenseRequest.ProtoReflect.Descriptor instead.
+func (*RestoreExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RestoreExpenseRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *RestoreExpenseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListDeletedExpensesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedExpensesRequest) Reset() {
+	*x = ListDeletedExpensesRequest{}
+	mi := &file_expense_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedExpensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedExpensesRequest) ProtoMessage() {}
+
+func (x *ListDeletedExpensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedExpensesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedExpensesRequest) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListDeletedExpensesRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+type ListDeletedExpensesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expenses      []*Expense             `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"` // Most recently deleted first, without splits
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedExpensesResponse) Reset() {
+	*x = ListDeletedExpensesResponse{}
+	mi := &file_expense_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedExpensesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedExpensesResponse) ProtoMessage() {}
+
+func (x *ListDeletedExpensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedExpensesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedExpensesResponse) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListDeletedExpensesResponse) GetExpenses() []*Expense {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+type Expense struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ColocationId    string                 `protobuf:"bytes,2,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	PaidBy          string                 `protobuf:"bytes,3,opt,name=paid_by,json=paidBy,proto3" json:"paid_by,omitempty"`
+	PaidByNom       string                 `protobuf:"bytes,4,opt,name=paid_by_nom,json=paidByNom,proto3" json:"paid_by_nom,omitempty"`
+	PaidByPrenom    string                 `protobuf:"bytes,5,opt,name=paid_by_prenom,json=paidByPrenom,proto3" json:"paid_by_prenom,omitempty"`
+	CategoryId      string                 `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName    string                 `protobuf:"bytes,7,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Title           string                 `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
+	Description     *string                `protobuf:"bytes,9,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Amount          *Money                 `protobuf:"bytes,10,opt,name=amount,proto3" json:"amount,omitempty"`
+	SplitType       SplitType              `protobuf:"varint,11,opt,name=split_type,json=splitType,proto3,enum=coloc.SplitType" json:"split_type,omitempty"`
+	ExpenseDate     string                 `protobuf:"bytes,12,opt,name=expense_date,json=expenseDate,proto3" json:"expense_date,omitempty"`
+	RecurringId     *string                `protobuf:"bytes,13,opt,name=recurring_id,json=recurringId,proto3,oneof" json:"recurring_id,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Splits          []*ExpenseSplit        `protobuf:"bytes,15,rep,name=splits,proto3" json:"splits,omitempty"`
+	BaseAmount      *Money                 `protobuf:"bytes,16,opt,name=base_amount,json=baseAmount,proto3" json:"base_amount,omitempty"`                      // Amount in the colocation base currency
+	ExchangeRate    string                 `protobuf:"bytes,17,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`                // Rate frozen at creation (1 when in base currency)
+	Items           []*ExpenseItem         `protobuf:"bytes,18,rep,name=items,proto3" json:"items,omitempty"`                                                  // Line items of itemized expenses, only returned by GetExpense
+	CreatedBy       string                 `protobuf:"bytes,19,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                         // Member who recorded the expense
+	Payers          []*ExpensePayer        `protobuf:"bytes,20,rep,name=payers,proto3" json:"payers,omitempty"`                                                // Contributions of the payers, paid_by is the main one
+	DeletedAt       *string                `protobuf:"bytes,21,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`                   // Set on deleted expenses
+	RestorableUntil *string                `protobuf:"bytes,22,opt,name=restorable_until,json=restorableUntil,proto3,oneof" json:"restorable_until,omitempty"` // Set on deleted expenses
+	Tags            []*Tag                 `protobuf:"bytes,23,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Expense) Reset() {
+	*x = Expense{}
+	mi := &file_expense_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Expense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Expense) ProtoMessage() {}
+
+func (x *Expense) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Expense.ProtoReflect.Descriptor instead.
+func (*Expense) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Expense) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Expense) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *Expense) GetPaidBy() string {
+	if x != nil {
+		return x.PaidBy
+	}
+	return ""
+}
+
+func (x *Expense) GetPaidByNom() string {
+	if x != nil {
+		return x.PaidByNom
+	}
+	return ""
+}
+
+func (x *Expense) GetPaidByPrenom() string {
+	if x != nil {
+		return x.PaidByPrenom
+	}
+	return ""
+}
+
+func (x *Expense) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *Expense) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *Expense) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Expense) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Expense) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Expense) GetSplitType() SplitType {
+	if x != nil {
+		return x.SplitType
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *Expense) GetExpenseDate() string {
+	if x != nil {
+		return x.ExpenseDate
+	}
+	return ""
+}
+
+func (x *Expense) GetRecurringId() string {
+	if x != nil && x.RecurringId != nil {
+		return *x.RecurringId
+	}
+	return ""
+}
+
+func (x *Expense) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Expense) GetSplits() []*ExpenseSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+func (x *Expense) GetBaseAmount() *Money {
+	if x != nil {
+		return x.BaseAmount
+	}
+	return nil
+}
+
+func (x *Expense) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+func (x *Expense) GetItems() []*ExpenseItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Expense) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Expense) GetPayers() []*ExpensePayer {
+	if x != nil {
+		return x.Payers
+	}
+	return nil
+}
+
+func (x *Expense) GetDeletedAt() string {
+	if x != nil && x.DeletedAt != nil {
+		return *x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Expense) GetRestorableUntil() string {
+	if x != nil && x.RestorableUntil != nil {
+		return *x.RestorableUntil
+	}
+	return ""
+}
+
+func (x *Expense) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListExpenseHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	ExpenseId     string                 `protobuf:"bytes,2,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpenseHistoryRequest) Reset() {
+	*x = ListExpenseHistoryRequest{}
+	mi := &file_expense_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpenseHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpenseHistoryRequest) ProtoMessage() {}
+
+func (x *ListExpenseHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpenseHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListExpenseHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListExpenseHistoryRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *ListExpenseHistoryRequest) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+type ListExpenseHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*ExpenseRevision     `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpenseHistoryResponse) Reset() {
+	*x = ListExpenseHistoryResponse{}
+	mi := &file_expense_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpenseHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpenseHistoryResponse) ProtoMessage() {}
+
+func (x *ListExpenseHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpenseHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListExpenseHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListExpenseHistoryResponse) GetRevisions() []*ExpenseRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type ExpenseRevision struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Revision        int32                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Action          RevisionAction         `protobuf:"varint,2,opt,name=action,proto3,enum=coloc.RevisionAction" json:"action,omitempty"`
+	ChangedBy       *string                `protobuf:"bytes,3,opt,name=changed_by,json=changedBy,proto3,oneof" json:"changed_by,omitempty"` // Unset for changes made by the scheduler
+	ChangedByNom    string                 `protobuf:"bytes,4,opt,name=changed_by_nom,json=changedByNom,proto3" json:"changed_by_nom,omitempty"`
+	ChangedByPrenom string                 `protobuf:"bytes,5,opt,name=changed_by_prenom,json=changedByPrenom,proto3" json:"changed_by_prenom,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Changes         []*ExpenseFieldChange  `protobuf:"bytes,7,rep,name=changes,proto3" json:"changes,omitempty"` // Compared to the previous revision
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExpenseRevision) Reset() {
+	*x = ExpenseRevision{}
+	mi := &file_expense_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseRevision) ProtoMessage() {}
+
+func (x *ExpenseRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseRevision.ProtoReflect.Descriptor instead.
+func (*ExpenseRevision) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExpenseRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ExpenseRevision) GetAction() RevisionAction {
+	if x != nil {
+		return x.Action
+	}
+	return RevisionAction_REVISION_ACTION_UNSPECIFIED
+}
+
+func (x *ExpenseRevision) GetChangedBy() string {
+	if x != nil && x.ChangedBy != nil {
+		return *x.ChangedBy
+	}
+	return ""
+}
+
+func (x *ExpenseRevision) GetChangedByNom() string {
+	if x != nil {
+		return x.ChangedByNom
+	}
+	return ""
+}
+
+func (x *ExpenseRevision) GetChangedByPrenom() string {
+	if x != nil {
+		return x.ChangedByPrenom
+	}
+	return ""
+}
+
+func (x *ExpenseRevision) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ExpenseRevision) GetChanges() []*ExpenseFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ExpenseFieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // title, description, amount, exchange_rate, category, expense_date, split_type, payers, splits or items
+	OldValue      string                 `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      string                 `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseFieldChange) Reset() {
+	*x = ExpenseFieldChange{}
+	mi := &file_expense_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseFieldChange) ProtoMessage() {}
+
+func (x *ExpenseFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseFieldChange.ProtoReflect.Descriptor instead.
+func (*ExpenseFieldChange) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExpenseFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ExpenseFieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *ExpenseFieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+type RestoreExpenseRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	ExpenseId     string                 `protobuf:"bytes,2,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	Revision      int32                  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreExpenseRevisionRequest) Reset() {
+	*x = RestoreExpenseRevisionRequest{}
+	mi := &file_expense_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreExpenseRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreExpenseRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreExpenseRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreExpenseRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreExpenseRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RestoreExpenseRevisionRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *RestoreExpenseRevisionRequest) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+func (x *RestoreExpenseRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type CreateRecurringExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	SplitType     SplitType              `protobuf:"varint,6,opt,name=split_type,json=splitType,proto3,enum=coloc.SplitType" json:"split_type,omitempty"`
+	Splits        []*ExpenseSplitInput   `protobuf:"bytes,7,rep,name=splits,proto3" json:"splits,omitempty"`
+	Recurrence    Recurrence             `protobuf:"varint,8,opt,name=recurrence,proto3,enum=coloc.Recurrence" json:"recurrence,omitempty"`
+	StartDate     string                 `protobuf:"bytes,9,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *string                `protobuf:"bytes,10,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecurringExpenseRequest) Reset() {
+	*x = CreateRecurringExpenseRequest{}
+	mi := &file_expense_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecurringExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringExpenseRequest) ProtoMessage() {}
+
+func (x *CreateRecurringExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecurringExpenseRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecurringExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateRecurringExpenseRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *CreateRecurringExpenseRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateRecurringExpenseRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateRecurringExpenseRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *CreateRecurringExpenseRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreateRecurringExpenseRequest) GetSplitType() SplitType {
+	if x != nil {
+		return x.SplitType
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *CreateRecurringExpenseRequest) GetSplits() []*ExpenseSplitInput {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+func (x *CreateRecurringExpenseRequest) GetRecurrence() Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return Recurrence_RECURRENCE_UNSPECIFIED
+}
+
+func (x *CreateRecurringExpenseRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateRecurringExpenseRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+type ListRecurringExpensesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecurringExpensesRequest) Reset() {
+	*x = ListRecurringExpensesRequest{}
+	mi := &file_expense_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecurringExpensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurringExpensesRequest) ProtoMessage() {}
+
+func (x *ListRecurringExpensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurringExpensesRequest.ProtoReflect.Descriptor instead.
+func (*ListRecurringExpensesRequest) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListRecurringExpensesRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+type ListRecurringExpensesResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	RecurringExpenses []*RecurringExpense    `protobuf:"bytes,1,rep,name=recurring_expenses,json=recurringExpenses,proto3" json:"recurring_expenses,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListRecurringExpensesResponse) Reset() {
+	*x = ListRecurringExpensesResponse{}
+	mi := &file_expense_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecurringExpensesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurringExpensesResponse) ProtoMessage() {}
+
+func (x *ListRecurringExpensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurringExpensesResponse.ProtoReflect.Descriptor instead.
+func (*ListRecurringExpensesResponse) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListRecurringExpensesResponse) GetRecurringExpenses() []*RecurringExpense {
+	if x != nil {
+		return x.RecurringExpenses
+	}
+	return nil
+}
+
+type UpdateRecurringExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Title         *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	CategoryId    *string                `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	SplitType     *SplitType             `protobuf:"varint,7,opt,name=split_type,json=splitType,proto3,enum=coloc.SplitType,oneof" json:"split_type,omitempty"`
+	Splits        []*ExpenseSplitInput   `protobuf:"bytes,8,rep,name=splits,proto3" json:"splits,omitempty"`
+	Recurrence    *Recurrence            `protobuf:"varint,9,opt,name=recurrence,proto3,enum=coloc.Recurrence,oneof" json:"recurrence,omitempty"`
+	EndDate       *string                `protobuf:"bytes,10,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecurringExpenseRequest) Reset() {
+	*x = UpdateRecurringExpenseRequest{}
+	mi := &file_expense_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecurringExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecurringExpenseRequest) ProtoMessage() {}
+
+func (x *UpdateRecurringExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1194,109 +2469,388 @@ func (x *UpdateRecurringExpenseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRecurringExpenseRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRecurringExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_expense_proto_rawDescGZIP(), []int{13}
+	return file_expense_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateRecurringExpenseRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *UpdateRecurringExpenseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRecurringExpenseRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateRecurringExpenseRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateRecurringExpenseRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *UpdateRecurringExpenseRequest) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *UpdateRecurringExpenseRequest) GetSplitType() SplitType {
+	if x != nil && x.SplitType != nil {
+		return *x.SplitType
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateRecurringExpenseRequest) GetSplits() []*ExpenseSplitInput {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+func (x *UpdateRecurringExpenseRequest) GetRecurrence() Recurrence {
+	if x != nil && x.Recurrence != nil {
+		return *x.Recurrence
+	}
+	return Recurrence_RECURRENCE_UNSPECIFIED
+}
+
+func (x *UpdateRecurringExpenseRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *UpdateRecurringExpenseRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type DeleteRecurringExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecurringExpenseRequest) Reset() {
+	*x = DeleteRecurringExpenseRequest{}
+	mi := &file_expense_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecurringExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecurringExpenseRequest) ProtoMessage() {}
+
+func (x *DeleteRecurringExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecurringExpenseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecurringExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteRecurringExpenseRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *DeleteRecurringExpenseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteRecurringExpenseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecurringExpenseResponse) Reset() {
+	*x = DeleteRecurringExpenseResponse{}
+	mi := &file_expense_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecurringExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecurringExpenseResponse) ProtoMessage() {}
+
+func (x *DeleteRecurringExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecurringExpenseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRecurringExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteRecurringExpenseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RecurringExpense struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Id            string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ColocationId  string                   `protobuf:"bytes,2,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	PaidBy        string                   `protobuf:"bytes,3,opt,name=paid_by,json=paidBy,proto3" json:"paid_by,omitempty"`
+	PaidByNom     string                   `protobuf:"bytes,4,opt,name=paid_by_nom,json=paidByNom,proto3" json:"paid_by_nom,omitempty"`
+	PaidByPrenom  string                   `protobuf:"bytes,5,opt,name=paid_by_prenom,json=paidByPrenom,proto3" json:"paid_by_prenom,omitempty"`
+	CategoryId    string                   `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName  string                   `protobuf:"bytes,7,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Title         string                   `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
+	Description   *string                  `protobuf:"bytes,9,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Amount        *Money                   `protobuf:"bytes,10,opt,name=amount,proto3" json:"amount,omitempty"`
+	SplitType     SplitType                `protobuf:"varint,11,opt,name=split_type,json=splitType,proto3,enum=coloc.SplitType" json:"split_type,omitempty"`
+	Recurrence    Recurrence               `protobuf:"varint,12,opt,name=recurrence,proto3,enum=coloc.Recurrence" json:"recurrence,omitempty"`
+	NextDueDate   string                   `protobuf:"bytes,13,opt,name=next_due_date,json=nextDueDate,proto3" json:"next_due_date,omitempty"`
+	EndDate       *string                  `protobuf:"bytes,14,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	IsActive      bool                     `protobuf:"varint,15,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt     string                   `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Splits        []*RecurringExpenseSplit `protobuf:"bytes,17,rep,name=splits,proto3" json:"splits,omitempty"`
+	PausedUntil   *string                  `protobuf:"bytes,18,opt,name=paused_until,json=pausedUntil,proto3,oneof" json:"paused_until,omitempty"` // No occurrence is generated before this date
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRecurringExpenseRequest) GetColocationId() string {
+func (x *RecurringExpense) Reset() {
+	*x = RecurringExpense{}
+	mi := &file_expense_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecurringExpense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringExpense) ProtoMessage() {}
+
+func (x *RecurringExpense) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringExpense.ProtoReflect.Descriptor instead.
+func (*RecurringExpense) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RecurringExpense) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RecurringExpense) GetColocationId() string {
 	if x != nil {
 		return x.ColocationId
 	}
 	return ""
 }
 
-func (x *UpdateRecurringExpenseRequest) GetId() string {
+func (x *RecurringExpense) GetPaidBy() string {
 	if x != nil {
-		return x.Id
+		return x.PaidBy
 	}
 	return ""
 }
 
-func (x *UpdateRecurringExpenseRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+func (x *RecurringExpense) GetPaidByNom() string {
+	if x != nil {
+		return x.PaidByNom
 	}
 	return ""
 }
 
-func (x *UpdateRecurringExpenseRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *RecurringExpense) GetPaidByPrenom() string {
+	if x != nil {
+		return x.PaidByPrenom
 	}
 	return ""
 }
 
-func (x *UpdateRecurringExpenseRequest) GetAmount() float64 {
-	if x != nil && x.Amount != nil {
-		return *x.Amount
+func (x *RecurringExpense) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateRecurringExpenseRequest) GetCategoryId() string {
-	if x != nil && x.CategoryId != nil {
-		return *x.CategoryId
+func (x *RecurringExpense) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
 	}
 	return ""
 }
 
-func (x *UpdateRecurringExpenseRequest) GetSplitType() SplitType {
-	if x != nil && x.SplitType != nil {
-		return *x.SplitType
+func (x *RecurringExpense) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
-	return SplitType_SPLIT_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *UpdateRecurringExpenseRequest) GetSplits() []*ExpenseSplitInput {
+func (x *RecurringExpense) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *RecurringExpense) GetAmount() *Money {
 	if x != nil {
-		return x.Splits
+		return x.Amount
 	}
 	return nil
 }
 
-func (x *UpdateRecurringExpenseRequest) GetRecurrence() Recurrence {
-	if x != nil && x.Recurrence != nil {
-		return *x.Recurrence
+func (x *RecurringExpense) GetSplitType() SplitType {
+	if x != nil {
+		return x.SplitType
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *RecurringExpense) GetRecurrence() Recurrence {
+	if x != nil {
+		return x.Recurrence
 	}
 	return Recurrence_RECURRENCE_UNSPECIFIED
 }
 
-func (x *UpdateRecurringExpenseRequest) GetEndDate() string {
+func (x *RecurringExpense) GetNextDueDate() string {
+	if x != nil {
+		return x.NextDueDate
+	}
+	return ""
+}
+
+func (x *RecurringExpense) GetEndDate() string {
 	if x != nil && x.EndDate != nil {
 		return *x.EndDate
 	}
 	return ""
 }
 
-func (x *UpdateRecurringExpenseRequest) GetIsActive() bool {
-	if x != nil && x.IsActive != nil {
-		return *x.IsActive
+func (x *RecurringExpense) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
 	}
 	return false
 }
 
-type DeleteRecurringExpenseRequest struct {
+func (x *RecurringExpense) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RecurringExpense) GetSplits() []*RecurringExpenseSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+func (x *RecurringExpense) GetPausedUntil() string {
+	if x != nil && x.PausedUntil != nil {
+		return *x.PausedUntil
+	}
+	return ""
+}
+
+type RecurringExpenseSplit struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Percentage    float64                `protobuf:"fixed64,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	UserNom       string                 `protobuf:"bytes,3,opt,name=user_nom,json=userNom,proto3" json:"user_nom,omitempty"`
+	UserPrenom    string                 `protobuf:"bytes,4,opt,name=user_prenom,json=userPrenom,proto3" json:"user_prenom,omitempty"`
+	Shares        int32                  `protobuf:"varint,5,opt,name=shares,proto3" json:"shares,omitempty"` // Shares (for SPLIT_TYPE_SHARES)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRecurringExpenseRequest) Reset() {
-	*x = DeleteRecurringExpenseRequest{}
-	mi := &file_expense_proto_msgTypes[14]
+func (x *RecurringExpenseSplit) Reset() {
+	*x = RecurringExpenseSplit{}
+	mi := &file_expense_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRecurringExpenseRequest) String() string {
+func (x *RecurringExpenseSplit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRecurringExpenseRequest) ProtoMessage() {}
+func (*RecurringExpenseSplit) ProtoMessage() {}
 
-func (x *DeleteRecurringExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_proto_msgTypes[14]
+func (x *RecurringExpenseSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1307,47 +2861,73 @@ func (x *DeleteRecurringExpenseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRecurringExpenseRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRecurringExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_expense_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RecurringExpenseSplit.ProtoReflect.Descriptor instead.
+func (*RecurringExpenseSplit) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *DeleteRecurringExpenseRequest) GetColocationId() string {
+func (x *RecurringExpenseSplit) GetUserId() string {
 	if x != nil {
-		return x.ColocationId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *DeleteRecurringExpenseRequest) GetId() string {
+func (x *RecurringExpenseSplit) GetPercentage() float64 {
 	if x != nil {
-		return x.Id
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *RecurringExpenseSplit) GetUserNom() string {
+	if x != nil {
+		return x.UserNom
 	}
 	return ""
 }
 
-type DeleteRecurringExpenseResponse struct {
+func (x *RecurringExpenseSplit) GetUserPrenom() string {
+	if x != nil {
+		return x.UserPrenom
+	}
+	return ""
+}
+
+func (x *RecurringExpenseSplit) GetShares() int32 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+type RecurringOccurrence struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	RecurringId   string                 `protobuf:"bytes,1,opt,name=recurring_id,json=recurringId,proto3" json:"recurring_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	PaidBy        string                 `protobuf:"bytes,4,opt,name=paid_by,json=paidBy,proto3" json:"paid_by,omitempty"`
+	Status        OccurrenceStatus       `protobuf:"varint,5,opt,name=status,proto3,enum=coloc.OccurrenceStatus" json:"status,omitempty"`
+	Overridden    bool                   `protobuf:"varint,6,opt,name=overridden,proto3" json:"overridden,omitempty"` // The amount, payer or skip differs from the recurring expense
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRecurringExpenseResponse) Reset() {
-	*x = DeleteRecurringExpenseResponse{}
-	mi := &file_expense_proto_msgTypes[15]
+func (x *RecurringOccurrence) Reset() {
+	*x = RecurringOccurrence{}
+	mi := &file_expense_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRecurringExpenseResponse) String() string {
+func (x *RecurringOccurrence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRecurringExpenseResponse) ProtoMessage() {}
+func (*RecurringOccurrence) ProtoMessage() {}
 
-func (x *DeleteRecurringExpenseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_proto_msgTypes[15]
+func (x *RecurringOccurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1358,56 +2938,77 @@ func (x *DeleteRecurringExpenseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRecurringExpenseResponse.ProtoReflect.Descriptor instead.
-func (*DeleteRecurringExpenseResponse) Descriptor() ([]byte, []int) {
-	return file_expense_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RecurringOccurrence.ProtoReflect.Descriptor instead.
+func (*RecurringOccurrence) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *DeleteRecurringExpenseResponse) GetSuccess() bool {
+func (x *RecurringOccurrence) GetRecurringId() string {
 	if x != nil {
-		return x.Success
+		return x.RecurringId
+	}
+	return ""
+}
+
+func (x *RecurringOccurrence) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *RecurringOccurrence) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *RecurringOccurrence) GetPaidBy() string {
+	if x != nil {
+		return x.PaidBy
+	}
+	return ""
+}
+
+func (x *RecurringOccurrence) GetStatus() OccurrenceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OccurrenceStatus_OCCURRENCE_STATUS_UNSPECIFIED
+}
+
+func (x *RecurringOccurrence) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
 	}
 	return false
 }
 
-type RecurringExpense struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Id            string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ColocationId  string                   `protobuf:"bytes,2,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
-	PaidBy        string                   `protobuf:"bytes,3,opt,name=paid_by,json=paidBy,proto3" json:"paid_by,omitempty"`
-	PaidByNom     string                   `protobuf:"bytes,4,opt,name=paid_by_nom,json=paidByNom,proto3" json:"paid_by_nom,omitempty"`
-	PaidByPrenom  string                   `protobuf:"bytes,5,opt,name=paid_by_prenom,json=paidByPrenom,proto3" json:"paid_by_prenom,omitempty"`
-	CategoryId    string                   `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	CategoryName  string                   `protobuf:"bytes,7,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
-	Title         string                   `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
-	Description   *string                  `protobuf:"bytes,9,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Amount        float64                  `protobuf:"fixed64,10,opt,name=amount,proto3" json:"amount,omitempty"`
-	SplitType     SplitType                `protobuf:"varint,11,opt,name=split_type,json=splitType,proto3,enum=coloc.SplitType" json:"split_type,omitempty"`
-	Recurrence    Recurrence               `protobuf:"varint,12,opt,name=recurrence,proto3,enum=coloc.Recurrence" json:"recurrence,omitempty"`
-	NextDueDate   string                   `protobuf:"bytes,13,opt,name=next_due_date,json=nextDueDate,proto3" json:"next_due_date,omitempty"`
-	EndDate       *string                  `protobuf:"bytes,14,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
-	IsActive      bool                     `protobuf:"varint,15,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	CreatedAt     string                   `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Splits        []*RecurringExpenseSplit `protobuf:"bytes,17,rep,name=splits,proto3" json:"splits,omitempty"`
+type PauseRecurringExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Until         *string                `protobuf:"bytes,3,opt,name=until,proto3,oneof" json:"until,omitempty"` // YYYY-MM-DD, empty to resume
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecurringExpense) Reset() {
-	*x = RecurringExpense{}
-	mi := &file_expense_proto_msgTypes[16]
+func (x *PauseRecurringExpenseRequest) Reset() {
+	*x = PauseRecurringExpenseRequest{}
+	mi := &file_expense_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecurringExpense) String() string {
+func (x *PauseRecurringExpenseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecurringExpense) ProtoMessage() {}
+func (*PauseRecurringExpenseRequest) ProtoMessage() {}
 
-func (x *RecurringExpense) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_proto_msgTypes[16]
+func (x *PauseRecurringExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1418,155 +3019,244 @@ func (x *RecurringExpense) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecurringExpense.ProtoReflect.Descriptor instead.
-func (*RecurringExpense) Descriptor() ([]byte, []int) {
-	return file_expense_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use PauseRecurringExpenseRequest.ProtoReflect.Descriptor instead.
+func (*PauseRecurringExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *RecurringExpense) GetId() string {
+func (x *PauseRecurringExpenseRequest) GetColocationId() string {
 	if x != nil {
-		return x.Id
+		return x.ColocationId
 	}
 	return ""
 }
 
-func (x *RecurringExpense) GetColocationId() string {
+func (x *PauseRecurringExpenseRequest) GetId() string {
 	if x != nil {
-		return x.ColocationId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *RecurringExpense) GetPaidBy() string {
-	if x != nil {
-		return x.PaidBy
+func (x *PauseRecurringExpenseRequest) GetUntil() string {
+	if x != nil && x.Until != nil {
+		return *x.Until
 	}
 	return ""
 }
 
-func (x *RecurringExpense) GetPaidByNom() string {
+type ListUpcomingOccurrencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	RecurringId   string                 `protobuf:"bytes,2,opt,name=recurring_id,json=recurringId,proto3" json:"recurring_id,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"` // Default 6, max 60
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUpcomingOccurrencesRequest) Reset() {
+	*x = ListUpcomingOccurrencesRequest{}
+	mi := &file_expense_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUpcomingOccurrencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpcomingOccurrencesRequest) ProtoMessage() {}
+
+func (x *ListUpcomingOccurrencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[34]
 	if x != nil {
-		return x.PaidByNom
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RecurringExpense) GetPaidByPrenom() string {
+// Deprecated: Use ListUpcomingOccurrencesRequest.ProtoReflect.Descriptor instead.
+func (*ListUpcomingOccurrencesRequest) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListUpcomingOccurrencesRequest) GetColocationId() string {
 	if x != nil {
-		return x.PaidByPrenom
+		return x.ColocationId
 	}
 	return ""
 }
 
-func (x *RecurringExpense) GetCategoryId() string {
+func (x *ListUpcomingOccurrencesRequest) GetRecurringId() string {
 	if x != nil {
-		return x.CategoryId
+		return x.RecurringId
 	}
 	return ""
 }
 
-func (x *RecurringExpense) GetCategoryName() string {
+func (x *ListUpcomingOccurrencesRequest) GetCount() int32 {
 	if x != nil {
-		return x.CategoryName
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *RecurringExpense) GetTitle() string {
+type ListUpcomingOccurrencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Occurrences   []*RecurringOccurrence `protobuf:"bytes,1,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUpcomingOccurrencesResponse) Reset() {
+	*x = ListUpcomingOccurrencesResponse{}
+	mi := &file_expense_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUpcomingOccurrencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpcomingOccurrencesResponse) ProtoMessage() {}
+
+func (x *ListUpcomingOccurrencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[35]
 	if x != nil {
-		return x.Title
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RecurringExpense) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
+// Deprecated: Use ListUpcomingOccurrencesResponse.ProtoReflect.Descriptor instead.
+func (*ListUpcomingOccurrencesResponse) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *RecurringExpense) GetAmount() float64 {
+func (x *ListUpcomingOccurrencesResponse) GetOccurrences() []*RecurringOccurrence {
 	if x != nil {
-		return x.Amount
+		return x.Occurrences
 	}
-	return 0
+	return nil
 }
 
-func (x *RecurringExpense) GetSplitType() SplitType {
+type SetOccurrenceOverrideRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId   string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	RecurringId    string                 `protobuf:"bytes,2,opt,name=recurring_id,json=recurringId,proto3" json:"recurring_id,omitempty"`
+	OccurrenceDate string                 `protobuf:"bytes,3,opt,name=occurrence_date,json=occurrenceDate,proto3" json:"occurrence_date,omitempty"` // YYYY-MM-DD
+	Skip           bool                   `protobuf:"varint,4,opt,name=skip,proto3" json:"skip,omitempty"`
+	Amount         *Money                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	PaidBy         *string                `protobuf:"bytes,6,opt,name=paid_by,json=paidBy,proto3,oneof" json:"paid_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetOccurrenceOverrideRequest) Reset() {
+	*x = SetOccurrenceOverrideRequest{}
+	mi := &file_expense_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOccurrenceOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOccurrenceOverrideRequest) ProtoMessage() {}
+
+func (x *SetOccurrenceOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[36]
 	if x != nil {
-		return x.SplitType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return SplitType_SPLIT_TYPE_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *RecurringExpense) GetRecurrence() Recurrence {
+// Deprecated: Use SetOccurrenceOverrideRequest.ProtoReflect.Descriptor instead.
+func (*SetOccurrenceOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetOccurrenceOverrideRequest) GetColocationId() string {
 	if x != nil {
-		return x.Recurrence
+		return x.ColocationId
 	}
-	return Recurrence_RECURRENCE_UNSPECIFIED
+	return ""
 }
 
-func (x *RecurringExpense) GetNextDueDate() string {
+func (x *SetOccurrenceOverrideRequest) GetRecurringId() string {
 	if x != nil {
-		return x.NextDueDate
+		return x.RecurringId
 	}
 	return ""
 }
 
-func (x *RecurringExpense) GetEndDate() string {
-	if x != nil && x.EndDate != nil {
-		return *x.EndDate
+func (x *SetOccurrenceOverrideRequest) GetOccurrenceDate() string {
+	if x != nil {
+		return x.OccurrenceDate
 	}
 	return ""
 }
 
-func (x *RecurringExpense) GetIsActive() bool {
+func (x *SetOccurrenceOverrideRequest) GetSkip() bool {
 	if x != nil {
-		return x.IsActive
+		return x.Skip
 	}
 	return false
 }
 
-func (x *RecurringExpense) GetCreatedAt() string {
+func (x *SetOccurrenceOverrideRequest) GetAmount() *Money {
 	if x != nil {
-		return x.CreatedAt
+		return x.Amount
 	}
-	return ""
+	return nil
 }
 
-func (x *RecurringExpense) GetSplits() []*RecurringExpenseSplit {
-	if x != nil {
-		return x.Splits
+func (x *SetOccurrenceOverrideRequest) GetPaidBy() string {
+	if x != nil && x.PaidBy != nil {
+		return *x.PaidBy
 	}
-	return nil
+	return ""
 }
 
-type RecurringExpenseSplit struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Percentage    float64                `protobuf:"fixed64,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
-	UserNom       string                 `protobuf:"bytes,3,opt,name=user_nom,json=userNom,proto3" json:"user_nom,omitempty"`
-	UserPrenom    string                 `protobuf:"bytes,4,opt,name=user_prenom,json=userPrenom,proto3" json:"user_prenom,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ClearOccurrenceOverrideRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId   string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	RecurringId    string                 `protobuf:"bytes,2,opt,name=recurring_id,json=recurringId,proto3" json:"recurring_id,omitempty"`
+	OccurrenceDate string                 `protobuf:"bytes,3,opt,name=occurrence_date,json=occurrenceDate,proto3" json:"occurrence_date,omitempty"` // YYYY-MM-DD
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RecurringExpenseSplit) Reset() {
-	*x = RecurringExpenseSplit{}
-	mi := &file_expense_proto_msgTypes[17]
+func (x *ClearOccurrenceOverrideRequest) Reset() {
+	*x = ClearOccurrenceOverrideRequest{}
+	mi := &file_expense_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecurringExpenseSplit) String() string {
+func (x *ClearOccurrenceOverrideRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecurringExpenseSplit) ProtoMessage() {}
+func (*ClearOccurrenceOverrideRequest) ProtoMessage() {}
 
-func (x *RecurringExpenseSplit) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_proto_msgTypes[17]
+func (x *ClearOccurrenceOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1577,35 +3267,28 @@ func (x *RecurringExpenseSplit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecurringExpenseSplit.ProtoReflect.Descriptor instead.
-func (*RecurringExpenseSplit) Descriptor() ([]byte, []int) {
-	return file_expense_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ClearOccurrenceOverrideRequest.ProtoReflect.Descriptor instead.
+func (*ClearOccurrenceOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_expense_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *RecurringExpenseSplit) GetUserId() string {
+func (x *ClearOccurrenceOverrideRequest) GetColocationId() string {
 	if x != nil {
-		return x.UserId
+		return x.ColocationId
 	}
 	return ""
 }
 
-func (x *RecurringExpenseSplit) GetPercentage() float64 {
-	if x != nil {
-		return x.Percentage
-	}
-	return 0
-}
-
-func (x *RecurringExpenseSplit) GetUserNom() string {
+func (x *ClearOccurrenceOverrideRequest) GetRecurringId() string {
 	if x != nil {
-		return x.UserNom
+		return x.RecurringId
 	}
 	return ""
 }
 
-func (x *RecurringExpenseSplit) GetUserPrenom() string {
+func (x *ClearOccurrenceOverrideRequest) GetOccurrenceDate() string {
 	if x != nil {
-		return x.UserPrenom
+		return x.OccurrenceDate
 	}
 	return ""
 }
@@ -1614,13 +3297,14 @@ type GetForecastRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
 	MonthsAhead   int32                  `protobuf:"varint,2,opt,name=months_ahead,json=monthsAhead,proto3" json:"months_ahead,omitempty"` // Number of months to forecast (default 3)
+	Mode          ForecastMode           `protobuf:"varint,3,opt,name=mode,proto3,enum=coloc.ForecastMode" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetForecastRequest) Reset() {
 	*x = GetForecastRequest{}
-	mi := &file_expense_proto_msgTypes[18]
+	mi := &file_expense_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1632,7 +3316,7 @@ func (x *GetForecastRequest) String() string {
 func (*GetForecastRequest) ProtoMessage() {}
 
 func (x *GetForecastRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_proto_msgTypes[18]
+	mi := &file_expense_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1645,7 +3329,7 @@ func (x *GetForecastRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetForecastRequest.ProtoReflect.Descriptor instead.
 func (*GetForecastRequest) Descriptor() ([]byte, []int) {
-	return file_expense_proto_rawDescGZIP(), []int{18}
+	return file_expense_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetForecastRequest) GetColocationId() string {
@@ -1662,6 +3346,13 @@ func (x *GetForecastRequest) GetMonthsAhead() int32 {
 	return 0
 }
 
+func (x *GetForecastRequest) GetMode() ForecastMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ForecastMode_FORECAST_MODE_UNSPECIFIED
+}
+
 type GetForecastResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Forecasts     []*MonthlyForecast     `protobuf:"bytes,1,rep,name=forecasts,proto3" json:"forecasts,omitempty"`
@@ -1671,7 +3362,7 @@ type GetForecastResponse struct {
 
 func (x *GetForecastResponse) Reset() {
 	*x = GetForecastResponse{}
-	mi := &file_expense_proto_msgTypes[19]
+	mi := &file_expense_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1683,7 +3374,7 @@ func (x *GetForecastResponse) String() string {
 func (*GetForecastResponse) ProtoMessage() {}
 
 func (x *GetForecastResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_proto_msgTypes[19]
+	mi := &file_expense_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1696,7 +3387,7 @@ func (x *GetForecastResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetForecastResponse.ProtoReflect.Descriptor instead.
 func (*GetForecastResponse) Descriptor() ([]byte, []int) {
-	return file_expense_proto_rawDescGZIP(), []int{19}
+	return file_expense_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetForecastResponse) GetForecasts() []*MonthlyForecast {
@@ -1707,17 +3398,21 @@ func (x *GetForecastResponse) GetForecasts() []*MonthlyForecast {
 }
 
 type MonthlyForecast struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"` // Format: YYYY-MM
-	TotalAmount   float64                `protobuf:"fixed64,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	Categories    []*CategoryForecast    `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Month           string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"` // Format: YYYY-MM
+	TotalAmount     *Money                 `protobuf:"bytes,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Categories      []*CategoryForecast    `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	RecurringAmount *Money                 `protobuf:"bytes,4,opt,name=recurring_amount,json=recurringAmount,proto3" json:"recurring_amount,omitempty"`
+	EstimatedAmount *Money                 `protobuf:"bytes,5,opt,name=estimated_amount,json=estimatedAmount,proto3" json:"estimated_amount,omitempty"`
+	LowAmount       *Money                 `protobuf:"bytes,6,opt,name=low_amount,json=lowAmount,proto3" json:"low_amount,omitempty"` // Sum of the category ranges
+	HighAmount      *Money                 `protobuf:"bytes,7,opt,name=high_amount,json=highAmount,proto3" json:"high_amount,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *MonthlyForecast) Reset() {
 	*x = MonthlyForecast{}
-	mi := &file_expense_proto_msgTypes[20]
+	mi := &file_expense_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1729,7 +3424,7 @@ func (x *MonthlyForecast) String() string {
 func (*MonthlyForecast) ProtoMessage() {}
 
 func (x *MonthlyForecast) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_proto_msgTypes[20]
+	mi := &file_expense_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1742,7 +3437,7 @@ func (x *MonthlyForecast) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MonthlyForecast.ProtoReflect.Descriptor instead.
 func (*MonthlyForecast) Descriptor() ([]byte, []int) {
-	return file_expense_proto_rawDescGZIP(), []int{20}
+	return file_expense_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *MonthlyForecast) GetMonth() string {
@@ -1752,11 +3447,11 @@ func (x *MonthlyForecast) GetMonth() string {
 	return ""
 }
 
-func (x *MonthlyForecast) GetTotalAmount() float64 {
+func (x *MonthlyForecast) GetTotalAmount() *Money {
 	if x != nil {
 		return x.TotalAmount
 	}
-	return 0
+	return nil
 }
 
 func (x *MonthlyForecast) GetCategories() []*CategoryForecast {
@@ -1766,18 +3461,50 @@ func (x *MonthlyForecast) GetCategories() []*CategoryForecast {
 	return nil
 }
 
+func (x *MonthlyForecast) GetRecurringAmount() *Money {
+	if x != nil {
+		return x.RecurringAmount
+	}
+	return nil
+}
+
+func (x *MonthlyForecast) GetEstimatedAmount() *Money {
+	if x != nil {
+		return x.EstimatedAmount
+	}
+	return nil
+}
+
+func (x *MonthlyForecast) GetLowAmount() *Money {
+	if x != nil {
+		return x.LowAmount
+	}
+	return nil
+}
+
+func (x *MonthlyForecast) GetHighAmount() *Money {
+	if x != nil {
+		return x.HighAmount
+	}
+	return nil
+}
+
 type CategoryForecast struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
-	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId      string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName    string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Amount          *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`                                          // recurring_amount + estimated_amount
+	RecurringAmount *Money                 `protobuf:"bytes,4,opt,name=recurring_amount,json=recurringAmount,proto3" json:"recurring_amount,omitempty"` // Occurrences of recurring expenses
+	EstimatedAmount *Money                 `protobuf:"bytes,5,opt,name=estimated_amount,json=estimatedAmount,proto3" json:"estimated_amount,omitempty"` // Expected one-off spending
+	LowAmount       *Money                 `protobuf:"bytes,6,opt,name=low_amount,json=lowAmount,proto3" json:"low_amount,omitempty"`                   // 80% confidence range of the amount
+	HighAmount      *Money                 `protobuf:"bytes,7,opt,name=high_amount,json=highAmount,proto3" json:"high_amount,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CategoryForecast) Reset() {
 	*x = CategoryForecast{}
-	mi := &file_expense_proto_msgTypes[21]
+	mi := &file_expense_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1789,7 +3516,7 @@ func (x *CategoryForecast) String() string {
 func (*CategoryForecast) ProtoMessage() {}
 
 func (x *CategoryForecast) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_proto_msgTypes[21]
+	mi := &file_expense_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1802,7 +3529,7 @@ func (x *CategoryForecast) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryForecast.ProtoReflect.Descriptor instead.
 func (*CategoryForecast) Descriptor() ([]byte, []int) {
-	return file_expense_proto_rawDescGZIP(), []int{21}
+	return file_expense_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *CategoryForecast) GetCategoryId() string {
@@ -1819,21 +3546,49 @@ func (x *CategoryForecast) GetCategoryName() string {
 	return ""
 }
 
-func (x *CategoryForecast) GetAmount() float64 {
+func (x *CategoryForecast) GetAmount() *Money {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return nil
+}
+
+func (x *CategoryForecast) GetRecurringAmount() *Money {
+	if x != nil {
+		return x.RecurringAmount
+	}
+	return nil
+}
+
+func (x *CategoryForecast) GetEstimatedAmount() *Money {
+	if x != nil {
+		return x.EstimatedAmount
+	}
+	return nil
+}
+
+func (x *CategoryForecast) GetLowAmount() *Money {
+	if x != nil {
+		return x.LowAmount
+	}
+	return nil
+}
+
+func (x *CategoryForecast) GetHighAmount() *Money {
+	if x != nil {
+		return x.HighAmount
+	}
+	return nil
 }
 
 var File_expense_proto protoreflect.FileDescriptor
 
 const file_expense_proto_rawDesc = "" +
 	"\n" +
-	"\rexpense.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\"\xba\x01\n" +
+	"\rexpense.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\x1a\fcommon.proto\x1a\ttag.proto\"\x8f\x03\n" +
 	"\fExpenseSplit\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1e\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12$\n" +
+	"\x06amount\x18\x02 \x01(\v2\f.coloc.MoneyR\x06amount\x12\x1e\n" +
 	"\n" +
 	"percentage\x18\x03 \x01(\x01R\n" +
 	"percentage\x12\x1d\n" +
@@ -1841,28 +3596,75 @@ const file_expense_proto_rawDesc = "" +
 	"is_settled\x18\x04 \x01(\bR\tisSettled\x12\x19\n" +
 	"\buser_nom\x18\x05 \x01(\tR\auserNom\x12\x1f\n" +
 	"\vuser_prenom\x18\x06 \x01(\tR\n" +
-	"userPrenom\"\xc7\x02\n" +
+	"userPrenom\x12-\n" +
+	"\vbase_amount\x18\a \x01(\v2\f.coloc.MoneyR\n" +
+	"baseAmount\x12\x16\n" +
+	"\x06shares\x18\b \x01(\x05R\x06shares\x123\n" +
+	"\x0esettled_amount\x18\t \x01(\v2\f.coloc.MoneyR\rsettledAmount\x12I\n" +
+	"\x11settlement_status\x18\n" +
+	" \x01(\x0e2\x1c.coloc.SplitSettlementStatusR\x10settlementStatus\"\xb5\x04\n" +
 	"\x14CreateExpenseRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12%\n" +
-	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x1f\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12$\n" +
+	"\x06amount\x18\x04 \x01(\v2\f.coloc.MoneyR\x06amount\x12\x1f\n" +
 	"\vcategory_id\x18\x05 \x01(\tR\n" +
 	"categoryId\x12/\n" +
 	"\n" +
 	"split_type\x18\x06 \x01(\x0e2\x10.coloc.SplitTypeR\tsplitType\x120\n" +
 	"\x06splits\x18\a \x03(\v2\x18.coloc.ExpenseSplitInputR\x06splits\x12!\n" +
-	"\fexpense_date\x18\b \x01(\tR\vexpenseDateB\x0e\n" +
-	"\f_description\"d\n" +
+	"\fexpense_date\x18\b \x01(\tR\vexpenseDate\x12(\n" +
+	"\rexchange_rate\x18\t \x01(\tH\x01R\fexchangeRate\x88\x01\x01\x12-\n" +
+	"\x05items\x18\n" +
+	" \x03(\v2\x17.coloc.ExpenseItemInputR\x05items\x12\x1c\n" +
+	"\apaid_by\x18\v \x01(\tH\x02R\x06paidBy\x88\x01\x01\x120\n" +
+	"\x06payers\x18\f \x03(\v2\x18.coloc.ExpensePayerInputR\x06payers\x12\x17\n" +
+	"\atag_ids\x18\r \x03(\tR\x06tagIdsB\x0e\n" +
+	"\f_descriptionB\x10\n" +
+	"\x0e_exchange_rateB\n" +
+	"\n" +
+	"\b_paid_by\"\x8a\x01\n" +
 	"\x11ExpenseSplitInput\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1e\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12$\n" +
+	"\x06amount\x18\x02 \x01(\v2\f.coloc.MoneyR\x06amount\x12\x1e\n" +
 	"\n" +
 	"percentage\x18\x03 \x01(\x01R\n" +
-	"percentage\"H\n" +
+	"percentage\x12\x16\n" +
+	"\x06shares\x18\x04 \x01(\x05R\x06shares\"R\n" +
+	"\x11ExpensePayerInput\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12$\n" +
+	"\x06amount\x18\x02 \x01(\v2\f.coloc.MoneyR\x06amount\"\xb8\x01\n" +
+	"\fExpensePayer\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12$\n" +
+	"\x06amount\x18\x02 \x01(\v2\f.coloc.MoneyR\x06amount\x12-\n" +
+	"\vbase_amount\x18\x03 \x01(\v2\f.coloc.MoneyR\n" +
+	"baseAmount\x12\x19\n" +
+	"\buser_nom\x18\x04 \x01(\tR\auserNom\x12\x1f\n" +
+	"\vuser_prenom\x18\x05 \x01(\tR\n" +
+	"userPrenom\"\xc0\x01\n" +
+	"\x10ExpenseItemInput\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12$\n" +
+	"\x06amount\x18\x02 \x01(\v2\f.coloc.MoneyR\x06amount\x12(\n" +
+	"\x10is_shared_charge\x18\x03 \x01(\bR\x0eisSharedCharge\x12F\n" +
+	"\fparticipants\x18\x04 \x03(\v2\".coloc.ExpenseItemParticipantInputR\fparticipants\"N\n" +
+	"\x1bExpenseItemParticipantInput\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x01R\x06weight\"\xc6\x01\n" +
+	"\vExpenseItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12$\n" +
+	"\x06amount\x18\x03 \x01(\v2\f.coloc.MoneyR\x06amount\x12(\n" +
+	"\x10is_shared_charge\x18\x04 \x01(\bR\x0eisSharedCharge\x12A\n" +
+	"\fparticipants\x18\x05 \x03(\v2\x1d.coloc.ExpenseItemParticipantR\fparticipants\"\x85\x01\n" +
+	"\x16ExpenseItemParticipant\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x01R\x06weight\x12\x19\n" +
+	"\buser_nom\x18\x03 \x01(\tR\auserNom\x12\x1f\n" +
+	"\vuser_prenom\x18\x04 \x01(\tR\n" +
+	"userPrenom\"H\n" +
 	"\x11GetExpenseRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\tR\x02id\"\xcc\x02\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x8b\x03\n" +
 	"\x13ListExpensesRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12$\n" +
 	"\vcategory_id\x18\x02 \x01(\tH\x00R\n" +
@@ -1872,7 +3674,9 @@ const file_expense_proto_rawDesc = "" +
 	"start_date\x18\x04 \x01(\tH\x02R\tstartDate\x88\x01\x01\x12\x1e\n" +
 	"\bend_date\x18\x05 \x01(\tH\x03R\aendDate\x88\x01\x01\x12\x17\n" +
 	"\x04page\x18\x06 \x01(\x05H\x04R\x04page\x88\x01\x01\x12 \n" +
-	"\tpage_size\x18\a \x01(\x05H\x05R\bpageSize\x88\x01\x01B\x0e\n" +
+	"\tpage_size\x18\a \x01(\x05H\x05R\bpageSize\x88\x01\x01\x12\x17\n" +
+	"\atag_ids\x18\b \x03(\tR\x06tagIds\x12$\n" +
+	"\x0ematch_all_tags\x18\t \x01(\bR\fmatchAllTagsB\x0e\n" +
 	"\f_category_idB\n" +
 	"\n" +
 	"\b_paid_byB\r\n" +
@@ -1886,30 +3690,48 @@ const file_expense_proto_rawDesc = "" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xb5\x03\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xb2\x05\n" +
 	"\x14UpdateExpenseRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x19\n" +
 	"\x05title\x18\x03 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1b\n" +
-	"\x06amount\x18\x05 \x01(\x01H\x02R\x06amount\x88\x01\x01\x12$\n" +
-	"\vcategory_id\x18\x06 \x01(\tH\x03R\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12$\n" +
+	"\x06amount\x18\x05 \x01(\v2\f.coloc.MoneyR\x06amount\x12$\n" +
+	"\vcategory_id\x18\x06 \x01(\tH\x02R\n" +
 	"categoryId\x88\x01\x01\x124\n" +
 	"\n" +
-	"split_type\x18\a \x01(\x0e2\x10.coloc.SplitTypeH\x04R\tsplitType\x88\x01\x01\x120\n" +
+	"split_type\x18\a \x01(\x0e2\x10.coloc.SplitTypeH\x03R\tsplitType\x88\x01\x01\x120\n" +
 	"\x06splits\x18\b \x03(\v2\x18.coloc.ExpenseSplitInputR\x06splits\x12&\n" +
-	"\fexpense_date\x18\t \x01(\tH\x05R\vexpenseDate\x88\x01\x01B\b\n" +
+	"\fexpense_date\x18\t \x01(\tH\x04R\vexpenseDate\x88\x01\x01\x12(\n" +
+	"\rexchange_rate\x18\n" +
+	" \x01(\tH\x05R\fexchangeRate\x88\x01\x01\x12-\n" +
+	"\x05items\x18\v \x03(\v2\x17.coloc.ExpenseItemInputR\x05items\x12\x1c\n" +
+	"\apaid_by\x18\f \x01(\tH\x06R\x06paidBy\x88\x01\x01\x120\n" +
+	"\x06payers\x18\r \x03(\v2\x18.coloc.ExpensePayerInputR\x06payers\x12\x17\n" +
+	"\atag_ids\x18\x0e \x03(\tR\x06tagIds\x12\x1d\n" +
+	"\n" +
+	"clear_tags\x18\x0f \x01(\bR\tclearTagsB\b\n" +
 	"\x06_titleB\x0e\n" +
-	"\f_descriptionB\t\n" +
-	"\a_amountB\x0e\n" +
+	"\f_descriptionB\x0e\n" +
 	"\f_category_idB\r\n" +
 	"\v_split_typeB\x0f\n" +
-	"\r_expense_date\"K\n" +
+	"\r_expense_dateB\x10\n" +
+	"\x0e_exchange_rateB\n" +
+	"\n" +
+	"\b_paid_by\"K\n" +
 	"\x14DeleteExpenseRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\tR\x02id\"1\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\\\n" +
 	"\x15DeleteExpenseResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa1\x04\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12)\n" +
+	"\x10restorable_until\x18\x02 \x01(\tR\x0frestorableUntil\"L\n" +
+	"\x15RestoreExpenseRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"A\n" +
+	"\x1aListDeletedExpensesRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\"I\n" +
+	"\x1bListDeletedExpensesResponse\x12*\n" +
+	"\bexpenses\x18\x01 \x03(\v2\x0e.coloc.ExpenseR\bexpenses\"\x91\a\n" +
 	"\aExpense\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
 	"\rcolocation_id\x18\x02 \x01(\tR\fcolocationId\x12\x17\n" +
@@ -1920,23 +3742,63 @@ const file_expense_proto_rawDesc = "" +
 	"categoryId\x12#\n" +
 	"\rcategory_name\x18\a \x01(\tR\fcategoryName\x12\x14\n" +
 	"\x05title\x18\b \x01(\tR\x05title\x12%\n" +
-	"\vdescription\x18\t \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x16\n" +
+	"\vdescription\x18\t \x01(\tH\x00R\vdescription\x88\x01\x01\x12$\n" +
 	"\x06amount\x18\n" +
-	" \x01(\x01R\x06amount\x12/\n" +
+	" \x01(\v2\f.coloc.MoneyR\x06amount\x12/\n" +
 	"\n" +
 	"split_type\x18\v \x01(\x0e2\x10.coloc.SplitTypeR\tsplitType\x12!\n" +
 	"\fexpense_date\x18\f \x01(\tR\vexpenseDate\x12&\n" +
 	"\frecurring_id\x18\r \x01(\tH\x01R\vrecurringId\x88\x01\x01\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x0e \x01(\tR\tcreatedAt\x12+\n" +
-	"\x06splits\x18\x0f \x03(\v2\x13.coloc.ExpenseSplitR\x06splitsB\x0e\n" +
+	"\x06splits\x18\x0f \x03(\v2\x13.coloc.ExpenseSplitR\x06splits\x12-\n" +
+	"\vbase_amount\x18\x10 \x01(\v2\f.coloc.MoneyR\n" +
+	"baseAmount\x12#\n" +
+	"\rexchange_rate\x18\x11 \x01(\tR\fexchangeRate\x12(\n" +
+	"\x05items\x18\x12 \x03(\v2\x12.coloc.ExpenseItemR\x05items\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x13 \x01(\tR\tcreatedBy\x12+\n" +
+	"\x06payers\x18\x14 \x03(\v2\x13.coloc.ExpensePayerR\x06payers\x12\"\n" +
+	"\n" +
+	"deleted_at\x18\x15 \x01(\tH\x02R\tdeletedAt\x88\x01\x01\x12.\n" +
+	"\x10restorable_until\x18\x16 \x01(\tH\x03R\x0frestorableUntil\x88\x01\x01\x12\x1e\n" +
+	"\x04tags\x18\x17 \x03(\v2\n" +
+	".coloc.TagR\x04tagsB\x0e\n" +
 	"\f_descriptionB\x0f\n" +
-	"\r_recurring_id\"\xac\x03\n" +
+	"\r_recurring_idB\r\n" +
+	"\v_deleted_atB\x13\n" +
+	"\x11_restorable_until\"_\n" +
+	"\x19ListExpenseHistoryRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x02 \x01(\tR\texpenseId\"R\n" +
+	"\x1aListExpenseHistoryResponse\x124\n" +
+	"\trevisions\x18\x01 \x03(\v2\x16.coloc.ExpenseRevisionR\trevisions\"\xb5\x02\n" +
+	"\x0fExpenseRevision\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x05R\brevision\x12-\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x15.coloc.RevisionActionR\x06action\x12\"\n" +
+	"\n" +
+	"changed_by\x18\x03 \x01(\tH\x00R\tchangedBy\x88\x01\x01\x12$\n" +
+	"\x0echanged_by_nom\x18\x04 \x01(\tR\fchangedByNom\x12*\n" +
+	"\x11changed_by_prenom\x18\x05 \x01(\tR\x0fchangedByPrenom\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x123\n" +
+	"\achanges\x18\a \x03(\v2\x19.coloc.ExpenseFieldChangeR\achangesB\r\n" +
+	"\v_changed_by\"d\n" +
+	"\x12ExpenseFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\"\x7f\n" +
+	"\x1dRestoreExpenseRevisionRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x02 \x01(\tR\texpenseId\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\x05R\brevision\"\xba\x03\n" +
 	"\x1dCreateRecurringExpenseRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12%\n" +
-	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x1f\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12$\n" +
+	"\x06amount\x18\x04 \x01(\v2\f.coloc.MoneyR\x06amount\x12\x1f\n" +
 	"\vcategory_id\x18\x05 \x01(\tR\n" +
 	"categoryId\x12/\n" +
 	"\n" +
@@ -1954,27 +3816,26 @@ const file_expense_proto_rawDesc = "" +
 	"\x1cListRecurringExpensesRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\"g\n" +
 	"\x1dListRecurringExpensesResponse\x12F\n" +
-	"\x12recurring_expenses\x18\x01 \x03(\v2\x17.coloc.RecurringExpenseR\x11recurringExpenses\"\xa9\x04\n" +
+	"\x12recurring_expenses\x18\x01 \x03(\v2\x17.coloc.RecurringExpenseR\x11recurringExpenses\"\xa7\x04\n" +
 	"\x1dUpdateRecurringExpenseRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x19\n" +
 	"\x05title\x18\x03 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1b\n" +
-	"\x06amount\x18\x05 \x01(\x01H\x02R\x06amount\x88\x01\x01\x12$\n" +
-	"\vcategory_id\x18\x06 \x01(\tH\x03R\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12$\n" +
+	"\x06amount\x18\x05 \x01(\v2\f.coloc.MoneyR\x06amount\x12$\n" +
+	"\vcategory_id\x18\x06 \x01(\tH\x02R\n" +
 	"categoryId\x88\x01\x01\x124\n" +
 	"\n" +
-	"split_type\x18\a \x01(\x0e2\x10.coloc.SplitTypeH\x04R\tsplitType\x88\x01\x01\x120\n" +
+	"split_type\x18\a \x01(\x0e2\x10.coloc.SplitTypeH\x03R\tsplitType\x88\x01\x01\x120\n" +
 	"\x06splits\x18\b \x03(\v2\x18.coloc.ExpenseSplitInputR\x06splits\x126\n" +
 	"\n" +
-	"recurrence\x18\t \x01(\x0e2\x11.coloc.RecurrenceH\x05R\n" +
+	"recurrence\x18\t \x01(\x0e2\x11.coloc.RecurrenceH\x04R\n" +
 	"recurrence\x88\x01\x01\x12\x1e\n" +
 	"\bend_date\x18\n" +
-	" \x01(\tH\x06R\aendDate\x88\x01\x01\x12 \n" +
-	"\tis_active\x18\v \x01(\bH\aR\bisActive\x88\x01\x01B\b\n" +
+	" \x01(\tH\x05R\aendDate\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\v \x01(\bH\x06R\bisActive\x88\x01\x01B\b\n" +
 	"\x06_titleB\x0e\n" +
-	"\f_descriptionB\t\n" +
-	"\a_amountB\x0e\n" +
+	"\f_descriptionB\x0e\n" +
 	"\f_category_idB\r\n" +
 	"\v_split_typeB\r\n" +
 	"\v_recurrenceB\v\n" +
@@ -1985,7 +3846,7 @@ const file_expense_proto_rawDesc = "" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\":\n" +
 	"\x1eDeleteRecurringExpenseResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xf8\x04\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xbf\x05\n" +
 	"\x10RecurringExpense\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
 	"\rcolocation_id\x18\x02 \x01(\tR\fcolocationId\x12\x17\n" +
@@ -1996,9 +3857,9 @@ const file_expense_proto_rawDesc = "" +
 	"categoryId\x12#\n" +
 	"\rcategory_name\x18\a \x01(\tR\fcategoryName\x12\x14\n" +
 	"\x05title\x18\b \x01(\tR\x05title\x12%\n" +
-	"\vdescription\x18\t \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x16\n" +
+	"\vdescription\x18\t \x01(\tH\x00R\vdescription\x88\x01\x01\x12$\n" +
 	"\x06amount\x18\n" +
-	" \x01(\x01R\x06amount\x12/\n" +
+	" \x01(\v2\f.coloc.MoneyR\x06amount\x12/\n" +
 	"\n" +
 	"split_type\x18\v \x01(\x0e2\x10.coloc.SplitTypeR\tsplitType\x121\n" +
 	"\n" +
@@ -2009,9 +3870,11 @@ const file_expense_proto_rawDesc = "" +
 	"\tis_active\x18\x0f \x01(\bR\bisActive\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x10 \x01(\tR\tcreatedAt\x124\n" +
-	"\x06splits\x18\x11 \x03(\v2\x1c.coloc.RecurringExpenseSplitR\x06splitsB\x0e\n" +
+	"\x06splits\x18\x11 \x03(\v2\x1c.coloc.RecurringExpenseSplitR\x06splits\x12&\n" +
+	"\fpaused_until\x18\x12 \x01(\tH\x02R\vpausedUntil\x88\x01\x01B\x0e\n" +
 	"\f_descriptionB\v\n" +
-	"\t_end_date\"\x8c\x01\n" +
+	"\t_end_dateB\x0f\n" +
+	"\r_paused_until\"\xa4\x01\n" +
 	"\x15RecurringExpenseSplit\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1e\n" +
 	"\n" +
@@ -2019,48 +3882,125 @@ const file_expense_proto_rawDesc = "" +
 	"percentage\x12\x19\n" +
 	"\buser_nom\x18\x03 \x01(\tR\auserNom\x12\x1f\n" +
 	"\vuser_prenom\x18\x04 \x01(\tR\n" +
-	"userPrenom\"\\\n" +
+	"userPrenom\x12\x16\n" +
+	"\x06shares\x18\x05 \x01(\x05R\x06shares\"\xdc\x01\n" +
+	"\x13RecurringOccurrence\x12!\n" +
+	"\frecurring_id\x18\x01 \x01(\tR\vrecurringId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12$\n" +
+	"\x06amount\x18\x03 \x01(\v2\f.coloc.MoneyR\x06amount\x12\x17\n" +
+	"\apaid_by\x18\x04 \x01(\tR\x06paidBy\x12/\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x17.coloc.OccurrenceStatusR\x06status\x12\x1e\n" +
+	"\n" +
+	"overridden\x18\x06 \x01(\bR\n" +
+	"overridden\"x\n" +
+	"\x1cPauseRecurringExpenseRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x19\n" +
+	"\x05until\x18\x03 \x01(\tH\x00R\x05until\x88\x01\x01B\b\n" +
+	"\x06_until\"~\n" +
+	"\x1eListUpcomingOccurrencesRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12!\n" +
+	"\frecurring_id\x18\x02 \x01(\tR\vrecurringId\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"_\n" +
+	"\x1fListUpcomingOccurrencesResponse\x12<\n" +
+	"\voccurrences\x18\x01 \x03(\v2\x1a.coloc.RecurringOccurrenceR\voccurrences\"\xf3\x01\n" +
+	"\x1cSetOccurrenceOverrideRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12!\n" +
+	"\frecurring_id\x18\x02 \x01(\tR\vrecurringId\x12'\n" +
+	"\x0foccurrence_date\x18\x03 \x01(\tR\x0eoccurrenceDate\x12\x12\n" +
+	"\x04skip\x18\x04 \x01(\bR\x04skip\x12$\n" +
+	"\x06amount\x18\x05 \x01(\v2\f.coloc.MoneyR\x06amount\x12\x1c\n" +
+	"\apaid_by\x18\x06 \x01(\tH\x00R\x06paidBy\x88\x01\x01B\n" +
+	"\n" +
+	"\b_paid_by\"\x91\x01\n" +
+	"\x1eClearOccurrenceOverrideRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12!\n" +
+	"\frecurring_id\x18\x02 \x01(\tR\vrecurringId\x12'\n" +
+	"\x0foccurrence_date\x18\x03 \x01(\tR\x0eoccurrenceDate\"\x85\x01\n" +
 	"\x12GetForecastRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12!\n" +
-	"\fmonths_ahead\x18\x02 \x01(\x05R\vmonthsAhead\"K\n" +
+	"\fmonths_ahead\x18\x02 \x01(\x05R\vmonthsAhead\x12'\n" +
+	"\x04mode\x18\x03 \x01(\x0e2\x13.coloc.ForecastModeR\x04mode\"K\n" +
 	"\x13GetForecastResponse\x124\n" +
-	"\tforecasts\x18\x01 \x03(\v2\x16.coloc.MonthlyForecastR\tforecasts\"\x83\x01\n" +
+	"\tforecasts\x18\x01 \x03(\v2\x16.coloc.MonthlyForecastR\tforecasts\"\xdf\x02\n" +
 	"\x0fMonthlyForecast\x12\x14\n" +
-	"\x05month\x18\x01 \x01(\tR\x05month\x12!\n" +
-	"\ftotal_amount\x18\x02 \x01(\x01R\vtotalAmount\x127\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12/\n" +
+	"\ftotal_amount\x18\x02 \x01(\v2\f.coloc.MoneyR\vtotalAmount\x127\n" +
 	"\n" +
 	"categories\x18\x03 \x03(\v2\x17.coloc.CategoryForecastR\n" +
-	"categories\"p\n" +
+	"categories\x127\n" +
+	"\x10recurring_amount\x18\x04 \x01(\v2\f.coloc.MoneyR\x0frecurringAmount\x127\n" +
+	"\x10estimated_amount\x18\x05 \x01(\v2\f.coloc.MoneyR\x0festimatedAmount\x12+\n" +
+	"\n" +
+	"low_amount\x18\x06 \x01(\v2\f.coloc.MoneyR\tlowAmount\x12-\n" +
+	"\vhigh_amount\x18\a \x01(\v2\f.coloc.MoneyR\n" +
+	"highAmount\"\xcc\x02\n" +
 	"\x10CategoryForecast\x12\x1f\n" +
 	"\vcategory_id\x18\x01 \x01(\tR\n" +
 	"categoryId\x12#\n" +
-	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x01R\x06amount*o\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12$\n" +
+	"\x06amount\x18\x03 \x01(\v2\f.coloc.MoneyR\x06amount\x127\n" +
+	"\x10recurring_amount\x18\x04 \x01(\v2\f.coloc.MoneyR\x0frecurringAmount\x127\n" +
+	"\x10estimated_amount\x18\x05 \x01(\v2\f.coloc.MoneyR\x0festimatedAmount\x12+\n" +
+	"\n" +
+	"low_amount\x18\x06 \x01(\v2\f.coloc.MoneyR\tlowAmount\x12-\n" +
+	"\vhigh_amount\x18\a \x01(\v2\f.coloc.MoneyR\n" +
+	"highAmount*\xbe\x01\n" +
 	"\tSplitType\x12\x1a\n" +
 	"\x16SPLIT_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10SPLIT_TYPE_EQUAL\x10\x01\x12\x19\n" +
 	"\x15SPLIT_TYPE_PERCENTAGE\x10\x02\x12\x15\n" +
-	"\x11SPLIT_TYPE_CUSTOM\x10\x03*\x84\x01\n" +
+	"\x11SPLIT_TYPE_CUSTOM\x10\x03\x12\x17\n" +
+	"\x13SPLIT_TYPE_ITEMIZED\x10\x04\x12\x1d\n" +
+	"\x19SPLIT_TYPE_EQUAL_SELECTED\x10\x05\x12\x15\n" +
+	"\x11SPLIT_TYPE_SHARES\x10\x06*\x84\x01\n" +
 	"\n" +
 	"Recurrence\x12\x1a\n" +
 	"\x16RECURRENCE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10RECURRENCE_DAILY\x10\x01\x12\x15\n" +
 	"\x11RECURRENCE_WEEKLY\x10\x02\x12\x16\n" +
 	"\x12RECURRENCE_MONTHLY\x10\x03\x12\x15\n" +
-	"\x11RECURRENCE_YEARLY\x10\x042\xf6\n" +
-	"\n" +
+	"\x11RECURRENCE_YEARLY\x10\x04*\xbb\x01\n" +
+	"\x15SplitSettlementStatus\x12'\n" +
+	"#SPLIT_SETTLEMENT_STATUS_UNSPECIFIED\x10\x00\x12%\n" +
+	"!SPLIT_SETTLEMENT_STATUS_UNSETTLED\x10\x01\x12-\n" +
+	")SPLIT_SETTLEMENT_STATUS_PARTIALLY_SETTLED\x10\x02\x12#\n" +
+	"\x1fSPLIT_SETTLEMENT_STATUS_SETTLED\x10\x03*\xa2\x01\n" +
+	"\x0eRevisionAction\x12\x1f\n" +
+	"\x1bREVISION_ACTION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16REVISION_ACTION_CREATE\x10\x01\x12\x1a\n" +
+	"\x16REVISION_ACTION_UPDATE\x10\x02\x12\x1a\n" +
+	"\x16REVISION_ACTION_DELETE\x10\x03\x12\x1b\n" +
+	"\x17REVISION_ACTION_RESTORE\x10\x04*\x93\x01\n" +
+	"\x10OccurrenceStatus\x12!\n" +
+	"\x1dOCCURRENCE_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bOCCURRENCE_STATUS_SCHEDULED\x10\x01\x12\x1d\n" +
+	"\x19OCCURRENCE_STATUS_SKIPPED\x10\x02\x12\x1c\n" +
+	"\x18OCCURRENCE_STATUS_PAUSED\x10\x03*e\n" +
+	"\fForecastMode\x12\x1d\n" +
+	"\x19FORECAST_MODE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17FORECAST_MODE_RECURRING\x10\x01\x12\x19\n" +
+	"\x15FORECAST_MODE_HISTORY\x10\x022\xde\x15\n" +
 	"\x0eExpenseService\x12r\n" +
 	"\rCreateExpense\x12\x1b.coloc.CreateExpenseRequest\x1a\x0e.coloc.Expense\"4\x82\xd3\xe4\x93\x02.:\x01*\")/api/colocations/{colocation_id}/expenses\x12n\n" +
 	"\n" +
 	"GetExpense\x12\x18.coloc.GetExpenseRequest\x1a\x0e.coloc.Expense\"6\x82\xd3\xe4\x93\x020\x12./api/colocations/{colocation_id}/expenses/{id}\x12z\n" +
 	"\fListExpenses\x12\x1a.coloc.ListExpensesRequest\x1a\x1b.coloc.ListExpensesResponse\"1\x82\xd3\xe4\x93\x02+\x12)/api/colocations/{colocation_id}/expenses\x12w\n" +
 	"\rUpdateExpense\x12\x1b.coloc.UpdateExpenseRequest\x1a\x0e.coloc.Expense\"9\x82\xd3\xe4\x93\x023:\x01*\x1a./api/colocations/{colocation_id}/expenses/{id}\x12\x82\x01\n" +
-	"\rDeleteExpense\x12\x1b.coloc.DeleteExpenseRequest\x1a\x1c.coloc.DeleteExpenseResponse\"6\x82\xd3\xe4\x93\x020*./api/colocations/{colocation_id}/expenses/{id}\x12\x97\x01\n" +
+	"\rDeleteExpense\x12\x1b.coloc.DeleteExpenseRequest\x1a\x1c.coloc.DeleteExpenseResponse\"6\x82\xd3\xe4\x93\x020*./api/colocations/{colocation_id}/expenses/{id}\x12\x81\x01\n" +
+	"\x0eRestoreExpense\x12\x1c.coloc.RestoreExpenseRequest\x1a\x0e.coloc.Expense\"A\x82\xd3\xe4\x93\x02;:\x01*\"6/api/colocations/{colocation_id}/expenses/{id}/restore\x12\xa1\x01\n" +
+	"\x12ListExpenseHistory\x12 .coloc.ListExpenseHistoryRequest\x1a!.coloc.ListExpenseHistoryResponse\"F\x82\xd3\xe4\x93\x02@\x12>/api/colocations/{colocation_id}/expenses/{expense_id}/history\x12\xac\x01\n" +
+	"\x16RestoreExpenseRevision\x12$.coloc.RestoreExpenseRevisionRequest\x1a\x0e.coloc.Expense\"\\\x82\xd3\xe4\x93\x02V:\x01*\"Q/api/colocations/{colocation_id}/expenses/{expense_id}/history/{revision}/restore\x12\x97\x01\n" +
 	"\x16CreateRecurringExpense\x12$.coloc.CreateRecurringExpenseRequest\x1a\x17.coloc.RecurringExpense\">\x82\xd3\xe4\x93\x028:\x01*\"3/api/colocations/{colocation_id}/recurring-expenses\x12\x9f\x01\n" +
 	"\x15ListRecurringExpenses\x12#.coloc.ListRecurringExpensesRequest\x1a$.coloc.ListRecurringExpensesResponse\";\x82\xd3\xe4\x93\x025\x123/api/colocations/{colocation_id}/recurring-expenses\x12\x9c\x01\n" +
 	"\x16UpdateRecurringExpense\x12$.coloc.UpdateRecurringExpenseRequest\x1a\x17.coloc.RecurringExpense\"C\x82\xd3\xe4\x93\x02=:\x01*\x1a8/api/colocations/{colocation_id}/recurring-expenses/{id}\x12\xa7\x01\n" +
-	"\x16DeleteRecurringExpense\x12$.coloc.DeleteRecurringExpenseRequest\x1a%.coloc.DeleteRecurringExpenseResponse\"@\x82\xd3\xe4\x93\x02:*8/api/colocations/{colocation_id}/recurring-expenses/{id}\x12\x80\x01\n" +
-	"\vGetForecast\x12\x19.coloc.GetForecastRequest\x1a\x1a.coloc.GetForecastResponse\":\x82\xd3\xe4\x93\x024\x122/api/colocations/{colocation_id}/expenses/forecastB,Z*github.com/vblanchet22/back_coloc/proto/pbb\x06proto3"
+	"\x16DeleteRecurringExpense\x12$.coloc.DeleteRecurringExpenseRequest\x1a%.coloc.DeleteRecurringExpenseResponse\"@\x82\xd3\xe4\x93\x02:*8/api/colocations/{colocation_id}/recurring-expenses/{id}\x12\xa0\x01\n" +
+	"\x15PauseRecurringExpense\x12#.coloc.PauseRecurringExpenseRequest\x1a\x17.coloc.RecurringExpense\"I\x82\xd3\xe4\x93\x02C:\x01*\">/api/colocations/{colocation_id}/recurring-expenses/{id}/pause\x12\xc0\x01\n" +
+	"\x17ListUpcomingOccurrences\x12%.coloc.ListUpcomingOccurrencesRequest\x1a&.coloc.ListUpcomingOccurrencesResponse\"V\x82\xd3\xe4\x93\x02P\x12N/api/colocations/{colocation_id}/recurring-expenses/{recurring_id}/occurrences\x12\xc5\x01\n" +
+	"\x15SetOccurrenceOverride\x12#.coloc.SetOccurrenceOverrideRequest\x1a\x1a.coloc.RecurringOccurrence\"k\x82\xd3\xe4\x93\x02e:\x01*\x1a`/api/colocations/{colocation_id}/recurring-expenses/{recurring_id}/occurrences/{occurrence_date}\x12\xc6\x01\n" +
+	"\x17ClearOccurrenceOverride\x12%.coloc.ClearOccurrenceOverrideRequest\x1a\x1a.coloc.RecurringOccurrence\"h\x82\xd3\xe4\x93\x02b*`/api/colocations/{colocation_id}/recurring-expenses/{recurring_id}/occurrences/{occurrence_date}\x12\x80\x01\n" +
+	"\vGetForecast\x12\x19.coloc.GetForecastRequest\x1a\x1a.coloc.GetForecastResponse\":\x82\xd3\xe4\x93\x024\x122/api/colocations/{colocation_id}/expenses/forecast\x12\x97\x01\n" +
+	"\x13ListDeletedExpenses\x12!.coloc.ListDeletedExpensesRequest\x1a\".coloc.ListDeletedExpensesResponse\"9\x82\xd3\xe4\x93\x023\x121/api/colocations/{colocation_id}/expenses/deletedB,Z*github.com/vblanchet22/back_coloc/proto/pbb\x06proto3"
 
 var (
 	file_expense_proto_rawDescOnce sync.Once
@@ -2074,79 +4014,166 @@ func file_expense_proto_rawDescGZIP() []byte {
 	return file_expense_proto_rawDescData
 }
 
-var file_expense_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_expense_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_expense_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_expense_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_expense_proto_goTypes = []any{
-	(SplitType)(0),                         // 0: coloc.SplitType
-	(Recurrence)(0),                        // 1: coloc.Recurrence
-	(*ExpenseSplit)(nil),                   // 2: coloc.ExpenseSplit
-	(*CreateExpenseRequest)(nil),           // 3: coloc.CreateExpenseRequest
-	(*ExpenseSplitInput)(nil),              // 4: coloc.ExpenseSplitInput
-	(*GetExpenseRequest)(nil),              // 5: coloc.GetExpenseRequest
-	(*ListExpensesRequest)(nil),            // 6: coloc.ListExpensesRequest
-	(*ListExpensesResponse)(nil),           // 7: coloc.ListExpensesResponse
-	(*UpdateExpenseRequest)(nil),           // 8: coloc.UpdateExpenseRequest
-	(*DeleteExpenseRequest)(nil),           // 9: coloc.DeleteExpenseRequest
-	(*DeleteExpenseResponse)(nil),          // 10: coloc.DeleteExpenseResponse
-	(*Expense)(nil),                        // 11: coloc.Expense
-	(*CreateRecurringExpenseRequest)(nil),  // 12: coloc.CreateRecurringExpenseRequest
-	(*ListRecurringExpensesRequest)(nil),   // 13: coloc.ListRecurringExpensesRequest
-	(*ListRecurringExpensesResponse)(nil),  // 14: coloc.ListRecurringExpensesResponse
-	(*UpdateRecurringExpenseRequest)(nil),  // 15: coloc.UpdateRecurringExpenseRequest
-	(*DeleteRecurringExpenseRequest)(nil),  // 16: coloc.DeleteRecurringExpenseRequest
-	(*DeleteRecurringExpenseResponse)(nil), // 17: coloc.DeleteRecurringExpenseResponse
-	(*RecurringExpense)(nil),               // 18: coloc.RecurringExpense
-	(*RecurringExpenseSplit)(nil),          // 19: coloc.RecurringExpenseSplit
-	(*GetForecastRequest)(nil),             // 20: coloc.GetForecastRequest
-	(*GetForecastResponse)(nil),            // 21: coloc.GetForecastResponse
-	(*MonthlyForecast)(nil),                // 22: coloc.MonthlyForecast
-	(*CategoryForecast)(nil),               // 23: coloc.CategoryForecast
+	(SplitType)(0),                          // 0: coloc.SplitType
+	(Recurrence)(0),                         // 1: coloc.Recurrence
+	(SplitSettlementStatus)(0),              // 2: coloc.SplitSettlementStatus
+	(RevisionAction)(0),                     // 3: coloc.RevisionAction
+	(OccurrenceStatus)(0),                   // 4: coloc.OccurrenceStatus
+	(ForecastMode)(0),                       // 5: coloc.ForecastMode
+	(*ExpenseSplit)(nil),                    // 6: coloc.ExpenseSplit
+	(*CreateExpenseRequest)(nil),            // 7: coloc.CreateExpenseRequest
+	(*ExpenseSplitInput)(nil),               // 8: coloc.ExpenseSplitInput
+	(*ExpensePayerInput)(nil),               // 9: coloc.ExpensePayerInput
+	(*ExpensePayer)(nil),                    // 10: coloc.ExpensePayer
+	(*ExpenseItemInput)(nil),                // 11: coloc.ExpenseItemInput
+	(*ExpenseItemParticipantInput)(nil),     // 12: coloc.ExpenseItemParticipantInput
+	(*ExpenseItem)(nil),                     // 13: coloc.ExpenseItem
+	(*ExpenseItemParticipant)(nil),          // 14: coloc.ExpenseItemParticipant
+	(*GetExpenseRequest)(nil),               // 15: coloc.GetExpenseRequest
+	(*ListExpensesRequest)(nil),             // 16: coloc.ListExpensesRequest
+	(*ListExpensesResponse)(nil),            // 17: coloc.ListExpensesResponse
+	(*UpdateExpenseRequest)(nil),            // 18: coloc.UpdateExpenseRequest
+	(*DeleteExpenseRequest)(nil),            // 19: coloc.DeleteExpenseRequest
+	(*DeleteExpenseResponse)(nil),           // 20: coloc.DeleteExpenseResponse
+	(*RestoreExpenseRequest)(nil),           // 21: coloc.RestoreExpenseRequest
+	(*ListDeletedExpensesRequest)(nil),      // 22: coloc.ListDeletedExpensesRequest
+	(*ListDeletedExpensesResponse)(nil),     // 23: coloc.ListDeletedExpensesResponse
+	(*Expense)(nil),                         // 24: coloc.Expense
+	(*ListExpenseHistoryRequest)(nil),       // 25: coloc.ListExpenseHistoryRequest
+	(*ListExpenseHistoryResponse)(nil),      // 26: coloc.ListExpenseHistoryResponse
+	(*ExpenseRevision)(nil),                 // 27: coloc.ExpenseRevision
+	(*ExpenseFieldChange)(nil),              // 28: coloc.ExpenseFieldChange
+	(*RestoreExpenseRevisionRequest)(nil),   // 29: coloc.RestoreExpenseRevisionRequest
+	(*CreateRecurringExpenseRequest)(nil),   // 30: coloc.CreateRecurringExpenseRequest
+	(*ListRecurringExpensesRequest)(nil),    // 31: coloc.ListRecurringExpensesRequest
+	(*ListRecurringExpensesResponse)(nil),   // 32: coloc.ListRecurringExpensesResponse
+	(*UpdateRecurringExpenseRequest)(nil),   // 33: coloc.UpdateRecurringExpenseRequest
+	(*DeleteRecurringExpenseRequest)(nil),   // 34: coloc.DeleteRecurringExpenseRequest
+	(*DeleteRecurringExpenseResponse)(nil),  // 35: coloc.DeleteRecurringExpenseResponse
+	(*RecurringExpense)(nil),                // 36: coloc.RecurringExpense
+	(*RecurringExpenseSplit)(nil),           // 37: coloc.RecurringExpenseSplit
+	(*RecurringOccurrence)(nil),             // 38: coloc.RecurringOccurrence
+	(*PauseRecurringExpenseRequest)(nil),    // 39: coloc.PauseRecurringExpenseRequest
+	(*ListUpcomingOccurrencesRequest)(nil),  // 40: coloc.ListUpcomingOccurrencesRequest
+	(*ListUpcomingOccurrencesResponse)(nil), // 41: coloc.ListUpcomingOccurrencesResponse
+	(*SetOccurrenceOverrideRequest)(nil),    // 42: coloc.SetOccurrenceOverrideRequest
+	(*ClearOccurrenceOverrideRequest)(nil),  // 43: coloc.ClearOccurrenceOverrideRequest
+	(*GetForecastRequest)(nil),              // 44: coloc.GetForecastRequest
+	(*GetForecastResponse)(nil),             // 45: coloc.GetForecastResponse
+	(*MonthlyForecast)(nil),                 // 46: coloc.MonthlyForecast
+	(*CategoryForecast)(nil),                // 47: coloc.CategoryForecast
+	(*Money)(nil),                           // 48: coloc.Money
+	(*Tag)(nil),                             // 49: coloc.Tag
 }
 var file_expense_proto_depIdxs = []int32{
-	0,  // 0: coloc.CreateExpenseRequest.split_type:type_name -> coloc.SplitType
-	4,  // 1: coloc.CreateExpenseRequest.splits:type_name -> coloc.ExpenseSplitInput
-	11, // 2: coloc.ListExpensesResponse.expenses:type_name -> coloc.Expense
-	0,  // 3: coloc.UpdateExpenseRequest.split_type:type_name -> coloc.SplitType
-	4,  // 4: coloc.UpdateExpenseRequest.splits:type_name -> coloc.ExpenseSplitInput
-	0,  // 5: coloc.Expense.split_type:type_name -> coloc.SplitType
-	2,  // 6: coloc.Expense.splits:type_name -> coloc.ExpenseSplit
-	0,  // 7: coloc.CreateRecurringExpenseRequest.split_type:type_name -> coloc.SplitType
-	4,  // 8: coloc.CreateRecurringExpenseRequest.splits:type_name -> coloc.ExpenseSplitInput
-	1,  // 9: coloc.CreateRecurringExpenseRequest.recurrence:type_name -> coloc.Recurrence
-	18, // 10: coloc.ListRecurringExpensesResponse.recurring_expenses:type_name -> coloc.RecurringExpense
-	0,  // 11: coloc.UpdateRecurringExpenseRequest.split_type:type_name -> coloc.SplitType
-	4,  // 12: coloc.UpdateRecurringExpenseRequest.splits:type_name -> coloc.ExpenseSplitInput
-	1,  // 13: coloc.UpdateRecurringExpenseRequest.recurrence:type_name -> coloc.Recurrence
-	0,  // 14: coloc.RecurringExpense.split_type:type_name -> coloc.SplitType
-	1,  // 15: coloc.RecurringExpense.recurrence:type_name -> coloc.Recurrence
-	19, // 16: coloc.RecurringExpense.splits:type_name -> coloc.RecurringExpenseSplit
-	22, // 17: coloc.GetForecastResponse.forecasts:type_name -> coloc.MonthlyForecast
-	23, // 18: coloc.MonthlyForecast.categories:type_name -> coloc.CategoryForecast
-	3,  // 19: coloc.ExpenseService.CreateExpense:input_type -> coloc.CreateExpenseRequest
-	5,  // 20: coloc.ExpenseService.GetExpense:input_type -> coloc.GetExpenseRequest
-	6,  // 21: coloc.ExpenseService.ListExpenses:input_type -> coloc.ListExpensesRequest
-	8,  // 22: coloc.ExpenseService.UpdateExpense:input_type -> coloc.UpdateExpenseRequest
-	9,  // 23: coloc.ExpenseService.DeleteExpense:input_type -> coloc.DeleteExpenseRequest
-	12, // 24: coloc.ExpenseService.CreateRecurringExpense:input_type -> coloc.CreateRecurringExpenseRequest
-	13, // 25: coloc.ExpenseService.ListRecurringExpenses:input_type -> coloc.ListRecurringExpensesRequest
-	15, // 26: coloc.ExpenseService.UpdateRecurringExpense:input_type -> coloc.UpdateRecurringExpenseRequest
-	16, // 27: coloc.ExpenseService.DeleteRecurringExpense:input_type -> coloc.DeleteRecurringExpenseRequest
-	20, // 28: coloc.ExpenseService.GetForecast:input_type -> coloc.GetForecastRequest
-	11, // 29: coloc.ExpenseService.CreateExpense:output_type -> coloc.Expense
-	11, // 30: coloc.ExpenseService.GetExpense:output_type -> coloc.Expense
-	7,  // 31: coloc.ExpenseService.ListExpenses:output_type -> coloc.ListExpensesResponse
-	11, // 32: coloc.ExpenseService.UpdateExpense:output_type -> coloc.Expense
-	10, // 33: coloc.ExpenseService.DeleteExpense:output_type -> coloc.DeleteExpenseResponse
-	18, // 34: coloc.ExpenseService.CreateRecurringExpense:output_type -> coloc.RecurringExpense
-	14, // 35: coloc.ExpenseService.ListRecurringExpenses:output_type -> coloc.ListRecurringExpensesResponse
-	18, // 36: coloc.ExpenseService.UpdateRecurringExpense:output_type -> coloc.RecurringExpense
-	17, // 37: coloc.ExpenseService.DeleteRecurringExpense:output_type -> coloc.DeleteRecurringExpenseResponse
-	21, // 38: coloc.ExpenseService.GetForecast:output_type -> coloc.GetForecastResponse
-	29, // [29:39] is the sub-list for method output_type
-	19, // [19:29] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	48, // 0: coloc.ExpenseSplit.amount:type_name -> coloc.Money
+	48, // 1: coloc.ExpenseSplit.base_amount:type_name -> coloc.Money
+	48, // 2: coloc.ExpenseSplit.settled_amount:type_name -> coloc.Money
+	2,  // 3: coloc.ExpenseSplit.settlement_status:type_name -> coloc.SplitSettlementStatus
+	48, // 4: coloc.CreateExpenseRequest.amount:type_name -> coloc.Money
+	0,  // 5: coloc.CreateExpenseRequest.split_type:type_name -> coloc.SplitType
+	8,  // 6: coloc.CreateExpenseRequest.splits:type_name -> coloc.ExpenseSplitInput
+	11, // 7: coloc.CreateExpenseRequest.items:type_name -> coloc.ExpenseItemInput
+	9,  // 8: coloc.CreateExpenseRequest.payers:type_name -> coloc.ExpensePayerInput
+	48, // 9: coloc.ExpenseSplitInput.amount:type_name -> coloc.Money
+	48, // 10: coloc.ExpensePayerInput.amount:type_name -> coloc.Money
+	48, // 11: coloc.ExpensePayer.amount:type_name -> coloc.Money
+	48, // 12: coloc.ExpensePayer.base_amount:type_name -> coloc.Money
+	48, // 13: coloc.ExpenseItemInput.amount:type_name -> coloc.Money
+	12, // 14: coloc.ExpenseItemInput.participants:type_name -> coloc.ExpenseItemParticipantInput
+	48, // 15: coloc.ExpenseItem.amount:type_name -> coloc.Money
+	14, // 16: coloc.ExpenseItem.participants:type_name -> coloc.ExpenseItemParticipant
+	24, // 17: coloc.ListExpensesResponse.expenses:type_name -> coloc.Expense
+	48, // 18: coloc.UpdateExpenseRequest.amount:type_name -> coloc.Money
+	0,  // 19: coloc.UpdateExpenseRequest.split_type:type_name -> coloc.SplitType
+	8,  // 20: coloc.UpdateExpenseRequest.splits:type_name -> coloc.ExpenseSplitInput
+	11, // 21: coloc.UpdateExpenseRequest.items:type_name -> coloc.ExpenseItemInput
+	9,  // 22: coloc.UpdateExpenseRequest.payers:type_name -> coloc.ExpensePayerInput
+	24, // 23: coloc.ListDeletedExpensesResponse.expenses:type_name -> coloc.Expense
+	48, // 24: coloc.Expense.amount:type_name -> coloc.Money
+	0,  // 25: coloc.Expense.split_type:type_name -> coloc.SplitType
+	6,  // 26: coloc.Expense.splits:type_name -> coloc.ExpenseSplit
+	48, // 27: coloc.Expense.base_amount:type_name -> coloc.Money
+	13, // 28: coloc.Expense.items:type_name -> coloc.ExpenseItem
+	10, // 29: coloc.Expense.payers:type_name -> coloc.ExpensePayer
+	49, // 30: coloc.Expense.tags:type_name -> coloc.Tag
+	27, // 31: coloc.ListExpenseHistoryResponse.revisions:type_name -> coloc.ExpenseRevision
+	3,  // 32: coloc.ExpenseRevision.action:type_name -> coloc.RevisionAction
+	28, // 33: coloc.ExpenseRevision.changes:type_name -> coloc.ExpenseFieldChange
+	48, // 34: coloc.CreateRecurringExpenseRequest.amount:type_name -> coloc.Money
+	0,  // 35: coloc.CreateRecurringExpenseRequest.split_type:type_name -> coloc.SplitType
+	8,  // 36: coloc.CreateRecurringExpenseRequest.splits:type_name -> coloc.ExpenseSplitInput
+	1,  // 37: coloc.CreateRecurringExpenseRequest.recurrence:type_name -> coloc.Recurrence
+	36, // 38: coloc.ListRecurringExpensesResponse.recurring_expenses:type_name -> coloc.RecurringExpense
+	48, // 39: coloc.UpdateRecurringExpenseRequest.amount:type_name -> coloc.Money
+	0,  // 40: coloc.UpdateRecurringExpenseRequest.split_type:type_name -> coloc.SplitType
+	8,  // 41: coloc.UpdateRecurringExpenseRequest.splits:type_name -> coloc.ExpenseSplitInput
+	1,  // 42: coloc.UpdateRecurringExpenseRequest.recurrence:type_name -> coloc.Recurrence
+	48, // 43: coloc.RecurringExpense.amount:type_name -> coloc.Money
+	0,  // 44: coloc.RecurringExpense.split_type:type_name -> coloc.SplitType
+	1,  // 45: coloc.RecurringExpense.recurrence:type_name -> coloc.Recurrence
+	37, // 46: coloc.RecurringExpense.splits:type_name -> coloc.RecurringExpenseSplit
+	48, // 47: coloc.RecurringOccurrence.amount:type_name -> coloc.Money
+	4,  // 48: coloc.RecurringOccurrence.status:type_name -> coloc.OccurrenceStatus
+	38, // 49: coloc.ListUpcomingOccurrencesResponse.occurrences:type_name -> coloc.RecurringOccurrence
+	48, // 50: coloc.SetOccurrenceOverrideRequest.amount:type_name -> coloc.Money
+	5,  // 51: coloc.GetForecastRequest.mode:type_name -> coloc.ForecastMode
+	46, // 52: coloc.GetForecastResponse.forecasts:type_name -> coloc.MonthlyForecast
+	48, // 53: coloc.MonthlyForecast.total_amount:type_name -> coloc.Money
+	47, // 54: coloc.MonthlyForecast.categories:type_name -> coloc.CategoryForecast
+	48, // 55: coloc.MonthlyForecast.recurring_amount:type_name -> coloc.Money
+	48, // 56: coloc.MonthlyForecast.estimated_amount:type_name -> coloc.Money
+	48, // 57: coloc.MonthlyForecast.low_amount:type_name -> coloc.Money
+	48, // 58: coloc.MonthlyForecast.high_amount:type_name -> coloc.Money
+	48, // 59: coloc.CategoryForecast.amount:type_name -> coloc.Money
+	48, // 60: coloc.CategoryForecast.recurring_amount:type_name -> coloc.Money
+	48, // 61: coloc.CategoryForecast.estimated_amount:type_name -> coloc.Money
+	48, // 62: coloc.CategoryForecast.low_amount:type_name -> coloc.Money
+	48, // 63: coloc.CategoryForecast.high_amount:type_name -> coloc.Money
+	7,  // 64: coloc.ExpenseService.CreateExpense:input_type -> coloc.CreateExpenseRequest
+	15, // 65: coloc.ExpenseService.GetExpense:input_type -> coloc.GetExpenseRequest
+	16, // 66: coloc.ExpenseService.ListExpenses:input_type -> coloc.ListExpensesRequest
+	18, // 67: coloc.ExpenseService.UpdateExpense:input_type -> coloc.UpdateExpenseRequest
+	19, // 68: coloc.ExpenseService.DeleteExpense:input_type -> coloc.DeleteExpenseRequest
+	21, // 69: coloc.ExpenseService.RestoreExpense:input_type -> coloc.RestoreExpenseRequest
+	25, // 70: coloc.ExpenseService.ListExpenseHistory:input_type -> coloc.ListExpenseHistoryRequest
+	29, // 71: coloc.ExpenseService.RestoreExpenseRevision:input_type -> coloc.RestoreExpenseRevisionRequest
+	30, // 72: coloc.ExpenseService.CreateRecurringExpense:input_type -> coloc.CreateRecurringExpenseRequest
+	31, // 73: coloc.ExpenseService.ListRecurringExpenses:input_type -> coloc.ListRecurringExpensesRequest
+	33, // 74: coloc.ExpenseService.UpdateRecurringExpense:input_type -> coloc.UpdateRecurringExpenseRequest
+	34, // 75: coloc.ExpenseService.DeleteRecurringExpense:input_type -> coloc.DeleteRecurringExpenseRequest
+	39, // 76: coloc.ExpenseService.PauseRecurringExpense:input_type -> coloc.PauseRecurringExpenseRequest
+	40, // 77: coloc.ExpenseService.ListUpcomingOccurrences:input_type -> coloc.ListUpcomingOccurrencesRequest
+	42, // 78: coloc.ExpenseService.SetOccurrenceOverride:input_type -> coloc.SetOccurrenceOverrideRequest
+	43, // 79: coloc.ExpenseService.ClearOccurrenceOverride:input_type -> coloc.ClearOccurrenceOverrideRequest
+	44, // 80: coloc.ExpenseService.GetForecast:input_type -> coloc.GetForecastRequest
+	22, // 81: coloc.ExpenseService.ListDeletedExpenses:input_type -> coloc.ListDeletedExpensesRequest
+	24, // 82: coloc.ExpenseService.CreateExpense:output_type -> coloc.Expense
+	24, // 83: coloc.ExpenseService.GetExpense:output_type -> coloc.Expense
+	17, // 84: coloc.ExpenseService.ListExpenses:output_type -> coloc.ListExpensesResponse
+	24, // 85: coloc.ExpenseService.UpdateExpense:output_type -> coloc.Expense
+	20, // 86: coloc.ExpenseService.DeleteExpense:output_type -> coloc.DeleteExpenseResponse
+	24, // 87: coloc.ExpenseService.RestoreExpense:output_type -> coloc.Expense
+	26, // 88: coloc.ExpenseService.ListExpenseHistory:output_type -> coloc.ListExpenseHistoryResponse
+	24, // 89: coloc.ExpenseService.RestoreExpenseRevision:output_type -> coloc.Expense
+	36, // 90: coloc.ExpenseService.CreateRecurringExpense:output_type -> coloc.RecurringExpense
+	32, // 91: coloc.ExpenseService.ListRecurringExpenses:output_type -> coloc.ListRecurringExpensesResponse
+	36, // 92: coloc.ExpenseService.UpdateRecurringExpense:output_type -> coloc.RecurringExpense
+	35, // 93: coloc.ExpenseService.DeleteRecurringExpense:output_type -> coloc.DeleteRecurringExpenseResponse
+	36, // 94: coloc.ExpenseService.PauseRecurringExpense:output_type -> coloc.RecurringExpense
+	41, // 95: coloc.ExpenseService.ListUpcomingOccurrences:output_type -> coloc.ListUpcomingOccurrencesResponse
+	38, // 96: coloc.ExpenseService.SetOccurrenceOverride:output_type -> coloc.RecurringOccurrence
+	38, // 97: coloc.ExpenseService.ClearOccurrenceOverride:output_type -> coloc.RecurringOccurrence
+	45, // 98: coloc.ExpenseService.GetForecast:output_type -> coloc.GetForecastResponse
+	23, // 99: coloc.ExpenseService.ListDeletedExpenses:output_type -> coloc.ListDeletedExpensesResponse
+	82, // [82:100] is the sub-list for method output_type
+	64, // [64:82] is the sub-list for method input_type
+	64, // [64:64] is the sub-list for extension type_name
+	64, // [64:64] is the sub-list for extension extendee
+	0,  // [0:64] is the sub-list for field type_name
 }
 
 func init() { file_expense_proto_init() }
@@ -2154,20 +4181,25 @@ func file_expense_proto_init() {
 	if File_expense_proto != nil {
 		return
 	}
+	file_common_proto_init()
+	file_tag_proto_init()
 	file_expense_proto_msgTypes[1].OneofWrappers = []any{}
-	file_expense_proto_msgTypes[4].OneofWrappers = []any{}
-	file_expense_proto_msgTypes[6].OneofWrappers = []any{}
-	file_expense_proto_msgTypes[9].OneofWrappers = []any{}
 	file_expense_proto_msgTypes[10].OneofWrappers = []any{}
-	file_expense_proto_msgTypes[13].OneofWrappers = []any{}
-	file_expense_proto_msgTypes[16].OneofWrappers = []any{}
+	file_expense_proto_msgTypes[12].OneofWrappers = []any{}
+	file_expense_proto_msgTypes[18].OneofWrappers = []any{}
+	file_expense_proto_msgTypes[21].OneofWrappers = []any{}
+	file_expense_proto_msgTypes[24].OneofWrappers = []any{}
+	file_expense_proto_msgTypes[27].OneofWrappers = []any{}
+	file_expense_proto_msgTypes[30].OneofWrappers = []any{}
+	file_expense_proto_msgTypes[33].OneofWrappers = []any{}
+	file_expense_proto_msgTypes[36].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_expense_proto_rawDesc), len(file_expense_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   22,
+			NumEnums:      6,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,16 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ExpenseService_CreateExpense_FullMethodName          = "/coloc.ExpenseService/CreateExpense"
-	ExpenseService_GetExpense_FullMethodName             = "/coloc.ExpenseService/GetExpense"
-	ExpenseService_ListExpenses_FullMethodName           = "/coloc.ExpenseService/ListExpenses"
-	ExpenseService_UpdateExpense_FullMethodName          = "/coloc.ExpenseService/UpdateExpense"
-	ExpenseService_DeleteExpense_FullMethodName          = "/coloc.ExpenseService/DeleteExpense"
-	ExpenseService_CreateRecurringExpense_FullMethodName = "/coloc.ExpenseService/CreateRecurringExpense"
-	ExpenseService_ListRecurringExpenses_FullMethodName  = "/coloc.ExpenseService/ListRecurringExpenses"
-	ExpenseService_UpdateRecurringExpense_FullMethodName = "/coloc.ExpenseService/UpdateRecurringExpense"
-	ExpenseService_DeleteRecurringExpense_FullMethodName = "/coloc.ExpenseService/DeleteRecurringExpense"
-	ExpenseService_GetForecast_FullMethodName            = "/coloc.ExpenseService/GetForecast"
+	ExpenseService_CreateExpense_FullMethodName           = "/coloc.ExpenseService/CreateExpense"
+	ExpenseService_GetExpense_FullMethodName              = "/coloc.ExpenseService/GetExpense"
+	ExpenseService_ListExpenses_FullMethodName            = "/coloc.ExpenseService/ListExpenses"
+	ExpenseService_UpdateExpense_FullMethodName           = "/coloc.ExpenseService/UpdateExpense"
+	ExpenseService_DeleteExpense_FullMethodName           = "/coloc.ExpenseService/DeleteExpense"
+	ExpenseService_RestoreExpense_FullMethodName          = "/coloc.ExpenseService/RestoreExpense"
+	ExpenseService_ListExpenseHistory_FullMethodName      = "/coloc.ExpenseService/ListExpenseHistory"
+	ExpenseService_RestoreExpenseRevision_FullMethodName  = "/coloc.ExpenseService/RestoreExpenseRevision"
+	ExpenseService_CreateRecurringExpense_FullMethodName  = "/coloc.ExpenseService/CreateRecurringExpense"
+	ExpenseService_ListRecurringExpenses_FullMethodName   = "/coloc.ExpenseService/ListRecurringExpenses"
+	ExpenseService_UpdateRecurringExpense_FullMethodName  = "/coloc.ExpenseService/UpdateRecurringExpense"
+	ExpenseService_DeleteRecurringExpense_FullMethodName  = "/coloc.ExpenseService/DeleteRecurringExpense"
+	ExpenseService_PauseRecurringExpense_FullMethodName   = "/coloc.ExpenseService/PauseRecurringExpense"
+	ExpenseService_ListUpcomingOccurrences_FullMethodName = "/coloc.ExpenseService/ListUpcomingOccurrences"
+	ExpenseService_SetOccurrenceOverride_FullMethodName   = "/coloc.ExpenseService/SetOccurrenceOverride"
+	ExpenseService_ClearOccurrenceOverride_FullMethodName = "/coloc.ExpenseService/ClearOccurrenceOverride"
+	ExpenseService_GetForecast_FullMethodName             = "/coloc.ExpenseService/GetForecast"
+	ExpenseService_ListDeletedExpenses_FullMethodName     = "/coloc.ExpenseService/ListDeletedExpenses"
 )
 
 // ExpenseServiceClient is the client API for ExpenseService service.
@@ -47,6 +55,12 @@ type ExpenseServiceClient interface {
 	UpdateExpense(ctx context.Context, in *UpdateExpenseRequest, opts ...grpc.CallOption) (*Expense, error)
 	// Delete expense
 	DeleteExpense(ctx context.Context, in *DeleteExpenseRequest, opts ...grpc.CallOption) (*DeleteExpenseResponse, error)
+	// Restore a deleted expense within the undo window
+	RestoreExpense(ctx context.Context, in *RestoreExpenseRequest, opts ...grpc.CallOption) (*Expense, error)
+	// List the history of an expense, including deleted ones
+	ListExpenseHistory(ctx context.Context, in *ListExpenseHistoryRequest, opts ...grpc.CallOption) (*ListExpenseHistoryResponse, error)
+	// Restore an expense to an earlier revision
+	RestoreExpenseRevision(ctx context.Context, in *RestoreExpenseRevisionRequest, opts ...grpc.CallOption) (*Expense, error)
 	// Create recurring expense template
 	CreateRecurringExpense(ctx context.Context, in *CreateRecurringExpenseRequest, opts ...grpc.CallOption) (*RecurringExpense, error)
 	// List recurring expenses
@@ -55,8 +69,18 @@ type ExpenseServiceClient interface {
 	UpdateRecurringExpense(ctx context.Context, in *UpdateRecurringExpenseRequest, opts ...grpc.CallOption) (*RecurringExpense, error)
 	// Delete recurring expense
 	DeleteRecurringExpense(ctx context.Context, in *DeleteRecurringExpenseRequest, opts ...grpc.CallOption) (*DeleteRecurringExpenseResponse, error)
+	// Pause a recurring expense until a date, or resume it
+	PauseRecurringExpense(ctx context.Context, in *PauseRecurringExpenseRequest, opts ...grpc.CallOption) (*RecurringExpense, error)
+	// List the next occurrences of a recurring expense
+	ListUpcomingOccurrences(ctx context.Context, in *ListUpcomingOccurrencesRequest, opts ...grpc.CallOption) (*ListUpcomingOccurrencesResponse, error)
+	// Skip an occurrence or change its amount or payer
+	SetOccurrenceOverride(ctx context.Context, in *SetOccurrenceOverrideRequest, opts ...grpc.CallOption) (*RecurringOccurrence, error)
+	// Restore an occurrence to the recurring expense
+	ClearOccurrenceOverride(ctx context.Context, in *ClearOccurrenceOverrideRequest, opts ...grpc.CallOption) (*RecurringOccurrence, error)
 	// Get expense forecast
 	GetForecast(ctx context.Context, in *GetForecastRequest, opts ...grpc.CallOption) (*GetForecastResponse, error)
+	// List deleted expenses that can still be restored
+	ListDeletedExpenses(ctx context.Context, in *ListDeletedExpensesRequest, opts ...grpc.CallOption) (*ListDeletedExpensesResponse, error)
 }
 
 type expenseServiceClient struct {
@@ -117,6 +141,36 @@ func (c *expenseServiceClient) DeleteExpense(ctx context.Context, in *DeleteExpe
 	return out, nil
 }
 
+func (c *expenseServiceClient) RestoreExpense(ctx context.Context, in *RestoreExpenseRequest, opts ...grpc.CallOption) (*Expense, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Expense)
+	err := c.cc.Invoke(ctx, ExpenseService_RestoreExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) ListExpenseHistory(ctx context.Context, in *ListExpenseHistoryRequest, opts ...grpc.CallOption) (*ListExpenseHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExpenseHistoryResponse)
+	err := c.cc.Invoke(ctx, ExpenseService_ListExpenseHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) RestoreExpenseRevision(ctx context.Context, in *RestoreExpenseRevisionRequest, opts ...grpc.CallOption) (*Expense, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Expense)
+	err := c.cc.Invoke(ctx, ExpenseService_RestoreExpenseRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *expenseServiceClient) CreateRecurringExpense(ctx context.Context, in *CreateRecurringExpenseRequest, opts ...grpc.CallOption) (*RecurringExpense, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RecurringExpense)
@@ -157,6 +211,46 @@ func (c *expenseServiceClient) DeleteRecurringExpense(ctx context.Context, in *D
 	return out, nil
 }
 
+func (c *expenseServiceClient) PauseRecurringExpense(ctx context.Context, in *PauseRecurringExpenseRequest, opts ...grpc.CallOption) (*RecurringExpense, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringExpense)
+	err := c.cc.Invoke(ctx, ExpenseService_PauseRecurringExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) ListUpcomingOccurrences(ctx context.Context, in *ListUpcomingOccurrencesRequest, opts ...grpc.CallOption) (*ListUpcomingOccurrencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUpcomingOccurrencesResponse)
+	err := c.cc.Invoke(ctx, ExpenseService_ListUpcomingOccurrences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) SetOccurrenceOverride(ctx context.Context, in *SetOccurrenceOverrideRequest, opts ...grpc.CallOption) (*RecurringOccurrence, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringOccurrence)
+	err := c.cc.Invoke(ctx, ExpenseService_SetOccurrenceOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) ClearOccurrenceOverride(ctx context.Context, in *ClearOccurrenceOverrideRequest, opts ...grpc.CallOption) (*RecurringOccurrence, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringOccurrence)
+	err := c.cc.Invoke(ctx, ExpenseService_ClearOccurrenceOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *expenseServiceClient) GetForecast(ctx context.Context, in *GetForecastRequest, opts ...grpc.CallOption) (*GetForecastResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetForecastResponse)
@@ -167,6 +261,16 @@ func (c *expenseServiceClient) GetForecast(ctx context.Context, in *GetForecastR
 	return out, nil
 }
 
+func (c *expenseServiceClient) ListDeletedExpenses(ctx context.Context, in *ListDeletedExpensesRequest, opts ...grpc.CallOption) (*ListDeletedExpensesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedExpensesResponse)
+	err := c.cc.Invoke(ctx, ExpenseService_ListDeletedExpenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExpenseServiceServer is the server API for ExpenseService service.
 // All implementations must embed UnimplementedExpenseServiceServer
 // for forward compatibility.
@@ -183,6 +287,12 @@ type ExpenseServiceServer interface {
 	UpdateExpense(context.Context, *UpdateExpenseRequest) (*Expense, error)
 	// Delete expense
 	DeleteExpense(context.Context, *DeleteExpenseRequest) (*DeleteExpenseResponse, error)
+	// Restore a deleted expense within the undo window
+	RestoreExpense(context.Context, *RestoreExpenseRequest) (*Expense, error)
+	// List the history of an expense, including deleted ones
+	ListExpenseHistory(context.Context, *ListExpenseHistoryRequest) (*ListExpenseHistoryResponse, error)
+	// Restore an expense to an earlier revision
+	RestoreExpenseRevision(context.Context, *RestoreExpenseRevisionRequest) (*Expense, error)
 	// Create recurring expense template
 	CreateRecurringExpense(context.Context, *CreateRecurringExpenseRequest) (*RecurringExpense, error)
 	// List recurring expenses
@@ -191,8 +301,18 @@ type ExpenseServiceServer interface {
 	UpdateRecurringExpense(context.Context, *UpdateRecurringExpenseRequest) (*RecurringExpense, error)
 	// Delete recurring expense
 	DeleteRecurringExpense(context.Context, *DeleteRecurringExpenseRequest) (*DeleteRecurringExpenseResponse, error)
+	// Pause a recurring expense until a date, or resume it
+	PauseRecurringExpense(context.Context, *PauseRecurringExpenseRequest) (*RecurringExpense, error)
+	// List the next occurrences of a recurring expense
+	ListUpcomingOccurrences(context.Context, *ListUpcomingOccurrencesRequest) (*ListUpcomingOccurrencesResponse, error)
+	// Skip an occurrence or change its amount or payer
+	SetOccurrenceOverride(context.Context, *SetOccurrenceOverrideRequest) (*RecurringOccurrence, error)
+	// Restore an occurrence to the recurring expense
+	ClearOccurrenceOverride(context.Context, *ClearOccurrenceOverrideRequest) (*RecurringOccurrence, error)
 	// Get expense forecast
 	GetForecast(context.Context, *GetForecastRequest) (*GetForecastResponse, error)
+	// List deleted expenses that can still be restored
+	ListDeletedExpenses(context.Context, *ListDeletedExpensesRequest) (*ListDeletedExpensesResponse, error)
 	mustEmbedUnimplementedExpenseServiceServer()
 }
 
@@ -218,6 +338,15 @@ func (UnimplementedExpenseServiceServer) UpdateExpense(context.Context, *UpdateE
 func (UnimplementedExpenseServiceServer) DeleteExpense(context.Context, *DeleteExpenseRequest) (*DeleteExpenseResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteExpense not implemented")
 }
+func (UnimplementedExpenseServiceServer) RestoreExpense(context.Context, *RestoreExpenseRequest) (*Expense, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreExpense not implemented")
+}
+func (UnimplementedExpenseServiceServer) ListExpenseHistory(context.Context, *ListExpenseHistoryRequest) (*ListExpenseHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListExpenseHistory not implemented")
+}
+func (UnimplementedExpenseServiceServer) RestoreExpenseRevision(context.Context, *RestoreExpenseRevisionRequest) (*Expense, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreExpenseRevision not implemented")
+}
 func (UnimplementedExpenseServiceServer) CreateRecurringExpense(context.Context, *CreateRecurringExpenseRequest) (*RecurringExpense, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateRecurringExpense not implemented")
 }
@@ -230,9 +359,24 @@ func (UnimplementedExpenseServiceServer) UpdateRecurringExpense(context.Context,
 func (UnimplementedExpenseServiceServer) DeleteRecurringExpense(context.Context, *DeleteRecurringExpenseRequest) (*DeleteRecurringExpenseResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteRecurringExpense not implemented")
 }
+func (UnimplementedExpenseServiceServer) PauseRecurringExpense(context.Context, *PauseRecurringExpenseRequest) (*RecurringExpense, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseRecurringExpense not implemented")
+}
+func (UnimplementedExpenseServiceServer) ListUpcomingOccurrences(context.Context, *ListUpcomingOccurrencesRequest) (*ListUpcomingOccurrencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUpcomingOccurrences not implemented")
+}
+func (UnimplementedExpenseServiceServer) SetOccurrenceOverride(context.Context, *SetOccurrenceOverrideRequest) (*RecurringOccurrence, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetOccurrenceOverride not implemented")
+}
+func (UnimplementedExpenseServiceServer) ClearOccurrenceOverride(context.Context, *ClearOccurrenceOverrideRequest) (*RecurringOccurrence, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClearOccurrenceOverride not implemented")
+}
 func (UnimplementedExpenseServiceServer) GetForecast(context.Context, *GetForecastRequest) (*GetForecastResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetForecast not implemented")
 }
+func (UnimplementedExpenseServiceServer) ListDeletedExpenses(context.Context, *ListDeletedExpensesRequest) (*ListDeletedExpensesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDeletedExpenses not implemented")
+}
 func (UnimplementedExpenseServiceServer) mustEmbedUnimplementedExpenseServiceServer() {}
 func (UnimplementedExpenseServiceServer) testEmbeddedByValue()                        {}
 
@@ -344,6 +488,60 @@ func _ExpenseService_DeleteExpense_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExpenseService_RestoreExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).RestoreExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_RestoreExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).RestoreExpense(ctx, req.(*RestoreExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExpenseService_ListExpenseHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpenseHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).ListExpenseHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_ListExpenseHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).ListExpenseHistory(ctx, req.(*ListExpenseHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExpenseService_RestoreExpenseRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreExpenseRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).RestoreExpenseRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_RestoreExpenseRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).RestoreExpenseRevision(ctx, req.(*RestoreExpenseRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ExpenseService_CreateRecurringExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateRecurringExpenseRequest)
 	if err := dec(in); err != nil {
@@ -416,6 +614,78 @@ func _ExpenseService_DeleteRecurringExpense_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExpenseService_PauseRecurringExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRecurringExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).PauseRecurringExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_PauseRecurringExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).PauseRecurringExpense(ctx, req.(*PauseRecurringExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExpenseService_ListUpcomingOccurrences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUpcomingOccurrencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).ListUpcomingOccurrences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_ListUpcomingOccurrences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).ListUpcomingOccurrences(ctx, req.(*ListUpcomingOccurrencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExpenseService_SetOccurrenceOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOccurrenceOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).SetOccurrenceOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_SetOccurrenceOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).SetOccurrenceOverride(ctx, req.(*SetOccurrenceOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExpenseService_ClearOccurrenceOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearOccurrenceOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).ClearOccurrenceOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_ClearOccurrenceOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).ClearOccurrenceOverride(ctx, req.(*ClearOccurrenceOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ExpenseService_GetForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetForecastRequest)
 	if err := dec(in); err != nil {
@@ -434,6 +704,24 @@ func _ExpenseService_GetForecast_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExpenseService_ListDeletedExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedExpensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).ListDeletedExpenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_ListDeletedExpenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).ListDeletedExpenses(ctx, req.(*ListDeletedExpensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ExpenseService_ServiceDesc is the grpc.ServiceDesc for ExpenseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -461,6 +749,18 @@ var ExpenseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteExpense",
 			Handler:    _ExpenseService_DeleteExpense_Handler,
 		},
+		{
+			MethodName: "RestoreExpense",
+			Handler:    _ExpenseService_RestoreExpense_Handler,
+		},
+		{
+			MethodName: "ListExpenseHistory",
+			Handler:    _ExpenseService_ListExpenseHistory_Handler,
+		},
+		{
+			MethodName: "RestoreExpenseRevision",
+			Handler:    _ExpenseService_RestoreExpenseRevision_Handler,
+		},
 		{
 			MethodName: "CreateRecurringExpense",
 			Handler:    _ExpenseService_CreateRecurringExpense_Handler,
@@ -477,10 +777,30 @@ var ExpenseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRecurringExpense",
 			Handler:    _ExpenseService_DeleteRecurringExpense_Handler,
 		},
+		{
+			MethodName: "PauseRecurringExpense",
+			Handler:    _ExpenseService_PauseRecurringExpense_Handler,
+		},
+		{
+			MethodName: "ListUpcomingOccurrences",
+			Handler:    _ExpenseService_ListUpcomingOccurrences_Handler,
+		},
+		{
+			MethodName: "SetOccurrenceOverride",
+			Handler:    _ExpenseService_SetOccurrenceOverride_Handler,
+		},
+		{
+			MethodName: "ClearOccurrenceOverride",
+			Handler:    _ExpenseService_ClearOccurrenceOverride_Handler,
+		},
 		{
 			MethodName: "GetForecast",
 			Handler:    _ExpenseService_GetForecast_Handler,
 		},
+		{
+			MethodName: "ListDeletedExpenses",
+			Handler:    _ExpenseService_ListDeletedExpenses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "expense.proto",
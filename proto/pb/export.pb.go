@@ -0,0 +1,310 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: export.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED   ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_EXPENSES_CSV  ExportFormat = 1 // One row per expense split
+	ExportFormat_EXPORT_FORMAT_PAYMENTS_CSV  ExportFormat = 2 // One row per payment
+	ExportFormat_EXPORT_FORMAT_JSON          ExportFormat = 3 // Complete archive of the colocation
+	ExportFormat_EXPORT_FORMAT_STATEMENT_PDF ExportFormat = 4 // Monthly statement of a member
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_EXPENSES_CSV",
+		2: "EXPORT_FORMAT_PAYMENTS_CSV",
+		3: "EXPORT_FORMAT_JSON",
+		4: "EXPORT_FORMAT_STATEMENT_PDF",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED":   0,
+		"EXPORT_FORMAT_EXPENSES_CSV":  1,
+		"EXPORT_FORMAT_PAYMENTS_CSV":  2,
+		"EXPORT_FORMAT_JSON":          3,
+		"EXPORT_FORMAT_STATEMENT_PDF": 4,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_export_proto_enumTypes[0].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_export_proto_enumTypes[0]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_export_proto_rawDescGZIP(), []int{0}
+}
+
+type ExportColocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	Format        ExportFormat           `protobuf:"varint,2,opt,name=format,proto3,enum=coloc.ExportFormat" json:"format,omitempty"`
+	StartDate     *string                `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // CSV exports, format: YYYY-MM-DD
+	EndDate       *string                `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`       // CSV exports, format: YYYY-MM-DD
+	Month         *string                `protobuf:"bytes,5,opt,name=month,proto3,oneof" json:"month,omitempty"`                          // PDF statement, format: YYYY-MM (default: current month)
+	UserId        *string                `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`          // PDF statement member (default: current user)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportColocationRequest) Reset() {
+	*x = ExportColocationRequest{}
+	mi := &file_export_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportColocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportColocationRequest) ProtoMessage() {}
+
+func (x *ExportColocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_export_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportColocationRequest.ProtoReflect.Descriptor instead.
+func (*ExportColocationRequest) Descriptor() ([]byte, []int) {
+	return file_export_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportColocationRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *ExportColocationRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportColocationRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *ExportColocationRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *ExportColocationRequest) GetMonth() string {
+	if x != nil && x.Month != nil {
+		return *x.Month
+	}
+	return ""
+}
+
+func (x *ExportColocationRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+type ExportFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportFile) Reset() {
+	*x = ExportFile{}
+	mi := &file_export_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportFile) ProtoMessage() {}
+
+func (x *ExportFile) ProtoReflect() protoreflect.Message {
+	mi := &file_export_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportFile.ProtoReflect.Descriptor instead.
+func (*ExportFile) Descriptor() ([]byte, []int) {
+	return file_export_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportFile) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportFile) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportFile) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+var File_export_proto protoreflect.FileDescriptor
+
+const file_export_proto_rawDesc = "" +
+	"\n" +
+	"\fexport.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\"\x9a\x02\n" +
+	"\x17ExportColocationRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12+\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x13.coloc.ExportFormatR\x06format\x12\"\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tH\x00R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\x04 \x01(\tH\x01R\aendDate\x88\x01\x01\x12\x19\n" +
+	"\x05month\x18\x05 \x01(\tH\x02R\x05month\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x06 \x01(\tH\x03R\x06userId\x88\x01\x01B\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_dateB\b\n" +
+	"\x06_monthB\n" +
+	"\n" +
+	"\b_user_id\"f\n" +
+	"\n" +
+	"ExportFile\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent*\xa6\x01\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aEXPORT_FORMAT_EXPENSES_CSV\x10\x01\x12\x1e\n" +
+	"\x1aEXPORT_FORMAT_PAYMENTS_CSV\x10\x02\x12\x16\n" +
+	"\x12EXPORT_FORMAT_JSON\x10\x03\x12\x1f\n" +
+	"\x1bEXPORT_FORMAT_STATEMENT_PDF\x10\x042\x87\x01\n" +
+	"\rExportService\x12v\n" +
+	"\x10ExportColocation\x12\x1e.coloc.ExportColocationRequest\x1a\x11.coloc.ExportFile\"/\x82\xd3\xe4\x93\x02)\x12'/api/colocations/{colocation_id}/exportB,Z*github.com/vblanchet22/back_coloc/proto/pbb\x06proto3"
+
+var (
+	file_export_proto_rawDescOnce sync.Once
+	file_export_proto_rawDescData []byte
+)
+
+func file_export_proto_rawDescGZIP() []byte {
+	file_export_proto_rawDescOnce.Do(func() {
+		file_export_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_export_proto_rawDesc), len(file_export_proto_rawDesc)))
+	})
+	return file_export_proto_rawDescData
+}
+
+var file_export_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_export_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_export_proto_goTypes = []any{
+	(ExportFormat)(0),               // 0: coloc.ExportFormat
+	(*ExportColocationRequest)(nil), // 1: coloc.ExportColocationRequest
+	(*ExportFile)(nil),              // 2: coloc.ExportFile
+}
+var file_export_proto_depIdxs = []int32{
+	0, // 0: coloc.ExportColocationRequest.format:type_name -> coloc.ExportFormat
+	1, // 1: coloc.ExportService.ExportColocation:input_type -> coloc.ExportColocationRequest
+	2, // 2: coloc.ExportService.ExportColocation:output_type -> coloc.ExportFile
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_export_proto_init() }
+func file_export_proto_init() {
+	if File_export_proto != nil {
+		return
+	}
+	file_export_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_export_proto_rawDesc), len(file_export_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_export_proto_goTypes,
+		DependencyIndexes: file_export_proto_depIdxs,
+		EnumInfos:         file_export_proto_enumTypes,
+		MessageInfos:      file_export_proto_msgTypes,
+	}.Build()
+	File_export_proto = out.File
+	file_export_proto_goTypes = nil
+	file_export_proto_depIdxs = nil
+}
@@ -22,12 +22,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type FundTransactionType int32
+
+const (
+	FundTransactionType_FUND_TRANSACTION_TYPE_UNSPECIFIED  FundTransactionType = 0
+	FundTransactionType_FUND_TRANSACTION_TYPE_CONTRIBUTION FundTransactionType = 1
+	FundTransactionType_FUND_TRANSACTION_TYPE_WITHDRAWAL   FundTransactionType = 2
+)
+
+// Enum value maps for FundTransactionType.
+var (
+	FundTransactionType_name = map[int32]string{
+		0: "FUND_TRANSACTION_TYPE_UNSPECIFIED",
+		1: "FUND_TRANSACTION_TYPE_CONTRIBUTION",
+		2: "FUND_TRANSACTION_TYPE_WITHDRAWAL",
+	}
+	FundTransactionType_value = map[string]int32{
+		"FUND_TRANSACTION_TYPE_UNSPECIFIED":  0,
+		"FUND_TRANSACTION_TYPE_CONTRIBUTION": 1,
+		"FUND_TRANSACTION_TYPE_WITHDRAWAL":   2,
+	}
+)
+
+func (x FundTransactionType) Enum() *FundTransactionType {
+	p := new(FundTransactionType)
+	*p = x
+	return p
+}
+
+func (x FundTransactionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FundTransactionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_fund_proto_enumTypes[0].Descriptor()
+}
+
+func (FundTransactionType) Type() protoreflect.EnumType {
+	return &file_fund_proto_enumTypes[0]
+}
+
+func (x FundTransactionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FundTransactionType.Descriptor instead.
+func (FundTransactionType) EnumDescriptor() ([]byte, []int) {
+	return file_fund_proto_rawDescGZIP(), []int{0}
+}
+
 type CreateFundRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	TargetAmount  *float64               `protobuf:"fixed64,4,opt,name=target_amount,json=targetAmount,proto3,oneof" json:"target_amount,omitempty"`
+	TargetAmount  *Money                 `protobuf:"bytes,4,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -83,11 +132,11 @@ func (x *CreateFundRequest) GetDescription() string {
 	return ""
 }
 
-func (x *CreateFundRequest) GetTargetAmount() float64 {
-	if x != nil && x.TargetAmount != nil {
-		return *x.TargetAmount
+func (x *CreateFundRequest) GetTargetAmount() *Money {
+	if x != nil {
+		return x.TargetAmount
 	}
-	return 0
+	return nil
 }
 
 type GetFundRequest struct {
@@ -244,7 +293,7 @@ type UpdateFundRequest struct {
 	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
 	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	TargetAmount  *float64               `protobuf:"fixed64,5,opt,name=target_amount,json=targetAmount,proto3,oneof" json:"target_amount,omitempty"`
+	TargetAmount  *Money                 `protobuf:"bytes,5,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
 	IsActive      *bool                  `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -308,11 +357,11 @@ func (x *UpdateFundRequest) GetDescription() string {
 	return ""
 }
 
-func (x *UpdateFundRequest) GetTargetAmount() float64 {
-	if x != nil && x.TargetAmount != nil {
-		return *x.TargetAmount
+func (x *UpdateFundRequest) GetTargetAmount() *Money {
+	if x != nil {
+		return x.TargetAmount
 	}
-	return 0
+	return nil
 }
 
 func (x *UpdateFundRequest) GetIsActive() bool {
@@ -422,7 +471,7 @@ type AddContributionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
 	FundId        string                 `protobuf:"bytes,2,opt,name=fund_id,json=fundId,proto3" json:"fund_id,omitempty"`
-	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	Note          *string                `protobuf:"bytes,4,opt,name=note,proto3,oneof" json:"note,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -472,11 +521,11 @@ func (x *AddContributionRequest) GetFundId() string {
 	return ""
 }
 
-func (x *AddContributionRequest) GetAmount() float64 {
+func (x *AddContributionRequest) GetAmount() *Money {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return nil
 }
 
 func (x *AddContributionRequest) GetNote() string {
@@ -643,10 +692,11 @@ func (x *DeleteContributionRequest) GetId() string {
 }
 
 type DeleteContributionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	RestorableUntil string                 `protobuf:"bytes,2,opt,name=restorable_until,json=restorableUntil,proto3" json:"restorable_until,omitempty"` // The contribution can be restored until this date
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *DeleteContributionResponse) Reset() {
@@ -686,39 +736,36 @@ func (x *DeleteContributionResponse) GetSuccess() bool {
 	return false
 }
 
-type Fund struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ColocationId       string                 `protobuf:"bytes,2,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
-	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description        *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	TargetAmount       *float64               `protobuf:"fixed64,5,opt,name=target_amount,json=targetAmount,proto3,oneof" json:"target_amount,omitempty"`
-	CurrentAmount      float64                `protobuf:"fixed64,6,opt,name=current_amount,json=currentAmount,proto3" json:"current_amount,omitempty"`
-	IsActive           bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	CreatedBy          string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	CreatedByNom       string                 `protobuf:"bytes,9,opt,name=created_by_nom,json=createdByNom,proto3" json:"created_by_nom,omitempty"`
-	CreatedByPrenom    string                 `protobuf:"bytes,10,opt,name=created_by_prenom,json=createdByPrenom,proto3" json:"created_by_prenom,omitempty"`
-	ProgressPercentage float64                `protobuf:"fixed64,11,opt,name=progress_percentage,json=progressPercentage,proto3" json:"progress_percentage,omitempty"` // current_amount / target_amount * 100
-	CreatedAt          string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Contributors       []*ContributorSummary  `protobuf:"bytes,13,rep,name=contributors,proto3" json:"contributors,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *DeleteContributionResponse) GetRestorableUntil() string {
+	if x != nil {
+		return x.RestorableUntil
+	}
+	return ""
 }
 
-func (x *Fund) Reset() {
-	*x = Fund{}
+type RestoreContributionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	FundId        string                 `protobuf:"bytes,2,opt,name=fund_id,json=fundId,proto3" json:"fund_id,omitempty"`
+	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreContributionRequest) Reset() {
+	*x = RestoreContributionRequest{}
 	mi := &file_fund_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Fund) String() string {
+func (x *RestoreContributionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Fund) ProtoMessage() {}
+func (*RestoreContributionRequest) ProtoMessage() {}
 
-func (x *Fund) ProtoReflect() protoreflect.Message {
+func (x *RestoreContributionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_fund_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -730,127 +777,132 @@ func (x *Fund) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Fund.ProtoReflect.Descriptor instead.
-func (*Fund) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreContributionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreContributionRequest) Descriptor() ([]byte, []int) {
 	return file_fund_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Fund) GetId() string {
+func (x *RestoreContributionRequest) GetColocationId() string {
 	if x != nil {
-		return x.Id
+		return x.ColocationId
 	}
 	return ""
 }
 
-func (x *Fund) GetColocationId() string {
+func (x *RestoreContributionRequest) GetFundId() string {
 	if x != nil {
-		return x.ColocationId
+		return x.FundId
 	}
 	return ""
 }
 
-func (x *Fund) GetName() string {
+func (x *RestoreContributionRequest) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Fund) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
+type WithdrawFromFundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	FundId        string                 `protobuf:"bytes,2,opt,name=fund_id,json=fundId,proto3" json:"fund_id,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"` // Must be the expense amount when expense_id is set, the default
+	Note          *string                `protobuf:"bytes,4,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	ExpenseId     *string                `protobuf:"bytes,5,opt,name=expense_id,json=expenseId,proto3,oneof" json:"expense_id,omitempty"` // Expense paid by the fund, left out of the balances; the caller must be its only payer
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Fund) GetTargetAmount() float64 {
-	if x != nil && x.TargetAmount != nil {
-		return *x.TargetAmount
-	}
-	return 0
+func (x *WithdrawFromFundRequest) Reset() {
+	*x = WithdrawFromFundRequest{}
+	mi := &file_fund_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Fund) GetCurrentAmount() float64 {
-	if x != nil {
-		return x.CurrentAmount
-	}
-	return 0
+func (x *WithdrawFromFundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Fund) GetIsActive() bool {
+func (*WithdrawFromFundRequest) ProtoMessage() {}
+
+func (x *WithdrawFromFundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fund_proto_msgTypes[13]
 	if x != nil {
-		return x.IsActive
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Fund) GetCreatedBy() string {
-	if x != nil {
-		return x.CreatedBy
-	}
-	return ""
+// Deprecated: Use WithdrawFromFundRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawFromFundRequest) Descriptor() ([]byte, []int) {
+	return file_fund_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Fund) GetCreatedByNom() string {
+func (x *WithdrawFromFundRequest) GetColocationId() string {
 	if x != nil {
-		return x.CreatedByNom
+		return x.ColocationId
 	}
 	return ""
 }
 
-func (x *Fund) GetCreatedByPrenom() string {
+func (x *WithdrawFromFundRequest) GetFundId() string {
 	if x != nil {
-		return x.CreatedByPrenom
+		return x.FundId
 	}
 	return ""
 }
 
-func (x *Fund) GetProgressPercentage() float64 {
+func (x *WithdrawFromFundRequest) GetAmount() *Money {
 	if x != nil {
-		return x.ProgressPercentage
+		return x.Amount
 	}
-	return 0
+	return nil
 }
 
-func (x *Fund) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
+func (x *WithdrawFromFundRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
 	}
 	return ""
 }
 
-func (x *Fund) GetContributors() []*ContributorSummary {
-	if x != nil {
-		return x.Contributors
+func (x *WithdrawFromFundRequest) GetExpenseId() string {
+	if x != nil && x.ExpenseId != nil {
+		return *x.ExpenseId
 	}
-	return nil
+	return ""
 }
 
-type ContributorSummary struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserNom          string                 `protobuf:"bytes,2,opt,name=user_nom,json=userNom,proto3" json:"user_nom,omitempty"`
-	UserPrenom       string                 `protobuf:"bytes,3,opt,name=user_prenom,json=userPrenom,proto3" json:"user_prenom,omitempty"`
-	TotalContributed float64                `protobuf:"fixed64,4,opt,name=total_contributed,json=totalContributed,proto3" json:"total_contributed,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type DeleteWithdrawalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	FundId        string                 `protobuf:"bytes,2,opt,name=fund_id,json=fundId,proto3" json:"fund_id,omitempty"`
+	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContributorSummary) Reset() {
-	*x = ContributorSummary{}
-	mi := &file_fund_proto_msgTypes[13]
+func (x *DeleteWithdrawalRequest) Reset() {
+	*x = DeleteWithdrawalRequest{}
+	mi := &file_fund_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContributorSummary) String() string {
+func (x *DeleteWithdrawalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContributorSummary) ProtoMessage() {}
+func (*DeleteWithdrawalRequest) ProtoMessage() {}
 
-func (x *ContributorSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_fund_proto_msgTypes[13]
+func (x *DeleteWithdrawalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fund_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -861,68 +913,108 @@ func (x *ContributorSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContributorSummary.ProtoReflect.Descriptor instead.
-func (*ContributorSummary) Descriptor() ([]byte, []int) {
-	return file_fund_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use DeleteWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return file_fund_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ContributorSummary) GetUserId() string {
+func (x *DeleteWithdrawalRequest) GetColocationId() string {
 	if x != nil {
-		return x.UserId
+		return x.ColocationId
 	}
 	return ""
 }
 
-func (x *ContributorSummary) GetUserNom() string {
+func (x *DeleteWithdrawalRequest) GetFundId() string {
 	if x != nil {
-		return x.UserNom
+		return x.FundId
 	}
 	return ""
 }
 
-func (x *ContributorSummary) GetUserPrenom() string {
+func (x *DeleteWithdrawalRequest) GetId() string {
 	if x != nil {
-		return x.UserPrenom
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ContributorSummary) GetTotalContributed() float64 {
+type DeleteWithdrawalResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	RestorableUntil string                 `protobuf:"bytes,2,opt,name=restorable_until,json=restorableUntil,proto3" json:"restorable_until,omitempty"` // The withdrawal can be restored until this date
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteWithdrawalResponse) Reset() {
+	*x = DeleteWithdrawalResponse{}
+	mi := &file_fund_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWithdrawalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWithdrawalResponse) ProtoMessage() {}
+
+func (x *DeleteWithdrawalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fund_proto_msgTypes[15]
 	if x != nil {
-		return x.TotalContributed
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type Contribution struct {
+// Deprecated: Use DeleteWithdrawalResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return file_fund_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteWithdrawalResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteWithdrawalResponse) GetRestorableUntil() string {
+	if x != nil {
+		return x.RestorableUntil
+	}
+	return ""
+}
+
+type RestoreWithdrawalRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
 	FundId        string                 `protobuf:"bytes,2,opt,name=fund_id,json=fundId,proto3" json:"fund_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserNom       string                 `protobuf:"bytes,4,opt,name=user_nom,json=userNom,proto3" json:"user_nom,omitempty"`
-	UserPrenom    string                 `protobuf:"bytes,5,opt,name=user_prenom,json=userPrenom,proto3" json:"user_prenom,omitempty"`
-	Amount        float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	Note          *string                `protobuf:"bytes,7,opt,name=note,proto3,oneof" json:"note,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Contribution) Reset() {
-	*x = Contribution{}
-	mi := &file_fund_proto_msgTypes[14]
+func (x *RestoreWithdrawalRequest) Reset() {
+	*x = RestoreWithdrawalRequest{}
+	mi := &file_fund_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Contribution) String() string {
+func (x *RestoreWithdrawalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Contribution) ProtoMessage() {}
+func (*RestoreWithdrawalRequest) ProtoMessage() {}
 
-func (x *Contribution) ProtoReflect() protoreflect.Message {
-	mi := &file_fund_proto_msgTypes[14]
+func (x *RestoreWithdrawalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fund_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -933,53 +1025,422 @@ func (x *Contribution) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Contribution.ProtoReflect.Descriptor instead.
-func (*Contribution) Descriptor() ([]byte, []int) {
-	return file_fund_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RestoreWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*RestoreWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return file_fund_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Contribution) GetId() string {
+func (x *RestoreWithdrawalRequest) GetColocationId() string {
 	if x != nil {
-		return x.Id
+		return x.ColocationId
 	}
 	return ""
 }
 
-func (x *Contribution) GetFundId() string {
+func (x *RestoreWithdrawalRequest) GetFundId() string {
 	if x != nil {
 		return x.FundId
 	}
 	return ""
 }
 
-func (x *Contribution) GetUserId() string {
+func (x *RestoreWithdrawalRequest) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Contribution) GetUserNom() string {
+type ListFundTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	FundId        string                 `protobuf:"bytes,2,opt,name=fund_id,json=fundId,proto3" json:"fund_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFundTransactionsRequest) Reset() {
+	*x = ListFundTransactionsRequest{}
+	mi := &file_fund_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFundTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFundTransactionsRequest) ProtoMessage() {}
+
+func (x *ListFundTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fund_proto_msgTypes[17]
 	if x != nil {
-		return x.UserNom
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Contribution) GetUserPrenom() string {
+// Deprecated: Use ListFundTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListFundTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_fund_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListFundTransactionsRequest) GetColocationId() string {
 	if x != nil {
-		return x.UserPrenom
+		return x.ColocationId
 	}
 	return ""
 }
 
-func (x *Contribution) GetAmount() float64 {
+func (x *ListFundTransactionsRequest) GetFundId() string {
 	if x != nil {
-		return x.Amount
+		return x.FundId
+	}
+	return ""
+}
+
+type ListFundTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*FundTransaction     `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFundTransactionsResponse) Reset() {
+	*x = ListFundTransactionsResponse{}
+	mi := &file_fund_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFundTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFundTransactionsResponse) ProtoMessage() {}
+
+func (x *ListFundTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fund_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFundTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListFundTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_fund_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListFundTransactionsResponse) GetTransactions() []*FundTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type Fund struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ColocationId       string                 `protobuf:"bytes,2,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description        *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	TargetAmount       *Money                 `protobuf:"bytes,5,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	CurrentAmount      *Money                 `protobuf:"bytes,6,opt,name=current_amount,json=currentAmount,proto3" json:"current_amount,omitempty"`
+	IsActive           bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedBy          string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedByNom       string                 `protobuf:"bytes,9,opt,name=created_by_nom,json=createdByNom,proto3" json:"created_by_nom,omitempty"`
+	CreatedByPrenom    string                 `protobuf:"bytes,10,opt,name=created_by_prenom,json=createdByPrenom,proto3" json:"created_by_prenom,omitempty"`
+	ProgressPercentage float64                `protobuf:"fixed64,11,opt,name=progress_percentage,json=progressPercentage,proto3" json:"progress_percentage,omitempty"` // current_amount / target_amount * 100
+	CreatedAt          string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Contributors       []*ContributorSummary  `protobuf:"bytes,13,rep,name=contributors,proto3" json:"contributors,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Fund) Reset() {
+	*x = Fund{}
+	mi := &file_fund_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fund) ProtoMessage() {}
+
+func (x *Fund) ProtoReflect() protoreflect.Message {
+	mi := &file_fund_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fund.ProtoReflect.Descriptor instead.
+func (*Fund) Descriptor() ([]byte, []int) {
+	return file_fund_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Fund) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Fund) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *Fund) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Fund) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Fund) GetTargetAmount() *Money {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return nil
+}
+
+func (x *Fund) GetCurrentAmount() *Money {
+	if x != nil {
+		return x.CurrentAmount
+	}
+	return nil
+}
+
+func (x *Fund) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Fund) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Fund) GetCreatedByNom() string {
+	if x != nil {
+		return x.CreatedByNom
+	}
+	return ""
+}
+
+func (x *Fund) GetCreatedByPrenom() string {
+	if x != nil {
+		return x.CreatedByPrenom
+	}
+	return ""
+}
+
+func (x *Fund) GetProgressPercentage() float64 {
+	if x != nil {
+		return x.ProgressPercentage
 	}
 	return 0
 }
 
+func (x *Fund) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Fund) GetContributors() []*ContributorSummary {
+	if x != nil {
+		return x.Contributors
+	}
+	return nil
+}
+
+type ContributorSummary struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserNom          string                 `protobuf:"bytes,2,opt,name=user_nom,json=userNom,proto3" json:"user_nom,omitempty"`
+	UserPrenom       string                 `protobuf:"bytes,3,opt,name=user_prenom,json=userPrenom,proto3" json:"user_prenom,omitempty"`
+	TotalContributed *Money                 `protobuf:"bytes,4,opt,name=total_contributed,json=totalContributed,proto3" json:"total_contributed,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ContributorSummary) Reset() {
+	*x = ContributorSummary{}
+	mi := &file_fund_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContributorSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContributorSummary) ProtoMessage() {}
+
+func (x *ContributorSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_fund_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContributorSummary.ProtoReflect.Descriptor instead.
+func (*ContributorSummary) Descriptor() ([]byte, []int) {
+	return file_fund_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ContributorSummary) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ContributorSummary) GetUserNom() string {
+	if x != nil {
+		return x.UserNom
+	}
+	return ""
+}
+
+func (x *ContributorSummary) GetUserPrenom() string {
+	if x != nil {
+		return x.UserPrenom
+	}
+	return ""
+}
+
+func (x *ContributorSummary) GetTotalContributed() *Money {
+	if x != nil {
+		return x.TotalContributed
+	}
+	return nil
+}
+
+type Contribution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FundId        string                 `protobuf:"bytes,2,opt,name=fund_id,json=fundId,proto3" json:"fund_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserNom       string                 `protobuf:"bytes,4,opt,name=user_nom,json=userNom,proto3" json:"user_nom,omitempty"`
+	UserPrenom    string                 `protobuf:"bytes,5,opt,name=user_prenom,json=userPrenom,proto3" json:"user_prenom,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note          *string                `protobuf:"bytes,7,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Contribution) Reset() {
+	*x = Contribution{}
+	mi := &file_fund_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Contribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contribution) ProtoMessage() {}
+
+func (x *Contribution) ProtoReflect() protoreflect.Message {
+	mi := &file_fund_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contribution.ProtoReflect.Descriptor instead.
+func (*Contribution) Descriptor() ([]byte, []int) {
+	return file_fund_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Contribution) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Contribution) GetFundId() string {
+	if x != nil {
+		return x.FundId
+	}
+	return ""
+}
+
+func (x *Contribution) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Contribution) GetUserNom() string {
+	if x != nil {
+		return x.UserNom
+	}
+	return ""
+}
+
+func (x *Contribution) GetUserPrenom() string {
+	if x != nil {
+		return x.UserPrenom
+	}
+	return ""
+}
+
+func (x *Contribution) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
 func (x *Contribution) GetNote() string {
 	if x != nil && x.Note != nil {
 		return *x.Note
@@ -994,19 +1455,259 @@ func (x *Contribution) GetCreatedAt() string {
 	return ""
 }
 
+type Withdrawal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FundId        string                 `protobuf:"bytes,2,opt,name=fund_id,json=fundId,proto3" json:"fund_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserNom       string                 `protobuf:"bytes,4,opt,name=user_nom,json=userNom,proto3" json:"user_nom,omitempty"`
+	UserPrenom    string                 `protobuf:"bytes,5,opt,name=user_prenom,json=userPrenom,proto3" json:"user_prenom,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note          *string                `protobuf:"bytes,7,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	ExpenseId     *string                `protobuf:"bytes,8,opt,name=expense_id,json=expenseId,proto3,oneof" json:"expense_id,omitempty"`
+	ExpenseTitle  *string                `protobuf:"bytes,9,opt,name=expense_title,json=expenseTitle,proto3,oneof" json:"expense_title,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Withdrawal) Reset() {
+	*x = Withdrawal{}
+	mi := &file_fund_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Withdrawal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Withdrawal) ProtoMessage() {}
+
+func (x *Withdrawal) ProtoReflect() protoreflect.Message {
+	mi := &file_fund_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Withdrawal.ProtoReflect.Descriptor instead.
+func (*Withdrawal) Descriptor() ([]byte, []int) {
+	return file_fund_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Withdrawal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetFundId() string {
+	if x != nil {
+		return x.FundId
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetUserNom() string {
+	if x != nil {
+		return x.UserNom
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetUserPrenom() string {
+	if x != nil {
+		return x.UserPrenom
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Withdrawal) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetExpenseId() string {
+	if x != nil && x.ExpenseId != nil {
+		return *x.ExpenseId
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetExpenseTitle() string {
+	if x != nil && x.ExpenseTitle != nil {
+		return *x.ExpenseTitle
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// Line of the ledger of a fund
+type FundTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the contribution or the withdrawal
+	Type          FundTransactionType    `protobuf:"varint,2,opt,name=type,proto3,enum=coloc.FundTransactionType" json:"type,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserNom       string                 `protobuf:"bytes,4,opt,name=user_nom,json=userNom,proto3" json:"user_nom,omitempty"`
+	UserPrenom    string                 `protobuf:"bytes,5,opt,name=user_prenom,json=userPrenom,proto3" json:"user_prenom,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`   // Always positive
+	Balance       *Money                 `protobuf:"bytes,7,opt,name=balance,proto3" json:"balance,omitempty"` // Fund amount right after the transaction
+	Note          *string                `protobuf:"bytes,8,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	ExpenseId     *string                `protobuf:"bytes,9,opt,name=expense_id,json=expenseId,proto3,oneof" json:"expense_id,omitempty"`
+	ExpenseTitle  *string                `protobuf:"bytes,10,opt,name=expense_title,json=expenseTitle,proto3,oneof" json:"expense_title,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FundTransaction) Reset() {
+	*x = FundTransaction{}
+	mi := &file_fund_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FundTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundTransaction) ProtoMessage() {}
+
+func (x *FundTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_fund_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundTransaction.ProtoReflect.Descriptor instead.
+func (*FundTransaction) Descriptor() ([]byte, []int) {
+	return file_fund_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FundTransaction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FundTransaction) GetType() FundTransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return FundTransactionType_FUND_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *FundTransaction) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FundTransaction) GetUserNom() string {
+	if x != nil {
+		return x.UserNom
+	}
+	return ""
+}
+
+func (x *FundTransaction) GetUserPrenom() string {
+	if x != nil {
+		return x.UserPrenom
+	}
+	return ""
+}
+
+func (x *FundTransaction) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *FundTransaction) GetBalance() *Money {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *FundTransaction) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *FundTransaction) GetExpenseId() string {
+	if x != nil && x.ExpenseId != nil {
+		return *x.ExpenseId
+	}
+	return ""
+}
+
+func (x *FundTransaction) GetExpenseTitle() string {
+	if x != nil && x.ExpenseTitle != nil {
+		return *x.ExpenseTitle
+	}
+	return ""
+}
+
+func (x *FundTransaction) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
 var File_fund_proto protoreflect.FileDescriptor
 
 const file_fund_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"fund.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\"\xbf\x01\n" +
+	"fund.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\x1a\fcommon.proto\"\xb6\x01\n" +
 	"\x11CreateFundRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
-	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12(\n" +
-	"\rtarget_amount\x18\x04 \x01(\x01H\x01R\ftargetAmount\x88\x01\x01B\x0e\n" +
-	"\f_descriptionB\x10\n" +
-	"\x0e_target_amount\"E\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x121\n" +
+	"\rtarget_amount\x18\x04 \x01(\v2\f.coloc.MoneyR\ftargetAmountB\x0e\n" +
+	"\f_description\"E\n" +
 	"\x0eGetFundRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\"g\n" +
@@ -1016,28 +1717,27 @@ const file_fund_proto_rawDesc = "" +
 	"\n" +
 	"_is_active\"6\n" +
 	"\x11ListFundsResponse\x12!\n" +
-	"\x05funds\x18\x01 \x03(\v2\v.coloc.FundR\x05funds\"\x8d\x02\n" +
+	"\x05funds\x18\x01 \x03(\v2\v.coloc.FundR\x05funds\"\x84\x02\n" +
 	"\x11UpdateFundRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x17\n" +
 	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12(\n" +
-	"\rtarget_amount\x18\x05 \x01(\x01H\x02R\ftargetAmount\x88\x01\x01\x12 \n" +
-	"\tis_active\x18\x06 \x01(\bH\x03R\bisActive\x88\x01\x01B\a\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x121\n" +
+	"\rtarget_amount\x18\x05 \x01(\v2\f.coloc.MoneyR\ftargetAmount\x12 \n" +
+	"\tis_active\x18\x06 \x01(\bH\x02R\bisActive\x88\x01\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
-	"\f_descriptionB\x10\n" +
-	"\x0e_target_amountB\f\n" +
+	"\f_descriptionB\f\n" +
 	"\n" +
 	"_is_active\"H\n" +
 	"\x11DeleteFundRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\".\n" +
 	"\x12DeleteFundResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x90\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x9e\x01\n" +
 	"\x16AddContributionRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x17\n" +
-	"\afund_id\x18\x02 \x01(\tR\x06fundId\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x17\n" +
+	"\afund_id\x18\x02 \x01(\tR\x06fundId\x12$\n" +
+	"\x06amount\x18\x03 \x01(\v2\f.coloc.MoneyR\x06amount\x12\x17\n" +
 	"\x04note\x18\x04 \x01(\tH\x00R\x04note\x88\x01\x01B\a\n" +
 	"\x05_note\"X\n" +
 	"\x18ListContributionsRequest\x12#\n" +
@@ -1048,16 +1748,46 @@ const file_fund_proto_rawDesc = "" +
 	"\x19DeleteContributionRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x17\n" +
 	"\afund_id\x18\x02 \x01(\tR\x06fundId\x12\x0e\n" +
-	"\x02id\x18\x03 \x01(\tR\x02id\"6\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\"a\n" +
 	"\x1aDeleteContributionResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x86\x04\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12)\n" +
+	"\x10restorable_until\x18\x02 \x01(\tR\x0frestorableUntil\"j\n" +
+	"\x1aRestoreContributionRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x17\n" +
+	"\afund_id\x18\x02 \x01(\tR\x06fundId\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\"\xd2\x01\n" +
+	"\x17WithdrawFromFundRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x17\n" +
+	"\afund_id\x18\x02 \x01(\tR\x06fundId\x12$\n" +
+	"\x06amount\x18\x03 \x01(\v2\f.coloc.MoneyR\x06amount\x12\x17\n" +
+	"\x04note\x18\x04 \x01(\tH\x00R\x04note\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"expense_id\x18\x05 \x01(\tH\x01R\texpenseId\x88\x01\x01B\a\n" +
+	"\x05_noteB\r\n" +
+	"\v_expense_id\"g\n" +
+	"\x17DeleteWithdrawalRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x17\n" +
+	"\afund_id\x18\x02 \x01(\tR\x06fundId\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\"_\n" +
+	"\x18DeleteWithdrawalResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12)\n" +
+	"\x10restorable_until\x18\x02 \x01(\tR\x0frestorableUntil\"h\n" +
+	"\x18RestoreWithdrawalRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x17\n" +
+	"\afund_id\x18\x02 \x01(\tR\x06fundId\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\"[\n" +
+	"\x1bListFundTransactionsRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x17\n" +
+	"\afund_id\x18\x02 \x01(\tR\x06fundId\"Z\n" +
+	"\x1cListFundTransactionsResponse\x12:\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x16.coloc.FundTransactionR\ftransactions\"\x8b\x04\n" +
 	"\x04Fund\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
 	"\rcolocation_id\x18\x02 \x01(\tR\fcolocationId\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12%\n" +
-	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01\x12(\n" +
-	"\rtarget_amount\x18\x05 \x01(\x01H\x01R\ftargetAmount\x88\x01\x01\x12%\n" +
-	"\x0ecurrent_amount\x18\x06 \x01(\x01R\rcurrentAmount\x12\x1b\n" +
+	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01\x121\n" +
+	"\rtarget_amount\x18\x05 \x01(\v2\f.coloc.MoneyR\ftargetAmount\x123\n" +
+	"\x0ecurrent_amount\x18\x06 \x01(\v2\f.coloc.MoneyR\rcurrentAmount\x12\x1b\n" +
 	"\tis_active\x18\a \x01(\bR\bisActive\x12\x1d\n" +
 	"\n" +
 	"created_by\x18\b \x01(\tR\tcreatedBy\x12$\n" +
@@ -1068,26 +1798,67 @@ const file_fund_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\f \x01(\tR\tcreatedAt\x12=\n" +
 	"\fcontributors\x18\r \x03(\v2\x19.coloc.ContributorSummaryR\fcontributorsB\x0e\n" +
-	"\f_descriptionB\x10\n" +
-	"\x0e_target_amount\"\x96\x01\n" +
+	"\f_description\"\xa4\x01\n" +
 	"\x12ContributorSummary\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
 	"\buser_nom\x18\x02 \x01(\tR\auserNom\x12\x1f\n" +
 	"\vuser_prenom\x18\x03 \x01(\tR\n" +
-	"userPrenom\x12+\n" +
-	"\x11total_contributed\x18\x04 \x01(\x01R\x10totalContributed\"\xe5\x01\n" +
+	"userPrenom\x129\n" +
+	"\x11total_contributed\x18\x04 \x01(\v2\f.coloc.MoneyR\x10totalContributed\"\xf3\x01\n" +
 	"\fContribution\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\afund_id\x18\x02 \x01(\tR\x06fundId\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x19\n" +
 	"\buser_nom\x18\x04 \x01(\tR\auserNom\x12\x1f\n" +
 	"\vuser_prenom\x18\x05 \x01(\tR\n" +
-	"userPrenom\x12\x16\n" +
-	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12\x17\n" +
+	"userPrenom\x12$\n" +
+	"\x06amount\x18\x06 \x01(\v2\f.coloc.MoneyR\x06amount\x12\x17\n" +
 	"\x04note\x18\a \x01(\tH\x00R\x04note\x88\x01\x01\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\b \x01(\tR\tcreatedAtB\a\n" +
-	"\x05_note2\x8b\b\n" +
+	"\x05_note\"\xe0\x02\n" +
+	"\n" +
+	"Withdrawal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\afund_id\x18\x02 \x01(\tR\x06fundId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x19\n" +
+	"\buser_nom\x18\x04 \x01(\tR\auserNom\x12\x1f\n" +
+	"\vuser_prenom\x18\x05 \x01(\tR\n" +
+	"userPrenom\x12$\n" +
+	"\x06amount\x18\x06 \x01(\v2\f.coloc.MoneyR\x06amount\x12\x17\n" +
+	"\x04note\x18\a \x01(\tH\x00R\x04note\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"expense_id\x18\b \x01(\tH\x01R\texpenseId\x88\x01\x01\x12(\n" +
+	"\rexpense_title\x18\t \x01(\tH\x02R\fexpenseTitle\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAtB\a\n" +
+	"\x05_noteB\r\n" +
+	"\v_expense_idB\x10\n" +
+	"\x0e_expense_title\"\xa4\x03\n" +
+	"\x0fFundTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1a.coloc.FundTransactionTypeR\x04type\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x19\n" +
+	"\buser_nom\x18\x04 \x01(\tR\auserNom\x12\x1f\n" +
+	"\vuser_prenom\x18\x05 \x01(\tR\n" +
+	"userPrenom\x12$\n" +
+	"\x06amount\x18\x06 \x01(\v2\f.coloc.MoneyR\x06amount\x12&\n" +
+	"\abalance\x18\a \x01(\v2\f.coloc.MoneyR\abalance\x12\x17\n" +
+	"\x04note\x18\b \x01(\tH\x00R\x04note\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"expense_id\x18\t \x01(\tH\x01R\texpenseId\x88\x01\x01\x12(\n" +
+	"\rexpense_title\x18\n" +
+	" \x01(\tH\x02R\fexpenseTitle\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAtB\a\n" +
+	"\x05_noteB\r\n" +
+	"\v_expense_idB\x10\n" +
+	"\x0e_expense_title*\x8a\x01\n" +
+	"\x13FundTransactionType\x12%\n" +
+	"!FUND_TRANSACTION_TYPE_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"FUND_TRANSACTION_TYPE_CONTRIBUTION\x10\x01\x12$\n" +
+	" FUND_TRANSACTION_TYPE_WITHDRAWAL\x10\x022\xae\x0e\n" +
 	"\vFundService\x12f\n" +
 	"\n" +
 	"CreateFund\x12\x18.coloc.CreateFundRequest\x1a\v.coloc.Fund\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/colocations/{colocation_id}/funds\x12b\n" +
@@ -1099,7 +1870,12 @@ const file_fund_proto_rawDesc = "" +
 	"DeleteFund\x12\x18.coloc.DeleteFundRequest\x1a\x19.coloc.DeleteFundResponse\"3\x82\xd3\xe4\x93\x02-*+/api/colocations/{colocation_id}/funds/{id}\x12\x90\x01\n" +
 	"\x0fAddContribution\x12\x1d.coloc.AddContributionRequest\x1a\x13.coloc.Contribution\"I\x82\xd3\xe4\x93\x02C:\x01*\">/api/colocations/{colocation_id}/funds/{fund_id}/contributions\x12\x9e\x01\n" +
 	"\x11ListContributions\x12\x1f.coloc.ListContributionsRequest\x1a .coloc.ListContributionsResponse\"F\x82\xd3\xe4\x93\x02@\x12>/api/colocations/{colocation_id}/funds/{fund_id}/contributions\x12\xa6\x01\n" +
-	"\x12DeleteContribution\x12 .coloc.DeleteContributionRequest\x1a!.coloc.DeleteContributionResponse\"K\x82\xd3\xe4\x93\x02E*C/api/colocations/{colocation_id}/funds/{fund_id}/contributions/{id}B,Z*github.com/vblanchet22/back_coloc/proto/pbb\x06proto3"
+	"\x12DeleteContribution\x12 .coloc.DeleteContributionRequest\x1a!.coloc.DeleteContributionResponse\"K\x82\xd3\xe4\x93\x02E*C/api/colocations/{colocation_id}/funds/{fund_id}/contributions/{id}\x12\xa5\x01\n" +
+	"\x13RestoreContribution\x12!.coloc.RestoreContributionRequest\x1a\x13.coloc.Contribution\"V\x82\xd3\xe4\x93\x02P:\x01*\"K/api/colocations/{colocation_id}/funds/{fund_id}/contributions/{id}/restore\x12\x8e\x01\n" +
+	"\x10WithdrawFromFund\x12\x1e.coloc.WithdrawFromFundRequest\x1a\x11.coloc.Withdrawal\"G\x82\xd3\xe4\x93\x02A:\x01*\"</api/colocations/{colocation_id}/funds/{fund_id}/withdrawals\x12\x9e\x01\n" +
+	"\x10DeleteWithdrawal\x12\x1e.coloc.DeleteWithdrawalRequest\x1a\x1f.coloc.DeleteWithdrawalResponse\"I\x82\xd3\xe4\x93\x02C*A/api/colocations/{colocation_id}/funds/{fund_id}/withdrawals/{id}\x12\x9d\x01\n" +
+	"\x11RestoreWithdrawal\x12\x1f.coloc.RestoreWithdrawalRequest\x1a\x11.coloc.Withdrawal\"T\x82\xd3\xe4\x93\x02N:\x01*\"I/api/colocations/{colocation_id}/funds/{fund_id}/withdrawals/{id}/restore\x12\xa6\x01\n" +
+	"\x14ListFundTransactions\x12\".coloc.ListFundTransactionsRequest\x1a#.coloc.ListFundTransactionsResponse\"E\x82\xd3\xe4\x93\x02?\x12=/api/colocations/{colocation_id}/funds/{fund_id}/transactionsB,Z*github.com/vblanchet22/back_coloc/proto/pbb\x06proto3"
 
 var (
 	file_fund_proto_rawDescOnce sync.Once
@@ -1113,49 +1889,84 @@ func file_fund_proto_rawDescGZIP() []byte {
 	return file_fund_proto_rawDescData
 }
 
-var file_fund_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_fund_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_fund_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_fund_proto_goTypes = []any{
-	(*CreateFundRequest)(nil),          // 0: coloc.CreateFundRequest
-	(*GetFundRequest)(nil),             // 1: coloc.GetFundRequest
-	(*ListFundsRequest)(nil),           // 2: coloc.ListFundsRequest
-	(*ListFundsResponse)(nil),          // 3: coloc.ListFundsResponse
-	(*UpdateFundRequest)(nil),          // 4: coloc.UpdateFundRequest
-	(*DeleteFundRequest)(nil),          // 5: coloc.DeleteFundRequest
-	(*DeleteFundResponse)(nil),         // 6: coloc.DeleteFundResponse
-	(*AddContributionRequest)(nil),     // 7: coloc.AddContributionRequest
-	(*ListContributionsRequest)(nil),   // 8: coloc.ListContributionsRequest
-	(*ListContributionsResponse)(nil),  // 9: coloc.ListContributionsResponse
-	(*DeleteContributionRequest)(nil),  // 10: coloc.DeleteContributionRequest
-	(*DeleteContributionResponse)(nil), // 11: coloc.DeleteContributionResponse
-	(*Fund)(nil),                       // 12: coloc.Fund
-	(*ContributorSummary)(nil),         // 13: coloc.ContributorSummary
-	(*Contribution)(nil),               // 14: coloc.Contribution
+	(FundTransactionType)(0),             // 0: coloc.FundTransactionType
+	(*CreateFundRequest)(nil),            // 1: coloc.CreateFundRequest
+	(*GetFundRequest)(nil),               // 2: coloc.GetFundRequest
+	(*ListFundsRequest)(nil),             // 3: coloc.ListFundsRequest
+	(*ListFundsResponse)(nil),            // 4: coloc.ListFundsResponse
+	(*UpdateFundRequest)(nil),            // 5: coloc.UpdateFundRequest
+	(*DeleteFundRequest)(nil),            // 6: coloc.DeleteFundRequest
+	(*DeleteFundResponse)(nil),           // 7: coloc.DeleteFundResponse
+	(*AddContributionRequest)(nil),       // 8: coloc.AddContributionRequest
+	(*ListContributionsRequest)(nil),     // 9: coloc.ListContributionsRequest
+	(*ListContributionsResponse)(nil),    // 10: coloc.ListContributionsResponse
+	(*DeleteContributionRequest)(nil),    // 11: coloc.DeleteContributionRequest
+	(*DeleteContributionResponse)(nil),   // 12: coloc.DeleteContributionResponse
+	(*RestoreContributionRequest)(nil),   // 13: coloc.RestoreContributionRequest
+	(*WithdrawFromFundRequest)(nil),      // 14: coloc.WithdrawFromFundRequest
+	(*DeleteWithdrawalRequest)(nil),      // 15: coloc.DeleteWithdrawalRequest
+	(*DeleteWithdrawalResponse)(nil),     // 16: coloc.DeleteWithdrawalResponse
+	(*RestoreWithdrawalRequest)(nil),     // 17: coloc.RestoreWithdrawalRequest
+	(*ListFundTransactionsRequest)(nil),  // 18: coloc.ListFundTransactionsRequest
+	(*ListFundTransactionsResponse)(nil), // 19: coloc.ListFundTransactionsResponse
+	(*Fund)(nil),                         // 20: coloc.Fund
+	(*ContributorSummary)(nil),           // 21: coloc.ContributorSummary
+	(*Contribution)(nil),                 // 22: coloc.Contribution
+	(*Withdrawal)(nil),                   // 23: coloc.Withdrawal
+	(*FundTransaction)(nil),              // 24: coloc.FundTransaction
+	(*Money)(nil),                        // 25: coloc.Money
 }
 var file_fund_proto_depIdxs = []int32{
-	12, // 0: coloc.ListFundsResponse.funds:type_name -> coloc.Fund
-	14, // 1: coloc.ListContributionsResponse.contributions:type_name -> coloc.Contribution
-	13, // 2: coloc.Fund.contributors:type_name -> coloc.ContributorSummary
-	0,  // 3: coloc.FundService.CreateFund:input_type -> coloc.CreateFundRequest
-	1,  // 4: coloc.FundService.GetFund:input_type -> coloc.GetFundRequest
-	2,  // 5: coloc.FundService.ListFunds:input_type -> coloc.ListFundsRequest
-	4,  // 6: coloc.FundService.UpdateFund:input_type -> coloc.UpdateFundRequest
-	5,  // 7: coloc.FundService.DeleteFund:input_type -> coloc.DeleteFundRequest
-	7,  // 8: coloc.FundService.AddContribution:input_type -> coloc.AddContributionRequest
-	8,  // 9: coloc.FundService.ListContributions:input_type -> coloc.ListContributionsRequest
-	10, // 10: coloc.FundService.DeleteContribution:input_type -> coloc.DeleteContributionRequest
-	12, // 11: coloc.FundService.CreateFund:output_type -> coloc.Fund
-	12, // 12: coloc.FundService.GetFund:output_type -> coloc.Fund
-	3,  // 13: coloc.FundService.ListFunds:output_type -> coloc.ListFundsResponse
-	12, // 14: coloc.FundService.UpdateFund:output_type -> coloc.Fund
-	6,  // 15: coloc.FundService.DeleteFund:output_type -> coloc.DeleteFundResponse
-	14, // 16: coloc.FundService.AddContribution:output_type -> coloc.Contribution
-	9,  // 17: coloc.FundService.ListContributions:output_type -> coloc.ListContributionsResponse
-	11, // 18: coloc.FundService.DeleteContribution:output_type -> coloc.DeleteContributionResponse
-	11, // [11:19] is the sub-list for method output_type
-	3,  // [3:11] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	25, // 0: coloc.CreateFundRequest.target_amount:type_name -> coloc.Money
+	20, // 1: coloc.ListFundsResponse.funds:type_name -> coloc.Fund
+	25, // 2: coloc.UpdateFundRequest.target_amount:type_name -> coloc.Money
+	25, // 3: coloc.AddContributionRequest.amount:type_name -> coloc.Money
+	22, // 4: coloc.ListContributionsResponse.contributions:type_name -> coloc.Contribution
+	25, // 5: coloc.WithdrawFromFundRequest.amount:type_name -> coloc.Money
+	24, // 6: coloc.ListFundTransactionsResponse.transactions:type_name -> coloc.FundTransaction
+	25, // 7: coloc.Fund.target_amount:type_name -> coloc.Money
+	25, // 8: coloc.Fund.current_amount:type_name -> coloc.Money
+	21, // 9: coloc.Fund.contributors:type_name -> coloc.ContributorSummary
+	25, // 10: coloc.ContributorSummary.total_contributed:type_name -> coloc.Money
+	25, // 11: coloc.Contribution.amount:type_name -> coloc.Money
+	25, // 12: coloc.Withdrawal.amount:type_name -> coloc.Money
+	0,  // 13: coloc.FundTransaction.type:type_name -> coloc.FundTransactionType
+	25, // 14: coloc.FundTransaction.amount:type_name -> coloc.Money
+	25, // 15: coloc.FundTransaction.balance:type_name -> coloc.Money
+	1,  // 16: coloc.FundService.CreateFund:input_type -> coloc.CreateFundRequest
+	2,  // 17: coloc.FundService.GetFund:input_type -> coloc.GetFundRequest
+	3,  // 18: coloc.FundService.ListFunds:input_type -> coloc.ListFundsRequest
+	5,  // 19: coloc.FundService.UpdateFund:input_type -> coloc.UpdateFundRequest
+	6,  // 20: coloc.FundService.DeleteFund:input_type -> coloc.DeleteFundRequest
+	8,  // 21: coloc.FundService.AddContribution:input_type -> coloc.AddContributionRequest
+	9,  // 22: coloc.FundService.ListContributions:input_type -> coloc.ListContributionsRequest
+	11, // 23: coloc.FundService.DeleteContribution:input_type -> coloc.DeleteContributionRequest
+	13, // 24: coloc.FundService.RestoreContribution:input_type -> coloc.RestoreContributionRequest
+	14, // 25: coloc.FundService.WithdrawFromFund:input_type -> coloc.WithdrawFromFundRequest
+	15, // 26: coloc.FundService.DeleteWithdrawal:input_type -> coloc.DeleteWithdrawalRequest
+	17, // 27: coloc.FundService.RestoreWithdrawal:input_type -> coloc.RestoreWithdrawalRequest
+	18, // 28: coloc.FundService.ListFundTransactions:input_type -> coloc.ListFundTransactionsRequest
+	20, // 29: coloc.FundService.CreateFund:output_type -> coloc.Fund
+	20, // 30: coloc.FundService.GetFund:output_type -> coloc.Fund
+	4,  // 31: coloc.FundService.ListFunds:output_type -> coloc.ListFundsResponse
+	20, // 32: coloc.FundService.UpdateFund:output_type -> coloc.Fund
+	7,  // 33: coloc.FundService.DeleteFund:output_type -> coloc.DeleteFundResponse
+	22, // 34: coloc.FundService.AddContribution:output_type -> coloc.Contribution
+	10, // 35: coloc.FundService.ListContributions:output_type -> coloc.ListContributionsResponse
+	12, // 36: coloc.FundService.DeleteContribution:output_type -> coloc.DeleteContributionResponse
+	22, // 37: coloc.FundService.RestoreContribution:output_type -> coloc.Contribution
+	23, // 38: coloc.FundService.WithdrawFromFund:output_type -> coloc.Withdrawal
+	16, // 39: coloc.FundService.DeleteWithdrawal:output_type -> coloc.DeleteWithdrawalResponse
+	23, // 40: coloc.FundService.RestoreWithdrawal:output_type -> coloc.Withdrawal
+	19, // 41: coloc.FundService.ListFundTransactions:output_type -> coloc.ListFundTransactionsResponse
+	29, // [29:42] is the sub-list for method output_type
+	16, // [16:29] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_fund_proto_init() }
@@ -1163,24 +1974,29 @@ func file_fund_proto_init() {
 	if File_fund_proto != nil {
 		return
 	}
+	file_common_proto_init()
 	file_fund_proto_msgTypes[0].OneofWrappers = []any{}
 	file_fund_proto_msgTypes[2].OneofWrappers = []any{}
 	file_fund_proto_msgTypes[4].OneofWrappers = []any{}
 	file_fund_proto_msgTypes[7].OneofWrappers = []any{}
-	file_fund_proto_msgTypes[12].OneofWrappers = []any{}
-	file_fund_proto_msgTypes[14].OneofWrappers = []any{}
+	file_fund_proto_msgTypes[13].OneofWrappers = []any{}
+	file_fund_proto_msgTypes[19].OneofWrappers = []any{}
+	file_fund_proto_msgTypes[21].OneofWrappers = []any{}
+	file_fund_proto_msgTypes[22].OneofWrappers = []any{}
+	file_fund_proto_msgTypes[23].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_fund_proto_rawDesc), len(file_fund_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   15,
+			NumEnums:      1,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_fund_proto_goTypes,
 		DependencyIndexes: file_fund_proto_depIdxs,
+		EnumInfos:         file_fund_proto_enumTypes,
 		MessageInfos:      file_fund_proto_msgTypes,
 	}.Build()
 	File_fund_proto = out.File
@@ -19,14 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FundService_CreateFund_FullMethodName         = "/coloc.FundService/CreateFund"
-	FundService_GetFund_FullMethodName            = "/coloc.FundService/GetFund"
-	FundService_ListFunds_FullMethodName          = "/coloc.FundService/ListFunds"
-	FundService_UpdateFund_FullMethodName         = "/coloc.FundService/UpdateFund"
-	FundService_DeleteFund_FullMethodName         = "/coloc.FundService/DeleteFund"
-	FundService_AddContribution_FullMethodName    = "/coloc.FundService/AddContribution"
-	FundService_ListContributions_FullMethodName  = "/coloc.FundService/ListContributions"
-	FundService_DeleteContribution_FullMethodName = "/coloc.FundService/DeleteContribution"
+	FundService_CreateFund_FullMethodName           = "/coloc.FundService/CreateFund"
+	FundService_GetFund_FullMethodName              = "/coloc.FundService/GetFund"
+	FundService_ListFunds_FullMethodName            = "/coloc.FundService/ListFunds"
+	FundService_UpdateFund_FullMethodName           = "/coloc.FundService/UpdateFund"
+	FundService_DeleteFund_FullMethodName           = "/coloc.FundService/DeleteFund"
+	FundService_AddContribution_FullMethodName      = "/coloc.FundService/AddContribution"
+	FundService_ListContributions_FullMethodName    = "/coloc.FundService/ListContributions"
+	FundService_DeleteContribution_FullMethodName   = "/coloc.FundService/DeleteContribution"
+	FundService_RestoreContribution_FullMethodName  = "/coloc.FundService/RestoreContribution"
+	FundService_WithdrawFromFund_FullMethodName     = "/coloc.FundService/WithdrawFromFund"
+	FundService_DeleteWithdrawal_FullMethodName     = "/coloc.FundService/DeleteWithdrawal"
+	FundService_RestoreWithdrawal_FullMethodName    = "/coloc.FundService/RestoreWithdrawal"
+	FundService_ListFundTransactions_FullMethodName = "/coloc.FundService/ListFundTransactions"
 )
 
 // FundServiceClient is the client API for FundService service.
@@ -51,6 +56,16 @@ type FundServiceClient interface {
 	ListContributions(ctx context.Context, in *ListContributionsRequest, opts ...grpc.CallOption) (*ListContributionsResponse, error)
 	// Delete contribution
 	DeleteContribution(ctx context.Context, in *DeleteContributionRequest, opts ...grpc.CallOption) (*DeleteContributionResponse, error)
+	// Restore a deleted contribution (by contributor, within the undo window)
+	RestoreContribution(ctx context.Context, in *RestoreContributionRequest, opts ...grpc.CallOption) (*Contribution, error)
+	// Spend money from a fund, optionally for an expense paid by the fund
+	WithdrawFromFund(ctx context.Context, in *WithdrawFromFundRequest, opts ...grpc.CallOption) (*Withdrawal, error)
+	// Delete a withdrawal, its amount goes back into the fund (by its author)
+	DeleteWithdrawal(ctx context.Context, in *DeleteWithdrawalRequest, opts ...grpc.CallOption) (*DeleteWithdrawalResponse, error)
+	// Restore a deleted withdrawal (by its author, within the undo window)
+	RestoreWithdrawal(ctx context.Context, in *RestoreWithdrawalRequest, opts ...grpc.CallOption) (*Withdrawal, error)
+	// List the contributions and withdrawals of a fund with its running balance
+	ListFundTransactions(ctx context.Context, in *ListFundTransactionsRequest, opts ...grpc.CallOption) (*ListFundTransactionsResponse, error)
 }
 
 type fundServiceClient struct {
@@ -141,6 +156,56 @@ func (c *fundServiceClient) DeleteContribution(ctx context.Context, in *DeleteCo
 	return out, nil
 }
 
+func (c *fundServiceClient) RestoreContribution(ctx context.Context, in *RestoreContributionRequest, opts ...grpc.CallOption) (*Contribution, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Contribution)
+	err := c.cc.Invoke(ctx, FundService_RestoreContribution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fundServiceClient) WithdrawFromFund(ctx context.Context, in *WithdrawFromFundRequest, opts ...grpc.CallOption) (*Withdrawal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Withdrawal)
+	err := c.cc.Invoke(ctx, FundService_WithdrawFromFund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fundServiceClient) DeleteWithdrawal(ctx context.Context, in *DeleteWithdrawalRequest, opts ...grpc.CallOption) (*DeleteWithdrawalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWithdrawalResponse)
+	err := c.cc.Invoke(ctx, FundService_DeleteWithdrawal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fundServiceClient) RestoreWithdrawal(ctx context.Context, in *RestoreWithdrawalRequest, opts ...grpc.CallOption) (*Withdrawal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Withdrawal)
+	err := c.cc.Invoke(ctx, FundService_RestoreWithdrawal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fundServiceClient) ListFundTransactions(ctx context.Context, in *ListFundTransactionsRequest, opts ...grpc.CallOption) (*ListFundTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFundTransactionsResponse)
+	err := c.cc.Invoke(ctx, FundService_ListFundTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FundServiceServer is the server API for FundService service.
 // All implementations must embed UnimplementedFundServiceServer
 // for forward compatibility.
@@ -163,6 +228,16 @@ type FundServiceServer interface {
 	ListContributions(context.Context, *ListContributionsRequest) (*ListContributionsResponse, error)
 	// Delete contribution
 	DeleteContribution(context.Context, *DeleteContributionRequest) (*DeleteContributionResponse, error)
+	// Restore a deleted contribution (by contributor, within the undo window)
+	RestoreContribution(context.Context, *RestoreContributionRequest) (*Contribution, error)
+	// Spend money from a fund, optionally for an expense paid by the fund
+	WithdrawFromFund(context.Context, *WithdrawFromFundRequest) (*Withdrawal, error)
+	// Delete a withdrawal, its amount goes back into the fund (by its author)
+	DeleteWithdrawal(context.Context, *DeleteWithdrawalRequest) (*DeleteWithdrawalResponse, error)
+	// Restore a deleted withdrawal (by its author, within the undo window)
+	RestoreWithdrawal(context.Context, *RestoreWithdrawalRequest) (*Withdrawal, error)
+	// List the contributions and withdrawals of a fund with its running balance
+	ListFundTransactions(context.Context, *ListFundTransactionsRequest) (*ListFundTransactionsResponse, error)
 	mustEmbedUnimplementedFundServiceServer()
 }
 
@@ -197,6 +272,21 @@ func (UnimplementedFundServiceServer) ListContributions(context.Context, *ListCo
 func (UnimplementedFundServiceServer) DeleteContribution(context.Context, *DeleteContributionRequest) (*DeleteContributionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteContribution not implemented")
 }
+func (UnimplementedFundServiceServer) RestoreContribution(context.Context, *RestoreContributionRequest) (*Contribution, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreContribution not implemented")
+}
+func (UnimplementedFundServiceServer) WithdrawFromFund(context.Context, *WithdrawFromFundRequest) (*Withdrawal, error) {
+	return nil, status.Error(codes.Unimplemented, "method WithdrawFromFund not implemented")
+}
+func (UnimplementedFundServiceServer) DeleteWithdrawal(context.Context, *DeleteWithdrawalRequest) (*DeleteWithdrawalResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteWithdrawal not implemented")
+}
+func (UnimplementedFundServiceServer) RestoreWithdrawal(context.Context, *RestoreWithdrawalRequest) (*Withdrawal, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreWithdrawal not implemented")
+}
+func (UnimplementedFundServiceServer) ListFundTransactions(context.Context, *ListFundTransactionsRequest) (*ListFundTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFundTransactions not implemented")
+}
 func (UnimplementedFundServiceServer) mustEmbedUnimplementedFundServiceServer() {}
 func (UnimplementedFundServiceServer) testEmbeddedByValue()                     {}
 
@@ -362,6 +452,96 @@ func _FundService_DeleteContribution_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FundService_RestoreContribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreContributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FundServiceServer).RestoreContribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FundService_RestoreContribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FundServiceServer).RestoreContribution(ctx, req.(*RestoreContributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FundService_WithdrawFromFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawFromFundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FundServiceServer).WithdrawFromFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FundService_WithdrawFromFund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FundServiceServer).WithdrawFromFund(ctx, req.(*WithdrawFromFundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FundService_DeleteWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FundServiceServer).DeleteWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FundService_DeleteWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FundServiceServer).DeleteWithdrawal(ctx, req.(*DeleteWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FundService_RestoreWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FundServiceServer).RestoreWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FundService_RestoreWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FundServiceServer).RestoreWithdrawal(ctx, req.(*RestoreWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FundService_ListFundTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFundTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FundServiceServer).ListFundTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FundService_ListFundTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FundServiceServer).ListFundTransactions(ctx, req.(*ListFundTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FundService_ServiceDesc is the grpc.ServiceDesc for FundService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -401,6 +581,26 @@ var FundService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteContribution",
 			Handler:    _FundService_DeleteContribution_Handler,
 		},
+		{
+			MethodName: "RestoreContribution",
+			Handler:    _FundService_RestoreContribution_Handler,
+		},
+		{
+			MethodName: "WithdrawFromFund",
+			Handler:    _FundService_WithdrawFromFund_Handler,
+		},
+		{
+			MethodName: "DeleteWithdrawal",
+			Handler:    _FundService_DeleteWithdrawal_Handler,
+		},
+		{
+			MethodName: "RestoreWithdrawal",
+			Handler:    _FundService_RestoreWithdrawal_Handler,
+		},
+		{
+			MethodName: "ListFundTransactions",
+			Handler:    _FundService_ListFundTransactions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fund.proto",
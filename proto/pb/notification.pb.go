@@ -31,9 +31,15 @@ const (
 	NotificationType_NOTIFICATION_TYPE_EXPENSE_UPDATED NotificationType = 2
 	NotificationType_NOTIFICATION_TYPE_EXPENSE_DELETED NotificationType = 3
 	// Payment notifications
-	NotificationType_NOTIFICATION_TYPE_PAYMENT_RECEIVED  NotificationType = 10
-	NotificationType_NOTIFICATION_TYPE_PAYMENT_CONFIRMED NotificationType = 11
-	NotificationType_NOTIFICATION_TYPE_PAYMENT_REJECTED  NotificationType = 12
+	NotificationType_NOTIFICATION_TYPE_PAYMENT_RECEIVED         NotificationType = 10
+	NotificationType_NOTIFICATION_TYPE_PAYMENT_CONFIRMED        NotificationType = 11
+	NotificationType_NOTIFICATION_TYPE_PAYMENT_REJECTED         NotificationType = 12
+	NotificationType_NOTIFICATION_TYPE_SETTLEMENT_CREATED       NotificationType = 13
+	NotificationType_NOTIFICATION_TYPE_SETTLEMENT_COMPLETED     NotificationType = 14
+	NotificationType_NOTIFICATION_TYPE_SETTLEMENT_INVALIDATED   NotificationType = 15
+	NotificationType_NOTIFICATION_TYPE_PAYMENT_DISPUTE_RESPONSE NotificationType = 16
+	NotificationType_NOTIFICATION_TYPE_PAYMENT_ESCALATED        NotificationType = 17
+	NotificationType_NOTIFICATION_TYPE_PAYMENT_RESOLVED         NotificationType = 18
 	// Colocation notifications
 	NotificationType_NOTIFICATION_TYPE_MEMBER_JOINED       NotificationType = 20
 	NotificationType_NOTIFICATION_TYPE_MEMBER_LEFT         NotificationType = 21
@@ -48,6 +54,7 @@ const (
 	NotificationType_NOTIFICATION_TYPE_FUND_CREATED      NotificationType = 40
 	NotificationType_NOTIFICATION_TYPE_FUND_CONTRIBUTION NotificationType = 41
 	NotificationType_NOTIFICATION_TYPE_FUND_GOAL_REACHED NotificationType = 42
+	NotificationType_NOTIFICATION_TYPE_FUND_WITHDRAWAL   NotificationType = 43
 	// Event notifications
 	NotificationType_NOTIFICATION_TYPE_EVENT_CREATED   NotificationType = 50
 	NotificationType_NOTIFICATION_TYPE_EVENT_UPDATED   NotificationType = 51
@@ -55,6 +62,12 @@ const (
 	NotificationType_NOTIFICATION_TYPE_EVENT_CANCELLED NotificationType = 53
 	// Recurring expense notifications
 	NotificationType_NOTIFICATION_TYPE_RECURRING_DUE NotificationType = 60
+	// Budget notifications
+	NotificationType_NOTIFICATION_TYPE_BUDGET_WARNING  NotificationType = 70
+	NotificationType_NOTIFICATION_TYPE_BUDGET_EXCEEDED NotificationType = 71
+	// Pending payment notifications
+	NotificationType_NOTIFICATION_TYPE_PAYMENT_REMINDER NotificationType = 80
+	NotificationType_NOTIFICATION_TYPE_PAYMENT_EXPIRED  NotificationType = 81
 )
 
 // Enum value maps for NotificationType.
@@ -67,6 +80,12 @@ var (
 		10: "NOTIFICATION_TYPE_PAYMENT_RECEIVED",
 		11: "NOTIFICATION_TYPE_PAYMENT_CONFIRMED",
 		12: "NOTIFICATION_TYPE_PAYMENT_REJECTED",
+		13: "NOTIFICATION_TYPE_SETTLEMENT_CREATED",
+		14: "NOTIFICATION_TYPE_SETTLEMENT_COMPLETED",
+		15: "NOTIFICATION_TYPE_SETTLEMENT_INVALIDATED",
+		16: "NOTIFICATION_TYPE_PAYMENT_DISPUTE_RESPONSE",
+		17: "NOTIFICATION_TYPE_PAYMENT_ESCALATED",
+		18: "NOTIFICATION_TYPE_PAYMENT_RESOLVED",
 		20: "NOTIFICATION_TYPE_MEMBER_JOINED",
 		21: "NOTIFICATION_TYPE_MEMBER_LEFT",
 		22: "NOTIFICATION_TYPE_MEMBER_REMOVED",
@@ -78,36 +97,52 @@ var (
 		40: "NOTIFICATION_TYPE_FUND_CREATED",
 		41: "NOTIFICATION_TYPE_FUND_CONTRIBUTION",
 		42: "NOTIFICATION_TYPE_FUND_GOAL_REACHED",
+		43: "NOTIFICATION_TYPE_FUND_WITHDRAWAL",
 		50: "NOTIFICATION_TYPE_EVENT_CREATED",
 		51: "NOTIFICATION_TYPE_EVENT_UPDATED",
 		52: "NOTIFICATION_TYPE_EVENT_REMINDER",
 		53: "NOTIFICATION_TYPE_EVENT_CANCELLED",
 		60: "NOTIFICATION_TYPE_RECURRING_DUE",
+		70: "NOTIFICATION_TYPE_BUDGET_WARNING",
+		71: "NOTIFICATION_TYPE_BUDGET_EXCEEDED",
+		80: "NOTIFICATION_TYPE_PAYMENT_REMINDER",
+		81: "NOTIFICATION_TYPE_PAYMENT_EXPIRED",
 	}
 	NotificationType_value = map[string]int32{
-		"NOTIFICATION_TYPE_UNSPECIFIED":         0,
-		"NOTIFICATION_TYPE_EXPENSE_CREATED":     1,
-		"NOTIFICATION_TYPE_EXPENSE_UPDATED":     2,
-		"NOTIFICATION_TYPE_EXPENSE_DELETED":     3,
-		"NOTIFICATION_TYPE_PAYMENT_RECEIVED":    10,
-		"NOTIFICATION_TYPE_PAYMENT_CONFIRMED":   11,
-		"NOTIFICATION_TYPE_PAYMENT_REJECTED":    12,
-		"NOTIFICATION_TYPE_MEMBER_JOINED":       20,
-		"NOTIFICATION_TYPE_MEMBER_LEFT":         21,
-		"NOTIFICATION_TYPE_MEMBER_REMOVED":      22,
-		"NOTIFICATION_TYPE_INVITATION_RECEIVED": 23,
-		"NOTIFICATION_TYPE_ROLE_CHANGED":        24,
-		"NOTIFICATION_TYPE_DECISION_CREATED":    30,
-		"NOTIFICATION_TYPE_DECISION_CLOSED":     31,
-		"NOTIFICATION_TYPE_DECISION_DEADLINE":   32,
-		"NOTIFICATION_TYPE_FUND_CREATED":        40,
-		"NOTIFICATION_TYPE_FUND_CONTRIBUTION":   41,
-		"NOTIFICATION_TYPE_FUND_GOAL_REACHED":   42,
-		"NOTIFICATION_TYPE_EVENT_CREATED":       50,
-		"NOTIFICATION_TYPE_EVENT_UPDATED":       51,
-		"NOTIFICATION_TYPE_EVENT_REMINDER":      52,
-		"NOTIFICATION_TYPE_EVENT_CANCELLED":     53,
-		"NOTIFICATION_TYPE_RECURRING_DUE":       60,
+		"NOTIFICATION_TYPE_UNSPECIFIED":              0,
+		"NOTIFICATION_TYPE_EXPENSE_CREATED":          1,
+		"NOTIFICATION_TYPE_EXPENSE_UPDATED":          2,
+		"NOTIFICATION_TYPE_EXPENSE_DELETED":          3,
+		"NOTIFICATION_TYPE_PAYMENT_RECEIVED":         10,
+		"NOTIFICATION_TYPE_PAYMENT_CONFIRMED":        11,
+		"NOTIFICATION_TYPE_PAYMENT_REJECTED":         12,
+		"NOTIFICATION_TYPE_SETTLEMENT_CREATED":       13,
+		"NOTIFICATION_TYPE_SETTLEMENT_COMPLETED":     14,
+		"NOTIFICATION_TYPE_SETTLEMENT_INVALIDATED":   15,
+		"NOTIFICATION_TYPE_PAYMENT_DISPUTE_RESPONSE": 16,
+		"NOTIFICATION_TYPE_PAYMENT_ESCALATED":        17,
+		"NOTIFICATION_TYPE_PAYMENT_RESOLVED":         18,
+		"NOTIFICATION_TYPE_MEMBER_JOINED":            20,
+		"NOTIFICATION_TYPE_MEMBER_LEFT":              21,
+		"NOTIFICATION_TYPE_MEMBER_REMOVED":           22,
+		"NOTIFICATION_TYPE_INVITATION_RECEIVED":      23,
+		"NOTIFICATION_TYPE_ROLE_CHANGED":             24,
+		"NOTIFICATION_TYPE_DECISION_CREATED":         30,
+		"NOTIFICATION_TYPE_DECISION_CLOSED":          31,
+		"NOTIFICATION_TYPE_DECISION_DEADLINE":        32,
+		"NOTIFICATION_TYPE_FUND_CREATED":             40,
+		"NOTIFICATION_TYPE_FUND_CONTRIBUTION":        41,
+		"NOTIFICATION_TYPE_FUND_GOAL_REACHED":        42,
+		"NOTIFICATION_TYPE_FUND_WITHDRAWAL":          43,
+		"NOTIFICATION_TYPE_EVENT_CREATED":            50,
+		"NOTIFICATION_TYPE_EVENT_UPDATED":            51,
+		"NOTIFICATION_TYPE_EVENT_REMINDER":           52,
+		"NOTIFICATION_TYPE_EVENT_CANCELLED":          53,
+		"NOTIFICATION_TYPE_RECURRING_DUE":            60,
+		"NOTIFICATION_TYPE_BUDGET_WARNING":           70,
+		"NOTIFICATION_TYPE_BUDGET_EXCEEDED":          71,
+		"NOTIFICATION_TYPE_PAYMENT_REMINDER":         80,
+		"NOTIFICATION_TYPE_PAYMENT_EXPIRED":          81,
 	}
 )
 
@@ -845,7 +880,8 @@ const file_notification_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x10\n" +
 	"\x0e_colocation_idB\x12\n" +
-	"\x10_colocation_name*\x8a\a\n" +
+	"\x10_colocation_name*\xd2\n" +
+	"\n" +
 	"\x10NotificationType\x12!\n" +
 	"\x1dNOTIFICATION_TYPE_UNSPECIFIED\x10\x00\x12%\n" +
 	"!NOTIFICATION_TYPE_EXPENSE_CREATED\x10\x01\x12%\n" +
@@ -854,7 +890,13 @@ const file_notification_proto_rawDesc = "" +
 	"\"NOTIFICATION_TYPE_PAYMENT_RECEIVED\x10\n" +
 	"\x12'\n" +
 	"#NOTIFICATION_TYPE_PAYMENT_CONFIRMED\x10\v\x12&\n" +
-	"\"NOTIFICATION_TYPE_PAYMENT_REJECTED\x10\f\x12#\n" +
+	"\"NOTIFICATION_TYPE_PAYMENT_REJECTED\x10\f\x12(\n" +
+	"$NOTIFICATION_TYPE_SETTLEMENT_CREATED\x10\r\x12*\n" +
+	"&NOTIFICATION_TYPE_SETTLEMENT_COMPLETED\x10\x0e\x12,\n" +
+	"(NOTIFICATION_TYPE_SETTLEMENT_INVALIDATED\x10\x0f\x12.\n" +
+	"*NOTIFICATION_TYPE_PAYMENT_DISPUTE_RESPONSE\x10\x10\x12'\n" +
+	"#NOTIFICATION_TYPE_PAYMENT_ESCALATED\x10\x11\x12&\n" +
+	"\"NOTIFICATION_TYPE_PAYMENT_RESOLVED\x10\x12\x12#\n" +
 	"\x1fNOTIFICATION_TYPE_MEMBER_JOINED\x10\x14\x12!\n" +
 	"\x1dNOTIFICATION_TYPE_MEMBER_LEFT\x10\x15\x12$\n" +
 	" NOTIFICATION_TYPE_MEMBER_REMOVED\x10\x16\x12)\n" +
@@ -865,12 +907,17 @@ const file_notification_proto_rawDesc = "" +
 	"#NOTIFICATION_TYPE_DECISION_DEADLINE\x10 \x12\"\n" +
 	"\x1eNOTIFICATION_TYPE_FUND_CREATED\x10(\x12'\n" +
 	"#NOTIFICATION_TYPE_FUND_CONTRIBUTION\x10)\x12'\n" +
-	"#NOTIFICATION_TYPE_FUND_GOAL_REACHED\x10*\x12#\n" +
+	"#NOTIFICATION_TYPE_FUND_GOAL_REACHED\x10*\x12%\n" +
+	"!NOTIFICATION_TYPE_FUND_WITHDRAWAL\x10+\x12#\n" +
 	"\x1fNOTIFICATION_TYPE_EVENT_CREATED\x102\x12#\n" +
 	"\x1fNOTIFICATION_TYPE_EVENT_UPDATED\x103\x12$\n" +
 	" NOTIFICATION_TYPE_EVENT_REMINDER\x104\x12%\n" +
 	"!NOTIFICATION_TYPE_EVENT_CANCELLED\x105\x12#\n" +
-	"\x1fNOTIFICATION_TYPE_RECURRING_DUE\x10<2\xae\x05\n" +
+	"\x1fNOTIFICATION_TYPE_RECURRING_DUE\x10<\x12$\n" +
+	" NOTIFICATION_TYPE_BUDGET_WARNING\x10F\x12%\n" +
+	"!NOTIFICATION_TYPE_BUDGET_EXCEEDED\x10G\x12&\n" +
+	"\"NOTIFICATION_TYPE_PAYMENT_REMINDER\x10P\x12%\n" +
+	"!NOTIFICATION_TYPE_PAYMENT_EXPIRED\x10Q2\xae\x05\n" +
 	"\x13NotificationService\x12r\n" +
 	"\x11ListNotifications\x12\x1f.coloc.ListNotificationsRequest\x1a .coloc.ListNotificationsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/notifications\x12j\n" +
 	"\n" +
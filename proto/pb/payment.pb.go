@@ -28,7 +28,11 @@ const (
 	PaymentStatus_PAYMENT_STATUS_UNSPECIFIED PaymentStatus = 0
 	PaymentStatus_PAYMENT_STATUS_PENDING     PaymentStatus = 1
 	PaymentStatus_PAYMENT_STATUS_CONFIRMED   PaymentStatus = 2
-	PaymentStatus_PAYMENT_STATUS_REJECTED    PaymentStatus = 3
+	PaymentStatus_PAYMENT_STATUS_REJECTED    PaymentStatus = 3 // Deprecated, rejected payments are now disputed
+	PaymentStatus_PAYMENT_STATUS_DISPUTED    PaymentStatus = 4 // Rejected by the recipient
+	PaymentStatus_PAYMENT_STATUS_ESCALATED   PaymentStatus = 5 // Dispute handed over to the admins
+	PaymentStatus_PAYMENT_STATUS_VOID        PaymentStatus = 6 // Dispute resolved against the sender
+	PaymentStatus_PAYMENT_STATUS_EXPIRED     PaymentStatus = 7 // Left pending past the expiry delay of the colocation
 )
 
 // Enum value maps for PaymentStatus.
@@ -38,12 +42,20 @@ var (
 		1: "PAYMENT_STATUS_PENDING",
 		2: "PAYMENT_STATUS_CONFIRMED",
 		3: "PAYMENT_STATUS_REJECTED",
+		4: "PAYMENT_STATUS_DISPUTED",
+		5: "PAYMENT_STATUS_ESCALATED",
+		6: "PAYMENT_STATUS_VOID",
+		7: "PAYMENT_STATUS_EXPIRED",
 	}
 	PaymentStatus_value = map[string]int32{
 		"PAYMENT_STATUS_UNSPECIFIED": 0,
 		"PAYMENT_STATUS_PENDING":     1,
 		"PAYMENT_STATUS_CONFIRMED":   2,
 		"PAYMENT_STATUS_REJECTED":    3,
+		"PAYMENT_STATUS_DISPUTED":    4,
+		"PAYMENT_STATUS_ESCALATED":   5,
+		"PAYMENT_STATUS_VOID":        6,
+		"PAYMENT_STATUS_EXPIRED":     7,
 	}
 )
 
@@ -74,12 +86,195 @@ func (PaymentStatus) EnumDescriptor() ([]byte, []int) {
 	return file_payment_proto_rawDescGZIP(), []int{0}
 }
 
+type PaymentEventType int32
+
+const (
+	PaymentEventType_PAYMENT_EVENT_TYPE_UNSPECIFIED PaymentEventType = 0
+	PaymentEventType_PAYMENT_EVENT_TYPE_CREATED     PaymentEventType = 1
+	PaymentEventType_PAYMENT_EVENT_TYPE_CONFIRMED   PaymentEventType = 2
+	PaymentEventType_PAYMENT_EVENT_TYPE_DISPUTED    PaymentEventType = 3
+	PaymentEventType_PAYMENT_EVENT_TYPE_RESPONDED   PaymentEventType = 4
+	PaymentEventType_PAYMENT_EVENT_TYPE_ESCALATED   PaymentEventType = 5
+	PaymentEventType_PAYMENT_EVENT_TYPE_VOIDED      PaymentEventType = 6
+	PaymentEventType_PAYMENT_EVENT_TYPE_CANCELLED   PaymentEventType = 7
+	PaymentEventType_PAYMENT_EVENT_TYPE_RESTORED    PaymentEventType = 8
+	PaymentEventType_PAYMENT_EVENT_TYPE_EXPIRED     PaymentEventType = 9
+)
+
+// Enum value maps for PaymentEventType.
+var (
+	PaymentEventType_name = map[int32]string{
+		0: "PAYMENT_EVENT_TYPE_UNSPECIFIED",
+		1: "PAYMENT_EVENT_TYPE_CREATED",
+		2: "PAYMENT_EVENT_TYPE_CONFIRMED",
+		3: "PAYMENT_EVENT_TYPE_DISPUTED",
+		4: "PAYMENT_EVENT_TYPE_RESPONDED",
+		5: "PAYMENT_EVENT_TYPE_ESCALATED",
+		6: "PAYMENT_EVENT_TYPE_VOIDED",
+		7: "PAYMENT_EVENT_TYPE_CANCELLED",
+		8: "PAYMENT_EVENT_TYPE_RESTORED",
+		9: "PAYMENT_EVENT_TYPE_EXPIRED",
+	}
+	PaymentEventType_value = map[string]int32{
+		"PAYMENT_EVENT_TYPE_UNSPECIFIED": 0,
+		"PAYMENT_EVENT_TYPE_CREATED":     1,
+		"PAYMENT_EVENT_TYPE_CONFIRMED":   2,
+		"PAYMENT_EVENT_TYPE_DISPUTED":    3,
+		"PAYMENT_EVENT_TYPE_RESPONDED":   4,
+		"PAYMENT_EVENT_TYPE_ESCALATED":   5,
+		"PAYMENT_EVENT_TYPE_VOIDED":      6,
+		"PAYMENT_EVENT_TYPE_CANCELLED":   7,
+		"PAYMENT_EVENT_TYPE_RESTORED":    8,
+		"PAYMENT_EVENT_TYPE_EXPIRED":     9,
+	}
+)
+
+func (x PaymentEventType) Enum() *PaymentEventType {
+	p := new(PaymentEventType)
+	*p = x
+	return p
+}
+
+func (x PaymentEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_proto_enumTypes[1].Descriptor()
+}
+
+func (PaymentEventType) Type() protoreflect.EnumType {
+	return &file_payment_proto_enumTypes[1]
+}
+
+func (x PaymentEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentEventType.Descriptor instead.
+func (PaymentEventType) EnumDescriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{1}
+}
+
+type PaymentResolution int32
+
+const (
+	PaymentResolution_PAYMENT_RESOLUTION_UNSPECIFIED PaymentResolution = 0
+	PaymentResolution_PAYMENT_RESOLUTION_CONFIRMED   PaymentResolution = 1
+	PaymentResolution_PAYMENT_RESOLUTION_VOID        PaymentResolution = 2
+)
+
+// Enum value maps for PaymentResolution.
+var (
+	PaymentResolution_name = map[int32]string{
+		0: "PAYMENT_RESOLUTION_UNSPECIFIED",
+		1: "PAYMENT_RESOLUTION_CONFIRMED",
+		2: "PAYMENT_RESOLUTION_VOID",
+	}
+	PaymentResolution_value = map[string]int32{
+		"PAYMENT_RESOLUTION_UNSPECIFIED": 0,
+		"PAYMENT_RESOLUTION_CONFIRMED":   1,
+		"PAYMENT_RESOLUTION_VOID":        2,
+	}
+)
+
+func (x PaymentResolution) Enum() *PaymentResolution {
+	p := new(PaymentResolution)
+	*p = x
+	return p
+}
+
+func (x PaymentResolution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentResolution) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_proto_enumTypes[2].Descriptor()
+}
+
+func (PaymentResolution) Type() protoreflect.EnumType {
+	return &file_payment_proto_enumTypes[2]
+}
+
+func (x PaymentResolution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentResolution.Descriptor instead.
+func (PaymentResolution) EnumDescriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{2}
+}
+
+type PaymentMethod int32
+
+const (
+	PaymentMethod_PAYMENT_METHOD_UNSPECIFIED   PaymentMethod = 0
+	PaymentMethod_PAYMENT_METHOD_BANK_TRANSFER PaymentMethod = 1
+	PaymentMethod_PAYMENT_METHOD_CASH          PaymentMethod = 2
+	PaymentMethod_PAYMENT_METHOD_PAYPAL        PaymentMethod = 3
+	PaymentMethod_PAYMENT_METHOD_LYDIA         PaymentMethod = 4
+	PaymentMethod_PAYMENT_METHOD_WERO          PaymentMethod = 5
+	PaymentMethod_PAYMENT_METHOD_OTHER         PaymentMethod = 6
+)
+
+// Enum value maps for PaymentMethod.
+var (
+	PaymentMethod_name = map[int32]string{
+		0: "PAYMENT_METHOD_UNSPECIFIED",
+		1: "PAYMENT_METHOD_BANK_TRANSFER",
+		2: "PAYMENT_METHOD_CASH",
+		3: "PAYMENT_METHOD_PAYPAL",
+		4: "PAYMENT_METHOD_LYDIA",
+		5: "PAYMENT_METHOD_WERO",
+		6: "PAYMENT_METHOD_OTHER",
+	}
+	PaymentMethod_value = map[string]int32{
+		"PAYMENT_METHOD_UNSPECIFIED":   0,
+		"PAYMENT_METHOD_BANK_TRANSFER": 1,
+		"PAYMENT_METHOD_CASH":          2,
+		"PAYMENT_METHOD_PAYPAL":        3,
+		"PAYMENT_METHOD_LYDIA":         4,
+		"PAYMENT_METHOD_WERO":          5,
+		"PAYMENT_METHOD_OTHER":         6,
+	}
+)
+
+func (x PaymentMethod) Enum() *PaymentMethod {
+	p := new(PaymentMethod)
+	*p = x
+	return p
+}
+
+func (x PaymentMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_proto_enumTypes[3].Descriptor()
+}
+
+func (PaymentMethod) Type() protoreflect.EnumType {
+	return &file_payment_proto_enumTypes[3]
+}
+
+func (x PaymentMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentMethod.Descriptor instead.
+func (PaymentMethod) EnumDescriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{3}
+}
+
 type CreatePaymentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
 	ToUserId      string                 `protobuf:"bytes,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
-	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	Note          *string                `protobuf:"bytes,4,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	ExchangeRate  *string                `protobuf:"bytes,5,opt,name=exchange_rate,json=exchangeRate,proto3,oneof" json:"exchange_rate,omitempty"` // Units of base currency per unit of amount.currency, overrides stored rates
+	ExpenseIds    []string               `protobuf:"bytes,6,rep,name=expense_ids,json=expenseIds,proto3" json:"expense_ids,omitempty"`             // Expenses to settle first once confirmed, in order; then the oldest debts
+	Method        PaymentMethod          `protobuf:"varint,7,opt,name=method,proto3,enum=coloc.PaymentMethod" json:"method,omitempty"`             // PAYMENT_METHOD_OTHER when unspecified
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -128,11 +323,11 @@ func (x *CreatePaymentRequest) GetToUserId() string {
 	return ""
 }
 
-func (x *CreatePaymentRequest) GetAmount() float64 {
+func (x *CreatePaymentRequest) GetAmount() *Money {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return nil
 }
 
 func (x *CreatePaymentRequest) GetNote() string {
@@ -142,6 +337,27 @@ func (x *CreatePaymentRequest) GetNote() string {
 	return ""
 }
 
+func (x *CreatePaymentRequest) GetExchangeRate() string {
+	if x != nil && x.ExchangeRate != nil {
+		return *x.ExchangeRate
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequest) GetExpenseIds() []string {
+	if x != nil {
+		return x.ExpenseIds
+	}
+	return nil
+}
+
+func (x *CreatePaymentRequest) GetMethod() PaymentMethod {
+	if x != nil {
+		return x.Method
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
 type GetPaymentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
@@ -402,7 +618,7 @@ type RejectPaymentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
 	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Reason        *string                `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	Reason        *string                `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"` // Required
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -458,28 +674,31 @@ func (x *RejectPaymentRequest) GetReason() string {
 	return ""
 }
 
-type CancelPaymentRequest struct {
+type RespondToPaymentDisputeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
 	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Comment       *string                `protobuf:"bytes,3,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	ProofFileName *string                `protobuf:"bytes,4,opt,name=proof_file_name,json=proofFileName,proto3,oneof" json:"proof_file_name,omitempty"` // Required with a proof
+	Proof         []byte                 `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`                                              // JPEG, PNG, GIF or PDF, detected from the content
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelPaymentRequest) Reset() {
-	*x = CancelPaymentRequest{}
+func (x *RespondToPaymentDisputeRequest) Reset() {
+	*x = RespondToPaymentDisputeRequest{}
 	mi := &file_payment_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelPaymentRequest) String() string {
+func (x *RespondToPaymentDisputeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelPaymentRequest) ProtoMessage() {}
+func (*RespondToPaymentDisputeRequest) ProtoMessage() {}
 
-func (x *CancelPaymentRequest) ProtoReflect() protoreflect.Message {
+func (x *RespondToPaymentDisputeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -491,46 +710,69 @@ func (x *CancelPaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelPaymentRequest.ProtoReflect.Descriptor instead.
-func (*CancelPaymentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RespondToPaymentDisputeRequest.ProtoReflect.Descriptor instead.
+func (*RespondToPaymentDisputeRequest) Descriptor() ([]byte, []int) {
 	return file_payment_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CancelPaymentRequest) GetColocationId() string {
+func (x *RespondToPaymentDisputeRequest) GetColocationId() string {
 	if x != nil {
 		return x.ColocationId
 	}
 	return ""
 }
 
-func (x *CancelPaymentRequest) GetId() string {
+func (x *RespondToPaymentDisputeRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type CancelPaymentResponse struct {
+func (x *RespondToPaymentDisputeRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *RespondToPaymentDisputeRequest) GetProofFileName() string {
+	if x != nil && x.ProofFileName != nil {
+		return *x.ProofFileName
+	}
+	return ""
+}
+
+func (x *RespondToPaymentDisputeRequest) GetProof() []byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+type EscalatePaymentDisputeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Comment       *string                `protobuf:"bytes,3,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelPaymentResponse) Reset() {
-	*x = CancelPaymentResponse{}
+func (x *EscalatePaymentDisputeRequest) Reset() {
+	*x = EscalatePaymentDisputeRequest{}
 	mi := &file_payment_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelPaymentResponse) String() string {
+func (x *EscalatePaymentDisputeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelPaymentResponse) ProtoMessage() {}
+func (*EscalatePaymentDisputeRequest) ProtoMessage() {}
 
-func (x *CancelPaymentResponse) ProtoReflect() protoreflect.Message {
+func (x *EscalatePaymentDisputeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -542,53 +784,56 @@ func (x *CancelPaymentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelPaymentResponse.ProtoReflect.Descriptor instead.
-func (*CancelPaymentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EscalatePaymentDisputeRequest.ProtoReflect.Descriptor instead.
+func (*EscalatePaymentDisputeRequest) Descriptor() ([]byte, []int) {
 	return file_payment_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CancelPaymentResponse) GetSuccess() bool {
+func (x *EscalatePaymentDisputeRequest) GetColocationId() string {
 	if x != nil {
-		return x.Success
+		return x.ColocationId
 	}
-	return false
+	return ""
 }
 
-type Payment struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ColocationId   string                 `protobuf:"bytes,2,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
-	FromUserId     string                 `protobuf:"bytes,3,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
-	FromUserNom    string                 `protobuf:"bytes,4,opt,name=from_user_nom,json=fromUserNom,proto3" json:"from_user_nom,omitempty"`
-	FromUserPrenom string                 `protobuf:"bytes,5,opt,name=from_user_prenom,json=fromUserPrenom,proto3" json:"from_user_prenom,omitempty"`
-	FromAvatarUrl  *string                `protobuf:"bytes,6,opt,name=from_avatar_url,json=fromAvatarUrl,proto3,oneof" json:"from_avatar_url,omitempty"`
-	ToUserId       string                 `protobuf:"bytes,7,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
-	ToUserNom      string                 `protobuf:"bytes,8,opt,name=to_user_nom,json=toUserNom,proto3" json:"to_user_nom,omitempty"`
-	ToUserPrenom   string                 `protobuf:"bytes,9,opt,name=to_user_prenom,json=toUserPrenom,proto3" json:"to_user_prenom,omitempty"`
-	ToAvatarUrl    *string                `protobuf:"bytes,10,opt,name=to_avatar_url,json=toAvatarUrl,proto3,oneof" json:"to_avatar_url,omitempty"`
-	Amount         float64                `protobuf:"fixed64,11,opt,name=amount,proto3" json:"amount,omitempty"`
-	Status         PaymentStatus          `protobuf:"varint,12,opt,name=status,proto3,enum=coloc.PaymentStatus" json:"status,omitempty"`
-	Note           *string                `protobuf:"bytes,13,opt,name=note,proto3,oneof" json:"note,omitempty"`
-	ConfirmedAt    *string                `protobuf:"bytes,14,opt,name=confirmed_at,json=confirmedAt,proto3,oneof" json:"confirmed_at,omitempty"`
-	CreatedAt      string                 `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *EscalatePaymentDisputeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (x *Payment) Reset() {
-	*x = Payment{}
+func (x *EscalatePaymentDisputeRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+type ResolvePaymentDisputeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Resolution    PaymentResolution      `protobuf:"varint,3,opt,name=resolution,proto3,enum=coloc.PaymentResolution" json:"resolution,omitempty"`
+	Comment       *string                `protobuf:"bytes,4,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolvePaymentDisputeRequest) Reset() {
+	*x = ResolvePaymentDisputeRequest{}
 	mi := &file_payment_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Payment) String() string {
+func (x *ResolvePaymentDisputeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Payment) ProtoMessage() {}
+func (*ResolvePaymentDisputeRequest) ProtoMessage() {}
 
-func (x *Payment) ProtoReflect() protoreflect.Message {
+func (x *ResolvePaymentDisputeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -600,129 +845,876 @@ func (x *Payment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
-func (*Payment) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResolvePaymentDisputeRequest.ProtoReflect.Descriptor instead.
+func (*ResolvePaymentDisputeRequest) Descriptor() ([]byte, []int) {
 	return file_payment_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Payment) GetId() string {
+func (x *ResolvePaymentDisputeRequest) GetColocationId() string {
 	if x != nil {
-		return x.Id
+		return x.ColocationId
 	}
 	return ""
 }
 
-func (x *Payment) GetColocationId() string {
+func (x *ResolvePaymentDisputeRequest) GetId() string {
 	if x != nil {
-		return x.ColocationId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Payment) GetFromUserId() string {
+func (x *ResolvePaymentDisputeRequest) GetResolution() PaymentResolution {
 	if x != nil {
-		return x.FromUserId
+		return x.Resolution
 	}
-	return ""
+	return PaymentResolution_PAYMENT_RESOLUTION_UNSPECIFIED
 }
 
-func (x *Payment) GetFromUserNom() string {
-	if x != nil {
-		return x.FromUserNom
+func (x *ResolvePaymentDisputeRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
 	}
 	return ""
 }
 
-func (x *Payment) GetFromUserPrenom() string {
+type GetPaymentProofRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	PaymentId     string                 `protobuf:"bytes,2,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaymentProofRequest) Reset() {
+	*x = GetPaymentProofRequest{}
+	mi := &file_payment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentProofRequest) ProtoMessage() {}
+
+func (x *GetPaymentProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[9]
 	if x != nil {
-		return x.FromUserPrenom
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Payment) GetFromAvatarUrl() string {
-	if x != nil && x.FromAvatarUrl != nil {
-		return *x.FromAvatarUrl
-	}
-	return ""
+// Deprecated: Use GetPaymentProofRequest.ProtoReflect.Descriptor instead.
+func (*GetPaymentProofRequest) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Payment) GetToUserId() string {
+func (x *GetPaymentProofRequest) GetColocationId() string {
 	if x != nil {
-		return x.ToUserId
+		return x.ColocationId
 	}
 	return ""
 }
 
-func (x *Payment) GetToUserNom() string {
+func (x *GetPaymentProofRequest) GetPaymentId() string {
 	if x != nil {
-		return x.ToUserNom
+		return x.PaymentId
 	}
 	return ""
 }
 
-func (x *Payment) GetToUserPrenom() string {
+func (x *GetPaymentProofRequest) GetEventId() string {
 	if x != nil {
-		return x.ToUserPrenom
+		return x.EventId
 	}
 	return ""
 }
 
-func (x *Payment) GetToAvatarUrl() string {
-	if x != nil && x.ToAvatarUrl != nil {
-		return *x.ToAvatarUrl
-	}
-	return ""
+type GetPaymentProofResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Payment) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
+func (x *GetPaymentProofResponse) Reset() {
+	*x = GetPaymentProofResponse{}
+	mi := &file_payment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Payment) GetStatus() PaymentStatus {
+func (x *GetPaymentProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentProofResponse) ProtoMessage() {}
+
+func (x *GetPaymentProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[10]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *Payment) GetNote() string {
-	if x != nil && x.Note != nil {
-		return *x.Note
+// Deprecated: Use GetPaymentProofResponse.ProtoReflect.Descriptor instead.
+func (*GetPaymentProofResponse) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPaymentProofResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
 	}
 	return ""
 }
 
-func (x *Payment) GetConfirmedAt() string {
-	if x != nil && x.ConfirmedAt != nil {
-		return *x.ConfirmedAt
+func (x *GetPaymentProofResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
 	}
 	return ""
 }
 
-func (x *Payment) GetCreatedAt() string {
+func (x *GetPaymentProofResponse) GetContent() []byte {
 	if x != nil {
-		return x.CreatedAt
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-var File_payment_proto protoreflect.FileDescriptor
+type CancelPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_payment_proto_rawDesc = "" +
-	"\n" +
-	"\rpayment.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\"\x93\x01\n" +
-	"\x14CreatePaymentRequest\x12#\n" +
-	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x1c\n" +
-	"\n" +
-	"to_user_id\x18\x02 \x01(\tR\btoUserId\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x17\n" +
-	"\x04note\x18\x04 \x01(\tH\x00R\x04note\x88\x01\x01B\a\n" +
-	"\x05_note\"H\n" +
-	"\x11GetPaymentRequest\x12#\n" +
+func (x *CancelPaymentRequest) Reset() {
+	*x = CancelPaymentRequest{}
+	mi := &file_payment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPaymentRequest) ProtoMessage() {}
+
+func (x *CancelPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPaymentRequest.ProtoReflect.Descriptor instead.
+func (*CancelPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelPaymentRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *CancelPaymentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelPaymentResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	RestorableUntil string                 `protobuf:"bytes,2,opt,name=restorable_until,json=restorableUntil,proto3" json:"restorable_until,omitempty"` // The payment can be restored until this date
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CancelPaymentResponse) Reset() {
+	*x = CancelPaymentResponse{}
+	mi := &file_payment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPaymentResponse) ProtoMessage() {}
+
+func (x *CancelPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPaymentResponse.ProtoReflect.Descriptor instead.
+func (*CancelPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CancelPaymentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CancelPaymentResponse) GetRestorableUntil() string {
+	if x != nil {
+		return x.RestorableUntil
+	}
+	return ""
+}
+
+type RestorePaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePaymentRequest) Reset() {
+	*x = RestorePaymentRequest{}
+	mi := &file_payment_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePaymentRequest) ProtoMessage() {}
+
+func (x *RestorePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePaymentRequest.ProtoReflect.Descriptor instead.
+func (*RestorePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RestorePaymentRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *RestorePaymentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Payment struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ColocationId     string                 `protobuf:"bytes,2,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	FromUserId       string                 `protobuf:"bytes,3,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	FromUserNom      string                 `protobuf:"bytes,4,opt,name=from_user_nom,json=fromUserNom,proto3" json:"from_user_nom,omitempty"`
+	FromUserPrenom   string                 `protobuf:"bytes,5,opt,name=from_user_prenom,json=fromUserPrenom,proto3" json:"from_user_prenom,omitempty"`
+	FromAvatarUrl    *string                `protobuf:"bytes,6,opt,name=from_avatar_url,json=fromAvatarUrl,proto3,oneof" json:"from_avatar_url,omitempty"`
+	ToUserId         string                 `protobuf:"bytes,7,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	ToUserNom        string                 `protobuf:"bytes,8,opt,name=to_user_nom,json=toUserNom,proto3" json:"to_user_nom,omitempty"`
+	ToUserPrenom     string                 `protobuf:"bytes,9,opt,name=to_user_prenom,json=toUserPrenom,proto3" json:"to_user_prenom,omitempty"`
+	ToAvatarUrl      *string                `protobuf:"bytes,10,opt,name=to_avatar_url,json=toAvatarUrl,proto3,oneof" json:"to_avatar_url,omitempty"`
+	Amount           *Money                 `protobuf:"bytes,11,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status           PaymentStatus          `protobuf:"varint,12,opt,name=status,proto3,enum=coloc.PaymentStatus" json:"status,omitempty"`
+	Note             *string                `protobuf:"bytes,13,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	ConfirmedAt      *string                `protobuf:"bytes,14,opt,name=confirmed_at,json=confirmedAt,proto3,oneof" json:"confirmed_at,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SettlementPlanId *string                `protobuf:"bytes,16,opt,name=settlement_plan_id,json=settlementPlanId,proto3,oneof" json:"settlement_plan_id,omitempty"`
+	BaseAmount       *Money                 `protobuf:"bytes,17,opt,name=base_amount,json=baseAmount,proto3" json:"base_amount,omitempty"`       // Amount in the colocation base currency
+	ExchangeRate     string                 `protobuf:"bytes,18,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"` // Rate frozen at creation (1 when in base currency)
+	ExpenseIds       []string               `protobuf:"bytes,19,rep,name=expense_ids,json=expenseIds,proto3" json:"expense_ids,omitempty"`       // Expenses to settle first, as requested at creation
+	Allocations      []*PaymentAllocation   `protobuf:"bytes,20,rep,name=allocations,proto3" json:"allocations,omitempty"`                       // Set once confirmed, only when getting a single payment
+	Method           PaymentMethod          `protobuf:"varint,21,opt,name=method,proto3,enum=coloc.PaymentMethod" json:"method,omitempty"`
+	Events           []*PaymentEvent        `protobuf:"bytes,22,rep,name=events,proto3" json:"events,omitempty"` // Timestamped transitions, oldest first
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_payment_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Payment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Payment) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *Payment) GetFromUserId() string {
+	if x != nil {
+		return x.FromUserId
+	}
+	return ""
+}
+
+func (x *Payment) GetFromUserNom() string {
+	if x != nil {
+		return x.FromUserNom
+	}
+	return ""
+}
+
+func (x *Payment) GetFromUserPrenom() string {
+	if x != nil {
+		return x.FromUserPrenom
+	}
+	return ""
+}
+
+func (x *Payment) GetFromAvatarUrl() string {
+	if x != nil && x.FromAvatarUrl != nil {
+		return *x.FromAvatarUrl
+	}
+	return ""
+}
+
+func (x *Payment) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+func (x *Payment) GetToUserNom() string {
+	if x != nil {
+		return x.ToUserNom
+	}
+	return ""
+}
+
+func (x *Payment) GetToUserPrenom() string {
+	if x != nil {
+		return x.ToUserPrenom
+	}
+	return ""
+}
+
+func (x *Payment) GetToAvatarUrl() string {
+	if x != nil && x.ToAvatarUrl != nil {
+		return *x.ToAvatarUrl
+	}
+	return ""
+}
+
+func (x *Payment) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Payment) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Payment) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *Payment) GetConfirmedAt() string {
+	if x != nil && x.ConfirmedAt != nil {
+		return *x.ConfirmedAt
+	}
+	return ""
+}
+
+func (x *Payment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Payment) GetSettlementPlanId() string {
+	if x != nil && x.SettlementPlanId != nil {
+		return *x.SettlementPlanId
+	}
+	return ""
+}
+
+func (x *Payment) GetBaseAmount() *Money {
+	if x != nil {
+		return x.BaseAmount
+	}
+	return nil
+}
+
+func (x *Payment) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+func (x *Payment) GetExpenseIds() []string {
+	if x != nil {
+		return x.ExpenseIds
+	}
+	return nil
+}
+
+func (x *Payment) GetAllocations() []*PaymentAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+func (x *Payment) GetMethod() PaymentMethod {
+	if x != nil {
+		return x.Method
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *Payment) GetEvents() []*PaymentEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Transition of a payment, such as its dispute or the answer of its sender
+type PaymentEvent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type             PaymentEventType       `protobuf:"varint,2,opt,name=type,proto3,enum=coloc.PaymentEventType" json:"type,omitempty"`
+	ActorId          *string                `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"` // Unset when the actor left the app
+	ActorNom         string                 `protobuf:"bytes,4,opt,name=actor_nom,json=actorNom,proto3" json:"actor_nom,omitempty"`
+	ActorPrenom      string                 `protobuf:"bytes,5,opt,name=actor_prenom,json=actorPrenom,proto3" json:"actor_prenom,omitempty"`
+	Comment          *string                `protobuf:"bytes,6,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	ProofFileName    *string                `protobuf:"bytes,7,opt,name=proof_file_name,json=proofFileName,proto3,oneof" json:"proof_file_name,omitempty"` // Set when a proof is attached
+	ProofContentType *string                `protobuf:"bytes,8,opt,name=proof_content_type,json=proofContentType,proto3,oneof" json:"proof_content_type,omitempty"`
+	ProofSizeBytes   *int64                 `protobuf:"varint,9,opt,name=proof_size_bytes,json=proofSizeBytes,proto3,oneof" json:"proof_size_bytes,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PaymentEvent) Reset() {
+	*x = PaymentEvent{}
+	mi := &file_payment_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentEvent) ProtoMessage() {}
+
+func (x *PaymentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentEvent.ProtoReflect.Descriptor instead.
+func (*PaymentEvent) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PaymentEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PaymentEvent) GetType() PaymentEventType {
+	if x != nil {
+		return x.Type
+	}
+	return PaymentEventType_PAYMENT_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *PaymentEvent) GetActorId() string {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return ""
+}
+
+func (x *PaymentEvent) GetActorNom() string {
+	if x != nil {
+		return x.ActorNom
+	}
+	return ""
+}
+
+func (x *PaymentEvent) GetActorPrenom() string {
+	if x != nil {
+		return x.ActorPrenom
+	}
+	return ""
+}
+
+func (x *PaymentEvent) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *PaymentEvent) GetProofFileName() string {
+	if x != nil && x.ProofFileName != nil {
+		return *x.ProofFileName
+	}
+	return ""
+}
+
+func (x *PaymentEvent) GetProofContentType() string {
+	if x != nil && x.ProofContentType != nil {
+		return *x.ProofContentType
+	}
+	return ""
+}
+
+func (x *PaymentEvent) GetProofSizeBytes() int64 {
+	if x != nil && x.ProofSizeBytes != nil {
+		return *x.ProofSizeBytes
+	}
+	return 0
+}
+
+func (x *PaymentEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// Part of a confirmed payment settling the share of its sender in an expense
+type PaymentAllocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId     string                 `protobuf:"bytes,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	ExpenseTitle  string                 `protobuf:"bytes,2,opt,name=expense_title,json=expenseTitle,proto3" json:"expense_title,omitempty"`
+	ExpenseDate   string                 `protobuf:"bytes,3,opt,name=expense_date,json=expenseDate,proto3" json:"expense_date,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"` // In the colocation base currency
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentAllocation) Reset() {
+	*x = PaymentAllocation{}
+	mi := &file_payment_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentAllocation) ProtoMessage() {}
+
+func (x *PaymentAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentAllocation.ProtoReflect.Descriptor instead.
+func (*PaymentAllocation) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PaymentAllocation) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+func (x *PaymentAllocation) GetExpenseTitle() string {
+	if x != nil {
+		return x.ExpenseTitle
+	}
+	return ""
+}
+
+func (x *PaymentAllocation) GetExpenseDate() string {
+	if x != nil {
+		return x.ExpenseDate
+	}
+	return ""
+}
+
+func (x *PaymentAllocation) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type GetPaymentInstructionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaymentInstructionsRequest) Reset() {
+	*x = GetPaymentInstructionsRequest{}
+	mi := &file_payment_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentInstructionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentInstructionsRequest) ProtoMessage() {}
+
+func (x *GetPaymentInstructionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentInstructionsRequest.ProtoReflect.Descriptor instead.
+func (*GetPaymentInstructionsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetPaymentInstructionsRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *GetPaymentInstructionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// SEPA credit transfer to the recipient of a payment
+type PaymentInstructions struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Payment         *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	BeneficiaryName string                 `protobuf:"bytes,2,opt,name=beneficiary_name,json=beneficiaryName,proto3" json:"beneficiary_name,omitempty"`
+	Iban            string                 `protobuf:"bytes,3,opt,name=iban,proto3" json:"iban,omitempty"`
+	Bic             *string                `protobuf:"bytes,4,opt,name=bic,proto3,oneof" json:"bic,omitempty"`
+	Remittance      string                 `protobuf:"bytes,5,opt,name=remittance,proto3" json:"remittance,omitempty"`
+	EpcPayload      string                 `protobuf:"bytes,6,opt,name=epc_payload,json=epcPayload,proto3" json:"epc_payload,omitempty"` // EPC069-12 QR code content
+	QrCode          []byte                 `protobuf:"bytes,7,opt,name=qr_code,json=qrCode,proto3" json:"qr_code,omitempty"`             // PNG image of the EPC QR code
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PaymentInstructions) Reset() {
+	*x = PaymentInstructions{}
+	mi := &file_payment_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentInstructions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentInstructions) ProtoMessage() {}
+
+func (x *PaymentInstructions) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentInstructions.ProtoReflect.Descriptor instead.
+func (*PaymentInstructions) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PaymentInstructions) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *PaymentInstructions) GetBeneficiaryName() string {
+	if x != nil {
+		return x.BeneficiaryName
+	}
+	return ""
+}
+
+func (x *PaymentInstructions) GetIban() string {
+	if x != nil {
+		return x.Iban
+	}
+	return ""
+}
+
+func (x *PaymentInstructions) GetBic() string {
+	if x != nil && x.Bic != nil {
+		return *x.Bic
+	}
+	return ""
+}
+
+func (x *PaymentInstructions) GetRemittance() string {
+	if x != nil {
+		return x.Remittance
+	}
+	return ""
+}
+
+func (x *PaymentInstructions) GetEpcPayload() string {
+	if x != nil {
+		return x.EpcPayload
+	}
+	return ""
+}
+
+func (x *PaymentInstructions) GetQrCode() []byte {
+	if x != nil {
+		return x.QrCode
+	}
+	return nil
+}
+
+var File_payment_proto protoreflect.FileDescriptor
+
+const file_payment_proto_rawDesc = "" +
+	"\n" +
+	"\rpayment.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\x1a\fcommon.proto\"\xac\x02\n" +
+	"\x14CreatePaymentRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x02 \x01(\tR\btoUserId\x12$\n" +
+	"\x06amount\x18\x03 \x01(\v2\f.coloc.MoneyR\x06amount\x12\x17\n" +
+	"\x04note\x18\x04 \x01(\tH\x00R\x04note\x88\x01\x01\x12(\n" +
+	"\rexchange_rate\x18\x05 \x01(\tH\x01R\fexchangeRate\x88\x01\x01\x12\x1f\n" +
+	"\vexpense_ids\x18\x06 \x03(\tR\n" +
+	"expenseIds\x12,\n" +
+	"\x06method\x18\a \x01(\x0e2\x14.coloc.PaymentMethodR\x06methodB\a\n" +
+	"\x05_noteB\x10\n" +
+	"\x0e_exchange_rate\"H\n" +
+	"\x11GetPaymentRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\"\xb4\x02\n" +
 	"\x13ListPaymentsRequest\x12#\n" +
@@ -753,12 +1745,49 @@ const file_payment_proto_rawDesc = "" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1b\n" +
 	"\x06reason\x18\x03 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
-	"\a_reason\"K\n" +
+	"\a_reason\"\xd7\x01\n" +
+	"\x1eRespondToPaymentDisputeRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1d\n" +
+	"\acomment\x18\x03 \x01(\tH\x00R\acomment\x88\x01\x01\x12+\n" +
+	"\x0fproof_file_name\x18\x04 \x01(\tH\x01R\rproofFileName\x88\x01\x01\x12\x14\n" +
+	"\x05proof\x18\x05 \x01(\fR\x05proofB\n" +
+	"\n" +
+	"\b_commentB\x12\n" +
+	"\x10_proof_file_name\"\x7f\n" +
+	"\x1dEscalatePaymentDisputeRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1d\n" +
+	"\acomment\x18\x03 \x01(\tH\x00R\acomment\x88\x01\x01B\n" +
+	"\n" +
+	"\b_comment\"\xb8\x01\n" +
+	"\x1cResolvePaymentDisputeRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x128\n" +
+	"\n" +
+	"resolution\x18\x03 \x01(\x0e2\x18.coloc.PaymentResolutionR\n" +
+	"resolution\x12\x1d\n" +
+	"\acomment\x18\x04 \x01(\tH\x00R\acomment\x88\x01\x01B\n" +
+	"\n" +
+	"\b_comment\"w\n" +
+	"\x16GetPaymentProofRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x02 \x01(\tR\tpaymentId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\"s\n" +
+	"\x17GetPaymentProofResponse\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"K\n" +
 	"\x14CancelPaymentRequest\x12#\n" +
 	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\tR\x02id\"1\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\\\n" +
 	"\x15CancelPaymentResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xce\x04\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12)\n" +
+	"\x10restorable_until\x18\x02 \x01(\tR\x0frestorableUntil\"L\n" +
+	"\x15RestorePaymentRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xb2\a\n" +
 	"\aPayment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
 	"\rcolocation_id\x18\x02 \x01(\tR\fcolocationId\x12 \n" +
@@ -772,30 +1801,113 @@ const file_payment_proto_rawDesc = "" +
 	"\vto_user_nom\x18\b \x01(\tR\ttoUserNom\x12$\n" +
 	"\x0eto_user_prenom\x18\t \x01(\tR\ftoUserPrenom\x12'\n" +
 	"\rto_avatar_url\x18\n" +
-	" \x01(\tH\x01R\vtoAvatarUrl\x88\x01\x01\x12\x16\n" +
-	"\x06amount\x18\v \x01(\x01R\x06amount\x12,\n" +
+	" \x01(\tH\x01R\vtoAvatarUrl\x88\x01\x01\x12$\n" +
+	"\x06amount\x18\v \x01(\v2\f.coloc.MoneyR\x06amount\x12,\n" +
 	"\x06status\x18\f \x01(\x0e2\x14.coloc.PaymentStatusR\x06status\x12\x17\n" +
 	"\x04note\x18\r \x01(\tH\x02R\x04note\x88\x01\x01\x12&\n" +
 	"\fconfirmed_at\x18\x0e \x01(\tH\x03R\vconfirmedAt\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x0f \x01(\tR\tcreatedAtB\x12\n" +
+	"created_at\x18\x0f \x01(\tR\tcreatedAt\x121\n" +
+	"\x12settlement_plan_id\x18\x10 \x01(\tH\x04R\x10settlementPlanId\x88\x01\x01\x12-\n" +
+	"\vbase_amount\x18\x11 \x01(\v2\f.coloc.MoneyR\n" +
+	"baseAmount\x12#\n" +
+	"\rexchange_rate\x18\x12 \x01(\tR\fexchangeRate\x12\x1f\n" +
+	"\vexpense_ids\x18\x13 \x03(\tR\n" +
+	"expenseIds\x12:\n" +
+	"\vallocations\x18\x14 \x03(\v2\x18.coloc.PaymentAllocationR\vallocations\x12,\n" +
+	"\x06method\x18\x15 \x01(\x0e2\x14.coloc.PaymentMethodR\x06method\x12+\n" +
+	"\x06events\x18\x16 \x03(\v2\x13.coloc.PaymentEventR\x06eventsB\x12\n" +
 	"\x10_from_avatar_urlB\x10\n" +
 	"\x0e_to_avatar_urlB\a\n" +
 	"\x05_noteB\x0f\n" +
-	"\r_confirmed_at*\x86\x01\n" +
+	"\r_confirmed_atB\x15\n" +
+	"\x13_settlement_plan_id\"\xd1\x03\n" +
+	"\fPaymentEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x17.coloc.PaymentEventTypeR\x04type\x12\x1e\n" +
+	"\bactor_id\x18\x03 \x01(\tH\x00R\aactorId\x88\x01\x01\x12\x1b\n" +
+	"\tactor_nom\x18\x04 \x01(\tR\bactorNom\x12!\n" +
+	"\factor_prenom\x18\x05 \x01(\tR\vactorPrenom\x12\x1d\n" +
+	"\acomment\x18\x06 \x01(\tH\x01R\acomment\x88\x01\x01\x12+\n" +
+	"\x0fproof_file_name\x18\a \x01(\tH\x02R\rproofFileName\x88\x01\x01\x121\n" +
+	"\x12proof_content_type\x18\b \x01(\tH\x03R\x10proofContentType\x88\x01\x01\x12-\n" +
+	"\x10proof_size_bytes\x18\t \x01(\x03H\x04R\x0eproofSizeBytes\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAtB\v\n" +
+	"\t_actor_idB\n" +
+	"\n" +
+	"\b_commentB\x12\n" +
+	"\x10_proof_file_nameB\x15\n" +
+	"\x13_proof_content_typeB\x13\n" +
+	"\x11_proof_size_bytes\"\xa0\x01\n" +
+	"\x11PaymentAllocation\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\tR\texpenseId\x12#\n" +
+	"\rexpense_title\x18\x02 \x01(\tR\fexpenseTitle\x12!\n" +
+	"\fexpense_date\x18\x03 \x01(\tR\vexpenseDate\x12$\n" +
+	"\x06amount\x18\x04 \x01(\v2\f.coloc.MoneyR\x06amount\"T\n" +
+	"\x1dGetPaymentInstructionsRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xf7\x01\n" +
+	"\x13PaymentInstructions\x12(\n" +
+	"\apayment\x18\x01 \x01(\v2\x0e.coloc.PaymentR\apayment\x12)\n" +
+	"\x10beneficiary_name\x18\x02 \x01(\tR\x0fbeneficiaryName\x12\x12\n" +
+	"\x04iban\x18\x03 \x01(\tR\x04iban\x12\x15\n" +
+	"\x03bic\x18\x04 \x01(\tH\x00R\x03bic\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"remittance\x18\x05 \x01(\tR\n" +
+	"remittance\x12\x1f\n" +
+	"\vepc_payload\x18\x06 \x01(\tR\n" +
+	"epcPayload\x12\x17\n" +
+	"\aqr_code\x18\a \x01(\fR\x06qrCodeB\x06\n" +
+	"\x04_bic*\xf6\x01\n" +
 	"\rPaymentStatus\x12\x1e\n" +
 	"\x1aPAYMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16PAYMENT_STATUS_PENDING\x10\x01\x12\x1c\n" +
 	"\x18PAYMENT_STATUS_CONFIRMED\x10\x02\x12\x1b\n" +
-	"\x17PAYMENT_STATUS_REJECTED\x10\x032\xf9\x05\n" +
+	"\x17PAYMENT_STATUS_REJECTED\x10\x03\x12\x1b\n" +
+	"\x17PAYMENT_STATUS_DISPUTED\x10\x04\x12\x1c\n" +
+	"\x18PAYMENT_STATUS_ESCALATED\x10\x05\x12\x17\n" +
+	"\x13PAYMENT_STATUS_VOID\x10\x06\x12\x1a\n" +
+	"\x16PAYMENT_STATUS_EXPIRED\x10\a*\xdf\x02\n" +
+	"\x10PaymentEventType\x12\"\n" +
+	"\x1ePAYMENT_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aPAYMENT_EVENT_TYPE_CREATED\x10\x01\x12 \n" +
+	"\x1cPAYMENT_EVENT_TYPE_CONFIRMED\x10\x02\x12\x1f\n" +
+	"\x1bPAYMENT_EVENT_TYPE_DISPUTED\x10\x03\x12 \n" +
+	"\x1cPAYMENT_EVENT_TYPE_RESPONDED\x10\x04\x12 \n" +
+	"\x1cPAYMENT_EVENT_TYPE_ESCALATED\x10\x05\x12\x1d\n" +
+	"\x19PAYMENT_EVENT_TYPE_VOIDED\x10\x06\x12 \n" +
+	"\x1cPAYMENT_EVENT_TYPE_CANCELLED\x10\a\x12\x1f\n" +
+	"\x1bPAYMENT_EVENT_TYPE_RESTORED\x10\b\x12\x1e\n" +
+	"\x1aPAYMENT_EVENT_TYPE_EXPIRED\x10\t*v\n" +
+	"\x11PaymentResolution\x12\"\n" +
+	"\x1ePAYMENT_RESOLUTION_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cPAYMENT_RESOLUTION_CONFIRMED\x10\x01\x12\x1b\n" +
+	"\x17PAYMENT_RESOLUTION_VOID\x10\x02*\xd2\x01\n" +
+	"\rPaymentMethod\x12\x1e\n" +
+	"\x1aPAYMENT_METHOD_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cPAYMENT_METHOD_BANK_TRANSFER\x10\x01\x12\x17\n" +
+	"\x13PAYMENT_METHOD_CASH\x10\x02\x12\x19\n" +
+	"\x15PAYMENT_METHOD_PAYPAL\x10\x03\x12\x18\n" +
+	"\x14PAYMENT_METHOD_LYDIA\x10\x04\x12\x17\n" +
+	"\x13PAYMENT_METHOD_WERO\x10\x05\x12\x18\n" +
+	"\x14PAYMENT_METHOD_OTHER\x10\x062\x89\r\n" +
 	"\x0ePaymentService\x12r\n" +
 	"\rCreatePayment\x12\x1b.coloc.CreatePaymentRequest\x1a\x0e.coloc.Payment\"4\x82\xd3\xe4\x93\x02.:\x01*\")/api/colocations/{colocation_id}/payments\x12n\n" +
 	"\n" +
 	"GetPayment\x12\x18.coloc.GetPaymentRequest\x1a\x0e.coloc.Payment\"6\x82\xd3\xe4\x93\x020\x12./api/colocations/{colocation_id}/payments/{id}\x12z\n" +
 	"\fListPayments\x12\x1a.coloc.ListPaymentsRequest\x1a\x1b.coloc.ListPaymentsResponse\"1\x82\xd3\xe4\x93\x02+\x12)/api/colocations/{colocation_id}/payments\x12\x81\x01\n" +
 	"\x0eConfirmPayment\x12\x1c.coloc.ConfirmPaymentRequest\x1a\x0e.coloc.Payment\"A\x82\xd3\xe4\x93\x02;:\x01*\"6/api/colocations/{colocation_id}/payments/{id}/confirm\x12~\n" +
-	"\rRejectPayment\x12\x1b.coloc.RejectPaymentRequest\x1a\x0e.coloc.Payment\"@\x82\xd3\xe4\x93\x02::\x01*\"5/api/colocations/{colocation_id}/payments/{id}/reject\x12\x82\x01\n" +
-	"\rCancelPayment\x12\x1b.coloc.CancelPaymentRequest\x1a\x1c.coloc.CancelPaymentResponse\"6\x82\xd3\xe4\x93\x020*./api/colocations/{colocation_id}/payments/{id}B,Z*github.com/vblanchet22/back_coloc/proto/pbb\x06proto3"
+	"\rRejectPayment\x12\x1b.coloc.RejectPaymentRequest\x1a\x0e.coloc.Payment\"@\x82\xd3\xe4\x93\x02::\x01*\"5/api/colocations/{colocation_id}/payments/{id}/reject\x12\x95\x01\n" +
+	"\x17RespondToPaymentDispute\x12%.coloc.RespondToPaymentDisputeRequest\x1a\x0e.coloc.Payment\"C\x82\xd3\xe4\x93\x02=:\x01*\"8/api/colocations/{colocation_id}/payments/{id}/responses\x12\x92\x01\n" +
+	"\x16EscalatePaymentDispute\x12$.coloc.EscalatePaymentDisputeRequest\x1a\x0e.coloc.Payment\"B\x82\xd3\xe4\x93\x02<:\x01*\"7/api/colocations/{colocation_id}/payments/{id}/escalate\x12\x8f\x01\n" +
+	"\x15ResolvePaymentDispute\x12#.coloc.ResolvePaymentDisputeRequest\x1a\x0e.coloc.Payment\"A\x82\xd3\xe4\x93\x02;:\x01*\"6/api/colocations/{colocation_id}/payments/{id}/resolve\x12\xa8\x01\n" +
+	"\x0fGetPaymentProof\x12\x1d.coloc.GetPaymentProofRequest\x1a\x1e.coloc.GetPaymentProofResponse\"V\x82\xd3\xe4\x93\x02P\x12N/api/colocations/{colocation_id}/payments/{payment_id}/events/{event_id}/proof\x12\x82\x01\n" +
+	"\rCancelPayment\x12\x1b.coloc.CancelPaymentRequest\x1a\x1c.coloc.CancelPaymentResponse\"6\x82\xd3\xe4\x93\x020*./api/colocations/{colocation_id}/payments/{id}\x12\x81\x01\n" +
+	"\x0eRestorePayment\x12\x1c.coloc.RestorePaymentRequest\x1a\x0e.coloc.Payment\"A\x82\xd3\xe4\x93\x02;:\x01*\"6/api/colocations/{colocation_id}/payments/{id}/restore\x12\x9f\x01\n" +
+	"\x16GetPaymentInstructions\x12$.coloc.GetPaymentInstructionsRequest\x1a\x1a.coloc.PaymentInstructions\"C\x82\xd3\xe4\x93\x02=\x12;/api/colocations/{colocation_id}/payments/{id}/instructionsB,Z*github.com/vblanchet22/back_coloc/proto/pbb\x06proto3"
 
 var (
 	file_payment_proto_rawDescOnce sync.Once
@@ -809,41 +1921,78 @@ func file_payment_proto_rawDescGZIP() []byte {
 	return file_payment_proto_rawDescData
 }
 
-var file_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_payment_proto_goTypes = []any{
-	(PaymentStatus)(0),            // 0: coloc.PaymentStatus
-	(*CreatePaymentRequest)(nil),  // 1: coloc.CreatePaymentRequest
-	(*GetPaymentRequest)(nil),     // 2: coloc.GetPaymentRequest
-	(*ListPaymentsRequest)(nil),   // 3: coloc.ListPaymentsRequest
-	(*ListPaymentsResponse)(nil),  // 4: coloc.ListPaymentsResponse
-	(*ConfirmPaymentRequest)(nil), // 5: coloc.ConfirmPaymentRequest
-	(*RejectPaymentRequest)(nil),  // 6: coloc.RejectPaymentRequest
-	(*CancelPaymentRequest)(nil),  // 7: coloc.CancelPaymentRequest
-	(*CancelPaymentResponse)(nil), // 8: coloc.CancelPaymentResponse
-	(*Payment)(nil),               // 9: coloc.Payment
+	(PaymentStatus)(0),                     // 0: coloc.PaymentStatus
+	(PaymentEventType)(0),                  // 1: coloc.PaymentEventType
+	(PaymentResolution)(0),                 // 2: coloc.PaymentResolution
+	(PaymentMethod)(0),                     // 3: coloc.PaymentMethod
+	(*CreatePaymentRequest)(nil),           // 4: coloc.CreatePaymentRequest
+	(*GetPaymentRequest)(nil),              // 5: coloc.GetPaymentRequest
+	(*ListPaymentsRequest)(nil),            // 6: coloc.ListPaymentsRequest
+	(*ListPaymentsResponse)(nil),           // 7: coloc.ListPaymentsResponse
+	(*ConfirmPaymentRequest)(nil),          // 8: coloc.ConfirmPaymentRequest
+	(*RejectPaymentRequest)(nil),           // 9: coloc.RejectPaymentRequest
+	(*RespondToPaymentDisputeRequest)(nil), // 10: coloc.RespondToPaymentDisputeRequest
+	(*EscalatePaymentDisputeRequest)(nil),  // 11: coloc.EscalatePaymentDisputeRequest
+	(*ResolvePaymentDisputeRequest)(nil),   // 12: coloc.ResolvePaymentDisputeRequest
+	(*GetPaymentProofRequest)(nil),         // 13: coloc.GetPaymentProofRequest
+	(*GetPaymentProofResponse)(nil),        // 14: coloc.GetPaymentProofResponse
+	(*CancelPaymentRequest)(nil),           // 15: coloc.CancelPaymentRequest
+	(*CancelPaymentResponse)(nil),          // 16: coloc.CancelPaymentResponse
+	(*RestorePaymentRequest)(nil),          // 17: coloc.RestorePaymentRequest
+	(*Payment)(nil),                        // 18: coloc.Payment
+	(*PaymentEvent)(nil),                   // 19: coloc.PaymentEvent
+	(*PaymentAllocation)(nil),              // 20: coloc.PaymentAllocation
+	(*GetPaymentInstructionsRequest)(nil),  // 21: coloc.GetPaymentInstructionsRequest
+	(*PaymentInstructions)(nil),            // 22: coloc.PaymentInstructions
+	(*Money)(nil),                          // 23: coloc.Money
 }
 var file_payment_proto_depIdxs = []int32{
-	0, // 0: coloc.ListPaymentsRequest.status:type_name -> coloc.PaymentStatus
-	9, // 1: coloc.ListPaymentsResponse.payments:type_name -> coloc.Payment
-	0, // 2: coloc.Payment.status:type_name -> coloc.PaymentStatus
-	1, // 3: coloc.PaymentService.CreatePayment:input_type -> coloc.CreatePaymentRequest
-	2, // 4: coloc.PaymentService.GetPayment:input_type -> coloc.GetPaymentRequest
-	3, // 5: coloc.PaymentService.ListPayments:input_type -> coloc.ListPaymentsRequest
-	5, // 6: coloc.PaymentService.ConfirmPayment:input_type -> coloc.ConfirmPaymentRequest
-	6, // 7: coloc.PaymentService.RejectPayment:input_type -> coloc.RejectPaymentRequest
-	7, // 8: coloc.PaymentService.CancelPayment:input_type -> coloc.CancelPaymentRequest
-	9, // 9: coloc.PaymentService.CreatePayment:output_type -> coloc.Payment
-	9, // 10: coloc.PaymentService.GetPayment:output_type -> coloc.Payment
-	4, // 11: coloc.PaymentService.ListPayments:output_type -> coloc.ListPaymentsResponse
-	9, // 12: coloc.PaymentService.ConfirmPayment:output_type -> coloc.Payment
-	9, // 13: coloc.PaymentService.RejectPayment:output_type -> coloc.Payment
-	8, // 14: coloc.PaymentService.CancelPayment:output_type -> coloc.CancelPaymentResponse
-	9, // [9:15] is the sub-list for method output_type
-	3, // [3:9] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	23, // 0: coloc.CreatePaymentRequest.amount:type_name -> coloc.Money
+	3,  // 1: coloc.CreatePaymentRequest.method:type_name -> coloc.PaymentMethod
+	0,  // 2: coloc.ListPaymentsRequest.status:type_name -> coloc.PaymentStatus
+	18, // 3: coloc.ListPaymentsResponse.payments:type_name -> coloc.Payment
+	2,  // 4: coloc.ResolvePaymentDisputeRequest.resolution:type_name -> coloc.PaymentResolution
+	23, // 5: coloc.Payment.amount:type_name -> coloc.Money
+	0,  // 6: coloc.Payment.status:type_name -> coloc.PaymentStatus
+	23, // 7: coloc.Payment.base_amount:type_name -> coloc.Money
+	20, // 8: coloc.Payment.allocations:type_name -> coloc.PaymentAllocation
+	3,  // 9: coloc.Payment.method:type_name -> coloc.PaymentMethod
+	19, // 10: coloc.Payment.events:type_name -> coloc.PaymentEvent
+	1,  // 11: coloc.PaymentEvent.type:type_name -> coloc.PaymentEventType
+	23, // 12: coloc.PaymentAllocation.amount:type_name -> coloc.Money
+	18, // 13: coloc.PaymentInstructions.payment:type_name -> coloc.Payment
+	4,  // 14: coloc.PaymentService.CreatePayment:input_type -> coloc.CreatePaymentRequest
+	5,  // 15: coloc.PaymentService.GetPayment:input_type -> coloc.GetPaymentRequest
+	6,  // 16: coloc.PaymentService.ListPayments:input_type -> coloc.ListPaymentsRequest
+	8,  // 17: coloc.PaymentService.ConfirmPayment:input_type -> coloc.ConfirmPaymentRequest
+	9,  // 18: coloc.PaymentService.RejectPayment:input_type -> coloc.RejectPaymentRequest
+	10, // 19: coloc.PaymentService.RespondToPaymentDispute:input_type -> coloc.RespondToPaymentDisputeRequest
+	11, // 20: coloc.PaymentService.EscalatePaymentDispute:input_type -> coloc.EscalatePaymentDisputeRequest
+	12, // 21: coloc.PaymentService.ResolvePaymentDispute:input_type -> coloc.ResolvePaymentDisputeRequest
+	13, // 22: coloc.PaymentService.GetPaymentProof:input_type -> coloc.GetPaymentProofRequest
+	15, // 23: coloc.PaymentService.CancelPayment:input_type -> coloc.CancelPaymentRequest
+	17, // 24: coloc.PaymentService.RestorePayment:input_type -> coloc.RestorePaymentRequest
+	21, // 25: coloc.PaymentService.GetPaymentInstructions:input_type -> coloc.GetPaymentInstructionsRequest
+	18, // 26: coloc.PaymentService.CreatePayment:output_type -> coloc.Payment
+	18, // 27: coloc.PaymentService.GetPayment:output_type -> coloc.Payment
+	7,  // 28: coloc.PaymentService.ListPayments:output_type -> coloc.ListPaymentsResponse
+	18, // 29: coloc.PaymentService.ConfirmPayment:output_type -> coloc.Payment
+	18, // 30: coloc.PaymentService.RejectPayment:output_type -> coloc.Payment
+	18, // 31: coloc.PaymentService.RespondToPaymentDispute:output_type -> coloc.Payment
+	18, // 32: coloc.PaymentService.EscalatePaymentDispute:output_type -> coloc.Payment
+	18, // 33: coloc.PaymentService.ResolvePaymentDispute:output_type -> coloc.Payment
+	14, // 34: coloc.PaymentService.GetPaymentProof:output_type -> coloc.GetPaymentProofResponse
+	16, // 35: coloc.PaymentService.CancelPayment:output_type -> coloc.CancelPaymentResponse
+	18, // 36: coloc.PaymentService.RestorePayment:output_type -> coloc.Payment
+	22, // 37: coloc.PaymentService.GetPaymentInstructions:output_type -> coloc.PaymentInstructions
+	26, // [26:38] is the sub-list for method output_type
+	14, // [14:26] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_payment_proto_init() }
@@ -851,17 +2000,23 @@ func file_payment_proto_init() {
 	if File_payment_proto != nil {
 		return
 	}
+	file_common_proto_init()
 	file_payment_proto_msgTypes[0].OneofWrappers = []any{}
 	file_payment_proto_msgTypes[2].OneofWrappers = []any{}
 	file_payment_proto_msgTypes[5].OneofWrappers = []any{}
+	file_payment_proto_msgTypes[6].OneofWrappers = []any{}
+	file_payment_proto_msgTypes[7].OneofWrappers = []any{}
 	file_payment_proto_msgTypes[8].OneofWrappers = []any{}
+	file_payment_proto_msgTypes[14].OneofWrappers = []any{}
+	file_payment_proto_msgTypes[15].OneofWrappers = []any{}
+	file_payment_proto_msgTypes[18].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_proto_rawDesc), len(file_payment_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   9,
+			NumEnums:      4,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,12 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PaymentService_CreatePayment_FullMethodName  = "/coloc.PaymentService/CreatePayment"
-	PaymentService_GetPayment_FullMethodName     = "/coloc.PaymentService/GetPayment"
-	PaymentService_ListPayments_FullMethodName   = "/coloc.PaymentService/ListPayments"
-	PaymentService_ConfirmPayment_FullMethodName = "/coloc.PaymentService/ConfirmPayment"
-	PaymentService_RejectPayment_FullMethodName  = "/coloc.PaymentService/RejectPayment"
-	PaymentService_CancelPayment_FullMethodName  = "/coloc.PaymentService/CancelPayment"
+	PaymentService_CreatePayment_FullMethodName           = "/coloc.PaymentService/CreatePayment"
+	PaymentService_GetPayment_FullMethodName              = "/coloc.PaymentService/GetPayment"
+	PaymentService_ListPayments_FullMethodName            = "/coloc.PaymentService/ListPayments"
+	PaymentService_ConfirmPayment_FullMethodName          = "/coloc.PaymentService/ConfirmPayment"
+	PaymentService_RejectPayment_FullMethodName           = "/coloc.PaymentService/RejectPayment"
+	PaymentService_RespondToPaymentDispute_FullMethodName = "/coloc.PaymentService/RespondToPaymentDispute"
+	PaymentService_EscalatePaymentDispute_FullMethodName  = "/coloc.PaymentService/EscalatePaymentDispute"
+	PaymentService_ResolvePaymentDispute_FullMethodName   = "/coloc.PaymentService/ResolvePaymentDispute"
+	PaymentService_GetPaymentProof_FullMethodName         = "/coloc.PaymentService/GetPaymentProof"
+	PaymentService_CancelPayment_FullMethodName           = "/coloc.PaymentService/CancelPayment"
+	PaymentService_RestorePayment_FullMethodName          = "/coloc.PaymentService/RestorePayment"
+	PaymentService_GetPaymentInstructions_FullMethodName  = "/coloc.PaymentService/GetPaymentInstructions"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
@@ -41,10 +47,22 @@ type PaymentServiceClient interface {
 	ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error)
 	// Confirm payment (by recipient)
 	ConfirmPayment(ctx context.Context, in *ConfirmPaymentRequest, opts ...grpc.CallOption) (*Payment, error)
-	// Reject payment (by recipient)
+	// Dispute a pending payment with a mandatory reason (by recipient)
 	RejectPayment(ctx context.Context, in *RejectPaymentRequest, opts ...grpc.CallOption) (*Payment, error)
+	// Answer the dispute of a payment with a comment and/or a proof (by sender)
+	RespondToPaymentDispute(ctx context.Context, in *RespondToPaymentDisputeRequest, opts ...grpc.CallOption) (*Payment, error)
+	// Hand a disputed payment over to the colocation admins (by sender or recipient)
+	EscalatePaymentDispute(ctx context.Context, in *EscalatePaymentDisputeRequest, opts ...grpc.CallOption) (*Payment, error)
+	// Confirm or void an escalated payment (by admins)
+	ResolvePaymentDispute(ctx context.Context, in *ResolvePaymentDisputeRequest, opts ...grpc.CallOption) (*Payment, error)
+	// Download the proof attached to a dispute response (by the parties and admins)
+	GetPaymentProof(ctx context.Context, in *GetPaymentProofRequest, opts ...grpc.CallOption) (*GetPaymentProofResponse, error)
 	// Cancel payment (by sender, only if pending)
 	CancelPayment(ctx context.Context, in *CancelPaymentRequest, opts ...grpc.CallOption) (*CancelPaymentResponse, error)
+	// Restore a cancelled payment (by sender, within the undo window)
+	RestorePayment(ctx context.Context, in *RestorePaymentRequest, opts ...grpc.CallOption) (*Payment, error)
+	// Get the SEPA transfer details and QR code of a pending payment (by sender)
+	GetPaymentInstructions(ctx context.Context, in *GetPaymentInstructionsRequest, opts ...grpc.CallOption) (*PaymentInstructions, error)
 }
 
 type paymentServiceClient struct {
@@ -105,6 +123,46 @@ func (c *paymentServiceClient) RejectPayment(ctx context.Context, in *RejectPaym
 	return out, nil
 }
 
+func (c *paymentServiceClient) RespondToPaymentDispute(ctx context.Context, in *RespondToPaymentDisputeRequest, opts ...grpc.CallOption) (*Payment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payment)
+	err := c.cc.Invoke(ctx, PaymentService_RespondToPaymentDispute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) EscalatePaymentDispute(ctx context.Context, in *EscalatePaymentDisputeRequest, opts ...grpc.CallOption) (*Payment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payment)
+	err := c.cc.Invoke(ctx, PaymentService_EscalatePaymentDispute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ResolvePaymentDispute(ctx context.Context, in *ResolvePaymentDisputeRequest, opts ...grpc.CallOption) (*Payment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payment)
+	err := c.cc.Invoke(ctx, PaymentService_ResolvePaymentDispute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetPaymentProof(ctx context.Context, in *GetPaymentProofRequest, opts ...grpc.CallOption) (*GetPaymentProofResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPaymentProofResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetPaymentProof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *paymentServiceClient) CancelPayment(ctx context.Context, in *CancelPaymentRequest, opts ...grpc.CallOption) (*CancelPaymentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CancelPaymentResponse)
@@ -115,6 +173,26 @@ func (c *paymentServiceClient) CancelPayment(ctx context.Context, in *CancelPaym
 	return out, nil
 }
 
+func (c *paymentServiceClient) RestorePayment(ctx context.Context, in *RestorePaymentRequest, opts ...grpc.CallOption) (*Payment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payment)
+	err := c.cc.Invoke(ctx, PaymentService_RestorePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetPaymentInstructions(ctx context.Context, in *GetPaymentInstructionsRequest, opts ...grpc.CallOption) (*PaymentInstructions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentInstructions)
+	err := c.cc.Invoke(ctx, PaymentService_GetPaymentInstructions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility.
@@ -129,10 +207,22 @@ type PaymentServiceServer interface {
 	ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error)
 	// Confirm payment (by recipient)
 	ConfirmPayment(context.Context, *ConfirmPaymentRequest) (*Payment, error)
-	// Reject payment (by recipient)
+	// Dispute a pending payment with a mandatory reason (by recipient)
 	RejectPayment(context.Context, *RejectPaymentRequest) (*Payment, error)
+	// Answer the dispute of a payment with a comment and/or a proof (by sender)
+	RespondToPaymentDispute(context.Context, *RespondToPaymentDisputeRequest) (*Payment, error)
+	// Hand a disputed payment over to the colocation admins (by sender or recipient)
+	EscalatePaymentDispute(context.Context, *EscalatePaymentDisputeRequest) (*Payment, error)
+	// Confirm or void an escalated payment (by admins)
+	ResolvePaymentDispute(context.Context, *ResolvePaymentDisputeRequest) (*Payment, error)
+	// Download the proof attached to a dispute response (by the parties and admins)
+	GetPaymentProof(context.Context, *GetPaymentProofRequest) (*GetPaymentProofResponse, error)
 	// Cancel payment (by sender, only if pending)
 	CancelPayment(context.Context, *CancelPaymentRequest) (*CancelPaymentResponse, error)
+	// Restore a cancelled payment (by sender, within the undo window)
+	RestorePayment(context.Context, *RestorePaymentRequest) (*Payment, error)
+	// Get the SEPA transfer details and QR code of a pending payment (by sender)
+	GetPaymentInstructions(context.Context, *GetPaymentInstructionsRequest) (*PaymentInstructions, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -158,9 +248,27 @@ func (UnimplementedPaymentServiceServer) ConfirmPayment(context.Context, *Confir
 func (UnimplementedPaymentServiceServer) RejectPayment(context.Context, *RejectPaymentRequest) (*Payment, error) {
 	return nil, status.Error(codes.Unimplemented, "method RejectPayment not implemented")
 }
+func (UnimplementedPaymentServiceServer) RespondToPaymentDispute(context.Context, *RespondToPaymentDisputeRequest) (*Payment, error) {
+	return nil, status.Error(codes.Unimplemented, "method RespondToPaymentDispute not implemented")
+}
+func (UnimplementedPaymentServiceServer) EscalatePaymentDispute(context.Context, *EscalatePaymentDisputeRequest) (*Payment, error) {
+	return nil, status.Error(codes.Unimplemented, "method EscalatePaymentDispute not implemented")
+}
+func (UnimplementedPaymentServiceServer) ResolvePaymentDispute(context.Context, *ResolvePaymentDisputeRequest) (*Payment, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolvePaymentDispute not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetPaymentProof(context.Context, *GetPaymentProofRequest) (*GetPaymentProofResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPaymentProof not implemented")
+}
 func (UnimplementedPaymentServiceServer) CancelPayment(context.Context, *CancelPaymentRequest) (*CancelPaymentResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelPayment not implemented")
 }
+func (UnimplementedPaymentServiceServer) RestorePayment(context.Context, *RestorePaymentRequest) (*Payment, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestorePayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetPaymentInstructions(context.Context, *GetPaymentInstructionsRequest) (*PaymentInstructions, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPaymentInstructions not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
 
@@ -272,6 +380,78 @@ func _PaymentService_RejectPayment_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_RespondToPaymentDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondToPaymentDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RespondToPaymentDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_RespondToPaymentDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RespondToPaymentDispute(ctx, req.(*RespondToPaymentDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_EscalatePaymentDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EscalatePaymentDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).EscalatePaymentDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_EscalatePaymentDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).EscalatePaymentDispute(ctx, req.(*EscalatePaymentDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ResolvePaymentDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolvePaymentDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ResolvePaymentDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ResolvePaymentDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ResolvePaymentDispute(ctx, req.(*ResolvePaymentDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetPaymentProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetPaymentProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetPaymentProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetPaymentProof(ctx, req.(*GetPaymentProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PaymentService_CancelPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CancelPaymentRequest)
 	if err := dec(in); err != nil {
@@ -290,6 +470,42 @@ func _PaymentService_CancelPayment_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_RestorePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RestorePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_RestorePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RestorePayment(ctx, req.(*RestorePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetPaymentInstructions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentInstructionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetPaymentInstructions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetPaymentInstructions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetPaymentInstructions(ctx, req.(*GetPaymentInstructionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -317,10 +533,34 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RejectPayment",
 			Handler:    _PaymentService_RejectPayment_Handler,
 		},
+		{
+			MethodName: "RespondToPaymentDispute",
+			Handler:    _PaymentService_RespondToPaymentDispute_Handler,
+		},
+		{
+			MethodName: "EscalatePaymentDispute",
+			Handler:    _PaymentService_EscalatePaymentDispute_Handler,
+		},
+		{
+			MethodName: "ResolvePaymentDispute",
+			Handler:    _PaymentService_ResolvePaymentDispute_Handler,
+		},
+		{
+			MethodName: "GetPaymentProof",
+			Handler:    _PaymentService_GetPaymentProof_Handler,
+		},
 		{
 			MethodName: "CancelPayment",
 			Handler:    _PaymentService_CancelPayment_Handler,
 		},
+		{
+			MethodName: "RestorePayment",
+			Handler:    _PaymentService_RestorePayment_Handler,
+		},
+		{
+			MethodName: "GetPaymentInstructions",
+			Handler:    _PaymentService_GetPaymentInstructions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "payment.proto",
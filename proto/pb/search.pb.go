@@ -0,0 +1,484 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: search.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SearchEntityType int32
+
+const (
+	SearchEntityType_SEARCH_ENTITY_TYPE_UNSPECIFIED SearchEntityType = 0
+	SearchEntityType_SEARCH_ENTITY_TYPE_EXPENSE     SearchEntityType = 1 // Title and description
+	SearchEntityType_SEARCH_ENTITY_TYPE_DECISION    SearchEntityType = 2 // Title, options and description
+	SearchEntityType_SEARCH_ENTITY_TYPE_FUND        SearchEntityType = 3 // Name and description
+	SearchEntityType_SEARCH_ENTITY_TYPE_EVENT       SearchEntityType = 4 // Title and description
+)
+
+// Enum value maps for SearchEntityType.
+var (
+	SearchEntityType_name = map[int32]string{
+		0: "SEARCH_ENTITY_TYPE_UNSPECIFIED",
+		1: "SEARCH_ENTITY_TYPE_EXPENSE",
+		2: "SEARCH_ENTITY_TYPE_DECISION",
+		3: "SEARCH_ENTITY_TYPE_FUND",
+		4: "SEARCH_ENTITY_TYPE_EVENT",
+	}
+	SearchEntityType_value = map[string]int32{
+		"SEARCH_ENTITY_TYPE_UNSPECIFIED": 0,
+		"SEARCH_ENTITY_TYPE_EXPENSE":     1,
+		"SEARCH_ENTITY_TYPE_DECISION":    2,
+		"SEARCH_ENTITY_TYPE_FUND":        3,
+		"SEARCH_ENTITY_TYPE_EVENT":       4,
+	}
+)
+
+func (x SearchEntityType) Enum() *SearchEntityType {
+	p := new(SearchEntityType)
+	*p = x
+	return p
+}
+
+func (x SearchEntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchEntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_search_proto_enumTypes[0].Descriptor()
+}
+
+func (SearchEntityType) Type() protoreflect.EnumType {
+	return &file_search_proto_enumTypes[0]
+}
+
+func (x SearchEntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchEntityType.Descriptor instead.
+func (SearchEntityType) EnumDescriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{0}
+}
+
+type SearchColocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`                                     // Web search syntax: "quoted phrase", or, -excluded
+	Types         []SearchEntityType     `protobuf:"varint,3,rep,packed,name=types,proto3,enum=coloc.SearchEntityType" json:"types,omitempty"` // All types when empty
+	MinAmount     *Money                 `protobuf:"bytes,4,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`            // In the base currency, decisions are left out
+	MaxAmount     *Money                 `protobuf:"bytes,5,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	StartDate     *string                `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // Format: YYYY-MM-DD
+	EndDate       *string                `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`       // Format: YYYY-MM-DD
+	PageSize      int32                  `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`         // Hits per type (default 10, max 50)
+	Cursor        *string                `protobuf:"bytes,9,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`                        // next_cursor of a group, continues that group only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchColocationRequest) Reset() {
+	*x = SearchColocationRequest{}
+	mi := &file_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchColocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchColocationRequest) ProtoMessage() {}
+
+func (x *SearchColocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchColocationRequest.ProtoReflect.Descriptor instead.
+func (*SearchColocationRequest) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchColocationRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *SearchColocationRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchColocationRequest) GetTypes() []SearchEntityType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SearchColocationRequest) GetMinAmount() *Money {
+	if x != nil {
+		return x.MinAmount
+	}
+	return nil
+}
+
+func (x *SearchColocationRequest) GetMaxAmount() *Money {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return nil
+}
+
+func (x *SearchColocationRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *SearchColocationRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *SearchColocationRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchColocationRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type SearchColocationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*SearchGroup         `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchColocationResponse) Reset() {
+	*x = SearchColocationResponse{}
+	mi := &file_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchColocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchColocationResponse) ProtoMessage() {}
+
+func (x *SearchColocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchColocationResponse.ProtoReflect.Descriptor instead.
+func (*SearchColocationResponse) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchColocationResponse) GetGroups() []*SearchGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type SearchGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          SearchEntityType       `protobuf:"varint,1,opt,name=type,proto3,enum=coloc.SearchEntityType" json:"type,omitempty"`
+	Hits          []*SearchHit           `protobuf:"bytes,2,rep,name=hits,proto3" json:"hits,omitempty"`                               // Best match first
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchGroup) Reset() {
+	*x = SearchGroup{}
+	mi := &file_search_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchGroup) ProtoMessage() {}
+
+func (x *SearchGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchGroup.ProtoReflect.Descriptor instead.
+func (*SearchGroup) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchGroup) GetType() SearchEntityType {
+	if x != nil {
+		return x.Type
+	}
+	return SearchEntityType_SEARCH_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *SearchGroup) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchGroup) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type SearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Snippet       string                 `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"` // HTML-escaped text with the matching words wrapped in <mark> tags, safe to render as HTML
+	Amount        *Money                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`   // Base amount of expenses, current amount of funds, budget of events
+	Date          string                 `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`       // Format: YYYY-MM-DD
+	Rank          float32                `protobuf:"fixed32,6,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_search_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchHit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SearchHit) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchHit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchHit) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *SearchHit) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SearchHit) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+var File_search_proto protoreflect.FileDescriptor
+
+const file_search_proto_rawDesc = "" +
+	"\n" +
+	"\fsearch.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\x1a\fcommon.proto\"\x82\x03\n" +
+	"\x17SearchColocationRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12-\n" +
+	"\x05types\x18\x03 \x03(\x0e2\x17.coloc.SearchEntityTypeR\x05types\x12+\n" +
+	"\n" +
+	"min_amount\x18\x04 \x01(\v2\f.coloc.MoneyR\tminAmount\x12+\n" +
+	"\n" +
+	"max_amount\x18\x05 \x01(\v2\f.coloc.MoneyR\tmaxAmount\x12\"\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\tH\x00R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\a \x01(\tH\x01R\aendDate\x88\x01\x01\x12\x1b\n" +
+	"\tpage_size\x18\b \x01(\x05R\bpageSize\x12\x1b\n" +
+	"\x06cursor\x18\t \x01(\tH\x02R\x06cursor\x88\x01\x01B\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_dateB\t\n" +
+	"\a_cursor\"F\n" +
+	"\x18SearchColocationResponse\x12*\n" +
+	"\x06groups\x18\x01 \x03(\v2\x12.coloc.SearchGroupR\x06groups\"\x81\x01\n" +
+	"\vSearchGroup\x12+\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x17.coloc.SearchEntityTypeR\x04type\x12$\n" +
+	"\x04hits\x18\x02 \x03(\v2\x10.coloc.SearchHitR\x04hits\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\x99\x01\n" +
+	"\tSearchHit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\asnippet\x18\x03 \x01(\tR\asnippet\x12$\n" +
+	"\x06amount\x18\x04 \x01(\v2\f.coloc.MoneyR\x06amount\x12\x12\n" +
+	"\x04date\x18\x05 \x01(\tR\x04date\x12\x12\n" +
+	"\x04rank\x18\x06 \x01(\x02R\x04rank*\xb2\x01\n" +
+	"\x10SearchEntityType\x12\"\n" +
+	"\x1eSEARCH_ENTITY_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aSEARCH_ENTITY_TYPE_EXPENSE\x10\x01\x12\x1f\n" +
+	"\x1bSEARCH_ENTITY_TYPE_DECISION\x10\x02\x12\x1b\n" +
+	"\x17SEARCH_ENTITY_TYPE_FUND\x10\x03\x12\x1c\n" +
+	"\x18SEARCH_ENTITY_TYPE_EVENT\x10\x042\x96\x01\n" +
+	"\rSearchService\x12\x84\x01\n" +
+	"\x10SearchColocation\x12\x1e.coloc.SearchColocationRequest\x1a\x1f.coloc.SearchColocationResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/colocations/{colocation_id}/searchB,Z*github.com/vblanchet22/back_coloc/proto/pbb\x06proto3"
+
+var (
+	file_search_proto_rawDescOnce sync.Once
+	file_search_proto_rawDescData []byte
+)
+
+func file_search_proto_rawDescGZIP() []byte {
+	file_search_proto_rawDescOnce.Do(func() {
+		file_search_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_search_proto_rawDesc), len(file_search_proto_rawDesc)))
+	})
+	return file_search_proto_rawDescData
+}
+
+var file_search_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_search_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_search_proto_goTypes = []any{
+	(SearchEntityType)(0),            // 0: coloc.SearchEntityType
+	(*SearchColocationRequest)(nil),  // 1: coloc.SearchColocationRequest
+	(*SearchColocationResponse)(nil), // 2: coloc.SearchColocationResponse
+	(*SearchGroup)(nil),              // 3: coloc.SearchGroup
+	(*SearchHit)(nil),                // 4: coloc.SearchHit
+	(*Money)(nil),                    // 5: coloc.Money
+}
+var file_search_proto_depIdxs = []int32{
+	0, // 0: coloc.SearchColocationRequest.types:type_name -> coloc.SearchEntityType
+	5, // 1: coloc.SearchColocationRequest.min_amount:type_name -> coloc.Money
+	5, // 2: coloc.SearchColocationRequest.max_amount:type_name -> coloc.Money
+	3, // 3: coloc.SearchColocationResponse.groups:type_name -> coloc.SearchGroup
+	0, // 4: coloc.SearchGroup.type:type_name -> coloc.SearchEntityType
+	4, // 5: coloc.SearchGroup.hits:type_name -> coloc.SearchHit
+	5, // 6: coloc.SearchHit.amount:type_name -> coloc.Money
+	1, // 7: coloc.SearchService.SearchColocation:input_type -> coloc.SearchColocationRequest
+	2, // 8: coloc.SearchService.SearchColocation:output_type -> coloc.SearchColocationResponse
+	8, // [8:9] is the sub-list for method output_type
+	7, // [7:8] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_search_proto_init() }
+func file_search_proto_init() {
+	if File_search_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_search_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_search_proto_rawDesc), len(file_search_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_search_proto_goTypes,
+		DependencyIndexes: file_search_proto_depIdxs,
+		EnumInfos:         file_search_proto_enumTypes,
+		MessageInfos:      file_search_proto_msgTypes,
+	}.Build()
+	File_search_proto = out.File
+	file_search_proto_goTypes = nil
+	file_search_proto_depIdxs = nil
+}
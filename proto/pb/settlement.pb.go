@@ -0,0 +1,424 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: settlement.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SettlementStatus int32
+
+const (
+	SettlementStatus_SETTLEMENT_STATUS_UNSPECIFIED SettlementStatus = 0
+	SettlementStatus_SETTLEMENT_STATUS_ACTIVE      SettlementStatus = 1
+	SettlementStatus_SETTLEMENT_STATUS_COMPLETED   SettlementStatus = 2
+	SettlementStatus_SETTLEMENT_STATUS_INVALIDATED SettlementStatus = 3 // Balances changed before all payments were confirmed
+)
+
+// Enum value maps for SettlementStatus.
+var (
+	SettlementStatus_name = map[int32]string{
+		0: "SETTLEMENT_STATUS_UNSPECIFIED",
+		1: "SETTLEMENT_STATUS_ACTIVE",
+		2: "SETTLEMENT_STATUS_COMPLETED",
+		3: "SETTLEMENT_STATUS_INVALIDATED",
+	}
+	SettlementStatus_value = map[string]int32{
+		"SETTLEMENT_STATUS_UNSPECIFIED": 0,
+		"SETTLEMENT_STATUS_ACTIVE":      1,
+		"SETTLEMENT_STATUS_COMPLETED":   2,
+		"SETTLEMENT_STATUS_INVALIDATED": 3,
+	}
+)
+
+func (x SettlementStatus) Enum() *SettlementStatus {
+	p := new(SettlementStatus)
+	*p = x
+	return p
+}
+
+func (x SettlementStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SettlementStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_settlement_proto_enumTypes[0].Descriptor()
+}
+
+func (SettlementStatus) Type() protoreflect.EnumType {
+	return &file_settlement_proto_enumTypes[0]
+}
+
+func (x SettlementStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SettlementStatus.Descriptor instead.
+func (SettlementStatus) EnumDescriptor() ([]byte, []int) {
+	return file_settlement_proto_rawDescGZIP(), []int{0}
+}
+
+type SettleUpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettleUpRequest) Reset() {
+	*x = SettleUpRequest{}
+	mi := &file_settlement_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleUpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleUpRequest) ProtoMessage() {}
+
+func (x *SettleUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleUpRequest.ProtoReflect.Descriptor instead.
+func (*SettleUpRequest) Descriptor() ([]byte, []int) {
+	return file_settlement_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SettleUpRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+type GetSettlementPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettlementPlanRequest) Reset() {
+	*x = GetSettlementPlanRequest{}
+	mi := &file_settlement_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettlementPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettlementPlanRequest) ProtoMessage() {}
+
+func (x *GetSettlementPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettlementPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetSettlementPlanRequest) Descriptor() ([]byte, []int) {
+	return file_settlement_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetSettlementPlanRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *GetSettlementPlanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SettlementPlan struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ColocationId    string                 `protobuf:"bytes,2,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	CreatedBy       string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedByNom    string                 `protobuf:"bytes,4,opt,name=created_by_nom,json=createdByNom,proto3" json:"created_by_nom,omitempty"`
+	CreatedByPrenom string                 `protobuf:"bytes,5,opt,name=created_by_prenom,json=createdByPrenom,proto3" json:"created_by_prenom,omitempty"`
+	Status          SettlementStatus       `protobuf:"varint,6,opt,name=status,proto3,enum=coloc.SettlementStatus" json:"status,omitempty"`
+	TotalAmount     *Money                 `protobuf:"bytes,7,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	ConfirmedAmount *Money                 `protobuf:"bytes,8,opt,name=confirmed_amount,json=confirmedAmount,proto3" json:"confirmed_amount,omitempty"`
+	PaymentCount    int32                  `protobuf:"varint,9,opt,name=payment_count,json=paymentCount,proto3" json:"payment_count,omitempty"`
+	ConfirmedCount  int32                  `protobuf:"varint,10,opt,name=confirmed_count,json=confirmedCount,proto3" json:"confirmed_count,omitempty"`
+	Payments        []*Payment             `protobuf:"bytes,11,rep,name=payments,proto3" json:"payments,omitempty"`
+	CompletedAt     *string                `protobuf:"bytes,12,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
+	InvalidatedAt   *string                `protobuf:"bytes,13,opt,name=invalidated_at,json=invalidatedAt,proto3,oneof" json:"invalidated_at,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SettlementPlan) Reset() {
+	*x = SettlementPlan{}
+	mi := &file_settlement_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettlementPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementPlan) ProtoMessage() {}
+
+func (x *SettlementPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementPlan.ProtoReflect.Descriptor instead.
+func (*SettlementPlan) Descriptor() ([]byte, []int) {
+	return file_settlement_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SettlementPlan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SettlementPlan) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *SettlementPlan) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *SettlementPlan) GetCreatedByNom() string {
+	if x != nil {
+		return x.CreatedByNom
+	}
+	return ""
+}
+
+func (x *SettlementPlan) GetCreatedByPrenom() string {
+	if x != nil {
+		return x.CreatedByPrenom
+	}
+	return ""
+}
+
+func (x *SettlementPlan) GetStatus() SettlementStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SettlementStatus_SETTLEMENT_STATUS_UNSPECIFIED
+}
+
+func (x *SettlementPlan) GetTotalAmount() *Money {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return nil
+}
+
+func (x *SettlementPlan) GetConfirmedAmount() *Money {
+	if x != nil {
+		return x.ConfirmedAmount
+	}
+	return nil
+}
+
+func (x *SettlementPlan) GetPaymentCount() int32 {
+	if x != nil {
+		return x.PaymentCount
+	}
+	return 0
+}
+
+func (x *SettlementPlan) GetConfirmedCount() int32 {
+	if x != nil {
+		return x.ConfirmedCount
+	}
+	return 0
+}
+
+func (x *SettlementPlan) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *SettlementPlan) GetCompletedAt() string {
+	if x != nil && x.CompletedAt != nil {
+		return *x.CompletedAt
+	}
+	return ""
+}
+
+func (x *SettlementPlan) GetInvalidatedAt() string {
+	if x != nil && x.InvalidatedAt != nil {
+		return *x.InvalidatedAt
+	}
+	return ""
+}
+
+func (x *SettlementPlan) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+var File_settlement_proto protoreflect.FileDescriptor
+
+const file_settlement_proto_rawDesc = "" +
+	"\n" +
+	"\x10settlement.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\x1a\fcommon.proto\x1a\rpayment.proto\"6\n" +
+	"\x0fSettleUpRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\"O\n" +
+	"\x18GetSettlementPlanRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xe2\x04\n" +
+	"\x0eSettlementPlan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rcolocation_id\x18\x02 \x01(\tR\fcolocationId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x12$\n" +
+	"\x0ecreated_by_nom\x18\x04 \x01(\tR\fcreatedByNom\x12*\n" +
+	"\x11created_by_prenom\x18\x05 \x01(\tR\x0fcreatedByPrenom\x12/\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x17.coloc.SettlementStatusR\x06status\x12/\n" +
+	"\ftotal_amount\x18\a \x01(\v2\f.coloc.MoneyR\vtotalAmount\x127\n" +
+	"\x10confirmed_amount\x18\b \x01(\v2\f.coloc.MoneyR\x0fconfirmedAmount\x12#\n" +
+	"\rpayment_count\x18\t \x01(\x05R\fpaymentCount\x12'\n" +
+	"\x0fconfirmed_count\x18\n" +
+	" \x01(\x05R\x0econfirmedCount\x12*\n" +
+	"\bpayments\x18\v \x03(\v2\x0e.coloc.PaymentR\bpayments\x12&\n" +
+	"\fcompleted_at\x18\f \x01(\tH\x00R\vcompletedAt\x88\x01\x01\x12*\n" +
+	"\x0einvalidated_at\x18\r \x01(\tH\x01R\rinvalidatedAt\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\tR\tcreatedAtB\x0f\n" +
+	"\r_completed_atB\x11\n" +
+	"\x0f_invalidated_at*\x97\x01\n" +
+	"\x10SettlementStatus\x12!\n" +
+	"\x1dSETTLEMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18SETTLEMENT_STATUS_ACTIVE\x10\x01\x12\x1f\n" +
+	"\x1bSETTLEMENT_STATUS_COMPLETED\x10\x02\x12!\n" +
+	"\x1dSETTLEMENT_STATUS_INVALIDATED\x10\x032\x9a\x02\n" +
+	"\x11SettlementService\x12w\n" +
+	"\bSettleUp\x12\x16.coloc.SettleUpRequest\x1a\x15.coloc.SettlementPlan\"<\x82\xd3\xe4\x93\x026:\x01*\"1/api/colocations/{colocation_id}/settlement-plans\x12\x8b\x01\n" +
+	"\x11GetSettlementPlan\x12\x1f.coloc.GetSettlementPlanRequest\x1a\x15.coloc.SettlementPlan\">\x82\xd3\xe4\x93\x028\x126/api/colocations/{colocation_id}/settlement-plans/{id}B,Z*github.com/vblanchet22/back_coloc/proto/pbb\x06proto3"
+
+var (
+	file_settlement_proto_rawDescOnce sync.Once
+	file_settlement_proto_rawDescData []byte
+)
+
+func file_settlement_proto_rawDescGZIP() []byte {
+	file_settlement_proto_rawDescOnce.Do(func() {
+		file_settlement_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_settlement_proto_rawDesc), len(file_settlement_proto_rawDesc)))
+	})
+	return file_settlement_proto_rawDescData
+}
+
+var file_settlement_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_settlement_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_settlement_proto_goTypes = []any{
+	(SettlementStatus)(0),            // 0: coloc.SettlementStatus
+	(*SettleUpRequest)(nil),          // 1: coloc.SettleUpRequest
+	(*GetSettlementPlanRequest)(nil), // 2: coloc.GetSettlementPlanRequest
+	(*SettlementPlan)(nil),           // 3: coloc.SettlementPlan
+	(*Money)(nil),                    // 4: coloc.Money
+	(*Payment)(nil),                  // 5: coloc.Payment
+}
+var file_settlement_proto_depIdxs = []int32{
+	0, // 0: coloc.SettlementPlan.status:type_name -> coloc.SettlementStatus
+	4, // 1: coloc.SettlementPlan.total_amount:type_name -> coloc.Money
+	4, // 2: coloc.SettlementPlan.confirmed_amount:type_name -> coloc.Money
+	5, // 3: coloc.SettlementPlan.payments:type_name -> coloc.Payment
+	1, // 4: coloc.SettlementService.SettleUp:input_type -> coloc.SettleUpRequest
+	2, // 5: coloc.SettlementService.GetSettlementPlan:input_type -> coloc.GetSettlementPlanRequest
+	3, // 6: coloc.SettlementService.SettleUp:output_type -> coloc.SettlementPlan
+	3, // 7: coloc.SettlementService.GetSettlementPlan:output_type -> coloc.SettlementPlan
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_settlement_proto_init() }
+func file_settlement_proto_init() {
+	if File_settlement_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_payment_proto_init()
+	file_settlement_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_settlement_proto_rawDesc), len(file_settlement_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_settlement_proto_goTypes,
+		DependencyIndexes: file_settlement_proto_depIdxs,
+		EnumInfos:         file_settlement_proto_enumTypes,
+		MessageInfos:      file_settlement_proto_msgTypes,
+	}.Build()
+	File_settlement_proto = out.File
+	file_settlement_proto_goTypes = nil
+	file_settlement_proto_depIdxs = nil
+}
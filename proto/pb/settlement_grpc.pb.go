@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: settlement.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SettlementService_SettleUp_FullMethodName          = "/coloc.SettlementService/SettleUp"
+	SettlementService_GetSettlementPlan_FullMethodName = "/coloc.SettlementService/GetSettlementPlan"
+)
+
+// SettlementServiceClient is the client API for SettlementService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SettlementService turns simplified debts into settlement plans
+type SettlementServiceClient interface {
+	// Snapshot simplified debts into a plan of pending payments (returns the active plan if any)
+	SettleUp(ctx context.Context, in *SettleUpRequest, opts ...grpc.CallOption) (*SettlementPlan, error)
+	// Get a settlement plan with its progress
+	GetSettlementPlan(ctx context.Context, in *GetSettlementPlanRequest, opts ...grpc.CallOption) (*SettlementPlan, error)
+}
+
+type settlementServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSettlementServiceClient(cc grpc.ClientConnInterface) SettlementServiceClient {
+	return &settlementServiceClient{cc}
+}
+
+func (c *settlementServiceClient) SettleUp(ctx context.Context, in *SettleUpRequest, opts ...grpc.CallOption) (*SettlementPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettlementPlan)
+	err := c.cc.Invoke(ctx, SettlementService_SettleUp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) GetSettlementPlan(ctx context.Context, in *GetSettlementPlanRequest, opts ...grpc.CallOption) (*SettlementPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettlementPlan)
+	err := c.cc.Invoke(ctx, SettlementService_GetSettlementPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SettlementServiceServer is the server API for SettlementService service.
+// All implementations must embed UnimplementedSettlementServiceServer
+// for forward compatibility.
+//
+// SettlementService turns simplified debts into settlement plans
+type SettlementServiceServer interface {
+	// Snapshot simplified debts into a plan of pending payments (returns the active plan if any)
+	SettleUp(context.Context, *SettleUpRequest) (*SettlementPlan, error)
+	// Get a settlement plan with its progress
+	GetSettlementPlan(context.Context, *GetSettlementPlanRequest) (*SettlementPlan, error)
+	mustEmbedUnimplementedSettlementServiceServer()
+}
+
+// UnimplementedSettlementServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSettlementServiceServer struct{}
+
+func (UnimplementedSettlementServiceServer) SettleUp(context.Context, *SettleUpRequest) (*SettlementPlan, error) {
+	return nil, status.Error(codes.Unimplemented, "method SettleUp not implemented")
+}
+func (UnimplementedSettlementServiceServer) GetSettlementPlan(context.Context, *GetSettlementPlanRequest) (*SettlementPlan, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSettlementPlan not implemented")
+}
+func (UnimplementedSettlementServiceServer) mustEmbedUnimplementedSettlementServiceServer() {}
+func (UnimplementedSettlementServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeSettlementServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SettlementServiceServer will
+// result in compilation errors.
+type UnsafeSettlementServiceServer interface {
+	mustEmbedUnimplementedSettlementServiceServer()
+}
+
+func RegisterSettlementServiceServer(s grpc.ServiceRegistrar, srv SettlementServiceServer) {
+	// If the following call panics, it indicates UnimplementedSettlementServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SettlementService_ServiceDesc, srv)
+}
+
+func _SettlementService_SettleUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).SettleUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_SettleUp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).SettleUp(ctx, req.(*SettleUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_GetSettlementPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSettlementPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).GetSettlementPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_GetSettlementPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).GetSettlementPlan(ctx, req.(*GetSettlementPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SettlementService_ServiceDesc is the grpc.ServiceDesc for SettlementService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SettlementService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "coloc.SettlementService",
+	HandlerType: (*SettlementServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SettleUp",
+			Handler:    _SettlementService_SettleUp_Handler,
+		},
+		{
+			MethodName: "GetSettlementPlan",
+			Handler:    _SettlementService_GetSettlementPlan_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "settlement.proto",
+}
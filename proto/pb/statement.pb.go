@@ -0,0 +1,801 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: statement.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StatementFormat int32
+
+const (
+	StatementFormat_STATEMENT_FORMAT_UNSPECIFIED StatementFormat = 0 // Detected from the content
+	StatementFormat_STATEMENT_FORMAT_CSV         StatementFormat = 1
+	StatementFormat_STATEMENT_FORMAT_OFX         StatementFormat = 2
+	StatementFormat_STATEMENT_FORMAT_CAMT053     StatementFormat = 3 // ISO 20022 camt.053 XML
+)
+
+// Enum value maps for StatementFormat.
+var (
+	StatementFormat_name = map[int32]string{
+		0: "STATEMENT_FORMAT_UNSPECIFIED",
+		1: "STATEMENT_FORMAT_CSV",
+		2: "STATEMENT_FORMAT_OFX",
+		3: "STATEMENT_FORMAT_CAMT053",
+	}
+	StatementFormat_value = map[string]int32{
+		"STATEMENT_FORMAT_UNSPECIFIED": 0,
+		"STATEMENT_FORMAT_CSV":         1,
+		"STATEMENT_FORMAT_OFX":         2,
+		"STATEMENT_FORMAT_CAMT053":     3,
+	}
+)
+
+func (x StatementFormat) Enum() *StatementFormat {
+	p := new(StatementFormat)
+	*p = x
+	return p
+}
+
+func (x StatementFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatementFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_statement_proto_enumTypes[0].Descriptor()
+}
+
+func (StatementFormat) Type() protoreflect.EnumType {
+	return &file_statement_proto_enumTypes[0]
+}
+
+func (x StatementFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatementFormat.Descriptor instead.
+func (StatementFormat) EnumDescriptor() ([]byte, []int) {
+	return file_statement_proto_rawDescGZIP(), []int{0}
+}
+
+// CsvMapping describes the layout of a CSV statement. Columns are given by
+// header name or 1-based position; unset columns are detected from the header.
+type CsvMapping struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Delimiter         *string                `protobuf:"bytes,1,opt,name=delimiter,proto3,oneof" json:"delimiter,omitempty"` // Detected when unset
+	NoHeader          bool                   `protobuf:"varint,2,opt,name=no_header,json=noHeader,proto3" json:"no_header,omitempty"`
+	SkipRows          int32                  `protobuf:"varint,3,opt,name=skip_rows,json=skipRows,proto3" json:"skip_rows,omitempty"` // Rows before the header
+	DateColumn        *string                `protobuf:"bytes,4,opt,name=date_column,json=dateColumn,proto3,oneof" json:"date_column,omitempty"`
+	DescriptionColumn *string                `protobuf:"bytes,5,opt,name=description_column,json=descriptionColumn,proto3,oneof" json:"description_column,omitempty"`
+	AmountColumn      *string                `protobuf:"bytes,6,opt,name=amount_column,json=amountColumn,proto3,oneof" json:"amount_column,omitempty"` // Signed amount
+	DebitColumn       *string                `protobuf:"bytes,7,opt,name=debit_column,json=debitColumn,proto3,oneof" json:"debit_column,omitempty"`    // Alternative to amount_column
+	CreditColumn      *string                `protobuf:"bytes,8,opt,name=credit_column,json=creditColumn,proto3,oneof" json:"credit_column,omitempty"`
+	CurrencyColumn    *string                `protobuf:"bytes,9,opt,name=currency_column,json=currencyColumn,proto3,oneof" json:"currency_column,omitempty"`
+	ReferenceColumn   *string                `protobuf:"bytes,10,opt,name=reference_column,json=referenceColumn,proto3,oneof" json:"reference_column,omitempty"`
+	DateFormat        *string                `protobuf:"bytes,11,opt,name=date_format,json=dateFormat,proto3,oneof" json:"date_format,omitempty"`                   // e.g. "DD/MM/YYYY"
+	DecimalSeparator  *string                `protobuf:"bytes,12,opt,name=decimal_separator,json=decimalSeparator,proto3,oneof" json:"decimal_separator,omitempty"` // "," or "."
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CsvMapping) Reset() {
+	*x = CsvMapping{}
+	mi := &file_statement_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CsvMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CsvMapping) ProtoMessage() {}
+
+func (x *CsvMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_statement_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CsvMapping.ProtoReflect.Descriptor instead.
+func (*CsvMapping) Descriptor() ([]byte, []int) {
+	return file_statement_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CsvMapping) GetDelimiter() string {
+	if x != nil && x.Delimiter != nil {
+		return *x.Delimiter
+	}
+	return ""
+}
+
+func (x *CsvMapping) GetNoHeader() bool {
+	if x != nil {
+		return x.NoHeader
+	}
+	return false
+}
+
+func (x *CsvMapping) GetSkipRows() int32 {
+	if x != nil {
+		return x.SkipRows
+	}
+	return 0
+}
+
+func (x *CsvMapping) GetDateColumn() string {
+	if x != nil && x.DateColumn != nil {
+		return *x.DateColumn
+	}
+	return ""
+}
+
+func (x *CsvMapping) GetDescriptionColumn() string {
+	if x != nil && x.DescriptionColumn != nil {
+		return *x.DescriptionColumn
+	}
+	return ""
+}
+
+func (x *CsvMapping) GetAmountColumn() string {
+	if x != nil && x.AmountColumn != nil {
+		return *x.AmountColumn
+	}
+	return ""
+}
+
+func (x *CsvMapping) GetDebitColumn() string {
+	if x != nil && x.DebitColumn != nil {
+		return *x.DebitColumn
+	}
+	return ""
+}
+
+func (x *CsvMapping) GetCreditColumn() string {
+	if x != nil && x.CreditColumn != nil {
+		return *x.CreditColumn
+	}
+	return ""
+}
+
+func (x *CsvMapping) GetCurrencyColumn() string {
+	if x != nil && x.CurrencyColumn != nil {
+		return *x.CurrencyColumn
+	}
+	return ""
+}
+
+func (x *CsvMapping) GetReferenceColumn() string {
+	if x != nil && x.ReferenceColumn != nil {
+		return *x.ReferenceColumn
+	}
+	return ""
+}
+
+func (x *CsvMapping) GetDateFormat() string {
+	if x != nil && x.DateFormat != nil {
+		return *x.DateFormat
+	}
+	return ""
+}
+
+func (x *CsvMapping) GetDecimalSeparator() string {
+	if x != nil && x.DecimalSeparator != nil {
+		return *x.DecimalSeparator
+	}
+	return ""
+}
+
+type ImportStatementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Format        StatementFormat        `protobuf:"varint,3,opt,name=format,proto3,enum=coloc.StatementFormat" json:"format,omitempty"`
+	CsvMapping    *CsvMapping            `protobuf:"bytes,4,opt,name=csv_mapping,json=csvMapping,proto3" json:"csv_mapping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportStatementRequest) Reset() {
+	*x = ImportStatementRequest{}
+	mi := &file_statement_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStatementRequest) ProtoMessage() {}
+
+func (x *ImportStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_statement_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportStatementRequest.ProtoReflect.Descriptor instead.
+func (*ImportStatementRequest) Descriptor() ([]byte, []int) {
+	return file_statement_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ImportStatementRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *ImportStatementRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportStatementRequest) GetFormat() StatementFormat {
+	if x != nil {
+		return x.Format
+	}
+	return StatementFormat_STATEMENT_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportStatementRequest) GetCsvMapping() *CsvMapping {
+	if x != nil {
+		return x.CsvMapping
+	}
+	return nil
+}
+
+type ImportStatementResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Drafts         []*ExpenseDraft        `protobuf:"bytes,1,rep,name=drafts,proto3" json:"drafts,omitempty"`                                        // Debits of the statement
+	IgnoredCredits int32                  `protobuf:"varint,2,opt,name=ignored_credits,json=ignoredCredits,proto3" json:"ignored_credits,omitempty"` // Credits are not expenses
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportStatementResponse) Reset() {
+	*x = ImportStatementResponse{}
+	mi := &file_statement_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStatementResponse) ProtoMessage() {}
+
+func (x *ImportStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_statement_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportStatementResponse.ProtoReflect.Descriptor instead.
+func (*ImportStatementResponse) Descriptor() ([]byte, []int) {
+	return file_statement_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ImportStatementResponse) GetDrafts() []*ExpenseDraft {
+	if x != nil {
+		return x.Drafts
+	}
+	return nil
+}
+
+func (x *ImportStatementResponse) GetIgnoredCredits() int32 {
+	if x != nil {
+		return x.IgnoredCredits
+	}
+	return 0
+}
+
+type ExpenseDraft struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Reference             string                 `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"` // To send back on confirmation
+	Title                 string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`         // Suggested title
+	Label                 string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`         // Raw label of the statement line
+	Amount                *Money                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	ExpenseDate           string                 `protobuf:"bytes,5,opt,name=expense_date,json=expenseDate,proto3" json:"expense_date,omitempty"` // Format: YYYY-MM-DD
+	SuggestedCategoryId   string                 `protobuf:"bytes,6,opt,name=suggested_category_id,json=suggestedCategoryId,proto3" json:"suggested_category_id,omitempty"`
+	SuggestedCategoryName string                 `protobuf:"bytes,7,opt,name=suggested_category_name,json=suggestedCategoryName,proto3" json:"suggested_category_name,omitempty"`
+	AlreadyImported       bool                   `protobuf:"varint,8,opt,name=already_imported,json=alreadyImported,proto3" json:"already_imported,omitempty"`                 // The line was imported before
+	DuplicateExpenseId    *string                `protobuf:"bytes,9,opt,name=duplicate_expense_id,json=duplicateExpenseId,proto3,oneof" json:"duplicate_expense_id,omitempty"` // Possible duplicate of this expense
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ExpenseDraft) Reset() {
+	*x = ExpenseDraft{}
+	mi := &file_statement_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseDraft) ProtoMessage() {}
+
+func (x *ExpenseDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_statement_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseDraft.ProtoReflect.Descriptor instead.
+func (*ExpenseDraft) Descriptor() ([]byte, []int) {
+	return file_statement_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExpenseDraft) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ExpenseDraft) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ExpenseDraft) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ExpenseDraft) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *ExpenseDraft) GetExpenseDate() string {
+	if x != nil {
+		return x.ExpenseDate
+	}
+	return ""
+}
+
+func (x *ExpenseDraft) GetSuggestedCategoryId() string {
+	if x != nil {
+		return x.SuggestedCategoryId
+	}
+	return ""
+}
+
+func (x *ExpenseDraft) GetSuggestedCategoryName() string {
+	if x != nil {
+		return x.SuggestedCategoryName
+	}
+	return ""
+}
+
+func (x *ExpenseDraft) GetAlreadyImported() bool {
+	if x != nil {
+		return x.AlreadyImported
+	}
+	return false
+}
+
+func (x *ExpenseDraft) GetDuplicateExpenseId() string {
+	if x != nil && x.DuplicateExpenseId != nil {
+		return *x.DuplicateExpenseId
+	}
+	return ""
+}
+
+type ConfirmDraft struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reference     string                 `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	SplitType     SplitType              `protobuf:"varint,6,opt,name=split_type,json=splitType,proto3,enum=coloc.SplitType" json:"split_type,omitempty"` // Defaults to an equal split
+	Splits        []*ExpenseSplitInput   `protobuf:"bytes,7,rep,name=splits,proto3" json:"splits,omitempty"`
+	ExpenseDate   string                 `protobuf:"bytes,8,opt,name=expense_date,json=expenseDate,proto3" json:"expense_date,omitempty"` // Format: YYYY-MM-DD
+	ExchangeRate  *string                `protobuf:"bytes,9,opt,name=exchange_rate,json=exchangeRate,proto3,oneof" json:"exchange_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmDraft) Reset() {
+	*x = ConfirmDraft{}
+	mi := &file_statement_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmDraft) ProtoMessage() {}
+
+func (x *ConfirmDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_statement_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmDraft.ProtoReflect.Descriptor instead.
+func (*ConfirmDraft) Descriptor() ([]byte, []int) {
+	return file_statement_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ConfirmDraft) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ConfirmDraft) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ConfirmDraft) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *ConfirmDraft) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *ConfirmDraft) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ConfirmDraft) GetSplitType() SplitType {
+	if x != nil {
+		return x.SplitType
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *ConfirmDraft) GetSplits() []*ExpenseSplitInput {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+func (x *ConfirmDraft) GetExpenseDate() string {
+	if x != nil {
+		return x.ExpenseDate
+	}
+	return ""
+}
+
+func (x *ConfirmDraft) GetExchangeRate() string {
+	if x != nil && x.ExchangeRate != nil {
+		return *x.ExchangeRate
+	}
+	return ""
+}
+
+type ConfirmStatementImportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColocationId  string                 `protobuf:"bytes,1,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	Drafts        []*ConfirmDraft        `protobuf:"bytes,2,rep,name=drafts,proto3" json:"drafts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmStatementImportRequest) Reset() {
+	*x = ConfirmStatementImportRequest{}
+	mi := &file_statement_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmStatementImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmStatementImportRequest) ProtoMessage() {}
+
+func (x *ConfirmStatementImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_statement_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmStatementImportRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmStatementImportRequest) Descriptor() ([]byte, []int) {
+	return file_statement_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ConfirmStatementImportRequest) GetColocationId() string {
+	if x != nil {
+		return x.ColocationId
+	}
+	return ""
+}
+
+func (x *ConfirmStatementImportRequest) GetDrafts() []*ConfirmDraft {
+	if x != nil {
+		return x.Drafts
+	}
+	return nil
+}
+
+type ConfirmStatementImportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expenses      []*Expense             `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmStatementImportResponse) Reset() {
+	*x = ConfirmStatementImportResponse{}
+	mi := &file_statement_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmStatementImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmStatementImportResponse) ProtoMessage() {}
+
+func (x *ConfirmStatementImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_statement_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmStatementImportResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmStatementImportResponse) Descriptor() ([]byte, []int) {
+	return file_statement_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ConfirmStatementImportResponse) GetExpenses() []*Expense {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+var File_statement_proto protoreflect.FileDescriptor
+
+const file_statement_proto_rawDesc = "" +
+	"\n" +
+	"\x0fstatement.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\x1a\fcommon.proto\x1a\rexpense.proto\"\xae\x05\n" +
+	"\n" +
+	"CsvMapping\x12!\n" +
+	"\tdelimiter\x18\x01 \x01(\tH\x00R\tdelimiter\x88\x01\x01\x12\x1b\n" +
+	"\tno_header\x18\x02 \x01(\bR\bnoHeader\x12\x1b\n" +
+	"\tskip_rows\x18\x03 \x01(\x05R\bskipRows\x12$\n" +
+	"\vdate_column\x18\x04 \x01(\tH\x01R\n" +
+	"dateColumn\x88\x01\x01\x122\n" +
+	"\x12description_column\x18\x05 \x01(\tH\x02R\x11descriptionColumn\x88\x01\x01\x12(\n" +
+	"\ramount_column\x18\x06 \x01(\tH\x03R\famountColumn\x88\x01\x01\x12&\n" +
+	"\fdebit_column\x18\a \x01(\tH\x04R\vdebitColumn\x88\x01\x01\x12(\n" +
+	"\rcredit_column\x18\b \x01(\tH\x05R\fcreditColumn\x88\x01\x01\x12,\n" +
+	"\x0fcurrency_column\x18\t \x01(\tH\x06R\x0ecurrencyColumn\x88\x01\x01\x12.\n" +
+	"\x10reference_column\x18\n" +
+	" \x01(\tH\aR\x0freferenceColumn\x88\x01\x01\x12$\n" +
+	"\vdate_format\x18\v \x01(\tH\bR\n" +
+	"dateFormat\x88\x01\x01\x120\n" +
+	"\x11decimal_separator\x18\f \x01(\tH\tR\x10decimalSeparator\x88\x01\x01B\f\n" +
+	"\n" +
+	"_delimiterB\x0e\n" +
+	"\f_date_columnB\x15\n" +
+	"\x13_description_columnB\x10\n" +
+	"\x0e_amount_columnB\x0f\n" +
+	"\r_debit_columnB\x10\n" +
+	"\x0e_credit_columnB\x12\n" +
+	"\x10_currency_columnB\x13\n" +
+	"\x11_reference_columnB\x0e\n" +
+	"\f_date_formatB\x14\n" +
+	"\x12_decimal_separator\"\xbb\x01\n" +
+	"\x16ImportStatementRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12.\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x16.coloc.StatementFormatR\x06format\x122\n" +
+	"\vcsv_mapping\x18\x04 \x01(\v2\x11.coloc.CsvMappingR\n" +
+	"csvMapping\"o\n" +
+	"\x17ImportStatementResponse\x12+\n" +
+	"\x06drafts\x18\x01 \x03(\v2\x13.coloc.ExpenseDraftR\x06drafts\x12'\n" +
+	"\x0fignored_credits\x18\x02 \x01(\x05R\x0eignoredCredits\"\x88\x03\n" +
+	"\fExpenseDraft\x12\x1c\n" +
+	"\treference\x18\x01 \x01(\tR\treference\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12$\n" +
+	"\x06amount\x18\x04 \x01(\v2\f.coloc.MoneyR\x06amount\x12!\n" +
+	"\fexpense_date\x18\x05 \x01(\tR\vexpenseDate\x122\n" +
+	"\x15suggested_category_id\x18\x06 \x01(\tR\x13suggestedCategoryId\x126\n" +
+	"\x17suggested_category_name\x18\a \x01(\tR\x15suggestedCategoryName\x12)\n" +
+	"\x10already_imported\x18\b \x01(\bR\x0falreadyImported\x125\n" +
+	"\x14duplicate_expense_id\x18\t \x01(\tH\x00R\x12duplicateExpenseId\x88\x01\x01B\x17\n" +
+	"\x15_duplicate_expense_id\"\x82\x03\n" +
+	"\fConfirmDraft\x12\x1c\n" +
+	"\treference\x18\x01 \x01(\tR\treference\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12$\n" +
+	"\x06amount\x18\x04 \x01(\v2\f.coloc.MoneyR\x06amount\x12\x1f\n" +
+	"\vcategory_id\x18\x05 \x01(\tR\n" +
+	"categoryId\x12/\n" +
+	"\n" +
+	"split_type\x18\x06 \x01(\x0e2\x10.coloc.SplitTypeR\tsplitType\x120\n" +
+	"\x06splits\x18\a \x03(\v2\x18.coloc.ExpenseSplitInputR\x06splits\x12!\n" +
+	"\fexpense_date\x18\b \x01(\tR\vexpenseDate\x12(\n" +
+	"\rexchange_rate\x18\t \x01(\tH\x01R\fexchangeRate\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\x10\n" +
+	"\x0e_exchange_rate\"q\n" +
+	"\x1dConfirmStatementImportRequest\x12#\n" +
+	"\rcolocation_id\x18\x01 \x01(\tR\fcolocationId\x12+\n" +
+	"\x06drafts\x18\x02 \x03(\v2\x13.coloc.ConfirmDraftR\x06drafts\"L\n" +
+	"\x1eConfirmStatementImportResponse\x12*\n" +
+	"\bexpenses\x18\x01 \x03(\v2\x0e.coloc.ExpenseR\bexpenses*\x85\x01\n" +
+	"\x0fStatementFormat\x12 \n" +
+	"\x1cSTATEMENT_FORMAT_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14STATEMENT_FORMAT_CSV\x10\x01\x12\x18\n" +
+	"\x14STATEMENT_FORMAT_OFX\x10\x02\x12\x1c\n" +
+	"\x18STATEMENT_FORMAT_CAMT053\x10\x032\xd3\x02\n" +
+	"\x10StatementService\x12\x8f\x01\n" +
+	"\x0fImportStatement\x12\x1d.coloc.ImportStatementRequest\x1a\x1e.coloc.ImportStatementResponse\"=\x82\xd3\xe4\x93\x027:\x01*\"2/api/colocations/{colocation_id}/statement-imports\x12\xac\x01\n" +
+	"\x16ConfirmStatementImport\x12$.coloc.ConfirmStatementImportRequest\x1a%.coloc.ConfirmStatementImportResponse\"E\x82\xd3\xe4\x93\x02?:\x01*\":/api/colocations/{colocation_id}/statement-imports/confirmB,Z*github.com/vblanchet22/back_coloc/proto/pbb\x06proto3"
+
+var (
+	file_statement_proto_rawDescOnce sync.Once
+	file_statement_proto_rawDescData []byte
+)
+
+func file_statement_proto_rawDescGZIP() []byte {
+	file_statement_proto_rawDescOnce.Do(func() {
+		file_statement_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_statement_proto_rawDesc), len(file_statement_proto_rawDesc)))
+	})
+	return file_statement_proto_rawDescData
+}
+
+var file_statement_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_statement_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_statement_proto_goTypes = []any{
+	(StatementFormat)(0),                   // 0: coloc.StatementFormat
+	(*CsvMapping)(nil),                     // 1: coloc.CsvMapping
+	(*ImportStatementRequest)(nil),         // 2: coloc.ImportStatementRequest
+	(*ImportStatementResponse)(nil),        // 3: coloc.ImportStatementResponse
+	(*ExpenseDraft)(nil),                   // 4: coloc.ExpenseDraft
+	(*ConfirmDraft)(nil),                   // 5: coloc.ConfirmDraft
+	(*ConfirmStatementImportRequest)(nil),  // 6: coloc.ConfirmStatementImportRequest
+	(*ConfirmStatementImportResponse)(nil), // 7: coloc.ConfirmStatementImportResponse
+	(*Money)(nil),                          // 8: coloc.Money
+	(SplitType)(0),                         // 9: coloc.SplitType
+	(*ExpenseSplitInput)(nil),              // 10: coloc.ExpenseSplitInput
+	(*Expense)(nil),                        // 11: coloc.Expense
+}
+var file_statement_proto_depIdxs = []int32{
+	0,  // 0: coloc.ImportStatementRequest.format:type_name -> coloc.StatementFormat
+	1,  // 1: coloc.ImportStatementRequest.csv_mapping:type_name -> coloc.CsvMapping
+	4,  // 2: coloc.ImportStatementResponse.drafts:type_name -> coloc.ExpenseDraft
+	8,  // 3: coloc.ExpenseDraft.amount:type_name -> coloc.Money
+	8,  // 4: coloc.ConfirmDraft.amount:type_name -> coloc.Money
+	9,  // 5: coloc.ConfirmDraft.split_type:type_name -> coloc.SplitType
+	10, // 6: coloc.ConfirmDraft.splits:type_name -> coloc.ExpenseSplitInput
+	5,  // 7: coloc.ConfirmStatementImportRequest.drafts:type_name -> coloc.ConfirmDraft
+	11, // 8: coloc.ConfirmStatementImportResponse.expenses:type_name -> coloc.Expense
+	2,  // 9: coloc.StatementService.ImportStatement:input_type -> coloc.ImportStatementRequest
+	6,  // 10: coloc.StatementService.ConfirmStatementImport:input_type -> coloc.ConfirmStatementImportRequest
+	3,  // 11: coloc.StatementService.ImportStatement:output_type -> coloc.ImportStatementResponse
+	7,  // 12: coloc.StatementService.ConfirmStatementImport:output_type -> coloc.ConfirmStatementImportResponse
+	11, // [11:13] is the sub-list for method output_type
+	9,  // [9:11] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_statement_proto_init() }
+func file_statement_proto_init() {
+	if File_statement_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_expense_proto_init()
+	file_statement_proto_msgTypes[0].OneofWrappers = []any{}
+	file_statement_proto_msgTypes[3].OneofWrappers = []any{}
+	file_statement_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_statement_proto_rawDesc), len(file_statement_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_statement_proto_goTypes,
+		DependencyIndexes: file_statement_proto_depIdxs,
+		EnumInfos:         file_statement_proto_enumTypes,
+		MessageInfos:      file_statement_proto_msgTypes,
+	}.Build()
+	File_statement_proto = out.File
+	file_statement_proto_goTypes = nil
+	file_statement_proto_depIdxs = nil
+}
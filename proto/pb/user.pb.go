@@ -111,6 +111,8 @@ type UpdateUserRequest struct {
 	AvatarUrl       *string                `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
 	CurrentPassword *string                `protobuf:"bytes,5,opt,name=current_password,json=currentPassword,proto3,oneof" json:"current_password,omitempty"`
 	NewPassword     *string                `protobuf:"bytes,6,opt,name=new_password,json=newPassword,proto3,oneof" json:"new_password,omitempty"`
+	Iban            *string                `protobuf:"bytes,7,opt,name=iban,proto3,oneof" json:"iban,omitempty"` // Empty to remove the bank details
+	Bic             *string                `protobuf:"bytes,8,opt,name=bic,proto3,oneof" json:"bic,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -187,6 +189,20 @@ func (x *UpdateUserRequest) GetNewPassword() string {
 	return ""
 }
 
+func (x *UpdateUserRequest) GetIban() string {
+	if x != nil && x.Iban != nil {
+		return *x.Iban
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetBic() string {
+	if x != nil && x.Bic != nil {
+		return *x.Bic
+	}
+	return ""
+}
+
 type DeleteCurrentUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -278,6 +294,8 @@ type User struct {
 	IsActive      bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // French formatted datetime
 	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // French formatted datetime
+	Iban          *string                `protobuf:"bytes,10,opt,name=iban,proto3,oneof" json:"iban,omitempty"`                     // Only returned to the user themselves
+	Bic           *string                `protobuf:"bytes,11,opt,name=bic,proto3,oneof" json:"bic,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -375,6 +393,20 @@ func (x *User) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *User) GetIban() string {
+	if x != nil && x.Iban != nil {
+		return *x.Iban
+	}
+	return ""
+}
+
+func (x *User) GetBic() string {
+	if x != nil && x.Bic != nil {
+		return *x.Bic
+	}
+	return ""
+}
+
 var File_user_proto protoreflect.FileDescriptor
 
 const file_user_proto_rawDesc = "" +
@@ -383,7 +415,7 @@ const file_user_proto_rawDesc = "" +
 	"user.proto\x12\x05coloc\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x17\n" +
 	"\x15GetCurrentUserRequest\" \n" +
 	"\x0eGetUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xbc\x02\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xfd\x02\n" +
 	"\x11UpdateUserRequest\x12\x15\n" +
 	"\x03nom\x18\x01 \x01(\tH\x00R\x03nom\x88\x01\x01\x12\x1b\n" +
 	"\x06prenom\x18\x02 \x01(\tH\x01R\x06prenom\x88\x01\x01\x12!\n" +
@@ -391,17 +423,21 @@ const file_user_proto_rawDesc = "" +
 	"\n" +
 	"avatar_url\x18\x04 \x01(\tH\x03R\tavatarUrl\x88\x01\x01\x12.\n" +
 	"\x10current_password\x18\x05 \x01(\tH\x04R\x0fcurrentPassword\x88\x01\x01\x12&\n" +
-	"\fnew_password\x18\x06 \x01(\tH\x05R\vnewPassword\x88\x01\x01B\x06\n" +
+	"\fnew_password\x18\x06 \x01(\tH\x05R\vnewPassword\x88\x01\x01\x12\x17\n" +
+	"\x04iban\x18\a \x01(\tH\x06R\x04iban\x88\x01\x01\x12\x15\n" +
+	"\x03bic\x18\b \x01(\tH\aR\x03bic\x88\x01\x01B\x06\n" +
 	"\x04_nomB\t\n" +
 	"\a_prenomB\f\n" +
 	"\n" +
 	"_telephoneB\r\n" +
 	"\v_avatar_urlB\x13\n" +
 	"\x11_current_passwordB\x0f\n" +
-	"\r_new_password\"\x1a\n" +
+	"\r_new_passwordB\a\n" +
+	"\x05_ibanB\x06\n" +
+	"\x04_bic\"\x1a\n" +
 	"\x18DeleteCurrentUserRequest\".\n" +
 	"\x12DeleteUserResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x95\x02\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd6\x02\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x10\n" +
@@ -414,10 +450,15 @@ const file_user_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\tR\tupdatedAtB\f\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\x12\x17\n" +
+	"\x04iban\x18\n" +
+	" \x01(\tH\x02R\x04iban\x88\x01\x01\x12\x15\n" +
+	"\x03bic\x18\v \x01(\tH\x03R\x03bic\x88\x01\x01B\f\n" +
 	"\n" +
 	"_telephoneB\r\n" +
-	"\v_avatar_url2\xe7\x02\n" +
+	"\v_avatar_urlB\a\n" +
+	"\x05_ibanB\x06\n" +
+	"\x04_bic2\xe7\x02\n" +
 	"\vUserService\x12R\n" +
 	"\x0eGetCurrentUser\x12\x1c.coloc.GetCurrentUserRequest\x1a\v.coloc.User\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/users/me\x12T\n" +
 	"\x11UpdateCurrentUser\x12\x18.coloc.UpdateUserRequest\x1a\v.coloc.User\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\x1a\r/api/users/me\x12f\n" +